@@ -40,6 +40,8 @@ func init() {
 	rootCmd.PersistentFlags().StringP("log-file", "l", "", "path to the log file")
 	rootCmd.PersistentFlags().StringSliceP("toolsets", "t", []string{}, "comma-separated list of toolsets to enable")
 	rootCmd.PersistentFlags().Bool("read-only", false, "run server in read-only mode")
+	rootCmd.PersistentFlags().Bool("block-live-writes", false, "refuse write operations when a live mode (rzp_live_) key is used")
+	rootCmd.PersistentFlags().Int("max-retries", 2, "times a read is retried on server or network errors")
 
 	// bind flags to viper
 	_ = viper.BindPFlag("key", rootCmd.PersistentFlags().Lookup("key"))
@@ -47,6 +49,8 @@ func init() {
 	_ = viper.BindPFlag("log_file", rootCmd.PersistentFlags().Lookup("log-file"))
 	_ = viper.BindPFlag("toolsets", rootCmd.PersistentFlags().Lookup("toolsets"))
 	_ = viper.BindPFlag("read_only", rootCmd.PersistentFlags().Lookup("read-only"))
+	_ = viper.BindPFlag("block_live_writes", rootCmd.PersistentFlags().Lookup("block-live-writes"))
+	_ = viper.BindPFlag("max_retries", rootCmd.PersistentFlags().Lookup("max-retries"))
 
 	// Set environment variable mappings
 	_ = viper.BindEnv("key", "RAZORPAY_KEY_ID")        // Maps RAZORPAY_KEY_ID to key
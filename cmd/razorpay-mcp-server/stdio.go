@@ -53,7 +53,11 @@ var stdioCmd = &cobra.Command{
 		// Get read-only mode from config
 		readOnly := viper.GetBool("read_only")
 
-		err := runStdioServer(ctx, obs, client, enabledToolsets, readOnly)
+		// Get whether write tools refuse to run with a live mode key
+		blockLiveWrites := viper.GetBool("block_live_writes")
+
+		err := runStdioServer(ctx, obs, client, enabledToolsets, readOnly,
+			blockLiveWrites)
 		if err != nil {
 			obs.Logger.Errorf(ctx,
 				"error running stdio server", "error", err)
@@ -68,6 +72,7 @@ func runStdioServer(
 	client *rzpsdk.Client,
 	enabledToolsets []string,
 	readOnly bool,
+	blockLiveWrites bool,
 ) error {
 	ctx, stop := signal.NotifyContext(
 		ctx,
@@ -76,7 +81,8 @@ func runStdioServer(
 	)
 	defer stop()
 
-	srv, err := razorpay.NewRzpMcpServer(obs, client, enabledToolsets, readOnly)
+	srv, err := razorpay.NewRzpMcpServer(
+		obs, client, enabledToolsets, readOnly, blockLiveWrites)
 	if err != nil {
 		return fmt.Errorf("failed to create server: %w", err)
 	}
@@ -95,7 +101,8 @@ func runStdioServer(
 
 	_, _ = fmt.Fprintf(
 		os.Stderr,
-		"Razorpay MCP Server running on stdio\n",
+		"Razorpay MCP Server running on stdio (%s mode)\n",
+		razorpay.KeyMode(client.Request.Auth.Key),
 	)
 
 	// Wait for shutdown signal
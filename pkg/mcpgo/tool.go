@@ -36,6 +36,9 @@ type Tool interface {
 
 	// SetReadOnly sets whether this tool is read-only for annotation purposes
 	SetReadOnly(readOnly bool)
+
+	// WrapHandler replaces the tool's handler with the one wrap returns
+	WrapHandler(wrap func(handler ToolHandler) ToolHandler)
 }
 
 // PropertyOption represents a customization option for
@@ -435,6 +438,13 @@ func (t *mark3labsToolImpl) SetReadOnly(readOnly bool) {
 	t.isReadOnly = readOnly
 }
 
+// WrapHandler replaces the tool's handler with the one wrap returns
+func (t *mark3labsToolImpl) WrapHandler(
+	wrap func(handler ToolHandler) ToolHandler,
+) {
+	t.handler = wrap(t.handler)
+}
+
 // toMCPServerTool converts our Tool to mcp's ServerTool
 func (t *mark3labsToolImpl) toMCPServerTool() server.ServerTool {
 	// Create the mcp tool with appropriate options
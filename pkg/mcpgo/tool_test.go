@@ -983,6 +983,30 @@ func TestSetReadOnly(t *testing.T) {
 	})
 }
 
+func TestWrapHandler(t *testing.T) {
+	handler := func(
+		ctx context.Context, req CallToolRequest,
+	) (*ToolResult, error) {
+		return NewToolResultText("success"), nil
+	}
+	tool := NewTool(
+		"test-tool", "Test description", []ToolParameter{}, handler)
+
+	tool.WrapHandler(func(next ToolHandler) ToolHandler {
+		return func(
+			ctx context.Context, req CallToolRequest,
+		) (*ToolResult, error) {
+			result, err := next(ctx, req)
+			result.Text = "wrapped " + result.Text
+			return result, err
+		}
+	})
+
+	result, err := tool.GetHandler()(context.Background(), CallToolRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, "wrapped success", result.Text)
+}
+
 func TestToolAnnotations(t *testing.T) {
 	t.Run("read-only tool has correct annotations", func(t *testing.T) {
 		handler := func(
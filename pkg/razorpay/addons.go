@@ -0,0 +1,208 @@
+package razorpay
+
+import (
+	"context"
+	"fmt"
+
+	rzpsdk "github.com/razorpay/razorpay-go"
+
+	"github.com/razorpay/razorpay-mcp-server/pkg/mcpgo"
+	"github.com/razorpay/razorpay-mcp-server/pkg/observability"
+)
+
+// CreateAddon returns a tool that adds a one-time charge to a subscription
+func CreateAddon(
+	obs *observability.Observability,
+	client *rzpsdk.Client,
+) mcpgo.Tool {
+	parameters := []mcpgo.ToolParameter{
+		mcpgo.WithString(
+			"subscription_id",
+			mcpgo.Description("Unique identifier of the subscription the "+
+				"add-on is charged with. For example, 'sub_00000000000001'"),
+			mcpgo.Required(),
+		),
+		mcpgo.WithString(
+			"item_name",
+			mcpgo.Description("Name of the add-on, shown on the invoice"),
+			mcpgo.Required(),
+		),
+		mcpgo.WithNumber(
+			"item_amount",
+			mcpgo.Description("Amount of the add-on in the smallest currency "+
+				"sub-unit (e.g., for ₹30, use 3000)"),
+			mcpgo.Required(),
+			mcpgo.Min(100),
+		),
+		mcpgo.WithString(
+			"item_currency",
+			mcpgo.Description("ISO code for the currency. Must match the "+
+				"subscription's plan (e.g., INR)"),
+			mcpgo.Required(),
+			mcpgo.Pattern("^[A-Z]{3}$"),
+		),
+		mcpgo.WithString(
+			"item_description",
+			mcpgo.Description("Description of the add-on"),
+		),
+		mcpgo.WithNumber(
+			"quantity",
+			mcpgo.Description("Number of units of the add-on charged "+
+				"(default: 1)"),
+			mcpgo.Min(1),
+		),
+	}
+
+	handler := func(
+		ctx context.Context,
+		r mcpgo.CallToolRequest,
+	) (*mcpgo.ToolResult, error) {
+		client, err := getClientFromContextOrDefault(ctx, client)
+		if err != nil {
+			return mcpgo.NewToolResultError(err.Error()), nil
+		}
+
+		params := make(map[string]interface{})
+		item := make(map[string]interface{})
+		payload := make(map[string]interface{})
+
+		validator := NewValidator(&r).
+			ValidateAndAddRequiredString(params, "subscription_id").
+			ValidateAndAddRequiredString(params, "item_name").
+			ValidateAndAddRequiredInt(params, "item_amount").
+			ValidateAndAddRequiredString(params, "item_currency").
+			ValidateAndAddOptionalStringToPath(
+				item, "item_description", "description").
+			ValidateAndAddOptionalInt(payload, "quantity")
+
+		if result, err := validator.HandleErrorsIfAny(); result != nil {
+			return result, err
+		}
+
+		item["name"] = params["item_name"]
+		item["amount"] = params["item_amount"]
+		item["currency"] = params["item_currency"]
+		payload["item"] = item
+
+		addon, err := client.Subscription.CreateAddon(
+			params["subscription_id"].(string), payload, nil)
+		if err != nil {
+			return mcpgo.NewToolResultError(
+				fmt.Sprintf("creating addon failed: %s", err.Error())), nil
+		}
+
+		return mcpgo.NewToolResultJSON(addon)
+	}
+
+	return mcpgo.NewTool(
+		"create_addon",
+		"Add a one-time charge (add-on) to a subscription. The add-on is "+
+			"billed with the subscription's next invoice",
+		parameters,
+		handler,
+	)
+}
+
+// FetchAddon returns a tool that fetches an add-on by ID
+func FetchAddon(
+	obs *observability.Observability,
+	client *rzpsdk.Client,
+) mcpgo.Tool {
+	parameters := []mcpgo.ToolParameter{
+		mcpgo.WithString(
+			"addon_id",
+			mcpgo.Description("Unique identifier of the add-on. "+
+				"For example, 'ao_00000000000001'"),
+			mcpgo.Required(),
+		),
+	}
+
+	handler := func(
+		ctx context.Context,
+		r mcpgo.CallToolRequest,
+	) (*mcpgo.ToolResult, error) {
+		client, err := getClientFromContextOrDefault(ctx, client)
+		if err != nil {
+			return mcpgo.NewToolResultError(err.Error()), nil
+		}
+
+		params := make(map[string]interface{})
+
+		validator := NewValidator(&r).
+			ValidateAndAddRequiredString(params, "addon_id")
+
+		if result, err := validator.HandleErrorsIfAny(); result != nil {
+			return result, err
+		}
+
+		addon, err := client.Addon.Fetch(params["addon_id"].(string), nil, nil)
+		if err != nil {
+			return mcpgo.NewToolResultError(
+				fmt.Sprintf("fetching addon failed: %s", err.Error())), nil
+		}
+
+		return mcpgo.NewToolResultJSON(addon)
+	}
+
+	return mcpgo.NewTool(
+		"fetch_addon",
+		"Fetch an add-on's details using its ID",
+		parameters,
+		handler,
+	)
+}
+
+// DeleteAddon returns a tool that deletes an add-on
+func DeleteAddon(
+	obs *observability.Observability,
+	client *rzpsdk.Client,
+) mcpgo.Tool {
+	parameters := []mcpgo.ToolParameter{
+		mcpgo.WithString(
+			"addon_id",
+			mcpgo.Description("Unique identifier of the add-on to be "+
+				"deleted. For example, 'ao_00000000000001'"),
+			mcpgo.Required(),
+		),
+	}
+
+	handler := func(
+		ctx context.Context,
+		r mcpgo.CallToolRequest,
+	) (*mcpgo.ToolResult, error) {
+		client, err := getClientFromContextOrDefault(ctx, client)
+		if err != nil {
+			return mcpgo.NewToolResultError(err.Error()), nil
+		}
+
+		params := make(map[string]interface{})
+
+		validator := NewValidator(&r).
+			ValidateAndAddRequiredString(params, "addon_id")
+
+		if result, err := validator.HandleErrorsIfAny(); result != nil {
+			return result, err
+		}
+
+		addonID := params["addon_id"].(string)
+
+		// The API responds with an empty body on success
+		if _, err := client.Addon.Delete(addonID, nil, nil); err != nil {
+			return mcpgo.NewToolResultError(
+				fmt.Sprintf("deleting addon failed: %s", err.Error())), nil
+		}
+
+		return mcpgo.NewToolResultJSON(map[string]interface{}{
+			"id":      addonID,
+			"deleted": true,
+		})
+	}
+
+	return mcpgo.NewTool(
+		"delete_addon",
+		"Delete an add-on. Only add-ons that have not been billed yet can "+
+			"be deleted",
+		parameters,
+		handler,
+	)
+}
@@ -0,0 +1,213 @@
+package razorpay
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/razorpay/razorpay-go/constants"
+
+	"github.com/razorpay/razorpay-mcp-server/pkg/razorpay/mock"
+)
+
+func Test_CreateAddon(t *testing.T) {
+	createAddonPath := fmt.Sprintf(
+		"/%s%s/%s/addons",
+		constants.VERSION_V1,
+		constants.SUBSCRIPTION_URL,
+		"sub_00000000000001",
+	)
+
+	addonResp := map[string]interface{}{
+		"id":     "ao_00000000000001",
+		"entity": "addon",
+		"item": map[string]interface{}{
+			"name":     "Extra appala (papadum)",
+			"amount":   float64(3000),
+			"currency": "INR",
+		},
+		"quantity":        float64(2),
+		"subscription_id": "sub_00000000000001",
+	}
+
+	tests := []RazorpayToolTestCase{
+		{
+			Name: "successful addon creation",
+			Request: map[string]interface{}{
+				"subscription_id":  "sub_00000000000001",
+				"item_name":        "Extra appala (papadum)",
+				"item_amount":      float64(3000),
+				"item_currency":    "INR",
+				"item_description": "1 extra oil fried appala with meals",
+				"quantity":         float64(2),
+			},
+			MockHttpClient: func() (*http.Client, *httptest.Server) {
+				return mock.NewHTTPClient(
+					mock.Endpoint{
+						Path:     createAddonPath,
+						Method:   "POST",
+						Response: addonResp,
+					},
+				)
+			},
+			ExpectError:    false,
+			ExpectedResult: addonResp,
+		},
+		{
+			Name: "addon creation fails",
+			Request: map[string]interface{}{
+				"subscription_id": "sub_00000000000001",
+				"item_name":       "Extra appala (papadum)",
+				"item_amount":     float64(3000),
+				"item_currency":   "USD",
+			},
+			MockHttpClient: func() (*http.Client, *httptest.Server) {
+				return mock.NewHTTPClient(
+					mock.Endpoint{
+						Path:   createAddonPath,
+						Method: "POST",
+						Response: map[string]interface{}{
+							"error": map[string]interface{}{
+								"code": "BAD_REQUEST_ERROR",
+								"description": "Currency of the addon should " +
+									"match the plan currency",
+							},
+						},
+					},
+				)
+			},
+			ExpectError: true,
+			ExpectedErrMsg: "creating addon failed: " +
+				"Currency of the addon should match the plan currency",
+		},
+		{
+			Name: "missing subscription_id parameter",
+			Request: map[string]interface{}{
+				"item_name":     "Extra appala (papadum)",
+				"item_amount":   float64(3000),
+				"item_currency": "INR",
+			},
+			MockHttpClient: nil,
+			ExpectError:    true,
+			ExpectedErrMsg: "missing required parameter: subscription_id",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.Name, func(t *testing.T) {
+			runToolTest(t, tc, CreateAddon, "Addon")
+		})
+	}
+}
+
+func Test_FetchAddon(t *testing.T) {
+	fetchAddonPath := fmt.Sprintf(
+		"/%s%s/%s",
+		constants.VERSION_V1,
+		constants.ADDON_URL,
+		"ao_00000000000001",
+	)
+
+	addonResp := map[string]interface{}{
+		"id":              "ao_00000000000001",
+		"entity":          "addon",
+		"quantity":        float64(2),
+		"subscription_id": "sub_00000000000001",
+	}
+
+	tests := []RazorpayToolTestCase{
+		{
+			Name: "successful addon fetch",
+			Request: map[string]interface{}{
+				"addon_id": "ao_00000000000001",
+			},
+			MockHttpClient: func() (*http.Client, *httptest.Server) {
+				return mock.NewHTTPClient(
+					mock.Endpoint{
+						Path:     fetchAddonPath,
+						Method:   "GET",
+						Response: addonResp,
+					},
+				)
+			},
+			ExpectError:    false,
+			ExpectedResult: addonResp,
+		},
+		{
+			Name:           "missing addon_id parameter",
+			Request:        map[string]interface{}{},
+			MockHttpClient: nil,
+			ExpectError:    true,
+			ExpectedErrMsg: "missing required parameter: addon_id",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.Name, func(t *testing.T) {
+			runToolTest(t, tc, FetchAddon, "Addon")
+		})
+	}
+}
+
+func Test_DeleteAddon(t *testing.T) {
+	deleteAddonPath := fmt.Sprintf(
+		"/%s%s/%s",
+		constants.VERSION_V1,
+		constants.ADDON_URL,
+		"ao_00000000000001",
+	)
+
+	tests := []RazorpayToolTestCase{
+		{
+			Name: "successful addon deletion",
+			Request: map[string]interface{}{
+				"addon_id": "ao_00000000000001",
+			},
+			MockHttpClient: func() (*http.Client, *httptest.Server) {
+				return mock.NewHTTPClient(
+					mock.Endpoint{
+						Path:     deleteAddonPath,
+						Method:   "DELETE",
+						Response: []byte("[]"),
+					},
+				)
+			},
+			ExpectError: false,
+			ExpectedResult: map[string]interface{}{
+				"id":      "ao_00000000000001",
+				"deleted": true,
+			},
+		},
+		{
+			Name: "addon already billed",
+			Request: map[string]interface{}{
+				"addon_id": "ao_00000000000001",
+			},
+			MockHttpClient: func() (*http.Client, *httptest.Server) {
+				return mock.NewHTTPClient(
+					mock.Endpoint{
+						Path:   deleteAddonPath,
+						Method: "DELETE",
+						Response: map[string]interface{}{
+							"error": map[string]interface{}{
+								"code": "BAD_REQUEST_ERROR",
+								"description": "Addon cannot be deleted " +
+									"as it has been invoiced",
+							},
+						},
+					},
+				)
+			},
+			ExpectError: true,
+			ExpectedErrMsg: "deleting addon failed: " +
+				"Addon cannot be deleted as it has been invoiced",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.Name, func(t *testing.T) {
+			runToolTest(t, tc, DeleteAddon, "Addon")
+		})
+	}
+}
@@ -0,0 +1,105 @@
+package razorpay
+
+import (
+	"context"
+	"fmt"
+
+	rzpsdk "github.com/razorpay/razorpay-go"
+	"github.com/razorpay/razorpay-go/constants"
+
+	"github.com/razorpay/razorpay-mcp-server/pkg/mcpgo"
+	"github.com/razorpay/razorpay-mcp-server/pkg/observability"
+)
+
+// FetchBrandingConfig returns a tool that fetches the brand name, logo and
+// theme color configured for the account's checkout
+func FetchBrandingConfig(
+	obs *observability.Observability,
+	client *rzpsdk.Client,
+) mcpgo.Tool {
+	parameters := []mcpgo.ToolParameter{}
+
+	handler := func(
+		ctx context.Context,
+		r mcpgo.CallToolRequest,
+	) (*mcpgo.ToolResult, error) {
+		client, err := getClientFromContextOrDefault(ctx, client)
+		if err != nil {
+			return mcpgo.NewToolResultError(err.Error()), nil
+		}
+
+		url := fmt.Sprintf("/%s/preferences", constants.VERSION_V1)
+		preferences, err := client.Request.Get(
+			url,
+			map[string]interface{}{"key_id": client.Request.Auth.Key},
+			nil,
+		)
+		if err != nil {
+			return mcpgo.NewToolResultError(
+				fmt.Sprintf("fetching branding config failed: %s", err.Error())), nil
+		}
+
+		return mcpgo.NewToolResultJSON(buildBrandingConfig(preferences))
+	}
+
+	return mcpgo.NewTool(
+		"fetch_branding_config",
+		"Fetch the brand name, logo URL and theme color configured for the "+
+			"account's checkout in the dashboard. Pass them to "+
+			"integrate_razorpay_checkout as brandName, brandLogo and themeColor "+
+			"so the generated checkout matches the dashboard branding. Fields "+
+			"the account has not configured are listed in 'missing'",
+		parameters,
+		handler,
+	)
+}
+
+// buildBrandingConfig extracts the checkout branding from the account
+// preferences. The brand values live under options when set from the
+// dashboard, with the merchant name as a fallback for the brand name
+func buildBrandingConfig(
+	preferences map[string]interface{},
+) map[string]interface{} {
+	options, _ := preferences["options"].(map[string]interface{})
+
+	brandName := firstString(options["name"], preferences["merchant_name"])
+	logoURL := firstString(options["image"], preferences["merchant_logo"])
+
+	themeColor := ""
+	if theme, ok := options["theme"].(map[string]interface{}); ok {
+		themeColor = firstString(theme["color"])
+	}
+	if themeColor == "" {
+		themeColor = firstString(preferences["merchant_brand_color"])
+	}
+
+	result := map[string]interface{}{}
+	missing := []string{}
+	for _, field := range []struct {
+		key   string
+		value string
+	}{
+		{"brand_name", brandName},
+		{"logo_url", logoURL},
+		{"theme_color", themeColor},
+	} {
+		if field.value == "" {
+			missing = append(missing, field.key)
+			continue
+		}
+		result[field.key] = field.value
+	}
+	result["missing"] = missing
+
+	return result
+}
+
+// firstString returns the first value that is a non-empty string
+func firstString(values ...interface{}) string {
+	for _, v := range values {
+		if s, ok := v.(string); ok && s != "" {
+			return s
+		}
+	}
+	return ""
+}
@@ -0,0 +1,98 @@
+package razorpay
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/razorpay/razorpay-go/constants"
+
+	"github.com/razorpay/razorpay-mcp-server/pkg/razorpay/mock"
+)
+
+func Test_FetchBrandingConfig(t *testing.T) {
+	fetchPreferencesPath := fmt.Sprintf(
+		"/%s/preferences",
+		constants.VERSION_V1,
+	)
+
+	tests := []RazorpayToolTestCase{
+		{
+			Name:    "branding configured in the dashboard",
+			Request: map[string]interface{}{},
+			MockHttpClient: func() (*http.Client, *httptest.Server) {
+				return mock.NewHTTPClient(
+					mock.Endpoint{
+						Path:   fetchPreferencesPath,
+						Method: "GET",
+						Response: map[string]interface{}{
+							"merchant_name": "Acme Legal Pvt Ltd",
+							"options": map[string]interface{}{
+								"name":  "Acme",
+								"image": "https://cdn.razorpay.com/logos/acme.png",
+								"theme": map[string]interface{}{
+									"color": "#F37254",
+								},
+							},
+						},
+					},
+				)
+			},
+			ExpectError: false,
+			ExpectedResult: map[string]interface{}{
+				"brand_name":  "Acme",
+				"logo_url":    "https://cdn.razorpay.com/logos/acme.png",
+				"theme_color": "#F37254",
+				"missing":     []interface{}{},
+			},
+		},
+		{
+			Name:    "falls back to the merchant name",
+			Request: map[string]interface{}{},
+			MockHttpClient: func() (*http.Client, *httptest.Server) {
+				return mock.NewHTTPClient(
+					mock.Endpoint{
+						Path:   fetchPreferencesPath,
+						Method: "GET",
+						Response: map[string]interface{}{
+							"merchant_name": "Acme Legal Pvt Ltd",
+						},
+					},
+				)
+			},
+			ExpectError: false,
+			ExpectedResult: map[string]interface{}{
+				"brand_name": "Acme Legal Pvt Ltd",
+				"missing":    []interface{}{"logo_url", "theme_color"},
+			},
+		},
+		{
+			Name:    "preferences fetch fails",
+			Request: map[string]interface{}{},
+			MockHttpClient: func() (*http.Client, *httptest.Server) {
+				return mock.NewHTTPClient(
+					mock.Endpoint{
+						Path:   fetchPreferencesPath,
+						Method: "GET",
+						Response: map[string]interface{}{
+							"error": map[string]interface{}{
+								"code":        "BAD_REQUEST_ERROR",
+								"description": "The api key provided is invalid",
+							},
+						},
+					},
+				)
+			},
+			ExpectError: true,
+			ExpectedErrMsg: "fetching branding config failed: " +
+				"The api key provided is invalid",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.Name, func(t *testing.T) {
+			runToolTest(t, tc, FetchBrandingConfig, "Branding Config")
+		})
+	}
+}
@@ -3,6 +3,12 @@ package razorpay
 import (
 	"context"
 	"encoding/json"
+	"fmt"
+	"path"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
 
 	rzpsdk "github.com/razorpay/razorpay-go"
 	"github.com/spf13/viper"
@@ -55,18 +61,58 @@ type IntegrateCheckoutOutput struct {
 	Dependencies     []Dependency `json:"dependencies"`
 	EnvVars          []EnvVar     `json:"envVars"`
 	TestInstructions string       `json:"testInstructions"`
-	AIInstructions   string       `json:"aiInstructions"`
+	// AIInstructions is rendered from Steps and kept for agents that read
+	// the free-text form
+	AIInstructions string            `json:"aiInstructions"`
+	Steps          []IntegrationStep `json:"steps"`
+	// Webhooks are the events to enable in the Dashboard so the server
+	// learns about payments the browser never reported back
+	Webhooks            []WebhookConfig `json:"webhooks,omitempty"`
+	WebhookDashboardURL string          `json:"webhookDashboardUrl,omitempty"`
+}
+
+// WebhookConfig is a Razorpay webhook event the merchant should enable
+type WebhookConfig struct {
+	Event       string `json:"event"`
+	Description string `json:"description"`
+}
+
+// IntegrationStep is one machine-readable step of applying an integration.
+// Action is one of install, set_env, create_file, edit_file, wire_payment or
+// note; Target is the package, env var or file the step applies to.
+type IntegrationStep struct {
+	Order  int    `json:"order"`
+	Action string `json:"action"`
+	Target string `json:"target,omitempty"`
+	Detail string `json:"detail"`
 }
 
-// DetectStackOutput is the response from detect_stack
+// DetectStackOutput is the response from detect_stack. Signals lists the
+// evidence the detection was based on.
 type DetectStackOutput struct {
-	Language       string   `json:"language"`
-	Framework      string   `json:"framework"`
-	Frontend       string   `json:"frontend,omitempty"`
-	PackageManager string   `json:"packageManager"`
-	IsFullStack    bool     `json:"isFullStack"`
-	Confidence     float64  `json:"confidence"`
-	Notes          []string `json:"notes"`
+	Language       string            `json:"language"`
+	Runtime        string            `json:"runtime,omitempty"`
+	Framework      string            `json:"framework"`
+	Frontend       string            `json:"frontend,omitempty"`
+	PackageManager string            `json:"packageManager"`
+	IsFullStack    bool              `json:"isFullStack"`
+	Styling        string            `json:"styling,omitempty"`
+	NextRouter     string            `json:"nextRouter,omitempty"`
+	IsMonorepo     bool              `json:"isMonorepo,omitempty"`
+	Workspaces     []string          `json:"workspaces,omitempty"`
+	Confidence     float64           `json:"confidence"`
+	Signals        []DetectionSignal `json:"signals"`
+	Notes          []string          `json:"notes"`
+}
+
+// DetectionSignal is one piece of evidence detect_stack found. Kind is
+// manifest, file, dependency, lockfile or workspace, and Weight, from 0 to
+// 1, is how strongly it points at the detected stack. A default signal has
+// weight 0 and records an assumption made for lack of evidence.
+type DetectionSignal struct {
+	Kind   string  `json:"kind"`
+	Value  string  `json:"value"`
+	Weight float64 `json:"weight"`
 }
 
 // IntegrateRazorpayCheckout returns a tool for complete Razorpay checkout integration
@@ -77,21 +123,31 @@ func IntegrateRazorpayCheckout(
 	parameters := []mcpgo.ToolParameter{
 		mcpgo.WithString(
 			"language",
-			mcpgo.Description("Programming language: javascript, typescript, python, or go"),
+			mcpgo.Description("Programming language: javascript, typescript, python, go, ruby, java, csharp, or dart"),
 			mcpgo.Required(),
-			mcpgo.Enum("javascript", "typescript", "python", "go"),
+			mcpgo.Enum("javascript", "typescript", "python", "go", "ruby", "java", "csharp", "dart"),
 		),
 		mcpgo.WithString(
 			"backendFramework",
-			mcpgo.Description("Backend framework: express, nextjs, django, flask, fastapi, gin, echo, or fiber"),
+			mcpgo.Description("Backend framework: express, nextjs, nuxt, remix, astro, adonis, django, flask, fastapi, gin, echo, fiber, chi, rails, spring, aspnet, "+
+				"or bun (a Bun.serve server with no framework, for Bun projects detect_stack reports as framework=bun)"),
 			mcpgo.Required(),
-			mcpgo.Enum("express", "nextjs", "django", "flask", "fastapi", "gin", "echo", "fiber"),
+			mcpgo.Enum("express", "nextjs", "nuxt", "remix", "astro", "adonis", "django", "flask", "fastapi", "gin", "echo", "fiber", "chi", "rails", "spring", "aspnet", "bun"),
 		),
 		mcpgo.WithString(
 			"frontendFramework",
-			mcpgo.Description("Frontend framework: vanilla, react, nextjs, vue, angular, or svelte"),
+			mcpgo.Description("Frontend framework: vanilla, react, nextjs, vue, angular, svelte, "+
+				"react-native, or flutter. For flutter, backendFramework is the separate backend "+
+				"that creates the orders"),
 			mcpgo.Required(),
-			mcpgo.Enum("vanilla", "react", "nextjs", "vue", "angular", "svelte"),
+			mcpgo.Enum("vanilla", "react", "nextjs", "vue", "angular", "svelte", "react-native", "flutter"),
+		),
+		mcpgo.WithString(
+			"vueStyle",
+			mcpgo.Description("Component style for frontendFramework=vue: composition (<script setup>, Vue 3) "+
+				"or options (data, methods and mounted; works in Vue 2 and 3). Defaults to options when "+
+				"packageJson lists vue 2.x, composition otherwise"),
+			mcpgo.Enum("composition", "options"),
 		),
 		mcpgo.WithString(
 			"existingOrderEndpoint",
@@ -101,6 +157,115 @@ func IntegrateRazorpayCheckout(
 			"existingPaymentFunction",
 			mcpgo.Description("Existing payment/checkout function name in frontend if any"),
 		),
+		mcpgo.WithString(
+			"amountSource",
+			mcpgo.Description("Where the order amount comes from: client (sent by the frontend) or "+
+				"server (looked up from a server-side price map by product_id, never trusting the client). "+
+				"server is supported for gin, echo, fiber and chi. Default: client"),
+			mcpgo.Enum("client", "server"),
+		),
+		mcpgo.WithString(
+			"orderDataStrategy",
+			mcpgo.Description("Where cart/order data is kept while the customer pays: client "+
+				"(localStorage in the browser) or server (a map from Razorpay order_id to cart data, "+
+				"filled at order creation and read when the payment is verified). "+
+				"server is supported for express. Default: client"),
+			mcpgo.Enum("client", "server"),
+		),
+		mcpgo.WithBoolean(
+			"strictEnv",
+			mcpgo.Description("Emit a validated config module that throws a clear error at startup "+
+				"when a required env var is missing, instead of process.env.X! non-null assertions. "+
+				"Applies to TypeScript generators (nextjs, remix, nuxt, express). Default: false"),
+		),
+		mcpgo.WithBoolean(
+			"includeEnvCheck",
+			mcpgo.Description("Also emit a small pre-flight script (Node, Python, Go or shell, matching "+
+				"the backend) that reads the env file and prints PASS/FAIL depending on whether "+
+				"RAZORPAY_KEY_ID starts with rzp_ and the key secret is not a placeholder. Default: false"),
+		),
+		mcpgo.WithBoolean(
+			"includeAmountInput",
+			mcpgo.Description("Also emit a small form, for the chosen frontend, with an amount field "+
+				"that feeds the pay button so different amounts can be tested right away. The "+
+				"button stays disabled until the amount is numeric and positive. Default: false"),
+		),
+		mcpgo.WithBoolean(
+			"includeRateLimit",
+			mcpgo.Description("Rate limit the generated order endpoint to 10 requests a minute per client IP, "+
+				"so it cannot be used to spam order creation. Uses express-rate-limit (express), slowapi "+
+				"(fastapi), Flask-Limiter (flask), a token bucket (gin), the framework's limiter middleware "+
+				"(echo, fiber) or httprate (chi); other backends get instructions instead. Default: false"),
+		),
+		mcpgo.WithBoolean(
+			"includeTelemetry",
+			mcpgo.Description("Wrap the generated order and verify handlers in OpenTelemetry spans tagged "+
+				"with the Razorpay order id and the outcome, plus the SDK setup to export them over OTLP. "+
+				"Rewrites the express, fastapi and flask handlers; other backends get instructions instead. "+
+				"Adds OpenTelemetry dependencies, so it is off by default. Default: false"),
+		),
+		mcpgo.WithString(
+			"nextRouter",
+			mcpgo.Description("Next.js router the project uses: app (app/api/.../route.ts handlers) or pages "+
+				"(pages/api/... handlers with NextApiRequest/NextApiResponse). Use the nextRouter reported by "+
+				"detect_stack. Default: app"),
+			mcpgo.Enum("app", "pages"),
+		),
+		mcpgo.WithBoolean(
+			"dryRun",
+			mcpgo.Description("Return only the plan: the files list with path, action and description "+
+				"but no code, plus the dependencies and env vars. Call again without dryRun once the "+
+				"plan is approved. Default: false"),
+		),
+		mcpgo.WithString(
+			"styling",
+			mcpgo.Description("How the generated pay button is styled: tailwind (utility classes), "+
+				"inline (style attribute, works without any CSS setup) or none (unstyled, "+
+				"bring your own className). Applies to the nextjs frontend. Defaults to tailwind "+
+				"when packageJson lists tailwindcss, inline when it does not, and tailwind when "+
+				"packageJson is not given"),
+			mcpgo.Enum("tailwind", "inline", "none"),
+		),
+		mcpgo.WithBoolean(
+			"allowRetry",
+			mcpgo.Description("Let the customer retry a failed payment in the same checkout modal. "+
+				"Sets options.retry = { enabled: true, max_count: retryMaxCount } in the frontend and "+
+				"only reports a failure once the retries are used up. Default: false"),
+		),
+		mcpgo.WithBoolean(
+			"exposePublicKey",
+			mcpgo.Description("Read the key id in the frontend from a public, build-time env var "+
+				"(NEXT_PUBLIC_RAZORPAY_KEY_ID for nextjs, PUBLIC_RAZORPAY_KEY_ID for astro, "+
+				"VITE_RAZORPAY_KEY_ID for Vite react, vue and svelte apps) instead of the keyId field "+
+				"of the order response. The key secret stays server-only. Other frontends keep "+
+				"using keyId. Default: false"),
+		),
+		mcpgo.WithNumber(
+			"retryMaxCount",
+			mcpgo.Description("Number of retries allowed when allowRetry is set. Default: 4"),
+			mcpgo.Min(1),
+		),
+		mcpgo.WithString(
+			"brandName",
+			mcpgo.Description("Business name shown in the checkout modal. Use the brand_name from "+
+				"fetch_branding_config. Default: 'Payment'"),
+		),
+		mcpgo.WithString(
+			"brandLogo",
+			mcpgo.Description("HTTPS URL of the logo shown in the checkout modal. Use the logo_url "+
+				"from fetch_branding_config"),
+		),
+		mcpgo.WithString(
+			"themeColor",
+			mcpgo.Description("Hex color of the checkout modal (e.g., #F37254). Use the theme_color "+
+				"from fetch_branding_config. Default: #528FF0"),
+			mcpgo.Pattern("^#[0-9a-fA-F]{6}$"),
+		),
+		mcpgo.WithObject(
+			"packageJson",
+			mcpgo.Description("Contents of package.json if it exists. Used to pick the default styling "+
+				"and vueStyle"),
+		),
 	}
 
 	handler := func(
@@ -115,6 +280,39 @@ func IntegrateRazorpayCheckout(
 		language, _ := args["language"].(string)
 		backendFramework, _ := args["backendFramework"].(string)
 		frontendFramework, _ := args["frontendFramework"].(string)
+		amountSource, _ := args["amountSource"].(string)
+		strictEnv, _ := args["strictEnv"].(bool)
+		orderDataStrategy, _ := args["orderDataStrategy"].(string)
+		includeEnvCheck, _ := args["includeEnvCheck"].(bool)
+		includeAmountInput, _ := args["includeAmountInput"].(bool)
+		includeRateLimit, _ := args["includeRateLimit"].(bool)
+		includeTelemetry, _ := args["includeTelemetry"].(bool)
+		dryRun, _ := args["dryRun"].(bool)
+		nextRouter, _ := args["nextRouter"].(string)
+		branding := checkoutBranding{}
+		branding.Name, _ = args["brandName"].(string)
+		branding.Image, _ = args["brandLogo"].(string)
+		branding.ThemeColor, _ = args["themeColor"].(string)
+		allowRetry, _ := args["allowRetry"].(bool)
+		exposePublicKey, _ := args["exposePublicKey"].(bool)
+		retryMaxCount := defaultCheckoutRetryMaxCount
+		if n, ok := args["retryMaxCount"].(float64); ok && n >= 1 {
+			retryMaxCount = int(n)
+		}
+		styling, _ := args["styling"].(string)
+		if styling == "" {
+			styling = "tailwind"
+			if packageJson, ok := args["packageJson"].(map[string]interface{}); ok && !usesTailwind(packageJson) {
+				styling = "inline"
+			}
+		}
+		vueStyle, _ := args["vueStyle"].(string)
+		if vueStyle == "" {
+			vueStyle = "composition"
+			if packageJson, ok := args["packageJson"].(map[string]interface{}); ok && vueMajorVersion(packageJson) == 2 {
+				vueStyle = "options"
+			}
+		}
 
 		// Get credentials from config (set via MCP config env vars)
 		creds := Credentials{
@@ -126,9 +324,17 @@ func IntegrateRazorpayCheckout(
 
 		// Get frontend code based on frontend framework
 		frontendCode := getFrontendIntegration(frontendFramework, creds)
+		if frontendFramework == "vue" && vueStyle == "options" {
+			frontendCode = getVueOptionsFrontend()
+		}
 
-		// Route to appropriate backend integration
-		switch backendFramework {
+		// Route to appropriate backend integration. Flutter is client-only,
+		// so it gets the Dart client plus guidance for the backend it pairs with
+		route := backendFramework
+		if frontendFramework == "flutter" || language == "dart" {
+			route = "flutter"
+		}
+		switch route {
 		case "django":
 			output = getDjangoIntegration(creds, frontendCode)
 		case "flask":
@@ -136,17 +342,154 @@ func IntegrateRazorpayCheckout(
 		case "fastapi":
 			output = getFastAPIIntegration(creds, frontendCode)
 		case "gin":
-			output = getGinIntegration(creds, frontendCode)
+			output = getGinIntegration(creds, frontendCode, amountSource)
 		case "echo":
-			output = getEchoIntegration(creds, frontendCode)
+			output = getEchoIntegration(creds, frontendCode, amountSource)
 		case "fiber":
-			output = getFiberIntegration(creds, frontendCode)
+			output = getFiberIntegration(creds, frontendCode, amountSource)
+		case "chi":
+			output = getChiIntegration(creds, frontendCode, amountSource)
+		case "rails":
+			output = getRailsIntegration(creds, frontendCode)
+		case "spring":
+			output = getSpringBootIntegration(creds, frontendCode)
+		case "aspnet":
+			output = getAspNetIntegration(creds, frontendCode)
+		case "adonis":
+			output = getAdonisIntegration(creds, frontendCode)
 		case "nextjs":
-			output = getNextjsReactIntegration(language, creds)
+			output = getNextjsReactIntegration(language, creds, strictEnv, styling, nextRouter)
+		case "nuxt":
+			output = getNuxtIntegration(creds, strictEnv)
+		case "remix":
+			output = getRemixIntegration(creds, strictEnv)
+		case "astro":
+			output = getAstroIntegration(creds)
+		case "bun":
+			output = getBunIntegration(language, creds, frontendCode)
+		case "flutter":
+			output = getFlutterIntegration(backendFramework)
 		default: // express
-			output = getExpressVanillaIntegration(language, creds, frontendCode)
+			output = getExpressVanillaIntegration(language, creds, frontendCode, orderDataStrategy, strictEnv)
+		}
+
+		// Vanilla-style frontends in TypeScript projects need a declaration
+		// for the window.Razorpay global to compile without casts
+		if language == "typescript" && frontendFramework == "vanilla" &&
+			!emitsOwnFrontend(backendFramework) {
+			output.Files = append(output.Files, getRazorpayTypeDeclaration())
+			output.AIInstructions += `
+
+TYPESCRIPT: Create types/razorpay.d.ts and make sure it is covered by the "include"
+globs in tsconfig.json. Use new window.Razorpay(options) directly - do NOT cast
+window to any.`
+		}
+
+		// The backend generators describe wiring a web page; a React Native
+		// app is a separate project that calls the backend over the network
+		if frontendFramework == "react-native" && route != "flutter" {
+			output.AIInstructions += `
+
+REACT NATIVE: The app cannot create orders itself - the key secret must never ship
+in the app bundle. Orders are created by the backend endpoint, so:
+- Apply the backend files in the backend project. If it already has an order endpoint
+  that returns { success, orderId, amount, currency, keyId }, reuse it and only change
+  the paths in src/RazorpayCheckout.js
+- Create src/RazorpayCheckout.js in the React Native app and set API_BASE_URL to the
+  backend origin
+- Ignore the HTML <script> steps above - react-native-razorpay opens the native checkout
+- npm install react-native-razorpay, then cd ios && pod install. It needs native code,
+  so Expo apps need a development build (npx expo prebuild) and do not run in Expo Go`
+		}
+
+		applyCheckoutBranding(&output, branding)
+
+		if allowRetry {
+			applyCheckoutRetry(&output, retryMaxCount)
+		}
+
+		// The amount forms are web components; the mobile clients take the
+		// amount as an argument instead
+		if includeAmountInput && route != "flutter" && frontendFramework != "react-native" {
+			form, usage := getAmountInputForm(frontendFramework, backendFramework)
+			if frontendFramework == "vue" && vueStyle == "options" &&
+				!emitsOwnFrontend(backendFramework) {
+				form, usage = getVueOptionsAmountForm()
+			}
+			output.Files = append(output.Files, form)
+			output.AIInstructions += `
+
+AMOUNT INPUT: Create ` + form.Path + `. ` + usage + `.
+Amounts are entered in rupees (not paise); the pay button stays disabled until the
+value is numeric and positive, so do not remove the parseAmount check.`
+		}
+
+		if exposePublicKey {
+			client := frontendFramework
+			if emitsOwnFrontend(backendFramework) {
+				client = backendFramework
+			}
+			if env, ok := applyPublicKey(&output, client, creds.KeyID); ok {
+				output.AIInstructions += `
+
+PUBLIC KEY: The checkout reads the key id from ` + env + `, which the bundler inlines into
+the client at build time. The key id is public by design, but:
+- ` + env + ` must hold the same key id as RAZORPAY_KEY_ID, or checkout rejects the order
+  the server created. Switching between test and live keys needs a rebuild.
+- Only the key id gets the public prefix. RAZORPAY_KEY_SECRET must never be renamed to a
+  public variable or imported in client code - it would ship in the bundle.
+- The order endpoint still returns keyId, so other clients keep working.`
+			} else {
+				output.AIInstructions += `
+
+PUBLIC KEY: ` + client + ` has no build-time public env vars, so the checkout keeps using
+the keyId returned by the order endpoint. That is already safe - the key id is public
+and the key secret never leaves the server.`
+			}
+		}
+
+		if includeRateLimit && !applyOrderRateLimit(&output, route) {
+			output.AIInstructions += `
+
+RATE LIMIT: Limit the order endpoint to 10 requests a minute per client IP with ` +
+				orderRateLimitHints[route] + `. Answer HTTP 429 with { success: false, error }
+beyond that, and leave the verify endpoint unlimited.`
+		}
+
+		if includeTelemetry && !applyOrderTelemetry(&output, route) {
+			output.AIInstructions += `
+
+TELEMETRY: Wrap the order and verify handlers in OpenTelemetry spans named
+razorpay.order.create and razorpay.payment.verify with ` + orderTelemetryHints[route] + `.
+Set the razorpay.order_id attribute once the order id is known and razorpay.outcome to
+success or failure, and export the spans over OTLP to OTEL_EXPORTER_OTLP_ENDPOINT.`
+		}
+
+		if includeEnvCheck {
+			script, command := getEnvCheckScript(backendFramework)
+			output.Files = append(output.Files, script)
+			output.AIInstructions += `
+
+ENV CHECK: Create ` + script.Path + ` and run "` + command + `" after filling in the keys.
+It must print PASS before the server is started - a FAIL means the key id or secret
+is missing or still a placeholder, which shows up later as authentication errors.`
+		}
+
+		// Every route writes the keys to an env file, so keep it out of git
+		gitignore := getGitignoreAction()
+		output.Files = append(output.Files, gitignore)
+		output.AIInstructions += `
+
+SECRETS: Add the lines in ` + gitignore.Path + ` that are not there yet (create the file if
+it does not exist) BEFORE writing the keys. Never commit .env or .env.local - a pushed
+key secret must be regenerated in the Dashboard.`
+
+		if dryRun {
+			applyDryRun(&output)
+			return mcpgo.NewToolResultJSON(output)
 		}
 
+		applyIntegrationSteps(&output)
 		return mcpgo.NewToolResultJSON(output)
 	}
 
@@ -189,6 +532,18 @@ func DetectStack(
 			"pubspecYaml",
 			mcpgo.Description("Contents of pubspec.yaml if it exists (Flutter)"),
 		),
+		mcpgo.WithString(
+			"gemfile",
+			mcpgo.Description("Contents of Gemfile if it exists (Ruby)"),
+		),
+		mcpgo.WithString(
+			"pomXml",
+			mcpgo.Description("Contents of pom.xml if it exists (Java/Maven)"),
+		),
+		mcpgo.WithString(
+			"buildGradle",
+			mcpgo.Description("Contents of build.gradle or build.gradle.kts if it exists (Java/Gradle)"),
+		),
 	}
 
 	handler := func(
@@ -207,13 +562,191 @@ func DetectStack(
 	return mcpgo.NewTool(
 		"detect_stack",
 		"Detect the technology stack of a project based on file information. "+
-			"Returns language, framework, frontend framework, and package manager. "+
+			"Returns language, JavaScript runtime (node, deno or bun), framework, "+
+			"frontend framework, package manager, and "+
+			"whether Tailwind is available for styling (styling: tailwind or inline). "+
+			"For monorepos (pnpm workspaces, Turborepo, Nx or package.json workspaces) "+
+			"it also returns isMonorepo and the workspace directories; run it again "+
+			"on the target app's files to detect that app's stack. "+
 			"Use this to determine which integration approach to use.",
 		parameters,
 		handler,
 	)
 }
 
+// IntegrateRazorpayWebhook returns a tool that generates a webhook endpoint
+// for server-side payment events
+func IntegrateRazorpayWebhook(
+	obs *observability.Observability,
+	client *rzpsdk.Client,
+) mcpgo.Tool {
+	parameters := []mcpgo.ToolParameter{
+		mcpgo.WithString(
+			"language",
+			mcpgo.Description("Programming language: javascript, typescript, python, go, ruby, java, or csharp"),
+			mcpgo.Enum("javascript", "typescript", "python", "go", "ruby", "java", "csharp"),
+		),
+		mcpgo.WithString(
+			"backendFramework",
+			mcpgo.Description("Backend framework: express, nextjs, django, flask, fastapi, gin, echo, fiber, rails, spring, or aspnet"),
+			mcpgo.Required(),
+			mcpgo.Enum("express", "nextjs", "django", "flask", "fastapi", "gin", "echo", "fiber", "rails", "spring", "aspnet"),
+		),
+		mcpgo.WithBoolean(
+			"strictEnv",
+			mcpgo.Description("Emit a validated config module that throws a clear error at startup "+
+				"when a required env var is missing, instead of process.env.X! non-null assertions. "+
+				"Applies to nextjs. Default: false"),
+		),
+	}
+
+	handler := func(
+		ctx context.Context,
+		r mcpgo.CallToolRequest,
+	) (*mcpgo.ToolResult, error) {
+		args, ok := r.Arguments.(map[string]interface{})
+		if !ok {
+			return mcpgo.NewToolResultError("Invalid arguments"), nil
+		}
+
+		language, _ := args["language"].(string)
+		backendFramework, _ := args["backendFramework"].(string)
+
+		var output IntegrateCheckoutOutput
+		switch backendFramework {
+		case "nextjs":
+			output = getNextjsWebhookIntegration()
+		case "django":
+			output = getDjangoWebhookIntegration()
+		case "flask":
+			output = getFlaskWebhookIntegration()
+		case "fastapi":
+			output = getFastAPIWebhookIntegration()
+		case "gin", "echo", "fiber":
+			output = getGoWebhookIntegration(backendFramework)
+		case "rails":
+			output = getRailsWebhookIntegration()
+		case "spring":
+			output = getSpringBootWebhookIntegration()
+		case "aspnet":
+			output = getAspNetWebhookIntegration()
+		default: // express
+			output = getExpressWebhookIntegration(language)
+		}
+
+		output.EnvVars = append(output.EnvVars, EnvVar{
+			Name:  "RAZORPAY_WEBHOOK_SECRET",
+			Value: "YOUR_WEBHOOK_SECRET",
+		})
+		output.TestInstructions = "Expose the endpoint (e.g. with ngrok), add it in Dashboard -> " +
+			"Account & Settings -> Webhooks, then make a test payment with card 4111 1111 1111 1111"
+		output.AIInstructions += getWebhookInstructions()
+
+		applyIntegrationSteps(&output)
+		return mcpgo.NewToolResultJSON(output)
+	}
+
+	return mcpgo.NewTool(
+		"integrate_razorpay_webhook",
+		"Generate a server-side Razorpay webhook endpoint (/api/razorpay/webhook) that verifies "+
+			"the X-Razorpay-Signature header with HMAC-SHA256 over the RAW request body and handles "+
+			"payment.captured, payment.failed and order.paid events. Use this alongside "+
+			"integrate_razorpay_checkout so orders are fulfilled even if the browser closes after payment.",
+		parameters,
+		handler,
+	)
+}
+
+// IntegrateRazorpaySubscription returns a tool that generates a recurring
+// payments (subscriptions) integration
+func IntegrateRazorpaySubscription(
+	obs *observability.Observability,
+	client *rzpsdk.Client,
+) mcpgo.Tool {
+	parameters := []mcpgo.ToolParameter{
+		mcpgo.WithString(
+			"language",
+			mcpgo.Description("Programming language: javascript, typescript, python, go, ruby, java, or csharp"),
+			mcpgo.Enum("javascript", "typescript", "python", "go", "ruby", "java", "csharp"),
+		),
+		mcpgo.WithString(
+			"backendFramework",
+			mcpgo.Description("Backend framework: express, nextjs, django, flask, fastapi, gin, echo, fiber, rails, spring, or aspnet"),
+			mcpgo.Required(),
+			mcpgo.Enum("express", "nextjs", "django", "flask", "fastapi", "gin", "echo", "fiber", "rails", "spring", "aspnet"),
+		),
+	}
+
+	handler := func(
+		ctx context.Context,
+		r mcpgo.CallToolRequest,
+	) (*mcpgo.ToolResult, error) {
+		args, ok := r.Arguments.(map[string]interface{})
+		if !ok {
+			return mcpgo.NewToolResultError("Invalid arguments"), nil
+		}
+
+		language, _ := args["language"].(string)
+		backendFramework, _ := args["backendFramework"].(string)
+		strictEnv, _ := args["strictEnv"].(bool)
+
+		creds := Credentials{
+			KeyID:     viper.GetString("key"),
+			KeySecret: viper.GetString("secret"),
+		}
+
+		var output IntegrateCheckoutOutput
+		switch backendFramework {
+		case "nextjs":
+			output = getNextjsSubscriptionIntegration(strictEnv)
+		case "django", "flask", "fastapi":
+			output = getPythonSubscriptionIntegration(backendFramework)
+		case "gin", "echo", "fiber":
+			output = getGoSubscriptionIntegration(backendFramework)
+		case "rails":
+			output = getRailsSubscriptionIntegration()
+		case "spring":
+			output = getSpringBootSubscriptionIntegration()
+		case "aspnet":
+			output = getAspNetSubscriptionIntegration()
+		default: // express
+			output = getExpressSubscriptionIntegration(language)
+		}
+
+		if backendFramework != "nextjs" {
+			output.Files = append(output.Files, FileAction{
+				Action:      "create",
+				Path:        "public/js/razorpay-subscription.js",
+				Code:        getVanillaSubscriptionFrontend(),
+				Description: "Frontend helper that opens Checkout for a subscription",
+			})
+		}
+
+		keyID, keySecret := getKeysOrPlaceholders(creds)
+		output.EnvVars = append(output.EnvVars,
+			EnvVar{Name: "RAZORPAY_KEY_ID", Value: keyID},
+			EnvVar{Name: "RAZORPAY_KEY_SECRET", Value: keySecret},
+			EnvVar{Name: "RAZORPAY_PLAN_ID", Value: "plan_YOUR_PLAN_ID"},
+		)
+		output.TestInstructions = "Use test card: 4111 1111 1111 1111 (subscriptions need a card or " +
+			"UPI Autopay that supports recurring payments)"
+		output.AIInstructions += getSubscriptionInstructions()
+
+		applyIntegrationSteps(&output)
+		return mcpgo.NewToolResultJSON(output)
+	}
+
+	return mcpgo.NewTool(
+		"integrate_razorpay_subscription",
+		"Complete Razorpay Subscriptions (recurring payments) integration. Returns backend code that "+
+			"creates plans and subscriptions, a verify endpoint for the subscription signature "+
+			"(razorpay_payment_id|razorpay_subscription_id) and frontend code that opens Checkout "+
+			"with subscription_id instead of order_id.",
+		parameters,
+		handler,
+	)
+}
+
 // =============================================================================
 // EXPRESS + VANILLA JS INTEGRATION
 // =============================================================================
@@ -227,7 +760,7 @@ type FrontendIntegration struct {
 	Description string
 }
 
-func getExpressVanillaIntegration(language string, creds Credentials, frontend FrontendIntegration) IntegrateCheckoutOutput {
+func getExpressVanillaIntegration(language string, creds Credentials, frontend FrontendIntegration, orderDataStrategy string, strictEnv bool) IntegrateCheckoutOutput {
 	ext := "js"
 	if language == "typescript" {
 		ext = "ts"
@@ -243,6 +776,56 @@ func getExpressVanillaIntegration(language string, creds Credentials, frontend F
 		keySecret = "YOUR_KEY_SECRET"
 	}
 
+	// With the server strategy, cart data is kept server-side keyed by the
+	// Razorpay order_id instead of in the browser's localStorage
+	pendingOrdersCode := ""
+	orderBodyFields := "amount, currency = 'INR', receipt"
+	storePendingOrder := ""
+	verifyHandler := "(req, res) =>"
+	verifiedResponse := `      res.json({
+        success: true,
+        message: 'Payment verified successfully',
+        paymentId: razorpay_payment_id,
+        orderId: razorpay_order_id,
+      });`
+	if orderDataStrategy == "server" {
+		pendingOrdersCode = getExpressPendingOrdersCode()
+		orderBodyFields = "amount, currency = 'INR', receipt, orderData"
+		storePendingOrder = `
+
+    // TODO: Replace with an INSERT into your pending_orders table
+    pendingOrders.set(order.id, {
+      orderData: orderData || {},
+      amount: order.amount,
+      currency: order.currency,
+      createdAt: Date.now(),
+    });`
+		verifyHandler = "async (req, res) =>"
+		verifiedResponse = `      // TODO: Replace with a SELECT from your pending_orders table
+      const pending = pendingOrders.get(razorpay_order_id);
+      if (!pending) {
+        return res.status(404).json({ success: false, error: 'Order data not found' });
+      }
+
+      const fulfilledOrder = await fulfillOrder(pending.orderData, {
+        orderId: razorpay_order_id,
+        paymentId: razorpay_payment_id,
+        amount: pending.amount,
+        currency: pending.currency,
+      });
+
+      // TODO: Mark the row as paid instead of deleting it
+      pendingOrders.delete(razorpay_order_id);
+
+      res.json({
+        success: true,
+        message: 'Payment verified successfully',
+        paymentId: razorpay_payment_id,
+        orderId: razorpay_order_id,
+        order: fulfilledOrder,
+      });`
+	}
+
 	razorpayRoutesCode := `const express = require('express');
 const Razorpay = require('razorpay');
 const crypto = require('crypto');
@@ -254,20 +837,32 @@ const razorpay = new Razorpay({
   key_secret: process.env.RAZORPAY_KEY_SECRET,
 });
 
+// Razorpay expects amounts in the smallest currency unit. The order endpoint
+// multiplies the major-unit amount by this factor (INR=100: ₹1 = 100 paise).
+// Supporting another currency is a one-line change, e.g. USD: 100.
+const CURRENCY_MULTIPLIERS = {
+  INR: 100,
+};
+` + pendingOrdersCode + `
 // Create Razorpay Order
 router.post('/order', async (req, res) => {
   try {
-    const { amount, currency = 'INR', receipt } = req.body;
+    const { ` + orderBodyFields + ` } = req.body;
 
     if (!amount || amount <= 0) {
       return res.status(400).json({ success: false, error: 'Invalid amount' });
     }
 
+    const multiplier = CURRENCY_MULTIPLIERS[currency];
+    if (!multiplier) {
+      return res.status(400).json({ success: false, error: 'Unsupported currency' });
+    }
+
     const order = await razorpay.orders.create({
-      amount: Math.round(amount * 100), // Convert to paise
+      amount: Math.round(amount * multiplier), // Convert to the smallest currency unit
       currency,
       receipt: receipt || ` + "`receipt_${Date.now()}`" + `,
-    });
+    });` + storePendingOrder + `
 
     res.json({
       success: true,
@@ -283,7 +878,7 @@ router.post('/order', async (req, res) => {
 });
 
 // Verify Payment Signature
-router.post('/verify', (req, res) => {
+router.post('/verify', ` + verifyHandler + ` {
   try {
     const { razorpay_order_id, razorpay_payment_id, razorpay_signature } = req.body;
 
@@ -296,13 +891,16 @@ router.post('/verify', (req, res) => {
       .update(razorpay_order_id + '|' + razorpay_payment_id)
       .digest('hex');
 
-    if (crypto.timingSafeEqual(Buffer.from(expectedSignature), Buffer.from(razorpay_signature))) {
-      res.json({
-        success: true,
-        message: 'Payment verified successfully',
-        paymentId: razorpay_payment_id,
-        orderId: razorpay_order_id,
-      });
+    // timingSafeEqual throws on buffers of different lengths, so a malformed
+    // signature must be rejected before the comparison
+    const expectedBuffer = Buffer.from(expectedSignature);
+    const receivedBuffer = Buffer.from(String(razorpay_signature));
+    const isValid =
+      expectedBuffer.length === receivedBuffer.length &&
+      crypto.timingSafeEqual(expectedBuffer, receivedBuffer);
+
+    if (isValid) {
+` + verifiedResponse + `
     } else {
       res.status(400).json({ success: false, error: 'Invalid payment signature' });
     }
@@ -444,7 +1042,15 @@ COMMON MISTAKES TO AVOID:
 		},
 	}
 
-	return IntegrateCheckoutOutput{
+	if orderDataStrategy == "server" {
+		for i := range files {
+			if files[i].Action == "wire_payment" {
+				files[i].Code = getServerOrderDataWiringCode()
+			}
+		}
+	}
+
+	output := IntegrateCheckoutOutput{
 		Summary: "Complete Razorpay Standard Checkout integration for Express + " + frontend.Framework,
 		Files:   files,
 		Dependencies: []Dependency{
@@ -503,64 +1109,471 @@ THE INTEGRATION IS **NOT COMPLETE** IF:
 - You tell the user to "wire up the payment" as a next step
 
 DO NOT give "Next Steps" - complete EVERYTHING including discovering the correct files.`,
+		Webhooks:            checkoutWebhooks(),
+		WebhookDashboardURL: webhookDashboardURL,
 	}
-}
 
-// =============================================================================
-// NEXT.JS + REACT INTEGRATION
-// =============================================================================
+	if orderDataStrategy == "server" {
+		output.Summary += " (order data stored server-side)"
+		output.AIInstructions += `
 
-func getNextjsReactIntegration(language string, creds Credentials) IntegrateCheckoutOutput {
-	// Use actual keys if provided, otherwise use placeholders
-	keyID := creds.KeyID
-	keySecret := creds.KeySecret
-	if keyID == "" {
-		keyID = "rzp_test_YOUR_KEY_ID"
+**ORDER DATA IS STORED SERVER-SIDE:**
+- Pass the cart/customer data as the 4th argument: initiateRazorpayPayment(total, onSuccess, onError, orderData)
+- Do NOT use localStorage for pending orders
+- Move the existing order creation logic into fulfillOrder() in routes/razorpay.js
+- Replace the pendingOrders Map with a database table (see TODO markers) before going to production`
 	}
-	if keySecret == "" {
-		keySecret = "YOUR_KEY_SECRET"
-	}
-
-	orderRouteCode := `import { NextRequest, NextResponse } from 'next/server';
-import Razorpay from 'razorpay';
-
-const razorpay = new Razorpay({
-  key_id: process.env.RAZORPAY_KEY_ID!,
-  key_secret: process.env.RAZORPAY_KEY_SECRET!,
-});
-
-export async function POST(request: NextRequest) {
-  try {
-    const { amount, currency = 'INR', receipt } = await request.json();
 
-    if (!amount || amount <= 0) {
-      return NextResponse.json({ success: false, error: 'Invalid amount' }, { status: 400 });
-    }
+	if language == "typescript" {
+		applyExpressTypeScript(&output, strictEnv)
+	}
 
-    const order = await razorpay.orders.create({
-      amount: Math.round(amount * 100),
-      currency,
-      receipt: receipt || ` + "`receipt_${Date.now()}`" + `,
-    });
+	return output
+}
 
-    return NextResponse.json({
-      success: true,
-      orderId: order.id,
-      amount: order.amount,
-      currency: order.currency,
-      keyId: process.env.RAZORPAY_KEY_ID,
-    });
-  } catch (error) {
-    console.error('Razorpay order creation failed:', error);
-    return NextResponse.json({ success: false, error: 'Failed to create order' }, { status: 500 });
-  }
+// getExpressPendingOrdersCode returns the in-memory pending order store and
+// fulfillment hook used by the server order data strategy
+func getExpressPendingOrdersCode() string {
+	return `
+// Pending orders keyed by Razorpay order_id. The cart data is saved when the
+// order is created and read back once the payment signature is verified, so
+// nothing sensitive has to live in the browser.
+// TODO: Replace this Map with a database table (e.g. pending_orders with
+// order_id as the primary key). An in-memory Map is lost on restart and is
+// not shared between server instances.
+const pendingOrders = new Map();
+
+// TODO: Move your existing order creation here (save to DB, send emails,
+// reduce stock). Called exactly once per verified payment.
+async function fulfillOrder(orderData, payment) {
+  return {
+    ...orderData,
+    paymentMethod: 'razorpay',
+    paymentId: payment.paymentId,
+    razorpayOrderId: payment.orderId,
+  };
 }
 `
+}
 
-	verifyRouteCode := `import { NextRequest, NextResponse } from 'next/server';
-import crypto from 'crypto';
+// expressReturnResponse matches early returns of a response, which the
+// Express 5 handler types reject since handlers must return void
+var expressReturnResponse = regexp.MustCompile(`(?m)^([ \t]*)return (res\..*);$`)
+
+// applyExpressTypeScript turns the generated CommonJS routes and server edits
+// into TypeScript with ES modules and typed request bodies
+func applyExpressTypeScript(output *IntegrateCheckoutOutput, strictEnv bool) {
+	for i := range output.Files {
+		file := &output.Files[i]
+		switch {
+		case file.Path == "routes/razorpay.ts":
+			file.Code = getExpressTypeScriptRoutes(file.Code, strictEnv)
+		case file.Path == "server.js" && file.Action == "insert_code":
+			file.Path = "server.ts"
+			file.Description = strings.Replace(file.Description, "server.js", "server.ts", 1)
+			file.Code = `// Add these lines at the TOP of server.ts (before other imports):
+import 'dotenv/config';
+import razorpayRoutes from './routes/razorpay';
 
-export async function POST(request: NextRequest) {
+// Add this line with your other app.use() middleware (AFTER the above imports):
+// app.use('/api/razorpay', razorpayRoutes);
+`
+			file.Edits[0].Line = "STEP 1 - At the VERY TOP of server.ts (line 1, before any other import)"
+			file.Edits[0].Add = "import 'dotenv/config';"
+			file.Edits[0].Why = "Imports run in order, so this must be the first import to load env vars before the routes read them"
+			file.Edits[1].Add = "import razorpayRoutes from './routes/razorpay';"
+		}
+	}
+
+	output.Dependencies = append(output.Dependencies, Dependency{
+		Name:           "typescript",
+		InstallCommand: "npm install -D typescript @types/express @types/node",
+	})
+	output.AIInstructions = strings.NewReplacer(
+		"routes/razorpay.js", "routes/razorpay.ts",
+		"Edit server.js", "Edit server.ts",
+		"require('dotenv').config();", "import 'dotenv/config';",
+		"const razorpayRoutes = require('./routes/razorpay');", "import razorpayRoutes from './routes/razorpay';",
+		"with other requires", "with other imports",
+	).Replace(output.AIInstructions) + `
+
+TYPESCRIPT: routes/razorpay.ts uses ES module imports with default imports of
+express and razorpay, so tsconfig.json needs "esModuleInterop": true. The request
+bodies are typed by OrderRequestBody and VerifyRequestBody - extend them instead of
+casting req.body to any.`
+
+	if strictEnv {
+		output.Files = append([]FileAction{{
+			Action:      "create",
+			Path:        "lib/razorpay-config.ts",
+			Code:        getStrictEnvConfigCode(),
+			Description: "Validated Razorpay config - throws on load if an env var is missing",
+		}}, output.Files...)
+		output.AIInstructions += `
+
+STRICT ENV: Create lib/razorpay-config.ts and keep routes/razorpay.ts importing
+razorpayConfig - never reintroduce process.env.RAZORPAY_*! assertions. It is read
+when the routes are imported, so import 'dotenv/config' must stay first in server.ts.`
+	}
+}
+
+// getExpressTypeScriptRoutes converts the CommonJS Express routes to
+// TypeScript. With strictEnv the keys come from the validated config module
+// instead of process.env non-null assertions.
+func getExpressTypeScriptRoutes(code string, strictEnv bool) string {
+	orderDataField := ""
+	if strings.Contains(code, "orderData") {
+		orderDataField = "\n  orderData?: Record<string, unknown>;"
+	}
+
+	imports := "import crypto from 'crypto';\nimport express, { Request, Response } from 'express';\nimport Razorpay from 'razorpay';\n"
+	keyIDExpr := "process.env.RAZORPAY_KEY_ID!"
+	keyIDResponseExpr := "process.env.RAZORPAY_KEY_ID"
+	keySecretExpr := "process.env.RAZORPAY_KEY_SECRET!"
+	if strictEnv {
+		imports += "import { razorpayConfig } from '../lib/razorpay-config';\n"
+		keyIDExpr = "razorpayConfig.keyId"
+		keyIDResponseExpr = "razorpayConfig.keyId"
+		keySecretExpr = "razorpayConfig.keySecret"
+	}
+
+	code = strings.NewReplacer(
+		"const express = require('express');\nconst Razorpay = require('razorpay');\nconst crypto = require('crypto');\n",
+		imports,
+		"  key_id: process.env.RAZORPAY_KEY_ID,\n  key_secret: process.env.RAZORPAY_KEY_SECRET,\n",
+		"  key_id: "+keyIDExpr+",\n  key_secret: "+keySecretExpr+",\n",
+		"keyId: process.env.RAZORPAY_KEY_ID,",
+		"keyId: "+keyIDResponseExpr+",",
+		".createHmac('sha256', process.env.RAZORPAY_KEY_SECRET)",
+		".createHmac('sha256', "+keySecretExpr+")",
+		"const CURRENCY_MULTIPLIERS = {\n  INR: 100,\n};\n",
+		`const CURRENCY_MULTIPLIERS: Record<string, number> = {
+  INR: 100,
+};
+
+interface OrderRequestBody {
+  amount: number;
+  currency?: string;
+  receipt?: string;`+orderDataField+`
+}
+
+interface VerifyRequestBody {
+  razorpay_order_id?: string;
+  razorpay_payment_id?: string;
+  razorpay_signature?: string;
+}
+`,
+		"const { razorpay_order_id, razorpay_payment_id, razorpay_signature } = req.body;",
+		"const { razorpay_order_id, razorpay_payment_id, razorpay_signature } = req.body as VerifyRequestBody;",
+		"} = req.body;",
+		"} = req.body as OrderRequestBody;",
+		"(req, res) =>",
+		"(req: Request, res: Response) =>",
+		"const pendingOrders = new Map();",
+		`interface PendingOrder {
+  orderData: Record<string, unknown>;
+  amount: number | string;
+  currency: string;
+  createdAt: number;
+}
+
+const pendingOrders = new Map<string, PendingOrder>();`,
+		"async function fulfillOrder(orderData, payment) {",
+		`async function fulfillOrder(
+  orderData: Record<string, unknown>,
+  payment: { orderId: string; paymentId: string; amount: number | string; currency: string },
+) {`,
+		"module.exports = router;",
+		"export default router;",
+	).Replace(code)
+
+	return expressReturnResponse.ReplaceAllString(code, "${1}${2};\n${1}return;")
+}
+
+// getServerOrderDataWiringCode returns the checkout wiring steps for the
+// server order data strategy
+func getServerOrderDataWiringCode() string {
+	return `STEP-BY-STEP DISCOVERY PROCESS:
+
+1. FIND THE CHECKOUT HTML PAGE and the JS file it loads (do NOT assume app.js)
+
+2. ADD <script src="/js/razorpay.js"></script> BEFORE the checkout JS file
+
+3. FIND THE PAYMENT/CHECKOUT FUNCTION (initiatePayment, handleCheckout,
+   placeOrder, processPayment, submitOrder, handlePayment, COD placeholders)
+
+4. MODIFY THAT FUNCTION to send the order data WITH the payment request.
+   The server stores it against the Razorpay order_id and creates the order
+   in fulfillOrder() after the signature is verified.
+
+   Example pattern:
+   async function existingCheckoutFunction() {
+     const total = calculateTotal(); // or get from existing code
+
+     // Collect everything the original order creation needed
+     const orderData = {
+       items: getCartItems(),
+       customerInfo: {
+         name: document.getElementById('name-field').value,
+         email: document.getElementById('email-field').value,
+         phone: document.getElementById('phone-field').value,
+       },
+       shippingAddress: { /* ... */ },
+     };
+
+     initiateRazorpayPayment(
+       total,
+       (result) => {
+         // The order was already created server-side in fulfillOrder()
+         showOrderConfirmation(result.order);
+       },
+       (error) => {
+         alert('Payment failed: ' + error.message);
+       },
+       orderData
+     );
+   }
+
+5. MOVE the existing order creation API logic into fulfillOrder() in
+   routes/razorpay.js so it only runs for verified payments
+
+COMMON MISTAKES TO AVOID:
+- Storing pendingOrder in localStorage (not needed with this strategy)
+- Creating the order from the browser after payment (duplicates fulfillOrder)
+- Leaving the original COD/placeholder code active
+- Shipping to production with the in-memory pendingOrders Map`
+}
+
+// =============================================================================
+// ADONIS.JS INTEGRATION
+// =============================================================================
+
+func getAdonisIntegration(creds Credentials, frontend FrontendIntegration) IntegrateCheckoutOutput {
+	keyID, keySecret := getKeysOrPlaceholders(creds)
+
+	controllerCode := `import crypto from 'crypto'
+import Razorpay from 'razorpay'
+import Env from '@ioc:Adonis/Core/Env'
+import type { HttpContextContract } from '@ioc:Adonis/Core/HttpContext'
+
+const razorpay = new Razorpay({
+  key_id: Env.get('RAZORPAY_KEY_ID'),
+  key_secret: Env.get('RAZORPAY_KEY_SECRET'),
+})
+
+// Razorpay expects amounts in the smallest currency unit. The order endpoint
+// multiplies the major-unit amount by this factor (INR=100: 1 rupee = 100 paise).
+// Supporting another currency is a one-line change, e.g. USD: 100.
+const CURRENCY_MULTIPLIERS: Record<string, number> = {
+  INR: 100,
+}
+
+export default class RazorpayController {
+  public async createOrder({ request, response }: HttpContextContract) {
+    try {
+      const { amount, currency = 'INR', receipt } = request.only(['amount', 'currency', 'receipt'])
+
+      if (!amount || amount <= 0) {
+        return response.badRequest({ success: false, error: 'Invalid amount' })
+      }
+
+      const multiplier = CURRENCY_MULTIPLIERS[currency]
+      if (!multiplier) {
+        return response.badRequest({ success: false, error: 'Unsupported currency' })
+      }
+
+      const order = await razorpay.orders.create({
+        amount: Math.round(amount * multiplier), // Convert to the smallest currency unit
+        currency,
+        receipt: receipt || ` + "`receipt_${Date.now()}`" + `,
+      })
+
+      return response.ok({
+        success: true,
+        orderId: order.id,
+        amount: order.amount,
+        currency: order.currency,
+        keyId: Env.get('RAZORPAY_KEY_ID'),
+      })
+    } catch (error) {
+      console.error('Razorpay order creation failed:', error)
+      return response.internalServerError({ success: false, error: 'Failed to create payment order' })
+    }
+  }
+
+  public async verify({ request, response }: HttpContextContract) {
+    const { razorpay_order_id, razorpay_payment_id, razorpay_signature } = request.only([
+      'razorpay_order_id',
+      'razorpay_payment_id',
+      'razorpay_signature',
+    ])
+
+    if (!razorpay_order_id || !razorpay_payment_id || !razorpay_signature) {
+      return response.badRequest({ success: false, error: 'Missing payment details' })
+    }
+
+    const expectedSignature = crypto
+      .createHmac('sha256', Env.get('RAZORPAY_KEY_SECRET'))
+      .update(razorpay_order_id + '|' + razorpay_payment_id)
+      .digest('hex')
+
+    // timingSafeEqual throws on buffers of different lengths, so a malformed
+    // signature must be rejected before the comparison
+    const expectedBuffer = Buffer.from(expectedSignature)
+    const receivedBuffer = Buffer.from(String(razorpay_signature))
+    const isValid =
+      expectedBuffer.length === receivedBuffer.length &&
+      crypto.timingSafeEqual(expectedBuffer, receivedBuffer)
+
+    if (!isValid) {
+      return response.badRequest({ success: false, error: 'Invalid payment signature' })
+    }
+
+    return response.ok({ success: true, paymentId: razorpay_payment_id, orderId: razorpay_order_id })
+  }
+}
+`
+
+	return IntegrateCheckoutOutput{
+		Summary: "Complete Razorpay Standard Checkout integration for AdonisJS + " + frontend.Framework,
+		Files: []FileAction{
+			{
+				Action:      "create",
+				Path:        "app/Controllers/Http/RazorpayController.ts",
+				Code:        controllerCode,
+				Description: "AdonisJS controller for order creation and payment verification",
+			},
+			{Action: "create", Path: frontend.FileName, Code: frontend.Code, Description: frontend.Description},
+			{Action: "manual_edit", Path: "start/routes.ts", Description: "Add routes", Edits: []EditItem{
+				{Line: "After the existing routes", Add: "Route.post('/api/razorpay/order', 'RazorpayController.createOrder')", Why: "Order endpoint"},
+				{Line: "After order route", Add: "Route.post('/api/razorpay/verify', 'RazorpayController.verify')", Why: "Verify endpoint"},
+			}},
+			{Action: "manual_edit", Path: "env.ts", Description: "Validate the Razorpay env vars at boot", Edits: []EditItem{
+				{Line: "Inside Env.rules({ ... })", Add: "RAZORPAY_KEY_ID: Env.schema.string(),", Why: "Fails fast at boot when the key id is missing"},
+				{Line: "After RAZORPAY_KEY_ID", Add: "RAZORPAY_KEY_SECRET: Env.schema.string(),", Why: "Fails fast at boot when the key secret is missing"},
+			}},
+			getWirePaymentAction(),
+		},
+		Dependencies:     []Dependency{{Name: "razorpay", InstallCommand: "npm install razorpay"}},
+		EnvVars:          []EnvVar{{Name: "RAZORPAY_KEY_ID", Value: keyID}, {Name: "RAZORPAY_KEY_SECRET", Value: keySecret}},
+		TestInstructions: "Use test card: 4111 1111 1111 1111",
+		AIInstructions: `BACKEND SETUP:
+1) npm install razorpay
+2) Create app/Controllers/Http/RazorpayController.ts
+3) Register both routes in start/routes.ts
+4) Add RAZORPAY_KEY_ID and RAZORPAY_KEY_SECRET to env.ts rules and to .env
+5) Read the keys through Env.get() - do NOT use process.env in Adonis code
+6) The routes are POST endpoints, so exempt /api/razorpay/* from CSRF in
+   config/shield.ts (csrf.exceptRoutes) if shield is enabled` + getFrontendWiringInstructions(frontend),
+		Webhooks:            checkoutWebhooks(),
+		WebhookDashboardURL: webhookDashboardURL,
+	}
+}
+
+// =============================================================================
+// NEXT.JS + REACT INTEGRATION
+// =============================================================================
+
+// getNextjsButtonStyleProps returns the JSX props that style the checkout
+// button. A className passed by the caller always wins over the defaults.
+func getNextjsButtonStyleProps(styling string) string {
+	switch styling {
+	case "inline":
+		// Tailwind classes are no-ops without Tailwind, so fall back to a
+		// style attribute that renders the same button anywhere
+		return `        className={className || undefined}
+        style={className ? undefined : {
+          backgroundColor: '#2563eb',
+          color: '#ffffff',
+          padding: '8px 24px',
+          border: 'none',
+          borderRadius: 4,
+          cursor: loading || !scriptLoaded ? 'not-allowed' : 'pointer',
+          opacity: loading || !scriptLoaded ? 0.5 : 1,
+        }}`
+	case "none":
+		return `        className={className || undefined}`
+	default: // tailwind
+		return `        className={className || 'bg-blue-600 text-white px-6 py-2 rounded disabled:opacity-50'}`
+	}
+}
+
+func getNextjsReactIntegration(language string, creds Credentials, strictEnv bool, styling string, nextRouter string) IntegrateCheckoutOutput {
+	// Use actual keys if provided, otherwise use placeholders
+	keyID := creds.KeyID
+	keySecret := creds.KeySecret
+	if keyID == "" {
+		keyID = "rzp_test_YOUR_KEY_ID"
+	}
+	if keySecret == "" {
+		keySecret = "YOUR_KEY_SECRET"
+	}
+
+	// With strictEnv the routes read typed values from a config module that
+	// validates env vars on load, instead of asserting them with "!"
+	configImport := ""
+	keyIDExpr := "process.env.RAZORPAY_KEY_ID!"
+	keyIDResponseExpr := "process.env.RAZORPAY_KEY_ID"
+	keySecretExpr := "process.env.RAZORPAY_KEY_SECRET!"
+	if strictEnv {
+		configImport = "import { razorpayConfig } from '../../../../lib/razorpay-config';\n"
+		keyIDExpr = "razorpayConfig.keyId"
+		keyIDResponseExpr = "razorpayConfig.keyId"
+		keySecretExpr = "razorpayConfig.keySecret"
+	}
+
+	orderRouteCode := `import { NextRequest, NextResponse } from 'next/server';
+import Razorpay from 'razorpay';
+` + configImport + `
+const razorpay = new Razorpay({
+  key_id: ` + keyIDExpr + `,
+  key_secret: ` + keySecretExpr + `,
+});
+
+// Razorpay expects amounts in the smallest currency unit. The order endpoint
+// multiplies the major-unit amount by this factor (INR=100: ₹1 = 100 paise).
+// Supporting another currency is a one-line change, e.g. USD: 100.
+const CURRENCY_MULTIPLIERS: Record<string, number> = {
+  INR: 100,
+};
+
+export async function POST(request: NextRequest) {
+  try {
+    const { amount, currency = 'INR', receipt } = await request.json();
+
+    if (!amount || amount <= 0) {
+      return NextResponse.json({ success: false, error: 'Invalid amount' }, { status: 400 });
+    }
+
+    const multiplier = CURRENCY_MULTIPLIERS[currency];
+    if (!multiplier) {
+      return NextResponse.json({ success: false, error: 'Unsupported currency' }, { status: 400 });
+    }
+
+    const order = await razorpay.orders.create({
+      amount: Math.round(amount * multiplier), // Convert to the smallest currency unit
+      currency,
+      receipt: receipt || ` + "`receipt_${Date.now()}`" + `,
+    });
+
+    return NextResponse.json({
+      success: true,
+      orderId: order.id,
+      amount: order.amount,
+      currency: order.currency,
+      keyId: ` + keyIDResponseExpr + `,
+    });
+  } catch (error) {
+    console.error('Razorpay order creation failed:', error);
+    return NextResponse.json({ success: false, error: 'Failed to create order' }, { status: 500 });
+  }
+}
+`
+
+	verifyRouteCode := `import { NextRequest, NextResponse } from 'next/server';
+import crypto from 'crypto';
+` + configImport + `
+export async function POST(request: NextRequest) {
   try {
     const { razorpay_order_id, razorpay_payment_id, razorpay_signature } = await request.json();
 
@@ -569,14 +1582,17 @@ export async function POST(request: NextRequest) {
     }
 
     const expectedSignature = crypto
-      .createHmac('sha256', process.env.RAZORPAY_KEY_SECRET!)
+      .createHmac('sha256', ` + keySecretExpr + `)
       .update(razorpay_order_id + '|' + razorpay_payment_id)
       .digest('hex');
 
-    const isValid = crypto.timingSafeEqual(
-      Buffer.from(expectedSignature),
-      Buffer.from(razorpay_signature)
-    );
+    // timingSafeEqual throws on buffers of different lengths, so a malformed
+    // signature must be rejected before the comparison
+    const expectedBuffer = Buffer.from(expectedSignature);
+    const receivedBuffer = Buffer.from(String(razorpay_signature));
+    const isValid =
+      expectedBuffer.length === receivedBuffer.length &&
+      crypto.timingSafeEqual(expectedBuffer, receivedBuffer);
 
     if (isValid) {
       return NextResponse.json({
@@ -678,7 +1694,7 @@ export function RazorpayCheckout({
       <button
         onClick={handlePayment}
         disabled={loading || !scriptLoaded}
-        className={className || 'bg-blue-600 text-white px-6 py-2 rounded disabled:opacity-50'}
+` + getNextjsButtonStyleProps(styling) + `
       >
         {loading ? 'Processing...' : buttonText}
       </button>
@@ -687,18 +1703,42 @@ export function RazorpayCheckout({
 }
 `
 
-	return IntegrateCheckoutOutput{
+	// The Pages Router serves pages/api/* with a default export taking
+	// NextApiRequest/NextApiResponse instead of exported POST handlers
+	orderRoutePath := "app/api/razorpay/order/route.ts"
+	verifyRoutePath := "app/api/razorpay/verify/route.ts"
+	if nextRouter == "pages" {
+		orderRoutePath = "pages/api/razorpay/order.ts"
+		verifyRoutePath = "pages/api/razorpay/verify.ts"
+		orderRouteCode, verifyRouteCode = getNextjsPagesRoutes(
+			strings.Replace(configImport, "../../../../lib/", "../../../lib/", 1),
+			keyIDExpr, keyIDResponseExpr, keySecretExpr)
+		// Pages are client components already
+		checkoutComponentCode = strings.TrimPrefix(checkoutComponentCode, "'use client';\n\n")
+	}
+
+	files := []FileAction{}
+	if strictEnv {
+		files = append(files, FileAction{
+			Action:      "create",
+			Path:        "lib/razorpay-config.ts",
+			Code:        getStrictEnvConfigCode(),
+			Description: "Validated Razorpay config - throws on load if an env var is missing",
+		})
+	}
+
+	output := IntegrateCheckoutOutput{
 		Summary: "Complete Razorpay Standard Checkout integration for Next.js + React",
-		Files: []FileAction{
+		Files: append(files, []FileAction{
 			{
 				Action:      "create",
-				Path:        "app/api/razorpay/order/route.ts",
+				Path:        orderRoutePath,
 				Code:        orderRouteCode,
 				Description: "API route for creating Razorpay orders",
 			},
 			{
 				Action:      "create",
-				Path:        "app/api/razorpay/verify/route.ts",
+				Path:        verifyRoutePath,
 				Code:        verifyRouteCode,
 				Description: "API route for verifying payment signatures",
 			},
@@ -708,7 +1748,7 @@ export function RazorpayCheckout({
 				Code:        checkoutComponentCode,
 				Description: "React component for Razorpay checkout button",
 			},
-		},
+		}...),
 		Dependencies: []Dependency{
 			{Name: "razorpay", InstallCommand: "npm install razorpay"},
 		},
@@ -723,966 +1763,5907 @@ export function RazorpayCheckout({
 3) Create the RazorpayCheckout component
 4) Add env vars to .env.local
 5) Do NOT ask the user to do anything or give "Next Steps" - the integration must be complete and working.`,
+		Webhooks:            checkoutWebhooks(),
+		WebhookDashboardURL: webhookDashboardURL,
 	}
-}
 
-// =============================================================================
-// FRONTEND INTEGRATIONS
-// =============================================================================
+	if nextRouter == "pages" {
+		output.Summary += " (Pages Router)"
+		output.AIInstructions += `
+- This project uses the Pages Router: the routes go in pages/api/razorpay/, NOT in
+  app/api/. Do not create an app/ directory for them`
+	}
 
-func getFrontendIntegration(framework string, creds Credentials) FrontendIntegration {
-	switch framework {
-	case "react":
-		return getReactFrontend()
-	case "vue":
-		return getVueFrontend()
-	case "angular":
-		return getAngularFrontend()
-	case "svelte":
-		return getSvelteFrontend()
-	default: // vanilla
-		return getVanillaFrontend()
+	if strictEnv {
+		output.AIInstructions += `
+6) Create lib/razorpay-config.ts and keep the routes importing razorpayConfig - never
+   reintroduce process.env.RAZORPAY_*! assertions. To fail at server boot rather than on
+   the first request, also import lib/razorpay-config from instrumentation.ts`
 	}
+
+	return output
 }
 
-func getVanillaFrontend() FrontendIntegration {
-	code := `// Razorpay Payment Integration
-async function initiateRazorpayPayment(amount, onSuccess, onError) {
+// getNextjsPagesRoutes returns the order and verify API routes for the
+// Next.js Pages Router
+func getNextjsPagesRoutes(configImport, keyIDExpr, keyIDResponseExpr, keySecretExpr string) (string, string) {
+	orderRouteCode := `import type { NextApiRequest, NextApiResponse } from 'next';
+import Razorpay from 'razorpay';
+` + configImport + `
+const razorpay = new Razorpay({
+  key_id: ` + keyIDExpr + `,
+  key_secret: ` + keySecretExpr + `,
+});
+
+// Razorpay expects amounts in the smallest currency unit. The order endpoint
+// multiplies the major-unit amount by this factor (INR=100: ₹1 = 100 paise).
+// Supporting another currency is a one-line change, e.g. USD: 100.
+const CURRENCY_MULTIPLIERS: Record<string, number> = {
+  INR: 100,
+};
+
+// POST /api/razorpay/order
+export default async function handler(req: NextApiRequest, res: NextApiResponse) {
+  if (req.method !== 'POST') {
+    res.setHeader('Allow', 'POST');
+    return res.status(405).json({ success: false, error: 'Method not allowed' });
+  }
+
   try {
-    if (!window.Razorpay) {
-      await new Promise((resolve, reject) => {
-        const script = document.createElement('script');
-        script.src = 'https://checkout.razorpay.com/v1/checkout.js';
-        script.onload = resolve;
-        script.onerror = reject;
-        document.head.appendChild(script);
-      });
-    }
+    const { amount, currency = 'INR', receipt } = req.body;
 
-    const orderResponse = await fetch('/api/razorpay/order', {
-      method: 'POST',
-      headers: { 'Content-Type': 'application/json' },
-      body: JSON.stringify({ amount }),
-    });
+    if (!amount || amount <= 0) {
+      return res.status(400).json({ success: false, error: 'Invalid amount' });
+    }
 
-    const orderData = await orderResponse.json();
-    if (!orderData.success) throw new Error(orderData.error || 'Failed to create order');
+    const multiplier = CURRENCY_MULTIPLIERS[currency];
+    if (!multiplier) {
+      return res.status(400).json({ success: false, error: 'Unsupported currency' });
+    }
 
-    const options = {
-      key: orderData.keyId,
-      amount: orderData.amount,
-      currency: orderData.currency,
-      name: document.title || 'Payment',
-      order_id: orderData.orderId,
-      handler: async function(response) {
-        const verifyResponse = await fetch('/api/razorpay/verify', {
-          method: 'POST',
-          headers: { 'Content-Type': 'application/json' },
-          body: JSON.stringify(response),
-        });
-        const verifyData = await verifyResponse.json();
-        if (verifyData.success) { if (onSuccess) onSuccess(verifyData); }
-        else { if (onError) onError(new Error(verifyData.error)); }
-      },
-      modal: { ondismiss: () => { if (onError) onError(new Error('Payment cancelled')); } },
-      theme: { color: '#528FF0' },
-    };
+    const order = await razorpay.orders.create({
+      amount: Math.round(amount * multiplier), // Convert to the smallest currency unit
+      currency,
+      receipt: receipt || ` + "`receipt_${Date.now()}`" + `,
+    });
 
-    const razorpay = new window.Razorpay(options);
-    razorpay.on('payment.failed', (r) => { if (onError) onError(new Error(r.error.description)); });
-    razorpay.open();
+    return res.status(200).json({
+      success: true,
+      orderId: order.id,
+      amount: order.amount,
+      currency: order.currency,
+      keyId: ` + keyIDResponseExpr + `,
+    });
   } catch (error) {
-    console.error('Payment failed:', error);
-    if (onError) onError(error);
+    console.error('Razorpay order creation failed:', error);
+    return res.status(500).json({ success: false, error: 'Failed to create order' });
   }
 }
 `
-	return FrontendIntegration{
-		Framework:   "Vanilla JS",
-		Code:        code,
-		FileName:    "public/js/razorpay.js",
-		ScriptTag:   "Add <script src=\"/js/razorpay.js\"></script> to the CHECKOUT HTML file (find which HTML has the checkout - may be checkout.html, cart.html, NOT just index.html)",
-		Description: "Vanilla JS Razorpay payment helper",
+
+	verifyRouteCode := `import type { NextApiRequest, NextApiResponse } from 'next';
+import crypto from 'crypto';
+` + configImport + `
+// POST /api/razorpay/verify
+export default function handler(req: NextApiRequest, res: NextApiResponse) {
+  if (req.method !== 'POST') {
+    res.setHeader('Allow', 'POST');
+    return res.status(405).json({ success: false, error: 'Method not allowed' });
+  }
+
+  try {
+    const { razorpay_order_id, razorpay_payment_id, razorpay_signature } = req.body;
+
+    if (!razorpay_order_id || !razorpay_payment_id || !razorpay_signature) {
+      return res.status(400).json({ success: false, error: 'Missing payment details' });
+    }
+
+    const expectedSignature = crypto
+      .createHmac('sha256', ` + keySecretExpr + `)
+      .update(razorpay_order_id + '|' + razorpay_payment_id)
+      .digest('hex');
+
+    // timingSafeEqual throws on buffers of different lengths, so a malformed
+    // signature must be rejected before the comparison
+    const expectedBuffer = Buffer.from(expectedSignature);
+    const receivedBuffer = Buffer.from(String(razorpay_signature));
+    const isValid =
+      expectedBuffer.length === receivedBuffer.length &&
+      crypto.timingSafeEqual(expectedBuffer, receivedBuffer);
+
+    if (isValid) {
+      return res.status(200).json({
+        success: true,
+        message: 'Payment verified',
+        paymentId: razorpay_payment_id,
+        orderId: razorpay_order_id,
+      });
+    }
+    return res.status(400).json({ success: false, error: 'Invalid signature' });
+  } catch (error) {
+    console.error('Verification failed:', error);
+    return res.status(500).json({ success: false, error: 'Verification failed' });
+  }
+}
+`
+
+	return orderRouteCode, verifyRouteCode
+}
+
+// getStrictEnvConfigCode returns a TypeScript module that validates the
+// Razorpay env vars once and exposes them as typed config
+func getStrictEnvConfigCode() string {
+	return `// Validated Razorpay configuration.
+// Importing this module throws immediately if a required env var is missing,
+// so misconfiguration surfaces at startup instead of as a Razorpay auth failure.
+
+export function requireEnv(name: string): string {
+  const value = process.env[name];
+  if (!value || value.trim() === '') {
+    throw new Error(
+      ` + "`Missing required environment variable ${name}. Add it to .env.local (see .env.example).`" + `
+    );
+  }
+  return value;
+}
+
+export interface RazorpayConfig {
+  readonly keyId: string;
+  readonly keySecret: string;
+}
+
+export const razorpayConfig: RazorpayConfig = Object.freeze({
+  keyId: requireEnv('RAZORPAY_KEY_ID'),
+  keySecret: requireEnv('RAZORPAY_KEY_SECRET'),
+});
+`
+}
+
+// =============================================================================
+// REMIX INTEGRATION
+// =============================================================================
+
+func getRemixIntegration(creds Credentials, strictEnv bool) IntegrateCheckoutOutput {
+	keyID, keySecret := getKeysOrPlaceholders(creds)
+
+	// With strictEnv the routes read typed values from a config module that
+	// validates env vars on load. The .server suffix keeps it out of the
+	// client bundle.
+	configImport := ""
+	keyIDExpr := "process.env.RAZORPAY_KEY_ID!"
+	keyIDResponseExpr := "process.env.RAZORPAY_KEY_ID"
+	keySecretExpr := "process.env.RAZORPAY_KEY_SECRET!"
+	if strictEnv {
+		configImport = "import { razorpayConfig } from '~/lib/razorpay-config.server';\n"
+		keyIDExpr = "razorpayConfig.keyId"
+		keyIDResponseExpr = "razorpayConfig.keyId"
+		keySecretExpr = "razorpayConfig.keySecret"
 	}
+
+	orderRouteCode := `import { json, type ActionFunctionArgs } from '@remix-run/node';
+import Razorpay from 'razorpay';
+` + configImport + `
+const razorpay = new Razorpay({
+  key_id: ` + keyIDExpr + `,
+  key_secret: ` + keySecretExpr + `,
+});
+
+// Razorpay expects amounts in the smallest currency unit. The order action
+// multiplies the major-unit amount by this factor (INR=100: ₹1 = 100 paise).
+// Supporting another currency is a one-line change, e.g. USD: 100.
+const CURRENCY_MULTIPLIERS: Record<string, number> = {
+  INR: 100,
+};
+
+// POST /api/razorpay/order
+export async function action({ request }: ActionFunctionArgs) {
+  if (request.method !== 'POST') {
+    return json({ success: false, error: 'Method not allowed' }, { status: 405 });
+  }
+
+  try {
+    const { amount, currency = 'INR', receipt } = await request.json();
+
+    if (!amount || amount <= 0) {
+      return json({ success: false, error: 'Invalid amount' }, { status: 400 });
+    }
+
+    const multiplier = CURRENCY_MULTIPLIERS[currency];
+    if (!multiplier) {
+      return json({ success: false, error: 'Unsupported currency' }, { status: 400 });
+    }
+
+    const order = await razorpay.orders.create({
+      amount: Math.round(amount * multiplier), // Convert to the smallest currency unit
+      currency,
+      receipt: receipt || ` + "`receipt_${Date.now()}`" + `,
+    });
+
+    return json({
+      success: true,
+      orderId: order.id,
+      amount: order.amount,
+      currency: order.currency,
+      keyId: ` + keyIDResponseExpr + `,
+    });
+  } catch (error) {
+    console.error('Razorpay order creation failed:', error);
+    return json({ success: false, error: 'Failed to create order' }, { status: 500 });
+  }
 }
+`
 
-func getReactFrontend() FrontendIntegration {
-	code := `import { useState, useEffect } from 'react';
+	verifyRouteCode := `import { json, type ActionFunctionArgs } from '@remix-run/node';
+import crypto from 'crypto';
+` + configImport + `
+// POST /api/razorpay/verify
+export async function action({ request }: ActionFunctionArgs) {
+  if (request.method !== 'POST') {
+    return json({ success: false, error: 'Method not allowed' }, { status: 405 });
+  }
 
-export function useRazorpay() {
-  const [loading, setLoading] = useState(false);
-  const [scriptLoaded, setScriptLoaded] = useState(false);
+  try {
+    const { razorpay_order_id, razorpay_payment_id, razorpay_signature } = await request.json();
+
+    if (!razorpay_order_id || !razorpay_payment_id || !razorpay_signature) {
+      return json({ success: false, error: 'Missing payment details' }, { status: 400 });
+    }
+
+    const expectedSignature = crypto
+      .createHmac('sha256', ` + keySecretExpr + `)
+      .update(razorpay_order_id + '|' + razorpay_payment_id)
+      .digest('hex');
+
+    // timingSafeEqual throws on buffers of different lengths, so a malformed
+    // signature must be rejected before the comparison
+    const expectedBuffer = Buffer.from(expectedSignature);
+    const receivedBuffer = Buffer.from(String(razorpay_signature));
+    const isValid =
+      expectedBuffer.length === receivedBuffer.length &&
+      crypto.timingSafeEqual(expectedBuffer, receivedBuffer);
+
+    if (!isValid) {
+      return json({ success: false, error: 'Invalid signature' }, { status: 400 });
+    }
+
+    return json({
+      success: true,
+      message: 'Payment verified',
+      paymentId: razorpay_payment_id,
+      orderId: razorpay_order_id,
+    });
+  } catch (error) {
+    console.error('Verification failed:', error);
+    return json({ success: false, error: 'Verification failed' }, { status: 500 });
+  }
+}
+`
+
+	checkoutComponentCode := `import { useEffect, useState } from 'react';
+
+const CHECKOUT_SCRIPT = 'https://checkout.razorpay.com/v1/checkout.js';
+
+interface RazorpayCheckoutProps {
+  amount: number;
+  onSuccess?: (data: { paymentId: string; orderId: string }) => void;
+  onError?: (error: Error) => void;
+  buttonText?: string;
+  className?: string;
+}
+
+// Loads checkout.js once in the browser. Remix renders on the server first,
+// so the script is only added after hydration.
+function useCheckoutScript() {
+  const [loaded, setLoaded] = useState(false);
 
   useEffect(() => {
-    const script = document.createElement('script');
-    script.src = 'https://checkout.razorpay.com/v1/checkout.js';
-    script.onload = () => setScriptLoaded(true);
-    document.body.appendChild(script);
-    return () => document.body.removeChild(script);
+    if ((window as any).Razorpay) {
+      setLoaded(true);
+      return;
+    }
+    let script = document.querySelector<HTMLScriptElement>(` + "`script[src=\"${CHECKOUT_SCRIPT}\"]`" + `);
+    if (!script) {
+      script = document.createElement('script');
+      script.src = CHECKOUT_SCRIPT;
+      script.async = true;
+      document.body.appendChild(script);
+    }
+    const onLoad = () => setLoaded(true);
+    script.addEventListener('load', onLoad);
+    return () => script?.removeEventListener('load', onLoad);
   }, []);
 
-  const pay = async (amount, onSuccess, onError) => {
+  return loaded;
+}
+
+export function RazorpayCheckout({
+  amount,
+  onSuccess,
+  onError,
+  buttonText = 'Pay Now',
+  className,
+}: RazorpayCheckoutProps) {
+  const scriptLoaded = useCheckoutScript();
+  const [loading, setLoading] = useState(false);
+
+  const handlePayment = async () => {
     if (!scriptLoaded || loading) return;
     setLoading(true);
+
     try {
-      const res = await fetch('/api/razorpay/order', {
+      const orderRes = await fetch('/api/razorpay/order', {
         method: 'POST',
         headers: { 'Content-Type': 'application/json' },
         body: JSON.stringify({ amount }),
       });
-      const data = await res.json();
-      if (!data.success) throw new Error(data.error);
+
+      const orderData = await orderRes.json();
+      if (!orderData.success) throw new Error(orderData.error);
 
       const options = {
-        key: data.keyId,
-        amount: data.amount,
-        currency: data.currency,
-        order_id: data.orderId,
-        handler: async (response) => {
-          const verify = await fetch('/api/razorpay/verify', {
+        key: orderData.keyId,
+        amount: orderData.amount,
+        currency: orderData.currency,
+        name: 'Payment',
+        order_id: orderData.orderId,
+        handler: async (response: any) => {
+          const verifyRes = await fetch('/api/razorpay/verify', {
             method: 'POST',
             headers: { 'Content-Type': 'application/json' },
             body: JSON.stringify(response),
           });
-          const result = await verify.json();
-          result.success ? onSuccess?.(result) : onError?.(new Error(result.error));
+          const verifyData = await verifyRes.json();
+
+          if (verifyData.success) {
+            onSuccess?.({ paymentId: verifyData.paymentId, orderId: verifyData.orderId });
+          } else {
+            onError?.(new Error(verifyData.error));
+          }
           setLoading(false);
         },
         modal: { ondismiss: () => setLoading(false) },
+        theme: { color: '#528FF0' },
       };
-      new window.Razorpay(options).open();
-    } catch (e) {
-      onError?.(e);
+
+      const razorpay = new (window as any).Razorpay(options);
+      razorpay.on('payment.failed', (res: any) => {
+        onError?.(new Error(res.error.description));
+        setLoading(false);
+      });
+      razorpay.open();
+    } catch (error) {
+      onError?.(error as Error);
       setLoading(false);
     }
   };
 
-  return { pay, loading, ready: scriptLoaded };
+  return (
+    <button onClick={handlePayment} disabled={loading || !scriptLoaded} className={className}>
+      {loading ? 'Processing...' : buttonText}
+    </button>
+  );
 }
+`
+
+	payRouteCode := `import { useState } from 'react';
+import { useSearchParams } from '@remix-run/react';
+import { RazorpayCheckout } from '~/components/RazorpayCheckout';
+
+// /pay?amount=499 - a standalone page to test the integration end to end
+export default function Pay() {
+  const [searchParams] = useSearchParams();
+  const amount = Number(searchParams.get('amount')) || 100;
+  const [status, setStatus] = useState('');
 
-export function RazorpayButton({ amount, onSuccess, onError, children }) {
-  const { pay, loading, ready } = useRazorpay();
   return (
-    <button onClick={() => pay(amount, onSuccess, onError)} disabled={!ready || loading}>
-      {loading ? 'Processing...' : children || 'Pay Now'}
-    </button>
+    <main>
+      <h1>Pay ₹{amount}</h1>
+      <RazorpayCheckout
+        amount={amount}
+        onSuccess={({ paymentId }) => setStatus(` + "`Payment successful: ${paymentId}`" + `)}
+        onError={(error) => setStatus(` + "`Payment failed: ${error.message}`" + `)}
+      />
+      {status && <p role="status">{status}</p>}
+    </main>
   );
 }
 `
-	return FrontendIntegration{
-		Framework:   "React",
-		Code:        code,
-		FileName:    "src/components/RazorpayButton.jsx",
-		ScriptTag:   "Import and use <RazorpayButton amount={100} onSuccess={...} />",
-		Description: "React hook and component for Razorpay payments",
+
+	files := []FileAction{}
+	if strictEnv {
+		files = append(files, FileAction{
+			Action:      "create",
+			Path:        "app/lib/razorpay-config.server.ts",
+			Code:        getStrictEnvConfigCode(),
+			Description: "Validated Razorpay config - throws on load if an env var is missing",
+		})
+	}
+
+	output := IntegrateCheckoutOutput{
+		Summary: "Complete Razorpay Standard Checkout integration for Remix",
+		Files: append(files, []FileAction{
+			{
+				Action:      "create",
+				Path:        "app/routes/api.razorpay.order.ts",
+				Code:        orderRouteCode,
+				Description: "Resource route whose action creates Razorpay orders",
+			},
+			{
+				Action:      "create",
+				Path:        "app/routes/api.razorpay.verify.ts",
+				Code:        verifyRouteCode,
+				Description: "Resource route whose action verifies payment signatures",
+			},
+			{
+				Action:      "create",
+				Path:        "app/components/RazorpayCheckout.tsx",
+				Code:        checkoutComponentCode,
+				Description: "Checkout button that loads checkout.js after hydration",
+			},
+			{
+				Action:      "create",
+				Path:        "app/routes/pay.tsx",
+				Code:        payRouteCode,
+				Description: "Route rendering the checkout button, for testing at /pay?amount=100",
+			},
+		}...),
+		Dependencies: []Dependency{
+			{Name: "razorpay", InstallCommand: "npm install razorpay"},
+		},
+		EnvVars: []EnvVar{
+			{Name: "RAZORPAY_KEY_ID", Value: keyID},
+			{Name: "RAZORPAY_KEY_SECRET", Value: keySecret},
+		},
+		TestInstructions: "Open /pay?amount=100 and use test card: 4111 1111 1111 1111, any future expiry, any CVV",
+		AIInstructions: `IMPORTANT:
+1) npm install razorpay
+2) Create both resource routes. The file names use Remix v2 flat routes (dots become
+   slashes): api.razorpay.order.ts serves /api/razorpay/order. With the v1 folder
+   convention use app/routes/api/razorpay/order.ts instead
+3) Create app/components/RazorpayCheckout.tsx and render <RazorpayCheckout amount={...} />
+   on the existing checkout route; app/routes/pay.tsx is a standalone test page
+4) Add the env vars to .env - Remix loads it in dev; set them on the host in production
+5) The routes only export action, so they never render and Razorpay stays server-only.
+   Do NOT import razorpay from a component or loader-free client module
+6) Do NOT ask the user to do anything or give "Next Steps" - the integration must be complete and working.`,
+		Webhooks:            checkoutWebhooks(),
+		WebhookDashboardURL: webhookDashboardURL,
+	}
+
+	if strictEnv {
+		output.AIInstructions += `
+7) Create app/lib/razorpay-config.server.ts and keep the routes importing razorpayConfig -
+   never reintroduce process.env.RAZORPAY_*! assertions`
 	}
+
+	return output
 }
 
-func getVueFrontend() FrontendIntegration {
-	code := `<template>
-  <button @click="pay" :disabled="!ready || loading">
-    {{ loading ? 'Processing...' : 'Pay Now' }}
-  </button>
-</template>
+// =============================================================================
+// ASTRO INTEGRATION
+// =============================================================================
 
-<script setup>
-import { ref, onMounted } from 'vue';
+func getAstroIntegration(creds Credentials) IntegrateCheckoutOutput {
+	keyID, keySecret := getKeysOrPlaceholders(creds)
 
-const props = defineProps({ amount: Number });
-const emit = defineEmits(['success', 'error']);
+	orderRouteCode := `import type { APIRoute } from 'astro';
+import Razorpay from 'razorpay';
 
-const loading = ref(false);
-const ready = ref(false);
+// API routes must run on the server, also when the site is otherwise static
+export const prerender = false;
 
-onMounted(() => {
-  const script = document.createElement('script');
-  script.src = 'https://checkout.razorpay.com/v1/checkout.js';
-  script.onload = () => ready.value = true;
-  document.head.appendChild(script);
+const razorpay = new Razorpay({
+  key_id: import.meta.env.RAZORPAY_KEY_ID,
+  key_secret: import.meta.env.RAZORPAY_KEY_SECRET,
 });
 
-const pay = async () => {
-  if (!ready.value || loading.value) return;
-  loading.value = true;
-  try {
-    const res = await fetch('/api/razorpay/order', {
-      method: 'POST',
-      headers: { 'Content-Type': 'application/json' },
-      body: JSON.stringify({ amount: props.amount }),
+// Razorpay expects amounts in the smallest currency unit. The order route
+// multiplies the major-unit amount by this factor (INR=100: ₹1 = 100 paise).
+// Supporting another currency is a one-line change, e.g. USD: 100.
+const CURRENCY_MULTIPLIERS: Record<string, number> = {
+  INR: 100,
+};
+
+function json(body: unknown, status = 200) {
+  return new Response(JSON.stringify(body), {
+    status,
+    headers: { 'Content-Type': 'application/json' },
+  });
+}
+
+export const POST: APIRoute = async ({ request }) => {
+  try {
+    const { amount, currency = 'INR', receipt } = await request.json();
+
+    if (!amount || amount <= 0) {
+      return json({ success: false, error: 'Invalid amount' }, 400);
+    }
+
+    const multiplier = CURRENCY_MULTIPLIERS[currency];
+    if (!multiplier) {
+      return json({ success: false, error: 'Unsupported currency' }, 400);
+    }
+
+    const order = await razorpay.orders.create({
+      amount: Math.round(amount * multiplier), // Convert to the smallest currency unit
+      currency,
+      receipt: receipt || ` + "`receipt_${Date.now()}`" + `,
     });
-    const data = await res.json();
-    if (!data.success) throw new Error(data.error);
 
-    const options = {
-      key: data.keyId,
-      amount: data.amount,
-      currency: data.currency,
-      order_id: data.orderId,
-      handler: async (response) => {
-        const verify = await fetch('/api/razorpay/verify', {
-          method: 'POST',
-          headers: { 'Content-Type': 'application/json' },
-          body: JSON.stringify(response),
-        });
-        const result = await verify.json();
-        result.success ? emit('success', result) : emit('error', new Error(result.error));
-        loading.value = false;
-      },
-      modal: { ondismiss: () => loading.value = false },
-    };
-    new window.Razorpay(options).open();
-  } catch (e) {
-    emit('error', e);
-    loading.value = false;
+    return json({
+      success: true,
+      orderId: order.id,
+      amount: order.amount,
+      currency: order.currency,
+      keyId: import.meta.env.RAZORPAY_KEY_ID,
+    });
+  } catch (error) {
+    console.error('Razorpay order creation failed:', error);
+    return json({ success: false, error: 'Failed to create order' }, 500);
   }
 };
-</script>
 `
-	return FrontendIntegration{
-		Framework:   "Vue",
-		Code:        code,
-		FileName:    "src/components/RazorpayButton.vue",
-		ScriptTag:   "Import and use <RazorpayButton :amount=\"100\" @success=\"...\" />",
-		Description: "Vue 3 component for Razorpay payments",
-	}
-}
 
-func getAngularFrontend() FrontendIntegration {
-	code := `import { Component, Input, Output, EventEmitter, OnInit } from '@angular/core';
+	verifyRouteCode := `import type { APIRoute } from 'astro';
+import crypto from 'node:crypto';
 
-declare var Razorpay: any;
+export const prerender = false;
 
-@Component({
-  selector: 'app-razorpay-button',
-  template: ` + "`" + `
-    <button (click)="pay()" [disabled]="!ready || loading">
-      {{ loading ? 'Processing...' : 'Pay Now' }}
-    </button>
-  ` + "`" + `,
-})
-export class RazorpayButtonComponent implements OnInit {
-  @Input() amount: number = 0;
-  @Output() success = new EventEmitter<any>();
-  @Output() error = new EventEmitter<Error>();
+function json(body: unknown, status = 200) {
+  return new Response(JSON.stringify(body), {
+    status,
+    headers: { 'Content-Type': 'application/json' },
+  });
+}
 
-  loading = false;
-  ready = false;
+export const POST: APIRoute = async ({ request }) => {
+  try {
+    const { razorpay_order_id, razorpay_payment_id, razorpay_signature } = await request.json();
 
-  ngOnInit() {
-    const script = document.createElement('script');
-    script.src = 'https://checkout.razorpay.com/v1/checkout.js';
-    script.onload = () => this.ready = true;
-    document.head.appendChild(script);
-  }
+    if (!razorpay_order_id || !razorpay_payment_id || !razorpay_signature) {
+      return json({ success: false, error: 'Missing payment details' }, 400);
+    }
 
-  async pay() {
-    if (!this.ready || this.loading) return;
-    this.loading = true;
-    try {
-      const res = await fetch('/api/razorpay/order', {
-        method: 'POST',
-        headers: { 'Content-Type': 'application/json' },
-        body: JSON.stringify({ amount: this.amount }),
-      });
-      const data = await res.json();
-      if (!data.success) throw new Error(data.error);
+    const expectedSignature = crypto
+      .createHmac('sha256', import.meta.env.RAZORPAY_KEY_SECRET)
+      .update(razorpay_order_id + '|' + razorpay_payment_id)
+      .digest('hex');
 
-      const options = {
-        key: data.keyId,
-        amount: data.amount,
-        currency: data.currency,
-        order_id: data.orderId,
-        handler: async (response: any) => {
-          const verify = await fetch('/api/razorpay/verify', {
-            method: 'POST',
-            headers: { 'Content-Type': 'application/json' },
-            body: JSON.stringify(response),
-          });
-          const result = await verify.json();
-          result.success ? this.success.emit(result) : this.error.emit(new Error(result.error));
-          this.loading = false;
-        },
-        modal: { ondismiss: () => this.loading = false },
-      };
-      new Razorpay(options).open();
-    } catch (e) {
-      this.error.emit(e as Error);
-      this.loading = false;
+    // timingSafeEqual throws on buffers of different lengths, so a malformed
+    // signature must be rejected before the comparison
+    const expectedBuffer = Buffer.from(expectedSignature);
+    const receivedBuffer = Buffer.from(String(razorpay_signature));
+    const isValid =
+      expectedBuffer.length === receivedBuffer.length &&
+      crypto.timingSafeEqual(expectedBuffer, receivedBuffer);
+
+    if (!isValid) {
+      return json({ success: false, error: 'Invalid signature' }, 400);
     }
+
+    return json({
+      success: true,
+      message: 'Payment verified',
+      paymentId: razorpay_payment_id,
+      orderId: razorpay_order_id,
+    });
+  } catch (error) {
+    console.error('Verification failed:', error);
+    return json({ success: false, error: 'Verification failed' }, 500);
   }
-}
+};
 `
-	return FrontendIntegration{
-		Framework:   "Angular",
-		Code:        code,
-		FileName:    "src/app/components/razorpay-button.component.ts",
-		ScriptTag:   "Add to module declarations and use <app-razorpay-button [amount]=\"100\" (success)=\"...\">",
-		Description: "Angular component for Razorpay payments",
-	}
+
+	checkoutComponentCode := `---
+// Razorpay checkout button. The amount is read from data-amount when the
+// button is clicked, so scripts may change it after render.
+// Listen for razorpay:success / razorpay:error events on the button.
+interface Props {
+  amount: number;
+  buttonText?: string;
+  class?: string;
 }
 
-func getSvelteFrontend() FrontendIntegration {
-	code := `<script>
-  import { onMount } from 'svelte';
-  export let amount = 0;
+const { amount, buttonText = 'Pay Now', class: className } = Astro.props;
+---
 
-  let loading = false;
-  let ready = false;
+<script is:inline src="https://checkout.razorpay.com/v1/checkout.js" defer></script>
 
-  onMount(() => {
-    const script = document.createElement('script');
-    script.src = 'https://checkout.razorpay.com/v1/checkout.js';
-    script.onload = () => ready = true;
-    document.head.appendChild(script);
-  });
+<button type="button" data-razorpay-checkout data-amount={amount} class={className}>
+  {buttonText}
+</button>
 
-  import { createEventDispatcher } from 'svelte';
-  const dispatch = createEventDispatcher();
+<script>
+  async function pay(button: HTMLButtonElement) {
+    const amount = Number(button.dataset.amount);
+    const label = button.textContent;
+    const emit = (type: string, detail: unknown) =>
+      button.dispatchEvent(new CustomEvent(type, { detail, bubbles: true }));
+    const done = () => {
+      button.disabled = false;
+      button.textContent = label;
+    };
+
+    button.disabled = true;
+    button.textContent = 'Processing...';
 
-  async function pay() {
-    if (!ready || loading) return;
-    loading = true;
     try {
-      const res = await fetch('/api/razorpay/order', {
+      const orderRes = await fetch('/api/razorpay/order', {
         method: 'POST',
         headers: { 'Content-Type': 'application/json' },
         body: JSON.stringify({ amount }),
       });
-      const data = await res.json();
-      if (!data.success) throw new Error(data.error);
+      const orderData = await orderRes.json();
+      if (!orderData.success) throw new Error(orderData.error);
 
       const options = {
-        key: data.keyId,
-        amount: data.amount,
-        currency: data.currency,
-        order_id: data.orderId,
-        handler: async (response) => {
-          const verify = await fetch('/api/razorpay/verify', {
+        key: orderData.keyId,
+        amount: orderData.amount,
+        currency: orderData.currency,
+        name: 'Payment',
+        order_id: orderData.orderId,
+        handler: async (response: any) => {
+          const verifyRes = await fetch('/api/razorpay/verify', {
             method: 'POST',
             headers: { 'Content-Type': 'application/json' },
             body: JSON.stringify(response),
           });
-          const result = await verify.json();
-          result.success ? dispatch('success', result) : dispatch('error', new Error(result.error));
-          loading = false;
+          const verifyData = await verifyRes.json();
+
+          if (verifyData.success) {
+            emit('razorpay:success', { paymentId: verifyData.paymentId, orderId: verifyData.orderId });
+          } else {
+            emit('razorpay:error', new Error(verifyData.error));
+          }
+          done();
         },
-        modal: { ondismiss: () => loading = false },
+        modal: { ondismiss: done },
+        theme: { color: '#528FF0' },
       };
-      new window.Razorpay(options).open();
-    } catch (e) {
-      dispatch('error', e);
-      loading = false;
+
+      const razorpay = new (window as any).Razorpay(options);
+      razorpay.on('payment.failed', (res: any) => {
+        emit('razorpay:error', new Error(res.error.description));
+        done();
+      });
+      razorpay.open();
+    } catch (error) {
+      emit('razorpay:error', error);
+      done();
     }
   }
-</script>
 
-<button on:click={pay} disabled={!ready || loading}>
-  {loading ? 'Processing...' : 'Pay Now'}
-</button>
+  document.querySelectorAll<HTMLButtonElement>('[data-razorpay-checkout]').forEach((button) => {
+    button.addEventListener('click', () => pay(button));
+  });
+</script>
 `
-	return FrontendIntegration{
-		Framework:   "Svelte",
-		Code:        code,
-		FileName:    "src/components/RazorpayButton.svelte",
-		ScriptTag:   "Import and use <RazorpayButton amount={100} on:success={...} />",
-		Description: "Svelte component for Razorpay payments",
+
+	return IntegrateCheckoutOutput{
+		Summary: "Complete Razorpay Standard Checkout integration for Astro",
+		Files: []FileAction{
+			{
+				Action:      "create",
+				Path:        "src/pages/api/razorpay/order.ts",
+				Code:        orderRouteCode,
+				Description: "API route for creating Razorpay orders",
+			},
+			{
+				Action:      "create",
+				Path:        "src/pages/api/razorpay/verify.ts",
+				Code:        verifyRouteCode,
+				Description: "API route for verifying payment signatures",
+			},
+			{
+				Action:      "create",
+				Path:        "src/components/RazorpayCheckout.astro",
+				Code:        checkoutComponentCode,
+				Description: "Astro checkout button that loads checkout.js",
+			},
+			{Action: "manual_edit", Path: "astro.config.mjs", Description: "Enable on-demand rendering for the API routes", Edits: []EditItem{
+				{Line: "In defineConfig({ ... })", Add: "adapter: node({ mode: 'standalone' }), // or the adapter for your host", Why: "API routes need a server adapter; without one Astro builds a static site and the routes 404"},
+			}},
+		},
+		Dependencies: []Dependency{
+			{Name: "razorpay", InstallCommand: "npm install razorpay"},
+		},
+		EnvVars: []EnvVar{
+			{Name: "RAZORPAY_KEY_ID", Value: keyID},
+			{Name: "RAZORPAY_KEY_SECRET", Value: keySecret},
+		},
+		TestInstructions: "Use test card: 4111 1111 1111 1111, any future expiry, any CVV",
+		AIInstructions: `IMPORTANT:
+1) npm install razorpay
+2) Create both API routes under src/pages/api/razorpay/. They export prerender = false,
+   so they run on demand even in a mostly static site
+3) If astro.config.mjs has no adapter, add one (npx astro add node, or the adapter for
+   the host). Without it the API routes are not served
+4) Create src/components/RazorpayCheckout.astro and render
+   <RazorpayCheckout amount={499} /> on the checkout page. Handle the result by listening
+   for razorpay:success and razorpay:error events on the button
+5) Add the env vars to .env - they are read with import.meta.env on the server only. Do
+   NOT prefix them with PUBLIC_, which would ship the key secret to the browser
+6) Do NOT ask the user to do anything or give "Next Steps" - the integration must be complete and working.`,
+		Webhooks:            checkoutWebhooks(),
+		WebhookDashboardURL: webhookDashboardURL,
 	}
 }
 
 // =============================================================================
-// PYTHON BACKEND INTEGRATIONS
+// NUXT INTEGRATION
 // =============================================================================
 
-func getDjangoIntegration(creds Credentials, frontend FrontendIntegration) IntegrateCheckoutOutput {
+func getNuxtIntegration(creds Credentials, strictEnv bool) IntegrateCheckoutOutput {
 	keyID, keySecret := getKeysOrPlaceholders(creds)
 
-	viewsCode := `import json
-import time
-import razorpay
-import hmac
-import hashlib
-from django.http import JsonResponse
-from django.views.decorators.csrf import csrf_exempt
-from django.views.decorators.http import require_POST
-from django.conf import settings
+	// With strictEnv the routes read typed values from a config module under
+	// server/utils that validates env vars on load
+	configImport := ""
+	keyIDExpr := "process.env.RAZORPAY_KEY_ID!"
+	keyIDResponseExpr := "process.env.RAZORPAY_KEY_ID"
+	keySecretExpr := "process.env.RAZORPAY_KEY_SECRET!"
+	if strictEnv {
+		configImport = "import { razorpayConfig } from '../../utils/razorpay-config';\n"
+		keyIDExpr = "razorpayConfig.keyId"
+		keyIDResponseExpr = "razorpayConfig.keyId"
+		keySecretExpr = "razorpayConfig.keySecret"
+	}
 
-client = razorpay.Client(auth=(settings.RAZORPAY_KEY_ID, settings.RAZORPAY_KEY_SECRET))
+	orderRouteCode := `import Razorpay from 'razorpay';
+` + configImport + `
+const razorpay = new Razorpay({
+  key_id: ` + keyIDExpr + `,
+  key_secret: ` + keySecretExpr + `,
+});
 
-@csrf_exempt
-@require_POST
-def create_order(request):
-    try:
-        data = json.loads(request.body)
-        amount = data.get('amount', 0)
+// Razorpay expects amounts in the smallest currency unit. The order route
+// multiplies the major-unit amount by this factor (INR=100: ₹1 = 100 paise).
+// Supporting another currency is a one-line change, e.g. USD: 100.
+const CURRENCY_MULTIPLIERS: Record<string, number> = {
+  INR: 100,
+};
 
-        if amount <= 0:
-            return JsonResponse({'success': False, 'error': 'Invalid amount'}, status=400)
+export default defineEventHandler(async (event) => {
+  try {
+    const { amount, currency = 'INR', receipt } = await readBody(event);
 
-        order = client.order.create({
-            'amount': int(amount * 100),  # Convert to paise
-            'currency': data.get('currency', 'INR'),
-            'receipt': data.get('receipt', f'receipt_{int(time.time())}'),
-        })
+    if (!amount || amount <= 0) {
+      setResponseStatus(event, 400);
+      return { success: false, error: 'Invalid amount' };
+    }
 
-        return JsonResponse({
-            'success': True,
-            'orderId': order['id'],
-            'amount': order['amount'],
-            'currency': order['currency'],
-            'keyId': settings.RAZORPAY_KEY_ID,
-        })
-    except Exception as e:
-        return JsonResponse({'success': False, 'error': str(e)}, status=500)
+    const multiplier = CURRENCY_MULTIPLIERS[currency];
+    if (!multiplier) {
+      setResponseStatus(event, 400);
+      return { success: false, error: 'Unsupported currency' };
+    }
 
-@csrf_exempt
-@require_POST
-def verify_payment(request):
-    try:
-        data = json.loads(request.body)
-        razorpay_order_id = data.get('razorpay_order_id')
-        razorpay_payment_id = data.get('razorpay_payment_id')
-        razorpay_signature = data.get('razorpay_signature')
+    const order = await razorpay.orders.create({
+      amount: Math.round(amount * multiplier), // Convert to the smallest currency unit
+      currency,
+      receipt: receipt || ` + "`receipt_${Date.now()}`" + `,
+    });
 
-        if not all([razorpay_order_id, razorpay_payment_id, razorpay_signature]):
-            return JsonResponse({'success': False, 'error': 'Missing payment details'}, status=400)
+    return {
+      success: true,
+      orderId: order.id,
+      amount: order.amount,
+      currency: order.currency,
+      keyId: ` + keyIDResponseExpr + `,
+    };
+  } catch (error) {
+    console.error('Razorpay order creation failed:', error);
+    setResponseStatus(event, 500);
+    return { success: false, error: 'Failed to create order' };
+  }
+});
+`
 
-        msg = f'{razorpay_order_id}|{razorpay_payment_id}'
-        expected_signature = hmac.new(
-            settings.RAZORPAY_KEY_SECRET.encode(),
-            msg.encode(),
-            hashlib.sha256
-        ).hexdigest()
-
-        if hmac.compare_digest(expected_signature, razorpay_signature):
-            return JsonResponse({
-                'success': True,
-                'message': 'Payment verified',
-                'paymentId': razorpay_payment_id,
-                'orderId': razorpay_order_id,
-            })
-        else:
-            return JsonResponse({'success': False, 'error': 'Invalid signature'}, status=400)
-    except Exception as e:
-        return JsonResponse({'success': False, 'error': str(e)}, status=500)
+	verifyRouteCode := `import crypto from 'node:crypto';
+` + configImport + `
+export default defineEventHandler(async (event) => {
+  try {
+    const { razorpay_order_id, razorpay_payment_id, razorpay_signature } = await readBody(event);
+
+    if (!razorpay_order_id || !razorpay_payment_id || !razorpay_signature) {
+      setResponseStatus(event, 400);
+      return { success: false, error: 'Missing payment details' };
+    }
+
+    const expectedSignature = crypto
+      .createHmac('sha256', ` + keySecretExpr + `)
+      .update(razorpay_order_id + '|' + razorpay_payment_id)
+      .digest('hex');
+
+    // timingSafeEqual throws on buffers of different lengths, so a malformed
+    // signature must be rejected before the comparison
+    const expectedBuffer = Buffer.from(expectedSignature);
+    const receivedBuffer = Buffer.from(String(razorpay_signature));
+    const isValid =
+      expectedBuffer.length === receivedBuffer.length &&
+      crypto.timingSafeEqual(expectedBuffer, receivedBuffer);
+
+    if (!isValid) {
+      setResponseStatus(event, 400);
+      return { success: false, error: 'Invalid signature' };
+    }
+
+    return {
+      success: true,
+      message: 'Payment verified',
+      paymentId: razorpay_payment_id,
+      orderId: razorpay_order_id,
+    };
+  } catch (error) {
+    console.error('Verification failed:', error);
+    setResponseStatus(event, 500);
+    return { success: false, error: 'Verification failed' };
+  }
+});
 `
 
-	urlsCode := `from django.urls import path
-from . import views
+	composableCode := `import { ref } from 'vue';
 
-urlpatterns = [
-    path('order/', views.create_order, name='razorpay_order'),
-    path('verify/', views.verify_payment, name='razorpay_verify'),
-]
+export interface RazorpayPaymentResult {
+  paymentId: string;
+  orderId: string;
+}
+
+declare global {
+  interface Window {
+    Razorpay: any;
+  }
+}
+
+function loadCheckoutScript(): Promise<void> {
+  if (window.Razorpay) return Promise.resolve();
+  return new Promise((resolve, reject) => {
+    const script = document.createElement('script');
+    script.src = 'https://checkout.razorpay.com/v1/checkout.js';
+    script.onload = () => resolve();
+    script.onerror = () => reject(new Error('Failed to load Razorpay checkout'));
+    document.head.appendChild(script);
+  });
+}
+
+// useRazorpay opens the checkout for an amount in rupees. pay() resolves once
+// the server has verified the payment signature, and rejects on failure or
+// when the customer closes the modal.
+export function useRazorpay() {
+  const loading = ref(false);
+
+  async function pay(amount: number): Promise<RazorpayPaymentResult> {
+    loading.value = true;
+    try {
+      await loadCheckoutScript();
+
+      const res = await fetch('/api/razorpay/order', {
+        method: 'POST',
+        headers: { 'Content-Type': 'application/json' },
+        body: JSON.stringify({ amount }),
+      });
+      const data = await res.json();
+      if (!data.success) throw new Error(data.error);
+
+      return await new Promise<RazorpayPaymentResult>((resolve, reject) => {
+        const options = {
+          key: data.keyId,
+          amount: data.amount,
+          currency: data.currency,
+          name: 'Payment',
+          order_id: data.orderId,
+          handler: async (response: any) => {
+            try {
+              const verifyRes = await fetch('/api/razorpay/verify', {
+                method: 'POST',
+                headers: { 'Content-Type': 'application/json' },
+                body: JSON.stringify(response),
+              });
+              const result = await verifyRes.json();
+              if (result.success) {
+                resolve({ paymentId: result.paymentId, orderId: result.orderId });
+              } else {
+                reject(new Error(result.error));
+              }
+            } catch (e) {
+              reject(e);
+            }
+          },
+          modal: { ondismiss: () => reject(new Error('Payment cancelled')) },
+          theme: { color: '#528FF0' },
+        };
+
+        const razorpay = new window.Razorpay(options);
+        razorpay.on('payment.failed', (response: any) => {
+          reject(new Error(response.error.description));
+        });
+        razorpay.open();
+      });
+    } finally {
+      loading.value = false;
+    }
+  }
+
+  return { pay, loading };
+}
 `
 
-	return IntegrateCheckoutOutput{
-		Summary: "Complete Razorpay Standard Checkout integration for Django + " + frontend.Framework,
-		Files: []FileAction{
-			{Action: "create", Path: "razorpay_payments/views.py", Code: viewsCode, Description: "Django views for Razorpay"},
-			{Action: "create", Path: "razorpay_payments/urls.py", Code: urlsCode, Description: "Django URL patterns"},
-			{Action: "create", Path: frontend.FileName, Code: frontend.Code, Description: frontend.Description},
+	buttonCode := `<template>
+  <button type="button" :disabled="loading" @click="onClick">
+    {{ loading ? 'Processing...' : 'Pay Now' }}
+  </button>
+</template>
+
+<script setup lang="ts">
+import type { RazorpayPaymentResult } from '~/composables/useRazorpay';
+
+const props = defineProps<{ amount: number }>();
+const emit = defineEmits<{
+  success: [result: RazorpayPaymentResult];
+  error: [error: Error];
+}>();
+
+const { pay, loading } = useRazorpay();
+
+async function onClick() {
+  try {
+    emit('success', await pay(props.amount));
+  } catch (e) {
+    emit('error', e as Error);
+  }
+}
+</script>
+`
+
+	files := []FileAction{}
+	if strictEnv {
+		files = append(files, FileAction{
+			Action:      "create",
+			Path:        "server/utils/razorpay-config.ts",
+			Code:        getStrictEnvConfigCode(),
+			Description: "Validated Razorpay config - throws on load if an env var is missing",
+		})
+	}
+
+	output := IntegrateCheckoutOutput{
+		Summary: "Complete Razorpay Standard Checkout integration for Nuxt",
+		Files: append(files, []FileAction{
 			{
-				Action:      "manual_edit",
-				Path:        "settings.py",
-				Description: "Add to settings.py",
-				Edits: []EditItem{
-					{Line: "After other settings", Add: "RAZORPAY_KEY_ID = os.environ.get('RAZORPAY_KEY_ID')", Why: "Razorpay key ID"},
-					{Line: "After RAZORPAY_KEY_ID", Add: "RAZORPAY_KEY_SECRET = os.environ.get('RAZORPAY_KEY_SECRET')", Why: "Razorpay key secret"},
-				},
+				Action:      "create",
+				Path:        "server/api/razorpay/order.post.ts",
+				Code:        orderRouteCode,
+				Description: "Nitro route for creating Razorpay orders",
 			},
 			{
-				Action:      "manual_edit",
-				Path:        "urls.py",
-				Description: "Add to main urls.py",
-				Edits: []EditItem{
-					{Line: "In urlpatterns", Add: "path('api/razorpay/', include('razorpay_payments.urls')),", Why: "Mount Razorpay URLs"},
-				},
+				Action:      "create",
+				Path:        "server/api/razorpay/verify.post.ts",
+				Code:        verifyRouteCode,
+				Description: "Nitro route for verifying payment signatures",
 			},
-			getWirePaymentAction(),
+			{
+				Action:      "create",
+				Path:        "composables/useRazorpay.ts",
+				Code:        composableCode,
+				Description: "Composable that creates the order and opens the checkout",
+			},
+			{
+				Action:      "create",
+				Path:        "components/RazorpayButton.vue",
+				Code:        buttonCode,
+				Description: "Pay button built on useRazorpay",
+			},
+		}...),
+		Dependencies: []Dependency{
+			{Name: "razorpay", InstallCommand: "npm install razorpay"},
+		},
+		EnvVars: []EnvVar{
+			{Name: "RAZORPAY_KEY_ID", Value: keyID},
+			{Name: "RAZORPAY_KEY_SECRET", Value: keySecret},
 		},
-		Dependencies:     []Dependency{{Name: "razorpay", InstallCommand: "pip install razorpay"}},
-		EnvVars:          []EnvVar{{Name: "RAZORPAY_KEY_ID", Value: keyID}, {Name: "RAZORPAY_KEY_SECRET", Value: keySecret}},
 		TestInstructions: "Use test card: 4111 1111 1111 1111, any future expiry, any CVV",
-		AIInstructions: `BACKEND SETUP:
-1) pip install razorpay
-2) Create razorpay_payments app with views.py and urls.py
-3) Add RAZORPAY_KEY_ID and RAZORPAY_KEY_SECRET to settings.py
-4) Add import os at top of settings.py if not present
-5) Include razorpay_payments.urls in main urls.py
-6) Create .env with Razorpay keys` + getFrontendWiringInstructions(frontend),
+		AIInstructions: `IMPORTANT:
+1) npm install razorpay
+2) Create both routes under server/api/razorpay/. The .post suffix makes Nitro serve them
+   for POST only; defineEventHandler, readBody and setResponseStatus are auto-imported
+3) Create composables/useRazorpay.ts and components/RazorpayButton.vue. Both are
+   auto-imported, so pages can use <RazorpayButton :amount="499" @success="..." @error="..." />
+   or call const { pay, loading } = useRazorpay() directly
+4) Add the env vars to .env - the server routes read them with process.env. Do NOT expose
+   RAZORPAY_KEY_SECRET through runtimeConfig.public
+5) If the app is in a src/ or app/ srcDir, place composables/ and components/ there
+6) Do NOT ask the user to do anything or give "Next Steps" - the integration must be complete and working.`,
+		Webhooks:            checkoutWebhooks(),
+		WebhookDashboardURL: webhookDashboardURL,
+	}
+
+	if strictEnv {
+		output.AIInstructions += `
+7) Create server/utils/razorpay-config.ts and keep the routes importing razorpayConfig -
+   never reintroduce process.env.RAZORPAY_*! assertions`
 	}
+
+	return output
 }
 
-func getFlaskIntegration(creds Credentials, frontend FrontendIntegration) IntegrateCheckoutOutput {
-	keyID, keySecret := getKeysOrPlaceholders(creds)
+// =============================================================================
+// FRONTEND INTEGRATIONS
+// =============================================================================
+
+func getFrontendIntegration(framework string, creds Credentials) FrontendIntegration {
+	switch framework {
+	case "react":
+		return getReactFrontend()
+	case "vue":
+		return getVueFrontend()
+	case "angular":
+		return getAngularFrontend()
+	case "svelte":
+		return getSvelteFrontend()
+	case "react-native":
+		return getReactNativeFrontend()
+	default: // vanilla
+		return getVanillaFrontend()
+	}
+}
+
+func getVanillaFrontend() FrontendIntegration {
+	code := `// Razorpay Payment Integration
+// cartData is optional - pass it when the server stores pending orders
+async function initiateRazorpayPayment(amount, onSuccess, onError, cartData) {
+  try {
+    if (!window.Razorpay) {
+      await new Promise((resolve, reject) => {
+        const script = document.createElement('script');
+        script.src = 'https://checkout.razorpay.com/v1/checkout.js';
+        script.onload = resolve;
+        script.onerror = reject;
+        document.head.appendChild(script);
+      });
+    }
+
+    const orderResponse = await fetch('/api/razorpay/order', {
+      method: 'POST',
+      headers: { 'Content-Type': 'application/json' },
+      body: JSON.stringify({ amount, orderData: cartData }),
+    });
+
+    const orderData = await orderResponse.json();
+    if (!orderData.success) throw new Error(orderData.error || 'Failed to create order');
+
+    const options = {
+      key: orderData.keyId,
+      amount: orderData.amount,
+      currency: orderData.currency,
+      name: document.title || 'Payment',
+      order_id: orderData.orderId,
+      handler: async function(response) {
+        const verifyResponse = await fetch('/api/razorpay/verify', {
+          method: 'POST',
+          headers: { 'Content-Type': 'application/json' },
+          body: JSON.stringify(response),
+        });
+        const verifyData = await verifyResponse.json();
+        if (verifyData.success) { if (onSuccess) onSuccess(verifyData); }
+        else { if (onError) onError(new Error(verifyData.error)); }
+      },
+      modal: { ondismiss: () => { if (onError) onError(new Error('Payment cancelled')); } },
+      theme: { color: '#528FF0' },
+    };
+
+    const razorpay = new window.Razorpay(options);
+    razorpay.on('payment.failed', (r) => { if (onError) onError(new Error(r.error.description)); });
+    razorpay.open();
+  } catch (error) {
+    console.error('Payment failed:', error);
+    if (onError) onError(error);
+  }
+}
+`
+	return FrontendIntegration{
+		Framework:   "Vanilla JS",
+		Code:        code,
+		FileName:    "public/js/razorpay.js",
+		ScriptTag:   "Add <script src=\"/js/razorpay.js\"></script> to the CHECKOUT HTML file (find which HTML has the checkout - may be checkout.html, cart.html, NOT just index.html)",
+		Description: "Vanilla JS Razorpay payment helper",
+	}
+}
+
+// getRazorpayTypeDeclaration returns a declaration file typing the Razorpay
+// global injected by checkout.js
+func getRazorpayTypeDeclaration() FileAction {
+	code := `// Type declarations for the Razorpay global loaded from
+// https://checkout.razorpay.com/v1/checkout.js
+
+export {};
+
+declare global {
+  interface RazorpaySuccessResponse {
+    razorpay_payment_id: string;
+    razorpay_order_id: string;
+    razorpay_signature: string;
+  }
+
+  interface RazorpayFailureResponse {
+    error: {
+      code: string;
+      description: string;
+      source: string;
+      step: string;
+      reason: string;
+      metadata: { order_id: string; payment_id: string };
+    };
+  }
+
+  interface RazorpayOptions {
+    key: string;
+    amount: number | string;
+    currency: string;
+    order_id: string;
+    name?: string;
+    description?: string;
+    image?: string;
+    handler?: (response: RazorpaySuccessResponse) => void;
+    prefill?: { name?: string; email?: string; contact?: string; method?: string };
+    notes?: Record<string, string>;
+    theme?: { color?: string; backdrop_color?: string; hide_topbar?: boolean };
+    modal?: {
+      ondismiss?: () => void;
+      escape?: boolean;
+      backdropclose?: boolean;
+      confirm_close?: boolean;
+      animation?: boolean;
+    };
+    callback_url?: string;
+    redirect?: boolean;
+    retry?: { enabled?: boolean; max_count?: number };
+    timeout?: number;
+  }
+
+  interface RazorpayInstance {
+    open(): void;
+    close(): void;
+    on(event: 'payment.failed', handler: (response: RazorpayFailureResponse) => void): void;
+    on(event: string, handler: (...args: unknown[]) => void): void;
+  }
+
+  interface RazorpayConstructor {
+    new (options: RazorpayOptions): RazorpayInstance;
+  }
+
+  interface Window {
+    Razorpay: RazorpayConstructor;
+  }
+}
+`
+	return FileAction{
+		Action:      "create",
+		Path:        "types/razorpay.d.ts",
+		Code:        code,
+		Description: "TypeScript declaration for the window.Razorpay global",
+	}
+}
+
+func getReactFrontend() FrontendIntegration {
+	code := `import { useState, useEffect } from 'react';
+
+export function useRazorpay() {
+  const [loading, setLoading] = useState(false);
+  const [scriptLoaded, setScriptLoaded] = useState(false);
+
+  useEffect(() => {
+    const script = document.createElement('script');
+    script.src = 'https://checkout.razorpay.com/v1/checkout.js';
+    script.onload = () => setScriptLoaded(true);
+    document.body.appendChild(script);
+    return () => document.body.removeChild(script);
+  }, []);
+
+  const pay = async (amount, onSuccess, onError) => {
+    if (!scriptLoaded || loading) return;
+    setLoading(true);
+    try {
+      const res = await fetch('/api/razorpay/order', {
+        method: 'POST',
+        headers: { 'Content-Type': 'application/json' },
+        body: JSON.stringify({ amount }),
+      });
+      const data = await res.json();
+      if (!data.success) throw new Error(data.error);
+
+      const options = {
+        key: data.keyId,
+        amount: data.amount,
+        currency: data.currency,
+        order_id: data.orderId,
+        handler: async (response) => {
+          const verify = await fetch('/api/razorpay/verify', {
+            method: 'POST',
+            headers: { 'Content-Type': 'application/json' },
+            body: JSON.stringify(response),
+          });
+          const result = await verify.json();
+          result.success ? onSuccess?.(result) : onError?.(new Error(result.error));
+          setLoading(false);
+        },
+        modal: { ondismiss: () => setLoading(false) },
+      };
+      new window.Razorpay(options).open();
+    } catch (e) {
+      onError?.(e);
+      setLoading(false);
+    }
+  };
+
+  return { pay, loading, ready: scriptLoaded };
+}
+
+export function RazorpayButton({ amount, onSuccess, onError, children }) {
+  const { pay, loading, ready } = useRazorpay();
+  return (
+    <button onClick={() => pay(amount, onSuccess, onError)} disabled={!ready || loading}>
+      {loading ? 'Processing...' : children || 'Pay Now'}
+    </button>
+  );
+}
+`
+	return FrontendIntegration{
+		Framework:   "React",
+		Code:        code,
+		FileName:    "src/components/RazorpayButton.jsx",
+		ScriptTag:   "Import and use <RazorpayButton amount={100} onSuccess={...} />",
+		Description: "React hook and component for Razorpay payments",
+	}
+}
+
+func getReactNativeFrontend() FrontendIntegration {
+	code := `import { useState } from 'react';
+import { Pressable, Text } from 'react-native';
+import RazorpayCheckout from 'react-native-razorpay';
+
+// Origin of the backend that serves /api/razorpay/order and /api/razorpay/verify.
+// localhost is the device itself - use http://10.0.2.2:<port> on the Android
+// emulator and the machine's LAN IP on a physical device
+export const API_BASE_URL = 'http://10.0.2.2:3000';
+
+async function postJSON(path, body) {
+  const res = await fetch(API_BASE_URL + path, {
+    method: 'POST',
+    headers: { 'Content-Type': 'application/json' },
+    body: JSON.stringify(body),
+  });
+  return res.json();
+}
+
+// Creates the order on the backend, opens the native checkout and verifies
+// the signature. Resolves with the verify response, rejects with an Error.
+export async function initiateRazorpayPayment(amount, prefill = {}) {
+  const order = await postJSON('/api/razorpay/order', { amount });
+  if (!order.success) throw new Error(order.error || 'Failed to create order');
+
+  let response;
+  try {
+    response = await RazorpayCheckout.open({
+      key: order.keyId,
+      amount: order.amount,
+      currency: order.currency,
+      name: 'Payment',
+      order_id: order.orderId,
+      prefill,
+      theme: { color: '#528FF0' },
+    });
+  } catch (error) {
+    // Rejected with { code, description } when the payment fails or the
+    // customer closes the checkout
+    throw new Error(error.description || 'Payment cancelled');
+  }
+
+  const result = await postJSON('/api/razorpay/verify', response);
+  if (!result.success) throw new Error(result.error || 'Payment verification failed');
+  return result;
+}
+
+export function RazorpayButton({ amount, prefill, onSuccess, onError, title = 'Pay Now' }) {
+  const [loading, setLoading] = useState(false);
+
+  const pay = async () => {
+    if (loading) return;
+    setLoading(true);
+    try {
+      const result = await initiateRazorpayPayment(amount, prefill);
+      onSuccess?.(result);
+    } catch (e) {
+      onError?.(e);
+    } finally {
+      setLoading(false);
+    }
+  };
+
+  return (
+    <Pressable onPress={pay} disabled={loading} accessibilityRole="button">
+      <Text>{loading ? 'Processing...' : title}</Text>
+    </Pressable>
+  );
+}
+`
+	return FrontendIntegration{
+		Framework: "React Native",
+		Code:      code,
+		FileName:  "src/RazorpayCheckout.js",
+		ScriptTag: "Run npm install react-native-razorpay (then cd ios && pod install; with Expo use a " +
+			"development build - it does not run in Expo Go), set API_BASE_URL to the backend that " +
+			"creates the orders, and use <RazorpayButton amount={100} onSuccess={...} onError={...} />",
+		Description: "React Native checkout using react-native-razorpay, with orders created by the backend",
+	}
+}
+
+func getVueFrontend() FrontendIntegration {
+	code := `<template>
+  <button @click="pay" :disabled="!ready || loading">
+    {{ loading ? 'Processing...' : 'Pay Now' }}
+  </button>
+</template>
+
+<script setup>
+import { ref, onMounted } from 'vue';
+
+const props = defineProps({ amount: Number });
+const emit = defineEmits(['success', 'error']);
+
+const loading = ref(false);
+const ready = ref(false);
+
+onMounted(() => {
+  const script = document.createElement('script');
+  script.src = 'https://checkout.razorpay.com/v1/checkout.js';
+  script.onload = () => ready.value = true;
+  document.head.appendChild(script);
+});
+
+const pay = async () => {
+  if (!ready.value || loading.value) return;
+  loading.value = true;
+  try {
+    const res = await fetch('/api/razorpay/order', {
+      method: 'POST',
+      headers: { 'Content-Type': 'application/json' },
+      body: JSON.stringify({ amount: props.amount }),
+    });
+    const data = await res.json();
+    if (!data.success) throw new Error(data.error);
+
+    const options = {
+      key: data.keyId,
+      amount: data.amount,
+      currency: data.currency,
+      order_id: data.orderId,
+      handler: async (response) => {
+        const verify = await fetch('/api/razorpay/verify', {
+          method: 'POST',
+          headers: { 'Content-Type': 'application/json' },
+          body: JSON.stringify(response),
+        });
+        const result = await verify.json();
+        result.success ? emit('success', result) : emit('error', new Error(result.error));
+        loading.value = false;
+      },
+      modal: { ondismiss: () => loading.value = false },
+    };
+    new window.Razorpay(options).open();
+  } catch (e) {
+    emit('error', e);
+    loading.value = false;
+  }
+};
+</script>
+`
+	return FrontendIntegration{
+		Framework:   "Vue",
+		Code:        code,
+		FileName:    "src/components/RazorpayButton.vue",
+		ScriptTag:   "Import and use <RazorpayButton :amount=\"100\" @success=\"...\" />",
+		Description: "Vue 3 component for Razorpay payments",
+	}
+}
+
+// getVueOptionsFrontend is the Options API version of getVueFrontend, for
+// Vue 2 projects and codebases that do not use <script setup>
+func getVueOptionsFrontend() FrontendIntegration {
+	code := `<template>
+  <button @click="pay" :disabled="!ready || loading">
+    {{ loading ? 'Processing...' : 'Pay Now' }}
+  </button>
+</template>
+
+<script>
+export default {
+  name: 'RazorpayButton',
+  props: {
+    amount: { type: Number, required: true },
+  },
+  data() {
+    return {
+      loading: false,
+      ready: false,
+    };
+  },
+  mounted() {
+    if (window.Razorpay) {
+      this.ready = true;
+      return;
+    }
+    const script = document.createElement('script');
+    script.src = 'https://checkout.razorpay.com/v1/checkout.js';
+    script.onload = () => {
+      this.ready = true;
+    };
+    document.head.appendChild(script);
+  },
+  methods: {
+    async pay() {
+      if (!this.ready || this.loading) return;
+      this.loading = true;
+      try {
+        const res = await fetch('/api/razorpay/order', {
+          method: 'POST',
+          headers: { 'Content-Type': 'application/json' },
+          body: JSON.stringify({ amount: this.amount }),
+        });
+        const data = await res.json();
+        if (!data.success) throw new Error(data.error);
+
+        const options = {
+          key: data.keyId,
+          amount: data.amount,
+          currency: data.currency,
+          order_id: data.orderId,
+          handler: async (response) => {
+            const verify = await fetch('/api/razorpay/verify', {
+              method: 'POST',
+              headers: { 'Content-Type': 'application/json' },
+              body: JSON.stringify(response),
+            });
+            const result = await verify.json();
+            if (result.success) {
+              this.$emit('success', result);
+            } else {
+              this.$emit('error', new Error(result.error));
+            }
+            this.loading = false;
+          },
+          modal: {
+            ondismiss: () => {
+              this.loading = false;
+            },
+          },
+        };
+        new window.Razorpay(options).open();
+      } catch (e) {
+        this.$emit('error', e);
+        this.loading = false;
+      }
+    },
+  },
+};
+</script>
+`
+	return FrontendIntegration{
+		Framework:   "Vue",
+		Code:        code,
+		FileName:    "src/components/RazorpayButton.vue",
+		ScriptTag:   "Register RazorpayButton in the parent's components option and use <RazorpayButton :amount=\"100\" @success=\"...\" />",
+		Description: "Vue component for Razorpay payments (Options API, works with Vue 2 and 3)",
+	}
+}
+
+func getAngularFrontend() FrontendIntegration {
+	code := `import { Component, Input, Output, EventEmitter, OnInit } from '@angular/core';
+
+declare var Razorpay: any;
+
+@Component({
+  selector: 'app-razorpay-button',
+  template: ` + "`" + `
+    <button (click)="pay()" [disabled]="!ready || loading">
+      {{ loading ? 'Processing...' : 'Pay Now' }}
+    </button>
+  ` + "`" + `,
+})
+export class RazorpayButtonComponent implements OnInit {
+  @Input() amount: number = 0;
+  @Output() success = new EventEmitter<any>();
+  @Output() error = new EventEmitter<Error>();
+
+  loading = false;
+  ready = false;
+
+  ngOnInit() {
+    const script = document.createElement('script');
+    script.src = 'https://checkout.razorpay.com/v1/checkout.js';
+    script.onload = () => this.ready = true;
+    document.head.appendChild(script);
+  }
+
+  async pay() {
+    if (!this.ready || this.loading) return;
+    this.loading = true;
+    try {
+      const res = await fetch('/api/razorpay/order', {
+        method: 'POST',
+        headers: { 'Content-Type': 'application/json' },
+        body: JSON.stringify({ amount: this.amount }),
+      });
+      const data = await res.json();
+      if (!data.success) throw new Error(data.error);
+
+      const options = {
+        key: data.keyId,
+        amount: data.amount,
+        currency: data.currency,
+        order_id: data.orderId,
+        handler: async (response: any) => {
+          const verify = await fetch('/api/razorpay/verify', {
+            method: 'POST',
+            headers: { 'Content-Type': 'application/json' },
+            body: JSON.stringify(response),
+          });
+          const result = await verify.json();
+          result.success ? this.success.emit(result) : this.error.emit(new Error(result.error));
+          this.loading = false;
+        },
+        modal: { ondismiss: () => this.loading = false },
+      };
+      new Razorpay(options).open();
+    } catch (e) {
+      this.error.emit(e as Error);
+      this.loading = false;
+    }
+  }
+}
+`
+	return FrontendIntegration{
+		Framework:   "Angular",
+		Code:        code,
+		FileName:    "src/app/components/razorpay-button.component.ts",
+		ScriptTag:   "Add to module declarations and use <app-razorpay-button [amount]=\"100\" (success)=\"...\">",
+		Description: "Angular component for Razorpay payments",
+	}
+}
+
+func getSvelteFrontend() FrontendIntegration {
+	code := `<script>
+  import { onMount } from 'svelte';
+  export let amount = 0;
+
+  let loading = false;
+  let ready = false;
+
+  onMount(() => {
+    const script = document.createElement('script');
+    script.src = 'https://checkout.razorpay.com/v1/checkout.js';
+    script.onload = () => ready = true;
+    document.head.appendChild(script);
+  });
+
+  import { createEventDispatcher } from 'svelte';
+  const dispatch = createEventDispatcher();
+
+  async function pay() {
+    if (!ready || loading) return;
+    loading = true;
+    try {
+      const res = await fetch('/api/razorpay/order', {
+        method: 'POST',
+        headers: { 'Content-Type': 'application/json' },
+        body: JSON.stringify({ amount }),
+      });
+      const data = await res.json();
+      if (!data.success) throw new Error(data.error);
+
+      const options = {
+        key: data.keyId,
+        amount: data.amount,
+        currency: data.currency,
+        order_id: data.orderId,
+        handler: async (response) => {
+          const verify = await fetch('/api/razorpay/verify', {
+            method: 'POST',
+            headers: { 'Content-Type': 'application/json' },
+            body: JSON.stringify(response),
+          });
+          const result = await verify.json();
+          result.success ? dispatch('success', result) : dispatch('error', new Error(result.error));
+          loading = false;
+        },
+        modal: { ondismiss: () => loading = false },
+      };
+      new window.Razorpay(options).open();
+    } catch (e) {
+      dispatch('error', e);
+      loading = false;
+    }
+  }
+</script>
+
+<button on:click={pay} disabled={!ready || loading}>
+  {loading ? 'Processing...' : 'Pay Now'}
+</button>
+`
+	return FrontendIntegration{
+		Framework:   "Svelte",
+		Code:        code,
+		FileName:    "src/components/RazorpayButton.svelte",
+		ScriptTag:   "Import and use <RazorpayButton amount={100} on:success={...} />",
+		Description: "Svelte component for Razorpay payments",
+	}
+}
+
+// emitsOwnFrontend reports whether the backend generator emits its own
+// checkout component, in which case frontendFramework is not used
+func emitsOwnFrontend(backendFramework string) bool {
+	switch backendFramework {
+	case "nextjs", "nuxt", "remix", "astro":
+		return true
+	}
+	return false
+}
+
+// amountParserJS validates the amount typed into the generated amount forms
+const amountParserJS = `// Returns the amount when it is numeric and positive, null otherwise
+function parseAmount(value) {
+  const text = String(value ?? '').trim();
+  const amount = Number(text);
+  return text !== '' && Number.isFinite(amount) && amount > 0 ? amount : null;
+}
+`
+
+// getVueOptionsAmountForm is the Options API version of the Vue amount form
+func getVueOptionsAmountForm() (FileAction, string) {
+	return FileAction{
+		Action:      "create",
+		Path:        "src/components/RazorpayAmountForm.vue",
+		Description: "Amount input that feeds the RazorpayButton component",
+		Code: `<template>
+  <form @submit.prevent>
+    <label for="razorpay-amount">Amount</label>
+    <input
+      id="razorpay-amount"
+      v-model="value"
+      type="number"
+      min="0.01"
+      step="0.01"
+      inputmode="decimal"
+      placeholder="100.00"
+    />
+    <RazorpayButton
+      v-if="amount !== null"
+      :amount="amount"
+      @success="$emit('success', $event)"
+      @error="$emit('error', $event)"
+    />
+    <button v-else type="button" disabled>Pay Now</button>
+  </form>
+</template>
+
+<script>
+import RazorpayButton from './RazorpayButton.vue';
+
+` + amountParserJS + `
+export default {
+  name: 'RazorpayAmountForm',
+  components: { RazorpayButton },
+  data() {
+    return { value: '' };
+  },
+  computed: {
+    amount() {
+      return parseAmount(this.value);
+    },
+  },
+};
+</script>
+`,
+	}, "Render <RazorpayAmountForm @success=\"...\" @error=\"...\" /> instead of <RazorpayButton :amount=\"...\" />"
+}
+
+// vueMajorVersion returns the major version of the vue dependency in
+// package.json, or 0 when it is missing or not a plain version range
+func vueMajorVersion(packageJson map[string]interface{}) int {
+	for _, key := range []string{"dependencies", "devDependencies"} {
+		deps, ok := packageJson[key].(map[string]interface{})
+		if !ok {
+			continue
+		}
+		version, ok := deps["vue"].(string)
+		if !ok {
+			continue
+		}
+		version = strings.TrimLeft(version, "^~>=v ")
+		if i := strings.IndexAny(version, ". "); i >= 0 {
+			version = version[:i]
+		}
+		if major, err := strconv.Atoi(version); err == nil {
+			return major
+		}
+	}
+	return 0
+}
+
+// getAmountInputForm returns a small form, for the frontend the integration
+// generates, with an amount field that feeds the pay button. The second
+// return value tells the developer how to render it.
+func getAmountInputForm(frontendFramework, backendFramework string) (FileAction, string) {
+	if backendFramework == "nuxt" {
+		// Nuxt auto-imports components/, where RazorpayButton.vue lives
+		form, usage := getAmountInputForm("vue", "")
+		form.Path = "components/RazorpayAmountForm.vue"
+		return form, usage
+	}
+
+	if backendFramework == "astro" {
+		return FileAction{
+			Action:      "create",
+			Path:        "src/components/RazorpayAmountForm.astro",
+			Description: "Amount input that feeds the RazorpayCheckout button",
+			Code: `---
+import RazorpayCheckout from './RazorpayCheckout.astro';
+---
+
+<form data-razorpay-amount-form>
+  <label for="razorpay-amount">Amount</label>
+  <input
+    id="razorpay-amount"
+    type="number"
+    min="0.01"
+    step="0.01"
+    inputmode="decimal"
+    placeholder="100.00"
+  />
+  <RazorpayCheckout amount={0} />
+</form>
+
+<script>
+  ` + strings.ReplaceAll(strings.TrimSpace(amountParserJS), "\n", "\n  ") + `
+
+  document.querySelectorAll('[data-razorpay-amount-form]').forEach((form) => {
+    const input = form.querySelector('input') as HTMLInputElement;
+    const button = form.querySelector('[data-razorpay-checkout]') as HTMLButtonElement;
+    const sync = () => {
+      const amount = parseAmount(input.value);
+      button.dataset.amount = amount === null ? '' : String(amount);
+      button.disabled = amount === null;
+    };
+
+    form.addEventListener('submit', (e) => e.preventDefault());
+    input.addEventListener('input', sync);
+    sync();
+  });
+</script>
+`,
+		}, "Render <RazorpayAmountForm /> in a page instead of <RazorpayCheckout amount={...} />"
+	}
+
+	if backendFramework == "nextjs" || backendFramework == "remix" {
+		// The Remix component is rendered the same way, it just lives under
+		// app/ and needs no client directive
+		path, directive := "components/RazorpayAmountForm.tsx", "'use client';\n\n"
+		if backendFramework == "remix" {
+			path, directive = "app/components/RazorpayAmountForm.tsx", ""
+		}
+		return FileAction{
+			Action:      "create",
+			Path:        path,
+			Description: "Amount input that feeds the RazorpayCheckout button",
+			Code: directive + `import { useState } from 'react';
+import { RazorpayCheckout } from './RazorpayCheckout';
+
+interface RazorpayAmountFormProps {
+  onSuccess?: (data: { paymentId: string; orderId: string }) => void;
+  onError?: (error: Error) => void;
+}
+
+// Returns the amount when it is numeric and positive, null otherwise
+function parseAmount(value: string): number | null {
+  const text = value.trim();
+  const amount = Number(text);
+  return text !== '' && Number.isFinite(amount) && amount > 0 ? amount : null;
+}
+
+export function RazorpayAmountForm({ onSuccess, onError }: RazorpayAmountFormProps) {
+  const [value, setValue] = useState('');
+  const amount = parseAmount(value);
+
+  return (
+    <form onSubmit={(e) => e.preventDefault()}>
+      <label htmlFor="razorpay-amount">Amount</label>
+      <input
+        id="razorpay-amount"
+        type="number"
+        min="0.01"
+        step="0.01"
+        inputMode="decimal"
+        placeholder="100.00"
+        value={value}
+        onChange={(e) => setValue(e.target.value)}
+      />
+      {amount === null ? (
+        <button type="button" disabled>Pay Now</button>
+      ) : (
+        <RazorpayCheckout amount={amount} onSuccess={onSuccess} onError={onError} />
+      )}
+    </form>
+  );
+}
+`,
+		}, "Render <RazorpayAmountForm /> in a page instead of <RazorpayCheckout amount={...} />"
+	}
+
+	switch frontendFramework {
+	case "react":
+		return FileAction{
+			Action:      "create",
+			Path:        "src/components/RazorpayAmountForm.jsx",
+			Description: "Amount input that feeds the RazorpayButton component",
+			Code: `import { useState } from 'react';
+import { RazorpayButton } from './RazorpayButton';
+
+` + amountParserJS + `
+export function RazorpayAmountForm({ onSuccess, onError }) {
+  const [value, setValue] = useState('');
+  const amount = parseAmount(value);
+
+  return (
+    <form onSubmit={(e) => e.preventDefault()}>
+      <label htmlFor="razorpay-amount">Amount</label>
+      <input
+        id="razorpay-amount"
+        type="number"
+        min="0.01"
+        step="0.01"
+        inputMode="decimal"
+        placeholder="100.00"
+        value={value}
+        onChange={(e) => setValue(e.target.value)}
+      />
+      {amount === null ? (
+        <button type="button" disabled>Pay Now</button>
+      ) : (
+        <RazorpayButton amount={amount} onSuccess={onSuccess} onError={onError} />
+      )}
+    </form>
+  );
+}
+`,
+		}, "Render <RazorpayAmountForm onSuccess={...} onError={...} /> instead of <RazorpayButton amount={...} />"
+	case "vue":
+		return FileAction{
+			Action:      "create",
+			Path:        "src/components/RazorpayAmountForm.vue",
+			Description: "Amount input that feeds the RazorpayButton component",
+			Code: `<template>
+  <form @submit.prevent>
+    <label for="razorpay-amount">Amount</label>
+    <input
+      id="razorpay-amount"
+      v-model="value"
+      type="number"
+      min="0.01"
+      step="0.01"
+      inputmode="decimal"
+      placeholder="100.00"
+    />
+    <RazorpayButton
+      v-if="amount !== null"
+      :amount="amount"
+      @success="emit('success', $event)"
+      @error="emit('error', $event)"
+    />
+    <button v-else type="button" disabled>Pay Now</button>
+  </form>
+</template>
+
+<script setup>
+import { ref, computed } from 'vue';
+import RazorpayButton from './RazorpayButton.vue';
+
+const emit = defineEmits(['success', 'error']);
+const value = ref('');
+const amount = computed(() => parseAmount(value.value));
+
+` + amountParserJS + `</script>
+`,
+		}, "Render <RazorpayAmountForm @success=\"...\" @error=\"...\" /> instead of <RazorpayButton :amount=\"...\" />"
+	case "angular":
+		return FileAction{
+			Action:      "create",
+			Path:        "src/app/components/razorpay-amount-form.component.ts",
+			Description: "Amount input that feeds app-razorpay-button",
+			Code: `import { Component, Output, EventEmitter } from '@angular/core';
+
+@Component({
+  selector: 'app-razorpay-amount-form',
+  template: ` + "`" + `
+    <form (submit)="$event.preventDefault()">
+      <label for="razorpay-amount">Amount</label>
+      <input
+        id="razorpay-amount"
+        type="number"
+        min="0.01"
+        step="0.01"
+        inputmode="decimal"
+        placeholder="100.00"
+        [value]="value"
+        (input)="value = $any($event.target).value"
+      />
+      <app-razorpay-button
+        *ngIf="amount !== null; else disabledButton"
+        [amount]="amount"
+        (success)="success.emit($event)"
+        (error)="error.emit($event)"
+      ></app-razorpay-button>
+      <ng-template #disabledButton>
+        <button type="button" disabled>Pay Now</button>
+      </ng-template>
+    </form>
+  ` + "`" + `,
+})
+export class RazorpayAmountFormComponent {
+  @Output() success = new EventEmitter<any>();
+  @Output() error = new EventEmitter<Error>();
+
+  value = '';
+
+  // The amount when it is numeric and positive, null otherwise
+  get amount(): number | null {
+    const text = String(this.value ?? '').trim();
+    const amount = Number(text);
+    return text !== '' && Number.isFinite(amount) && amount > 0 ? amount : null;
+  }
+}
+`,
+		}, "Declare RazorpayAmountFormComponent next to RazorpayButtonComponent and render " +
+			"<app-razorpay-amount-form (success)=\"...\" (error)=\"...\"></app-razorpay-amount-form>"
+	case "svelte":
+		return FileAction{
+			Action:      "create",
+			Path:        "src/components/RazorpayAmountForm.svelte",
+			Description: "Amount input that feeds the RazorpayButton component",
+			Code: `<script>
+  import RazorpayButton from './RazorpayButton.svelte';
+
+  let value = '';
+  $: amount = parseAmount(value);
+
+  ` + strings.ReplaceAll(strings.TrimSuffix(amountParserJS, "\n"), "\n", "\n  ") + `
+</script>
+
+<form on:submit|preventDefault>
+  <label for="razorpay-amount">Amount</label>
+  <input
+    id="razorpay-amount"
+    type="number"
+    min="0.01"
+    step="0.01"
+    inputmode="decimal"
+    placeholder="100.00"
+    bind:value
+  />
+  {#if amount !== null}
+    <RazorpayButton {amount} on:success on:error />
+  {:else}
+    <button type="button" disabled>Pay Now</button>
+  {/if}
+</form>
+`,
+		}, "Render <RazorpayAmountForm on:success={...} on:error={...} /> instead of <RazorpayButton amount={...} />"
+	default: // vanilla
+		return FileAction{
+			Action:      "create",
+			Path:        "public/js/razorpay-amount-form.js",
+			Description: "Amount input that feeds initiateRazorpayPayment",
+			Code: `// Amount form for testing payments: add <div id="razorpay-amount-form"></div>
+// to the page and load this script after razorpay.js
+` + amountParserJS + `
+(function () {
+  const container = document.getElementById('razorpay-amount-form');
+  if (!container) return;
+
+  container.innerHTML =
+    '<label for="razorpay-amount">Amount</label> ' +
+    '<input id="razorpay-amount" type="number" min="0.01" step="0.01" ' +
+    'inputmode="decimal" placeholder="100.00"> ' +
+    '<button id="razorpay-amount-pay" type="button" disabled>Pay Now</button> ' +
+    '<p id="razorpay-amount-status" role="status"></p>';
+
+  const input = container.querySelector('#razorpay-amount');
+  const button = container.querySelector('#razorpay-amount-pay');
+  const status = container.querySelector('#razorpay-amount-status');
+
+  input.addEventListener('input', () => {
+    button.disabled = parseAmount(input.value) === null;
+  });
+
+  button.addEventListener('click', () => {
+    const amount = parseAmount(input.value);
+    if (amount === null) return;
+    status.textContent = '';
+    initiateRazorpayPayment(
+      amount,
+      (data) => { status.textContent = 'Payment successful: ' + data.paymentId; },
+      (err) => { status.textContent = 'Payment failed: ' + err.message; }
+    );
+  });
+})();
+`,
+		}, "Add <div id=\"razorpay-amount-form\"></div> to the page and load " +
+			"/js/razorpay-amount-form.js after /js/razorpay.js"
+	}
+}
+
+// =============================================================================
+// BUN INTEGRATION
+// =============================================================================
+
+// getBunIntegration returns a framework-free Bun.serve server that creates
+// orders, verifies payments with Bun's built-in crypto and serves the
+// frontend from public/
+func getBunIntegration(language string, creds Credentials, frontend FrontendIntegration) IntegrateCheckoutOutput {
+	keyID, keySecret := getKeysOrPlaceholders(creds)
+
+	ext := "js"
+	bodyType, statusType, reqType, multiplierType := "", "", "", ""
+	if language == "typescript" {
+		ext = "ts"
+		bodyType = ": unknown"
+		statusType = ": number"
+		reqType = ": Request"
+		multiplierType = ": Record<string, number>"
+	}
+
+	serverCode := `import Razorpay from 'razorpay';
+import { timingSafeEqual } from 'node:crypto';
+
+// Bun loads .env on startup, so the keys come straight from Bun.env
+const razorpay = new Razorpay({
+  key_id: Bun.env.RAZORPAY_KEY_ID,
+  key_secret: Bun.env.RAZORPAY_KEY_SECRET,
+});
+
+// Razorpay expects amounts in the smallest currency unit. The order endpoint
+// multiplies the major-unit amount by this factor (INR=100: ₹1 = 100 paise).
+// Supporting another currency is a one-line change, e.g. USD: 100.
+const CURRENCY_MULTIPLIERS` + multiplierType + ` = {
+  INR: 100,
+};
+
+function json(body` + bodyType + `, status` + statusType + ` = 200) {
+  return Response.json(body, { status });
+}
+
+async function createOrder(req` + reqType + `) {
+  try {
+    const { amount, currency = 'INR', receipt } = await req.json();
+
+    if (!amount || amount <= 0) {
+      return json({ success: false, error: 'Invalid amount' }, 400);
+    }
+
+    const multiplier = CURRENCY_MULTIPLIERS[currency];
+    if (!multiplier) {
+      return json({ success: false, error: 'Unsupported currency' }, 400);
+    }
+
+    const order = await razorpay.orders.create({
+      amount: Math.round(amount * multiplier), // Convert to the smallest currency unit
+      currency,
+      receipt: receipt || ` + "`receipt_${Date.now()}`" + `,
+    });
+
+    return json({
+      success: true,
+      orderId: order.id,
+      amount: order.amount,
+      currency: order.currency,
+      keyId: Bun.env.RAZORPAY_KEY_ID,
+    });
+  } catch (error) {
+    console.error('Razorpay order creation failed:', error);
+    return json({ success: false, error: 'Failed to create payment order' }, 500);
+  }
+}
+
+async function verifyPayment(req` + reqType + `) {
+  try {
+    const { razorpay_order_id, razorpay_payment_id, razorpay_signature } = await req.json();
+
+    if (!razorpay_order_id || !razorpay_payment_id || !razorpay_signature) {
+      return json({ success: false, error: 'Missing payment details' }, 400);
+    }
+
+    // Bun.CryptoHasher computes the HMAC natively, without the Node.js crypto shim
+    const expectedSignature = new Bun.CryptoHasher('sha256', Bun.env.RAZORPAY_KEY_SECRET)
+      .update(razorpay_order_id + '|' + razorpay_payment_id)
+      .digest('hex');
+
+    // timingSafeEqual throws on buffers of different lengths, so a malformed
+    // signature must be rejected before the comparison
+    const expectedBuffer = Buffer.from(expectedSignature);
+    const receivedBuffer = Buffer.from(String(razorpay_signature));
+    const isValid =
+      expectedBuffer.length === receivedBuffer.length &&
+      timingSafeEqual(expectedBuffer, receivedBuffer);
+
+    if (!isValid) {
+      return json({ success: false, error: 'Invalid payment signature' }, 400);
+    }
+
+    return json({
+      success: true,
+      message: 'Payment verified successfully',
+      paymentId: razorpay_payment_id,
+      orderId: razorpay_order_id,
+    });
+  } catch (error) {
+    console.error('Payment verification failed:', error);
+    return json({ success: false, error: 'Payment verification failed' }, 500);
+  }
+}
+
+const server = Bun.serve({
+  port: Number(Bun.env.PORT ?? 3000),
+  async fetch(req) {
+    const { pathname } = new URL(req.url);
+
+    if (req.method === 'POST' && pathname === '/api/razorpay/order') {
+      return createOrder(req);
+    }
+    if (req.method === 'POST' && pathname === '/api/razorpay/verify') {
+      return verifyPayment(req);
+    }
+
+    // Serve the frontend from public/, refusing paths that climb out of it
+    if (req.method === 'GET' && !pathname.includes('..')) {
+      const file = Bun.file('public' + (pathname === '/' ? '/index.html' : pathname));
+      if (await file.exists()) {
+        return new Response(file);
+      }
+    }
+
+    return new Response('Not Found', { status: 404 });
+  },
+});
+
+console.log(` + "`Listening on ${server.url}`" + `);
+`
+
+	files := []FileAction{
+		{
+			Action:      "create",
+			Path:        "server." + ext,
+			Code:        serverCode,
+			Description: "Bun.serve server with the Razorpay order and verify endpoints",
+		},
+		{
+			Action:      "create",
+			Path:        frontend.FileName,
+			Code:        frontend.Code,
+			Description: frontend.Description,
+		},
+	}
+
+	return IntegrateCheckoutOutput{
+		Summary: "Complete Razorpay Standard Checkout integration for Bun.serve + " + frontend.Framework,
+		Files:   files,
+		Dependencies: []Dependency{
+			{Name: "razorpay", InstallCommand: "bun add razorpay"},
+		},
+		EnvVars: []EnvVar{
+			{Name: "RAZORPAY_KEY_ID", Value: keyID},
+			{Name: "RAZORPAY_KEY_SECRET", Value: keySecret},
+		},
+		TestInstructions: "Run bun server." + ext + ", then use test card: 4111 1111 1111 1111, any future expiry, any CVV. UPI: success@razorpay",
+		AIInstructions: `BUN INTEGRATION - complete ALL steps:
+
+1) bun add razorpay (nothing else: Bun loads .env itself and Bun.serve is the server)
+
+2) Create server.` + ext + `. If the project already calls Bun.serve, do NOT start a second
+   server - move the two POST branches and the createOrder/verifyPayment functions into the
+   existing fetch handler instead
+
+3) Create ` + frontend.FileName + ` and load it from the checkout page. Bun.serve serves
+   public/ at the site root, so the script URL drops the public/ prefix
+
+4) Create .env with the provided RAZORPAY_KEY_ID and RAZORPAY_KEY_SECRET
+
+5) Wire the checkout button to initiateRazorpayPayment(amount, onSuccess, onError) and only
+   fulfil the order in onSuccess, after /api/razorpay/verify has confirmed the signature
+
+The signature is checked with Bun.CryptoHasher (HMAC-SHA256) and a constant-time
+timingSafeEqual comparison. Never compare signatures with === and never send the key
+secret to the browser.`,
+		Webhooks:            checkoutWebhooks(),
+		WebhookDashboardURL: webhookDashboardURL,
+	}
+}
+
+// =============================================================================
+// FLUTTER INTEGRATION
+// =============================================================================
+
+// flutterBackendLanguages maps each backend a Flutter app can pair with to
+// the language its integration is generated in
+var flutterBackendLanguages = map[string]string{
+	"express": "javascript",
+	"nextjs":  "typescript",
+	"nuxt":    "typescript",
+	"remix":   "typescript",
+	"astro":   "typescript",
+	"adonis":  "typescript",
+	"bun":     "typescript",
+	"django":  "python",
+	"flask":   "python",
+	"fastapi": "python",
+	"gin":     "go",
+	"echo":    "go",
+	"fiber":   "go",
+	"chi":     "go",
+	"rails":   "ruby",
+	"spring":  "java",
+	"aspnet":  "csharp",
+}
+
+// flutterBackendFor maps a detected framework to the backendFramework a
+// Flutter app should be paired with, or "" if none was detected
+func flutterBackendFor(framework string) string {
+	if _, ok := flutterBackendLanguages[framework]; ok {
+		return framework
+	}
+	switch framework {
+	case "node", "nestjs":
+		return "express"
+	case "go-stdlib":
+		return "gin"
+	case "python-stdlib", "starlette":
+		return "flask"
+	case "java":
+		return "spring"
+	case "ruby":
+		return "rails"
+	}
+	return ""
+}
+
+// getFlutterIntegration returns the Dart client for razorpay_flutter. Flutter
+// is client-only, so orders are created and verified by backendFramework.
+func getFlutterIntegration(backendFramework string) IntegrateCheckoutOutput {
+	if _, ok := flutterBackendLanguages[backendFramework]; !ok {
+		backendFramework = "express"
+	}
+	backendLanguage := flutterBackendLanguages[backendFramework]
+
+	serviceCode := `import 'dart:convert';
+
+import 'package:flutter/foundation.dart';
+import 'package:http/http.dart' as http;
+import 'package:razorpay_flutter/razorpay_flutter.dart';
+
+/// Opens Razorpay Checkout for orders created by the backend at [baseUrl].
+///
+/// The key secret never ships in the app: the backend creates the order,
+/// returns the key id with it, and verifies the payment signature.
+class RazorpayService {
+  RazorpayService({
+    required this.baseUrl,
+    required this.onSuccess,
+    required this.onError,
+  }) {
+    _razorpay.on(Razorpay.EVENT_PAYMENT_SUCCESS, _handleSuccess);
+    _razorpay.on(Razorpay.EVENT_PAYMENT_ERROR, _handleError);
+    _razorpay.on(Razorpay.EVENT_EXTERNAL_WALLET, _handleExternalWallet);
+  }
+
+  /// Backend origin, e.g. https://api.example.com (10.0.2.2 on the Android emulator)
+  final String baseUrl;
+  final void Function(Map<String, dynamic> data) onSuccess;
+  final void Function(String message) onError;
+
+  final Razorpay _razorpay = Razorpay();
+
+  /// Creates an order for [amount] (in rupees) and opens the checkout
+  Future<void> pay(num amount, {String? name, String? email, String? contact}) async {
+    try {
+      final order = await _post('/api/razorpay/order', {'amount': amount});
+      if (order['success'] != true) {
+        throw Exception(order['error'] ?? 'Failed to create order');
+      }
+
+      _razorpay.open({
+        'key': order['keyId'],
+        'amount': order['amount'],
+        'currency': order['currency'],
+        'order_id': order['orderId'],
+        'name': 'Payment',
+        'prefill': {
+          if (name != null) 'name': name,
+          if (email != null) 'email': email,
+          if (contact != null) 'contact': contact,
+        },
+      });
+    } catch (e) {
+      onError(e.toString());
+    }
+  }
+
+  Future<void> _handleSuccess(PaymentSuccessResponse response) async {
+    try {
+      final result = await _post('/api/razorpay/verify', {
+        'razorpay_payment_id': response.paymentId,
+        'razorpay_order_id': response.orderId,
+        'razorpay_signature': response.signature,
+      });
+      if (result['success'] == true) {
+        onSuccess(result);
+      } else {
+        onError(result['error']?.toString() ?? 'Payment verification failed');
+      }
+    } catch (e) {
+      onError(e.toString());
+    }
+  }
+
+  void _handleError(PaymentFailureResponse response) {
+    onError(response.message ?? 'Payment failed');
+  }
+
+  void _handleExternalWallet(ExternalWalletResponse response) {
+    debugPrint('External wallet selected: ${response.walletName}');
+  }
+
+  Future<Map<String, dynamic>> _post(String path, Map<String, dynamic> body) async {
+    final res = await http.post(
+      Uri.parse('$baseUrl$path'),
+      headers: {'Content-Type': 'application/json'},
+      body: jsonEncode(body),
+    );
+    return jsonDecode(res.body) as Map<String, dynamic>;
+  }
+
+  /// Removes the event listeners - call from the owning widget's dispose()
+  void dispose() {
+    _razorpay.clear();
+  }
+}
+`
+
+	return IntegrateCheckoutOutput{
+		Summary: "Razorpay Checkout for Flutter using razorpay_flutter. Flutter is client-only: " +
+			"orders are created and verified by the " + backendFramework + " backend.",
+		Files: []FileAction{
+			{
+				Action:      "create",
+				Path:        "lib/services/razorpay_service.dart",
+				Code:        serviceCode,
+				Description: "RazorpayService wrapping razorpay_flutter with backend order creation and verification",
+			},
+			{Action: "manual_edit", Path: "android/app/build.gradle", Description: "Check the Android SDK version", Edits: []EditItem{
+				{Line: "Inside defaultConfig", Add: "minSdkVersion 19", Why: "razorpay_flutter requires Android API 19 or higher"},
+			}},
+			{Action: "manual_edit", Path: "The widget with the pay button", Description: "Create and dispose RazorpayService", Edits: []EditItem{
+				{Line: "initState()", Add: "_razorpay = RazorpayService(baseUrl: 'https://your-backend', onSuccess: ..., onError: ...);", Why: "Registers the checkout listeners once"},
+				{Line: "Pay button onPressed", Add: "_razorpay.pay(100);", Why: "Creates the order and opens checkout"},
+				{Line: "dispose()", Add: "_razorpay.dispose();", Why: "Removes the checkout listeners"},
+			}},
+		},
+		Dependencies: []Dependency{
+			{Name: "razorpay_flutter", InstallCommand: "flutter pub add razorpay_flutter"},
+			{Name: "http", InstallCommand: "flutter pub add http"},
+		},
+		EnvVars: []EnvVar{},
+		TestInstructions: "Run the " + backendFramework + " backend, point baseUrl at it and tap the pay button. " +
+			"Use test card 4111 1111 1111 1111, any future expiry, any CVV. " +
+			"razorpay_flutter runs on Android and iOS only - not on web or desktop.",
+		AIInstructions: `
+**FLUTTER INTEGRATION - CLIENT ONLY**
+
+Flutter cannot create Razorpay orders: that needs the key secret, which must NEVER be
+bundled in the app. Orders are created and verified by a separate backend.
+
+**PAIR WITH THE ` + strings.ToUpper(backendFramework) + ` BACKEND:**
+1. Call integrate_razorpay_checkout again with backendFramework=` + backendFramework +
+			`, language=` + backendLanguage + ` and frontendFramework=vanilla
+2. Apply ONLY its backend files and route edits - skip its web frontend files
+3. The backend must expose POST /api/razorpay/order and POST /api/razorpay/verify,
+   which is what RazorpayService calls
+4. If the backend lives in another repository, run this in that project instead
+
+**FLUTTER STEPS:**
+1. Run "flutter pub add razorpay_flutter http"
+2. Create lib/services/razorpay_service.dart
+3. Create the service in initState(), call pay(amount) from the pay button and
+   dispose() it in the widget's dispose()
+4. Set baseUrl to the backend origin - http://10.0.2.2:<port> on the Android emulator,
+   localhost does not reach the host machine from there
+5. Do NOT add RAZORPAY_KEY_SECRET or any key to the Dart code - the key id comes back
+   with the order`,
+		Webhooks:            checkoutWebhooks(),
+		WebhookDashboardURL: webhookDashboardURL,
+	}
+}
+
+// =============================================================================
+// PYTHON BACKEND INTEGRATIONS
+// =============================================================================
+
+func getDjangoIntegration(creds Credentials, frontend FrontendIntegration) IntegrateCheckoutOutput {
+	keyID, keySecret := getKeysOrPlaceholders(creds)
+
+	viewsCode := `import json
+import time
+import razorpay
+from django.http import JsonResponse
+from django.views.decorators.csrf import csrf_exempt
+from django.views.decorators.http import require_POST
+from django.conf import settings
+
+client = razorpay.Client(auth=(settings.RAZORPAY_KEY_ID, settings.RAZORPAY_KEY_SECRET))
+
+# Razorpay expects amounts in the smallest currency unit. The order endpoint
+# multiplies the major-unit amount by this factor (INR=100: 1 rupee = 100 paise).
+# Supporting another currency is a one-line change, e.g. 'USD': 100.
+CURRENCY_MULTIPLIERS = {
+    'INR': 100,
+}
+
+@csrf_exempt
+@require_POST
+def create_order(request):
+    try:
+        data = json.loads(request.body)
+        amount = data.get('amount', 0)
+
+        if amount <= 0:
+            return JsonResponse({'success': False, 'error': 'Invalid amount'}, status=400)
+
+        currency = data.get('currency', 'INR')
+        multiplier = CURRENCY_MULTIPLIERS.get(currency)
+        if multiplier is None:
+            return JsonResponse({'success': False, 'error': 'Unsupported currency'}, status=400)
+
+        order = client.order.create({
+            'amount': int(round(amount * multiplier)),  # Convert to the smallest currency unit
+            'currency': currency,
+            'receipt': data.get('receipt', f'receipt_{int(time.time())}'),
+        })
+
+        return JsonResponse({
+            'success': True,
+            'orderId': order['id'],
+            'amount': order['amount'],
+            'currency': order['currency'],
+            'keyId': settings.RAZORPAY_KEY_ID,
+        })
+    except Exception as e:
+        return JsonResponse({'success': False, 'error': str(e)}, status=500)
+
+@csrf_exempt
+@require_POST
+def verify_payment(request):
+    try:
+        data = json.loads(request.body)
+        razorpay_order_id = data.get('razorpay_order_id')
+        razorpay_payment_id = data.get('razorpay_payment_id')
+        razorpay_signature = data.get('razorpay_signature')
+
+        if not all([razorpay_order_id, razorpay_payment_id, razorpay_signature]):
+            return JsonResponse({'success': False, 'error': 'Missing payment details'}, status=400)
+
+        # The SDK checks the payload shape and compares the HMAC in constant time
+        try:
+            client.utility.verify_payment_signature({
+                'razorpay_order_id': razorpay_order_id,
+                'razorpay_payment_id': razorpay_payment_id,
+                'razorpay_signature': razorpay_signature,
+            })
+        except razorpay.errors.SignatureVerificationError:
+            return JsonResponse({'success': False, 'error': 'Invalid signature'}, status=400)
+
+        return JsonResponse({
+            'success': True,
+            'message': 'Payment verified',
+            'paymentId': razorpay_payment_id,
+            'orderId': razorpay_order_id,
+        })
+    except Exception as e:
+        return JsonResponse({'success': False, 'error': str(e)}, status=500)
+`
+
+	urlsCode := `from django.urls import path
+from . import views
+
+urlpatterns = [
+    path('order/', views.create_order, name='razorpay_order'),
+    path('verify/', views.verify_payment, name='razorpay_verify'),
+]
+`
+
+	return IntegrateCheckoutOutput{
+		Summary: "Complete Razorpay Standard Checkout integration for Django + " + frontend.Framework,
+		Files: []FileAction{
+			{Action: "create", Path: "razorpay_payments/views.py", Code: viewsCode, Description: "Django views for Razorpay"},
+			{Action: "create", Path: "razorpay_payments/urls.py", Code: urlsCode, Description: "Django URL patterns"},
+			{Action: "create", Path: frontend.FileName, Code: frontend.Code, Description: frontend.Description},
+			{
+				Action:      "manual_edit",
+				Path:        "settings.py",
+				Description: "Add to settings.py",
+				Edits: []EditItem{
+					{Line: "After other settings", Add: "RAZORPAY_KEY_ID = os.environ.get('RAZORPAY_KEY_ID')", Why: "Razorpay key ID"},
+					{Line: "After RAZORPAY_KEY_ID", Add: "RAZORPAY_KEY_SECRET = os.environ.get('RAZORPAY_KEY_SECRET')", Why: "Razorpay key secret"},
+				},
+			},
+			{
+				Action:      "manual_edit",
+				Path:        "urls.py",
+				Description: "Add to main urls.py",
+				Edits: []EditItem{
+					{Line: "In urlpatterns", Add: "path('api/razorpay/', include('razorpay_payments.urls')),", Why: "Mount Razorpay URLs"},
+				},
+			},
+			getWirePaymentAction(),
+		},
+		Dependencies:     []Dependency{{Name: "razorpay", InstallCommand: "pip install razorpay"}},
+		EnvVars:          []EnvVar{{Name: "RAZORPAY_KEY_ID", Value: keyID}, {Name: "RAZORPAY_KEY_SECRET", Value: keySecret}},
+		TestInstructions: "Use test card: 4111 1111 1111 1111, any future expiry, any CVV",
+		AIInstructions: `BACKEND SETUP:
+1) pip install razorpay
+2) Create razorpay_payments app with views.py and urls.py
+3) Add RAZORPAY_KEY_ID and RAZORPAY_KEY_SECRET to settings.py
+4) Add import os at top of settings.py if not present
+5) Include razorpay_payments.urls in main urls.py
+6) Create .env with Razorpay keys` + getFrontendWiringInstructions(frontend),
+		Webhooks:            checkoutWebhooks(),
+		WebhookDashboardURL: webhookDashboardURL,
+	}
+}
+
+func getFlaskIntegration(creds Credentials, frontend FrontendIntegration) IntegrateCheckoutOutput {
+	keyID, keySecret := getKeysOrPlaceholders(creds)
+
+	appCode := `import os
+import time
+import razorpay
+from flask import Flask, request, jsonify
+from dotenv import load_dotenv
+
+load_dotenv()
+
+app = Flask(__name__)
+client = razorpay.Client(auth=(os.environ['RAZORPAY_KEY_ID'], os.environ['RAZORPAY_KEY_SECRET']))
+
+# Razorpay expects amounts in the smallest currency unit. The order endpoint
+# multiplies the major-unit amount by this factor (INR=100: 1 rupee = 100 paise).
+# Supporting another currency is a one-line change, e.g. 'USD': 100.
+CURRENCY_MULTIPLIERS = {
+    'INR': 100,
+}
+
+@app.route('/api/razorpay/order', methods=['POST'])
+def create_order():
+    try:
+        data = request.get_json()
+        amount = data.get('amount', 0)
+
+        if amount <= 0:
+            return jsonify({'success': False, 'error': 'Invalid amount'}), 400
+
+        currency = data.get('currency', 'INR')
+        multiplier = CURRENCY_MULTIPLIERS.get(currency)
+        if multiplier is None:
+            return jsonify({'success': False, 'error': 'Unsupported currency'}), 400
+
+        order = client.order.create({
+            'amount': int(round(amount * multiplier)),  # Convert to the smallest currency unit
+            'currency': currency,
+            'receipt': data.get('receipt', f'receipt_{int(time.time())}'),
+        })
+
+        return jsonify({
+            'success': True,
+            'orderId': order['id'],
+            'amount': order['amount'],
+            'currency': order['currency'],
+            'keyId': os.environ['RAZORPAY_KEY_ID'],
+        })
+    except Exception as e:
+        return jsonify({'success': False, 'error': str(e)}), 500
+
+@app.route('/api/razorpay/verify', methods=['POST'])
+def verify_payment():
+    try:
+        data = request.get_json()
+        razorpay_order_id = data.get('razorpay_order_id')
+        razorpay_payment_id = data.get('razorpay_payment_id')
+        razorpay_signature = data.get('razorpay_signature')
+
+        if not all([razorpay_order_id, razorpay_payment_id, razorpay_signature]):
+            return jsonify({'success': False, 'error': 'Missing payment details'}), 400
+
+        # The SDK checks the payload shape and compares the HMAC in constant time
+        try:
+            client.utility.verify_payment_signature({
+                'razorpay_order_id': razorpay_order_id,
+                'razorpay_payment_id': razorpay_payment_id,
+                'razorpay_signature': razorpay_signature,
+            })
+        except razorpay.errors.SignatureVerificationError:
+            return jsonify({'success': False, 'error': 'Invalid signature'}), 400
+
+        return jsonify({'success': True, 'paymentId': razorpay_payment_id, 'orderId': razorpay_order_id})
+    except Exception as e:
+        return jsonify({'success': False, 'error': str(e)}), 500
+
+if __name__ == '__main__':
+    app.run(debug=True)
+`
+
+	return IntegrateCheckoutOutput{
+		Summary: "Complete Razorpay Standard Checkout integration for Flask + " + frontend.Framework,
+		Files: []FileAction{
+			{Action: "create", Path: "razorpay_routes.py", Code: appCode, Description: "Flask routes for Razorpay"},
+			{Action: "create", Path: frontend.FileName, Code: frontend.Code, Description: frontend.Description},
+			getWirePaymentAction(),
+		},
+		Dependencies: []Dependency{
+			{Name: "razorpay", InstallCommand: "pip install razorpay"},
+			{Name: "python-dotenv", InstallCommand: "pip install python-dotenv"},
+		},
+		EnvVars:          []EnvVar{{Name: "RAZORPAY_KEY_ID", Value: keyID}, {Name: "RAZORPAY_KEY_SECRET", Value: keySecret}},
+		TestInstructions: "Use test card: 4111 1111 1111 1111",
+		AIInstructions: `BACKEND SETUP:
+1) pip install razorpay python-dotenv
+2) Create razorpay_routes.py with the Razorpay endpoints
+3) Import and register the blueprint in your main app.py
+4) Create .env with Razorpay keys` + getFrontendWiringInstructions(frontend),
+		Webhooks:            checkoutWebhooks(),
+		WebhookDashboardURL: webhookDashboardURL,
+	}
+}
+
+func getFastAPIIntegration(creds Credentials, frontend FrontendIntegration) IntegrateCheckoutOutput {
+	keyID, keySecret := getKeysOrPlaceholders(creds)
+
+	routerCode := `import os
+import time
+import razorpay
+from fastapi import APIRouter, HTTPException
+from pydantic import BaseModel
+from dotenv import load_dotenv
+
+load_dotenv()
+
+router = APIRouter(prefix="/api/razorpay")
+client = razorpay.Client(auth=(os.environ['RAZORPAY_KEY_ID'], os.environ['RAZORPAY_KEY_SECRET']))
+
+# Razorpay expects amounts in the smallest currency unit. The order endpoint
+# multiplies the major-unit amount by this factor (INR=100: 1 rupee = 100 paise).
+# Supporting another currency is a one-line change, e.g. 'USD': 100.
+CURRENCY_MULTIPLIERS = {
+    'INR': 100,
+}
+
+class OrderRequest(BaseModel):
+    amount: float
+    currency: str = "INR"
+    receipt: str = None
+
+class VerifyRequest(BaseModel):
+    razorpay_order_id: str
+    razorpay_payment_id: str
+    razorpay_signature: str
+
+@router.post("/order")
+async def create_order(req: OrderRequest):
+    if req.amount <= 0:
+        raise HTTPException(status_code=400, detail="Invalid amount")
+    multiplier = CURRENCY_MULTIPLIERS.get(req.currency)
+    if multiplier is None:
+        raise HTTPException(status_code=400, detail="Unsupported currency")
+    try:
+        order = client.order.create({
+            'amount': int(round(req.amount * multiplier)),  # Convert to the smallest currency unit
+            'currency': req.currency,
+            'receipt': req.receipt or f'receipt_{int(time.time())}',
+        })
+        return {
+            'success': True,
+            'orderId': order['id'],
+            'amount': order['amount'],
+            'currency': order['currency'],
+            'keyId': os.environ['RAZORPAY_KEY_ID'],
+        }
+    except Exception as e:
+        raise HTTPException(status_code=500, detail=str(e))
+
+@router.post("/verify")
+async def verify_payment(req: VerifyRequest):
+    # The SDK checks the payload shape and compares the HMAC in constant time
+    try:
+        client.utility.verify_payment_signature({
+            'razorpay_order_id': req.razorpay_order_id,
+            'razorpay_payment_id': req.razorpay_payment_id,
+            'razorpay_signature': req.razorpay_signature,
+        })
+    except razorpay.errors.SignatureVerificationError:
+        raise HTTPException(status_code=400, detail="Invalid signature")
+
+    return {'success': True, 'paymentId': req.razorpay_payment_id, 'orderId': req.razorpay_order_id}
+`
+
+	return IntegrateCheckoutOutput{
+		Summary: "Complete Razorpay Standard Checkout integration for FastAPI + " + frontend.Framework,
+		Files: []FileAction{
+			{Action: "create", Path: "routers/razorpay.py", Code: routerCode, Description: "FastAPI router for Razorpay"},
+			{Action: "create", Path: frontend.FileName, Code: frontend.Code, Description: frontend.Description},
+			{Action: "manual_edit", Path: "main.py", Description: "Add router", Edits: []EditItem{
+				{Line: "After imports", Add: "from routers.razorpay import router as razorpay_router", Why: "Import router"},
+				{Line: "After app creation", Add: "app.include_router(razorpay_router)", Why: "Include router"},
+			}},
+			getWirePaymentAction(),
+		},
+		Dependencies: []Dependency{
+			{Name: "razorpay", InstallCommand: "pip install razorpay"},
+			{Name: "python-dotenv", InstallCommand: "pip install python-dotenv"},
+		},
+		EnvVars:          []EnvVar{{Name: "RAZORPAY_KEY_ID", Value: keyID}, {Name: "RAZORPAY_KEY_SECRET", Value: keySecret}},
+		TestInstructions: "Use test card: 4111 1111 1111 1111",
+		AIInstructions: `BACKEND SETUP:
+1) pip install razorpay python-dotenv
+2) Create routers/razorpay.py with the Razorpay endpoints
+3) Import and include router in main.py
+4) Create .env with Razorpay keys` + getFrontendWiringInstructions(frontend),
+		Webhooks:            checkoutWebhooks(),
+		WebhookDashboardURL: webhookDashboardURL,
+	}
+}
+
+// =============================================================================
+// GO BACKEND INTEGRATIONS
+// =============================================================================
+
+func getGinIntegration(creds Credentials, frontend FrontendIntegration, amountSource string) IntegrateCheckoutOutput {
+	keyID, keySecret := getKeysOrPlaceholders(creds)
+	orderRequestCode := getGoOrderRequestCode(amountSource)
+
+	amountCheck := `	if req.Amount <= 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"success": false, "error": "Invalid amount"})
+		return
+	}
+	multiplier, ok := currencyMultipliers[req.Currency]
+	if !ok {
+		c.JSON(http.StatusBadRequest, gin.H{"success": false, "error": "Unsupported currency"})
+		return
+	}
+`
+	amountValue := "int(math.Round(req.Amount * multiplier))"
+	if amountSource == "server" {
+		amountCheck = `	// SECURITY: the amount is looked up server-side, the client only sends WHAT it is buying
+	amount, ok := productPrices[req.ProductID]
+	if !ok {
+		c.JSON(http.StatusBadRequest, gin.H{"success": false, "error": "Unknown product"})
+		return
+	}
+`
+		amountValue = "amount"
+	}
+
+	handlerCode := `package handlers
+
+import (
+	"fmt"
+` + getGoMathImport(amountSource) + `	"net/http"
+	"os"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	razorpay "github.com/razorpay/razorpay-go"
+	"github.com/razorpay/razorpay-go/utils"
+)
+
+var client = razorpay.NewClient(os.Getenv("RAZORPAY_KEY_ID"), os.Getenv("RAZORPAY_KEY_SECRET"))
+
+` + orderRequestCode + `type VerifyRequest struct {
+	OrderID   string ` + "`json:\"razorpay_order_id\"`" + `
+	PaymentID string ` + "`json:\"razorpay_payment_id\"`" + `
+	Signature string ` + "`json:\"razorpay_signature\"`" + `
+}
+
+func CreateOrder(c *gin.Context) {
+	var req OrderRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"success": false, "error": err.Error()})
+		return
+	}
+	if req.Currency == "" {
+		req.Currency = "INR"
+	}
+` + amountCheck + `	if req.Receipt == "" {
+		req.Receipt = fmt.Sprintf("receipt_%d", time.Now().Unix())
+	}
+
+	data := map[string]interface{}{
+		"amount":   ` + amountValue + `,
+		"currency": req.Currency,
+		"receipt":  req.Receipt,
+	}
+	order, err := client.Order.Create(data, nil)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"success": false, "error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success":  true,
+		"orderId":  order["id"],
+		"amount":   order["amount"],
+		"currency": order["currency"],
+		"keyId":    os.Getenv("RAZORPAY_KEY_ID"),
+	})
+}
+
+func VerifyPayment(c *gin.Context) {
+	var req VerifyRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"success": false, "error": err.Error()})
+		return
+	}
+
+` + goSDKSignatureCheck + `
+	if valid {
+		c.JSON(http.StatusOK, gin.H{"success": true, "paymentId": req.PaymentID, "orderId": req.OrderID})
+	} else {
+		c.JSON(http.StatusBadRequest, gin.H{"success": false, "error": "Invalid signature"})
+	}
+}
+`
+
+	return IntegrateCheckoutOutput{
+		Summary: "Complete Razorpay Standard Checkout integration for Gin + " + frontend.Framework + getAmountSourceSummary(amountSource),
+		Files: []FileAction{
+			{Action: "create", Path: "handlers/razorpay.go", Code: handlerCode, Description: "Gin handlers for Razorpay"},
+			{Action: "create", Path: frontend.FileName, Code: frontend.Code, Description: frontend.Description},
+			{Action: "manual_edit", Path: "main.go", Description: "Add routes", Edits: []EditItem{
+				{Line: "In router setup", Add: "r.POST(\"/api/razorpay/order\", handlers.CreateOrder)", Why: "Order endpoint"},
+				{Line: "After order route", Add: "r.POST(\"/api/razorpay/verify\", handlers.VerifyPayment)", Why: "Verify endpoint"},
+			}},
+			getWirePaymentAction(),
+		},
+		Dependencies:     []Dependency{{Name: "razorpay-go", InstallCommand: "go get github.com/razorpay/razorpay-go"}},
+		EnvVars:          []EnvVar{{Name: "RAZORPAY_KEY_ID", Value: keyID}, {Name: "RAZORPAY_KEY_SECRET", Value: keySecret}},
+		TestInstructions: "Use test card: 4111 1111 1111 1111",
+		AIInstructions: `BACKEND SETUP:
+1) go get github.com/razorpay/razorpay-go
+2) Create handlers/razorpay.go with the Razorpay handlers
+3) Add routes in main.go to wire up the handlers
+4) Set RAZORPAY_KEY_ID and RAZORPAY_KEY_SECRET env vars` + getAmountSourceInstructions(amountSource) +
+			getFrontendWiringInstructions(frontend),
+		Webhooks:            checkoutWebhooks(),
+		WebhookDashboardURL: webhookDashboardURL,
+	}
+}
+
+func getEchoIntegration(creds Credentials, frontend FrontendIntegration, amountSource string) IntegrateCheckoutOutput {
+	keyID, keySecret := getKeysOrPlaceholders(creds)
+	orderRequestCode := getGoOrderRequestCode(amountSource)
+
+	amountCheck := `	if req.Amount <= 0 {
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{"success": false, "error": "Invalid amount"})
+	}
+	multiplier, ok := currencyMultipliers[req.Currency]
+	if !ok {
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{"success": false, "error": "Unsupported currency"})
+	}
+`
+	amountValue := "int(math.Round(req.Amount * multiplier))"
+	if amountSource == "server" {
+		amountCheck = `	// SECURITY: the amount is looked up server-side, the client only sends WHAT it is buying
+	amount, ok := productPrices[req.ProductID]
+	if !ok {
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{"success": false, "error": "Unknown product"})
+	}
+`
+		amountValue = "amount"
+	}
+
+	handlerCode := `package handlers
+
+import (
+	"fmt"
+` + getGoMathImport(amountSource) + `	"net/http"
+	"os"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	razorpay "github.com/razorpay/razorpay-go"
+	"github.com/razorpay/razorpay-go/utils"
+)
+
+var client = razorpay.NewClient(os.Getenv("RAZORPAY_KEY_ID"), os.Getenv("RAZORPAY_KEY_SECRET"))
+
+` + orderRequestCode + `type VerifyRequest struct {
+	OrderID   string ` + "`json:\"razorpay_order_id\"`" + `
+	PaymentID string ` + "`json:\"razorpay_payment_id\"`" + `
+	Signature string ` + "`json:\"razorpay_signature\"`" + `
+}
+
+func CreateOrder(c echo.Context) error {
+	var req OrderRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{"success": false, "error": err.Error()})
+	}
+	if req.Currency == "" { req.Currency = "INR" }
+` + amountCheck + `	if req.Receipt == "" { req.Receipt = fmt.Sprintf("receipt_%d", time.Now().Unix()) }
+
+	data := map[string]interface{}{"amount": ` + amountValue + `, "currency": req.Currency, "receipt": req.Receipt}
+	order, err := client.Order.Create(data, nil)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]interface{}{"success": false, "error": err.Error()})
+	}
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"success": true, "orderId": order["id"], "amount": order["amount"],
+		"currency": order["currency"], "keyId": os.Getenv("RAZORPAY_KEY_ID"),
+	})
+}
+
+func VerifyPayment(c echo.Context) error {
+	var req VerifyRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{"success": false, "error": err.Error()})
+	}
+
+` + goSDKSignatureCheck + `
+	if valid {
+		return c.JSON(http.StatusOK, map[string]interface{}{"success": true, "paymentId": req.PaymentID, "orderId": req.OrderID})
+	}
+	return c.JSON(http.StatusBadRequest, map[string]interface{}{"success": false, "error": "Invalid signature"})
+}
+`
+
+	return IntegrateCheckoutOutput{
+		Summary: "Complete Razorpay Standard Checkout integration for Echo + " + frontend.Framework + getAmountSourceSummary(amountSource),
+		Files: []FileAction{
+			{Action: "create", Path: "handlers/razorpay.go", Code: handlerCode, Description: "Echo handlers for Razorpay"},
+			{Action: "create", Path: frontend.FileName, Code: frontend.Code, Description: frontend.Description},
+			{Action: "manual_edit", Path: "main.go", Description: "Add routes", Edits: []EditItem{
+				{Line: "In router setup", Add: "e.POST(\"/api/razorpay/order\", handlers.CreateOrder)", Why: "Order endpoint"},
+				{Line: "After order route", Add: "e.POST(\"/api/razorpay/verify\", handlers.VerifyPayment)", Why: "Verify endpoint"},
+			}},
+			getWirePaymentAction(),
+		},
+		Dependencies:     []Dependency{{Name: "razorpay-go", InstallCommand: "go get github.com/razorpay/razorpay-go"}},
+		EnvVars:          []EnvVar{{Name: "RAZORPAY_KEY_ID", Value: keyID}, {Name: "RAZORPAY_KEY_SECRET", Value: keySecret}},
+		TestInstructions: "Use test card: 4111 1111 1111 1111",
+		AIInstructions: `BACKEND SETUP:
+1) go get github.com/razorpay/razorpay-go
+2) Create handlers/razorpay.go with the Razorpay handlers
+3) Add routes in main.go to wire up the handlers
+4) Set RAZORPAY_KEY_ID and RAZORPAY_KEY_SECRET env vars` + getAmountSourceInstructions(amountSource) +
+			getFrontendWiringInstructions(frontend),
+		Webhooks:            checkoutWebhooks(),
+		WebhookDashboardURL: webhookDashboardURL,
+	}
+}
+
+func getFiberIntegration(creds Credentials, frontend FrontendIntegration, amountSource string) IntegrateCheckoutOutput {
+	keyID, keySecret := getKeysOrPlaceholders(creds)
+	orderRequestCode := getGoOrderRequestCode(amountSource)
+
+	amountCheck := `	if req.Amount <= 0 {
+		return c.Status(400).JSON(fiber.Map{"success": false, "error": "Invalid amount"})
+	}
+	multiplier, ok := currencyMultipliers[req.Currency]
+	if !ok {
+		return c.Status(400).JSON(fiber.Map{"success": false, "error": "Unsupported currency"})
+	}
+`
+	amountValue := "int(math.Round(req.Amount * multiplier))"
+	if amountSource == "server" {
+		amountCheck = `	// SECURITY: the amount is looked up server-side, the client only sends WHAT it is buying
+	amount, ok := productPrices[req.ProductID]
+	if !ok {
+		return c.Status(400).JSON(fiber.Map{"success": false, "error": "Unknown product"})
+	}
+`
+		amountValue = "amount"
+	}
+
+	handlerCode := `package handlers
+
+import (
+	"fmt"
+` + getGoMathImport(amountSource) + `	"os"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	razorpay "github.com/razorpay/razorpay-go"
+	"github.com/razorpay/razorpay-go/utils"
+)
+
+var client = razorpay.NewClient(os.Getenv("RAZORPAY_KEY_ID"), os.Getenv("RAZORPAY_KEY_SECRET"))
+
+` + orderRequestCode + `type VerifyRequest struct {
+	OrderID   string ` + "`json:\"razorpay_order_id\"`" + `
+	PaymentID string ` + "`json:\"razorpay_payment_id\"`" + `
+	Signature string ` + "`json:\"razorpay_signature\"`" + `
+}
+
+func CreateOrder(c *fiber.Ctx) error {
+	var req OrderRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(400).JSON(fiber.Map{"success": false, "error": err.Error()})
+	}
+	if req.Currency == "" { req.Currency = "INR" }
+` + amountCheck + `	if req.Receipt == "" { req.Receipt = fmt.Sprintf("receipt_%d", time.Now().Unix()) }
+
+	data := map[string]interface{}{"amount": ` + amountValue + `, "currency": req.Currency, "receipt": req.Receipt}
+	order, err := client.Order.Create(data, nil)
+	if err != nil {
+		return c.Status(500).JSON(fiber.Map{"success": false, "error": err.Error()})
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true, "orderId": order["id"], "amount": order["amount"],
+		"currency": order["currency"], "keyId": os.Getenv("RAZORPAY_KEY_ID"),
+	})
+}
+
+func VerifyPayment(c *fiber.Ctx) error {
+	var req VerifyRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(400).JSON(fiber.Map{"success": false, "error": err.Error()})
+	}
+
+` + goSDKSignatureCheck + `
+	if valid {
+		return c.JSON(fiber.Map{"success": true, "paymentId": req.PaymentID, "orderId": req.OrderID})
+	}
+	return c.Status(400).JSON(fiber.Map{"success": false, "error": "Invalid signature"})
+}
+`
+
+	return IntegrateCheckoutOutput{
+		Summary: "Complete Razorpay Standard Checkout integration for Fiber + " + frontend.Framework + getAmountSourceSummary(amountSource),
+		Files: []FileAction{
+			{Action: "create", Path: "handlers/razorpay.go", Code: handlerCode, Description: "Fiber handlers for Razorpay"},
+			{Action: "create", Path: frontend.FileName, Code: frontend.Code, Description: frontend.Description},
+			{Action: "manual_edit", Path: "main.go", Description: "Add routes", Edits: []EditItem{
+				{Line: "In router setup", Add: "app.Post(\"/api/razorpay/order\", handlers.CreateOrder)", Why: "Order endpoint"},
+				{Line: "After order route", Add: "app.Post(\"/api/razorpay/verify\", handlers.VerifyPayment)", Why: "Verify endpoint"},
+			}},
+			getWirePaymentAction(),
+		},
+		Dependencies:     []Dependency{{Name: "razorpay-go", InstallCommand: "go get github.com/razorpay/razorpay-go"}},
+		EnvVars:          []EnvVar{{Name: "RAZORPAY_KEY_ID", Value: keyID}, {Name: "RAZORPAY_KEY_SECRET", Value: keySecret}},
+		TestInstructions: "Use test card: 4111 1111 1111 1111",
+		AIInstructions: `BACKEND SETUP:
+1) go get github.com/razorpay/razorpay-go
+2) Create handlers/razorpay.go with the Razorpay handlers
+3) Add routes in main.go to wire up the handlers
+4) Set RAZORPAY_KEY_ID and RAZORPAY_KEY_SECRET env vars` + getAmountSourceInstructions(amountSource) +
+			getFrontendWiringInstructions(frontend),
+		Webhooks:            checkoutWebhooks(),
+		WebhookDashboardURL: webhookDashboardURL,
+	}
+}
+
+func getChiIntegration(creds Credentials, frontend FrontendIntegration, amountSource string) IntegrateCheckoutOutput {
+	keyID, keySecret := getKeysOrPlaceholders(creds)
+	orderRequestCode := getGoOrderRequestCode(amountSource)
+
+	amountCheck := `	if req.Amount <= 0 {
+		writeJSON(w, http.StatusBadRequest, map[string]interface{}{"success": false, "error": "Invalid amount"})
+		return
+	}
+	multiplier, ok := currencyMultipliers[req.Currency]
+	if !ok {
+		writeJSON(w, http.StatusBadRequest, map[string]interface{}{"success": false, "error": "Unsupported currency"})
+		return
+	}
+`
+	amountValue := "int(math.Round(req.Amount * multiplier))"
+	if amountSource == "server" {
+		amountCheck = `	// SECURITY: the amount is looked up server-side, the client only sends WHAT it is buying
+	amount, ok := productPrices[req.ProductID]
+	if !ok {
+		writeJSON(w, http.StatusBadRequest, map[string]interface{}{"success": false, "error": "Unknown product"})
+		return
+	}
+`
+		amountValue = "amount"
+	}
+
+	handlerCode := `package handlers
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+` + getGoMathImport(amountSource) + `	"net/http"
+	"os"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	razorpay "github.com/razorpay/razorpay-go"
+)
+
+var client = razorpay.NewClient(os.Getenv("RAZORPAY_KEY_ID"), os.Getenv("RAZORPAY_KEY_SECRET"))
+
+` + orderRequestCode + `type VerifyRequest struct {
+	OrderID   string ` + "`json:\"razorpay_order_id\"`" + `
+	PaymentID string ` + "`json:\"razorpay_payment_id\"`" + `
+	Signature string ` + "`json:\"razorpay_signature\"`" + `
+}
+
+// RegisterRoutes mounts the Razorpay endpoints on r
+func RegisterRoutes(r chi.Router) {
+	r.Post("/api/razorpay/order", CreateOrder)
+	r.Post("/api/razorpay/verify", VerifyPayment)
+}
+
+func writeJSON(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+func CreateOrder(w http.ResponseWriter, r *http.Request) {
+	var req OrderRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeJSON(w, http.StatusBadRequest, map[string]interface{}{"success": false, "error": err.Error()})
+		return
+	}
+	if req.Currency == "" { req.Currency = "INR" }
+` + amountCheck + `	if req.Receipt == "" { req.Receipt = fmt.Sprintf("receipt_%d", time.Now().Unix()) }
+
+	data := map[string]interface{}{"amount": ` + amountValue + `, "currency": req.Currency, "receipt": req.Receipt}
+	order, err := client.Order.Create(data, nil)
+	if err != nil {
+		writeJSON(w, http.StatusInternalServerError, map[string]interface{}{"success": false, "error": err.Error()})
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"success": true, "orderId": order["id"], "amount": order["amount"],
+		"currency": order["currency"], "keyId": os.Getenv("RAZORPAY_KEY_ID"),
+	})
+}
+
+func VerifyPayment(w http.ResponseWriter, r *http.Request) {
+	var req VerifyRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeJSON(w, http.StatusBadRequest, map[string]interface{}{"success": false, "error": err.Error()})
+		return
+	}
+
+	msg := req.OrderID + "|" + req.PaymentID
+	h := hmac.New(sha256.New, []byte(os.Getenv("RAZORPAY_KEY_SECRET")))
+	h.Write([]byte(msg))
+	expected := hex.EncodeToString(h.Sum(nil))
+
+	if hmac.Equal([]byte(expected), []byte(req.Signature)) {
+		writeJSON(w, http.StatusOK, map[string]interface{}{"success": true, "paymentId": req.PaymentID, "orderId": req.OrderID})
+		return
+	}
+	writeJSON(w, http.StatusBadRequest, map[string]interface{}{"success": false, "error": "Invalid signature"})
+}
+`
+
+	return IntegrateCheckoutOutput{
+		Summary: "Complete Razorpay Standard Checkout integration for chi + " + frontend.Framework + getAmountSourceSummary(amountSource),
+		Files: []FileAction{
+			{Action: "create", Path: "handlers/razorpay.go", Code: handlerCode, Description: "chi handlers and RegisterRoutes for Razorpay"},
+			{Action: "create", Path: frontend.FileName, Code: frontend.Code, Description: frontend.Description},
+			{Action: "manual_edit", Path: "main.go", Description: "Add routes", Edits: []EditItem{
+				{Line: "After r := chi.NewRouter() and its middleware", Add: "handlers.RegisterRoutes(r)", Why: "Mounts the order and verify endpoints"},
+			}},
+			getWirePaymentAction(),
+		},
+		Dependencies:     []Dependency{{Name: "razorpay-go", InstallCommand: "go get github.com/razorpay/razorpay-go"}},
+		EnvVars:          []EnvVar{{Name: "RAZORPAY_KEY_ID", Value: keyID}, {Name: "RAZORPAY_KEY_SECRET", Value: keySecret}},
+		TestInstructions: "Use test card: 4111 1111 1111 1111",
+		AIInstructions: `BACKEND SETUP:
+1) go get github.com/razorpay/razorpay-go
+2) Create handlers/razorpay.go with the Razorpay handlers
+3) Call handlers.RegisterRoutes(r) in main.go. It takes a chi.Router, so a sub-router
+   from r.Route or r.Group works too. If go.mod has github.com/go-chi/chi without /v5,
+   change the import to match
+4) Set RAZORPAY_KEY_ID and RAZORPAY_KEY_SECRET env vars` + getAmountSourceInstructions(amountSource) +
+			getFrontendWiringInstructions(frontend),
+		Webhooks:            checkoutWebhooks(),
+		WebhookDashboardURL: webhookDashboardURL,
+	}
+}
+
+// =============================================================================
+// RUBY BACKEND INTEGRATIONS
+// =============================================================================
+
+func getRailsIntegration(creds Credentials, frontend FrontendIntegration) IntegrateCheckoutOutput {
+	keyID, keySecret := getKeysOrPlaceholders(creds)
+
+	initializerCode := `# Reads keys from ENV first, falling back to encrypted credentials
+# (bin/rails credentials:edit -> razorpay: { key_id: ..., key_secret: ... })
+RAZORPAY_KEY_ID = ENV['RAZORPAY_KEY_ID'].presence ||
+                  Rails.application.credentials.dig(:razorpay, :key_id)
+RAZORPAY_KEY_SECRET = ENV['RAZORPAY_KEY_SECRET'].presence ||
+                      Rails.application.credentials.dig(:razorpay, :key_secret)
+
+Razorpay.setup(RAZORPAY_KEY_ID, RAZORPAY_KEY_SECRET)
+`
+
+	controllerCode := `class PaymentsController < ApplicationController
+  # JSON endpoints called from the checkout frontend
+  skip_before_action :verify_authenticity_token
+
+  # Razorpay expects amounts in the smallest currency unit. create_order
+  # multiplies the major-unit amount by this factor (INR=100: ₹1 = 100 paise).
+  # Supporting another currency is a one-line change, e.g. 'USD' => 100.
+  CURRENCY_MULTIPLIERS = {
+    'INR' => 100
+  }.freeze
+
+  def create_order
+    amount = params[:amount].to_f
+    currency = params[:currency].presence || 'INR'
+
+    if amount <= 0
+      return render json: { success: false, error: 'Invalid amount' }, status: :bad_request
+    end
+
+    multiplier = CURRENCY_MULTIPLIERS[currency]
+    unless multiplier
+      return render json: { success: false, error: 'Unsupported currency' }, status: :bad_request
+    end
+
+    order = Razorpay::Order.create(
+      amount: (amount * multiplier).round, # Convert to the smallest currency unit
+      currency: currency,
+      receipt: params[:receipt].presence || "receipt_#{Time.now.to_i}"
+    )
+
+    render json: {
+      success: true,
+      orderId: order.id,
+      amount: order.amount,
+      currency: order.currency,
+      keyId: RAZORPAY_KEY_ID
+    }
+  rescue Razorpay::Error => e
+    Rails.logger.error("Razorpay order creation failed: #{e.message}")
+    render json: { success: false, error: 'Failed to create payment order' }, status: :internal_server_error
+  end
+
+  def verify_payment
+    payment_response = {
+      razorpay_order_id: params[:razorpay_order_id],
+      razorpay_payment_id: params[:razorpay_payment_id],
+      razorpay_signature: params[:razorpay_signature]
+    }
+
+    if payment_response.values.any?(&:blank?)
+      return render json: { success: false, error: 'Missing payment details' }, status: :bad_request
+    end
+
+    # Raises SecurityError when the signature does not match
+    Razorpay::Utility.verify_payment_signature(payment_response)
+
+    render json: {
+      success: true,
+      message: 'Payment verified successfully',
+      paymentId: payment_response[:razorpay_payment_id],
+      orderId: payment_response[:razorpay_order_id]
+    }
+  rescue SecurityError
+    render json: { success: false, error: 'Invalid payment signature' }, status: :bad_request
+  end
+end
+`
+
+	return IntegrateCheckoutOutput{
+		Summary: "Complete Razorpay Standard Checkout integration for Rails + " + frontend.Framework,
+		Files: []FileAction{
+			{Action: "create", Path: "config/initializers/razorpay.rb", Code: initializerCode, Description: "Razorpay client setup"},
+			{Action: "create", Path: "app/controllers/payments_controller.rb", Code: controllerCode, Description: "Rails controller for Razorpay"},
+			{Action: "create", Path: frontend.FileName, Code: frontend.Code, Description: frontend.Description},
+			{Action: "manual_edit", Path: "config/routes.rb", Description: "Add routes", Edits: []EditItem{
+				{Line: "Inside Rails.application.routes.draw", Add: "post '/api/razorpay/order', to: 'payments#create_order'", Why: "Order endpoint"},
+				{Line: "After order route", Add: "post '/api/razorpay/verify', to: 'payments#verify_payment'", Why: "Verify endpoint"},
+			}},
+			getWirePaymentAction(),
+		},
+		Dependencies:     []Dependency{{Name: "razorpay", InstallCommand: "bundle add razorpay"}},
+		EnvVars:          []EnvVar{{Name: "RAZORPAY_KEY_ID", Value: keyID}, {Name: "RAZORPAY_KEY_SECRET", Value: keySecret}},
+		TestInstructions: "Use test card: 4111 1111 1111 1111",
+		AIInstructions: `BACKEND SETUP:
+1) bundle add razorpay
+2) Create config/initializers/razorpay.rb
+3) Create app/controllers/payments_controller.rb
+4) Add the order and verify routes to config/routes.rb
+5) Set RAZORPAY_KEY_ID and RAZORPAY_KEY_SECRET as env vars, or store them
+   under razorpay.key_id / razorpay.key_secret with bin/rails credentials:edit` + getFrontendWiringInstructions(frontend),
+		Webhooks:            checkoutWebhooks(),
+		WebhookDashboardURL: webhookDashboardURL,
+	}
+}
+
+// getGoOrderRequestCode returns the OrderRequest type for the generated Go
+// handlers. With server-side amounts the client only sends a product_id and
+// the amount is taken from a productPrices map.
+func getGoOrderRequestCode(amountSource string) string {
+	if amountSource != "server" {
+		return `// Razorpay expects amounts in the smallest currency unit. CreateOrder
+// multiplies the major-unit amount by this factor (INR=100: ₹1 = 100 paise).
+// Supporting another currency is a one-line change, e.g. "USD": 100.
+var currencyMultipliers = map[string]float64{
+	"INR": 100,
+}
+
+type OrderRequest struct {
+	// Amount is in the major currency unit and is rounded with math.Round,
+	// since float64(19.99) * 100 is 1998.9999... Prefer having clients send
+	// integer paise so no float conversion is needed at all.
+	Amount   float64 ` + "`json:\"amount\"`" + `
+	Currency string  ` + "`json:\"currency\"`" + `
+	Receipt  string  ` + "`json:\"receipt\"`" + `
+}
+
+`
+	}
+
+	return `// =============================================================================
+// SECURITY: NEVER TRUST AN AMOUNT SENT BY THE CLIENT
+// =============================================================================
+// Anything in the request body can be edited in the browser's dev tools. If the
+// order amount came from the client, a customer could pay ₹1 for a ₹10,000
+// product and the payment signature would still verify. The client only sends
+// a product_id; the amount is always looked up here on the server.
+//
+// TODO: Replace this map with a lookup against your product catalogue/database.
+var productPrices = map[string]int64{
+	"product_basic":   49900, // ₹499.00 in paise
+	"product_premium": 99900, // ₹999.00 in paise
+}
+
+type OrderRequest struct {
+	ProductID string ` + "`json:\"product_id\"`" + `
+	Currency  string ` + "`json:\"currency\"`" + `
+	Receipt   string ` + "`json:\"receipt\"`" + `
+}
+
+`
+}
+
+// goSDKSignatureCheck verifies the checkout signature with the razorpay-go
+// SDK instead of a hand-rolled HMAC, keeping the manual version as a
+// commented fallback. It sets valid for the handler to branch on
+const goSDKSignatureCheck = `	valid := utils.VerifyPaymentSignature(map[string]interface{}{
+		"razorpay_order_id":   req.OrderID,
+		"razorpay_payment_id": req.PaymentID,
+	}, req.Signature, os.Getenv("RAZORPAY_KEY_SECRET"))
+
+	// Manual fallback for SDK versions without utils.VerifyPaymentSignature
+	// (needs crypto/hmac, crypto/sha256 and encoding/hex):
+	//
+	//	h := hmac.New(sha256.New, []byte(os.Getenv("RAZORPAY_KEY_SECRET")))
+	//	h.Write([]byte(req.OrderID + "|" + req.PaymentID))
+	//	expected := hex.EncodeToString(h.Sum(nil))
+	//	valid := hmac.Equal([]byte(expected), []byte(req.Signature))
+`
+
+// getGoMathImport returns the math import needed to round client-sent
+// amounts; server-side amounts are already integer paise
+func getGoMathImport(amountSource string) string {
+	if amountSource == "server" {
+		return ""
+	}
+	return "\t\"math\"\n"
+}
+
+// getAmountSourceSummary returns the summary suffix for the amount source
+func getAmountSourceSummary(amountSource string) string {
+	if amountSource == "server" {
+		return " (server-side amounts)"
+	}
+	return ""
+}
+
+// getAmountSourceInstructions returns extra AI instructions for the amount
+// source. Server-side amounts need the price map filled in and the frontend
+// changed to send a product_id instead of an amount.
+func getAmountSourceInstructions(amountSource string) string {
+	if amountSource != "server" {
+		return ""
+	}
+	return `
+5) Replace the TODO productPrices map in handlers/razorpay.go with your real
+   product catalogue (amounts in paise)
+6) Change the frontend order request body from { amount } to { product_id }
+   - the backend IGNORES any client-supplied amount by design`
+}
+
+// =============================================================================
+// JAVA BACKEND INTEGRATIONS
+// =============================================================================
+
+func getSpringBootIntegration(creds Credentials, frontend FrontendIntegration) IntegrateCheckoutOutput {
+	keyID, keySecret := getKeysOrPlaceholders(creds)
+
+	controllerCode := `package com.example.payments;
+
+import com.razorpay.Order;
+import com.razorpay.RazorpayClient;
+import com.razorpay.RazorpayException;
+import com.razorpay.Utils;
+import org.json.JSONObject;
+import org.springframework.beans.factory.annotation.Value;
+import org.springframework.http.ResponseEntity;
+import org.springframework.web.bind.annotation.PostMapping;
+import org.springframework.web.bind.annotation.RequestBody;
+import org.springframework.web.bind.annotation.RestController;
+
+import java.util.Map;
+
+@RestController
+public class RazorpayController {
+
+    // Razorpay expects amounts in the smallest currency unit. createOrder
+    // multiplies the major-unit amount by this factor (INR=100: ₹1 = 100 paise).
+    // Supporting another currency is a one-line change, e.g. Map.entry("USD", 100).
+    private static final Map<String, Integer> CURRENCY_MULTIPLIERS = Map.ofEntries(
+            Map.entry("INR", 100));
+
+    private final String keyId;
+    private final String keySecret;
+    private final RazorpayClient razorpay;
+
+    public RazorpayController(
+            @Value("${razorpay.key-id}") String keyId,
+            @Value("${razorpay.key-secret}") String keySecret) throws RazorpayException {
+        this.keyId = keyId;
+        this.keySecret = keySecret;
+        this.razorpay = new RazorpayClient(keyId, keySecret);
+    }
+
+    @PostMapping("/api/razorpay/order")
+    public ResponseEntity<Map<String, Object>> createOrder(@RequestBody Map<String, Object> body) {
+        Object rawAmount = body.get("amount");
+        if (!(rawAmount instanceof Number) || ((Number) rawAmount).doubleValue() <= 0) {
+            return ResponseEntity.badRequest().body(Map.of("success", false, "error", "Invalid amount"));
+        }
+
+        String currency = String.valueOf(body.getOrDefault("currency", "INR"));
+        Integer multiplier = CURRENCY_MULTIPLIERS.get(currency);
+        if (multiplier == null) {
+            return ResponseEntity.badRequest().body(Map.of("success", false, "error", "Unsupported currency"));
+        }
+
+        try {
+            JSONObject request = new JSONObject();
+            request.put("amount", Math.round(((Number) rawAmount).doubleValue() * multiplier)); // Convert to the smallest currency unit
+            request.put("currency", currency);
+            request.put("receipt", body.getOrDefault("receipt", "receipt_" + System.currentTimeMillis() / 1000));
+
+            Order order = razorpay.orders.create(request);
+
+            return ResponseEntity.ok(Map.of(
+                    "success", true,
+                    "orderId", order.get("id"),
+                    "amount", order.get("amount"),
+                    "currency", order.get("currency"),
+                    "keyId", keyId));
+        } catch (RazorpayException e) {
+            return ResponseEntity.internalServerError()
+                    .body(Map.of("success", false, "error", "Failed to create payment order"));
+        }
+    }
+
+    @PostMapping("/api/razorpay/verify")
+    public ResponseEntity<Map<String, Object>> verifyPayment(@RequestBody Map<String, String> body) {
+        String orderId = body.get("razorpay_order_id");
+        String paymentId = body.get("razorpay_payment_id");
+        String signature = body.get("razorpay_signature");
+
+        if (orderId == null || paymentId == null || signature == null) {
+            return ResponseEntity.badRequest().body(Map.of("success", false, "error", "Missing payment details"));
+        }
+
+        try {
+            JSONObject attributes = new JSONObject();
+            attributes.put("razorpay_order_id", orderId);
+            attributes.put("razorpay_payment_id", paymentId);
+            attributes.put("razorpay_signature", signature);
+
+            if (Utils.verifyPaymentSignature(attributes, keySecret)) {
+                return ResponseEntity.ok(Map.of(
+                        "success", true,
+                        "message", "Payment verified successfully",
+                        "paymentId", paymentId,
+                        "orderId", orderId));
+            }
+            return ResponseEntity.badRequest().body(Map.of("success", false, "error", "Invalid payment signature"));
+        } catch (RazorpayException e) {
+            return ResponseEntity.internalServerError()
+                    .body(Map.of("success", false, "error", "Payment verification failed"));
+        }
+    }
+}
+`
+
+	return IntegrateCheckoutOutput{
+		Summary: "Complete Razorpay Standard Checkout integration for Spring Boot + " + frontend.Framework,
+		Files: []FileAction{
+			{
+				Action:      "create",
+				Path:        "src/main/java/com/example/payments/RazorpayController.java",
+				Code:        controllerCode,
+				Description: "Spring Boot REST controller for Razorpay",
+			},
+			{Action: "create", Path: frontend.FileName, Code: frontend.Code, Description: frontend.Description},
+			{Action: "manual_edit", Path: "pom.xml", Description: "Add Razorpay SDK (Maven projects)", Edits: []EditItem{
+				{
+					Line: "Inside <dependencies>",
+					Add:  "<dependency>\n  <groupId>com.razorpay</groupId>\n  <artifactId>razorpay-java</artifactId>\n  <version>1.4.8</version>\n</dependency>",
+					Why:  "Razorpay Java SDK",
+				},
+			}},
+			{Action: "manual_edit", Path: "build.gradle", Description: "Add Razorpay SDK (Gradle projects)", Edits: []EditItem{
+				{Line: "Inside dependencies { }", Add: "implementation 'com.razorpay:razorpay-java:1.4.8'", Why: "Razorpay Java SDK"},
+			}},
+			{Action: "manual_edit", Path: "src/main/resources/application.properties", Description: "Add Razorpay keys", Edits: []EditItem{
+				{Line: "End of file", Add: "razorpay.key-id=${RAZORPAY_KEY_ID}", Why: "Razorpay key ID from env"},
+				{Line: "After razorpay.key-id", Add: "razorpay.key-secret=${RAZORPAY_KEY_SECRET}", Why: "Razorpay key secret from env"},
+			}},
+			getWirePaymentAction(),
+		},
+		Dependencies: []Dependency{
+			{Name: "com.razorpay:razorpay-java", InstallCommand: "Add com.razorpay:razorpay-java:1.4.8 to pom.xml or build.gradle"},
+		},
+		EnvVars:          []EnvVar{{Name: "RAZORPAY_KEY_ID", Value: keyID}, {Name: "RAZORPAY_KEY_SECRET", Value: keySecret}},
+		TestInstructions: "Use test card: 4111 1111 1111 1111",
+		AIInstructions: `BACKEND SETUP:
+1) Add com.razorpay:razorpay-java to pom.xml OR build.gradle (only the one the project uses)
+2) Create RazorpayController.java - change the package declaration and path to
+   match the project's base package (next to the @SpringBootApplication class)
+3) Add razorpay.key-id and razorpay.key-secret to application.properties
+   (or the equivalent keys in application.yml)
+4) Set RAZORPAY_KEY_ID and RAZORPAY_KEY_SECRET env vars` + getFrontendWiringInstructions(frontend),
+		Webhooks:            checkoutWebhooks(),
+		WebhookDashboardURL: webhookDashboardURL,
+	}
+}
+
+// =============================================================================
+// .NET BACKEND INTEGRATIONS
+// =============================================================================
+
+func getAspNetIntegration(creds Credentials, frontend FrontendIntegration) IntegrateCheckoutOutput {
+	keyID, keySecret := getKeysOrPlaceholders(creds)
+
+	controllerCode := `using System.Security.Cryptography;
+using System.Text;
+using System.Text.Json.Serialization;
+using Microsoft.AspNetCore.Mvc;
+using Razorpay.Api;
+
+namespace Payments.Controllers;
+
+[ApiController]
+[Route("api/razorpay")]
+public class RazorpayController : ControllerBase
+{
+    // Razorpay expects amounts in the smallest currency unit. CreateOrder
+    // multiplies the major-unit amount by this factor (INR=100: ₹1 = 100 paise).
+    // Supporting another currency is a one-line change, e.g. { "USD", 100 }.
+    private static readonly IReadOnlyDictionary<string, int> CurrencyMultipliers = new Dictionary<string, int>
+    {
+        { "INR", 100 },
+    };
+
+    private readonly string _keyId;
+    private readonly string _keySecret;
+
+    public RazorpayController(IConfiguration configuration)
+    {
+        // Razorpay:KeyId / Razorpay:KeySecret come from user-secrets or appsettings,
+        // falling back to the RAZORPAY_KEY_ID / RAZORPAY_KEY_SECRET env vars
+        _keyId = configuration["Razorpay:KeyId"]
+            ?? Environment.GetEnvironmentVariable("RAZORPAY_KEY_ID")
+            ?? throw new InvalidOperationException("Razorpay:KeyId is not configured");
+        _keySecret = configuration["Razorpay:KeySecret"]
+            ?? Environment.GetEnvironmentVariable("RAZORPAY_KEY_SECRET")
+            ?? throw new InvalidOperationException("Razorpay:KeySecret is not configured");
+    }
+
+    public record OrderRequest(
+        [property: JsonPropertyName("amount")] decimal Amount,
+        [property: JsonPropertyName("currency")] string? Currency,
+        [property: JsonPropertyName("receipt")] string? Receipt);
+
+    public record VerifyRequest(
+        [property: JsonPropertyName("razorpay_order_id")] string? OrderId,
+        [property: JsonPropertyName("razorpay_payment_id")] string? PaymentId,
+        [property: JsonPropertyName("razorpay_signature")] string? Signature);
+
+    [HttpPost("order")]
+    public IActionResult CreateOrder([FromBody] OrderRequest request)
+    {
+        if (request.Amount <= 0)
+        {
+            return BadRequest(new { success = false, error = "Invalid amount" });
+        }
+
+        var currency = request.Currency ?? "INR";
+        if (!CurrencyMultipliers.TryGetValue(currency, out var multiplier))
+        {
+            return BadRequest(new { success = false, error = "Unsupported currency" });
+        }
+
+        try
+        {
+            var client = new RazorpayClient(_keyId, _keySecret);
+            var options = new Dictionary<string, object>
+            {
+                { "amount", (long)Math.Round(request.Amount * multiplier) }, // Convert to the smallest currency unit
+                { "currency", currency },
+                { "receipt", request.Receipt ?? $"receipt_{DateTimeOffset.UtcNow.ToUnixTimeSeconds()}" },
+            };
+
+            Order order = client.Order.Create(options);
+
+            return Ok(new
+            {
+                success = true,
+                orderId = order["id"].ToString(),
+                amount = (long)order["amount"],
+                currency = order["currency"].ToString(),
+                keyId = _keyId,
+            });
+        }
+        catch (Exception)
+        {
+            return StatusCode(500, new { success = false, error = "Failed to create payment order" });
+        }
+    }
+
+    [HttpPost("verify")]
+    public IActionResult VerifyPayment([FromBody] VerifyRequest request)
+    {
+        if (string.IsNullOrEmpty(request.OrderId) ||
+            string.IsNullOrEmpty(request.PaymentId) ||
+            string.IsNullOrEmpty(request.Signature))
+        {
+            return BadRequest(new { success = false, error = "Missing payment details" });
+        }
+
+        using var hmac = new HMACSHA256(Encoding.UTF8.GetBytes(_keySecret));
+        var hash = hmac.ComputeHash(Encoding.UTF8.GetBytes(request.OrderId + "|" + request.PaymentId));
+        var expected = Convert.ToHexString(hash).ToLowerInvariant();
+
+        if (CryptographicOperations.FixedTimeEquals(
+                Encoding.UTF8.GetBytes(expected),
+                Encoding.UTF8.GetBytes(request.Signature)))
+        {
+            return Ok(new
+            {
+                success = true,
+                message = "Payment verified successfully",
+                paymentId = request.PaymentId,
+                orderId = request.OrderId,
+            });
+        }
+
+        return BadRequest(new { success = false, error = "Invalid payment signature" });
+    }
+}
+`
+
+	return IntegrateCheckoutOutput{
+		Summary: "Complete Razorpay Standard Checkout integration for ASP.NET Core + " + frontend.Framework,
+		Files: []FileAction{
+			{Action: "create", Path: "Controllers/RazorpayController.cs", Code: controllerCode, Description: "ASP.NET Core controller for Razorpay"},
+			{Action: "create", Path: frontend.FileName, Code: frontend.Code, Description: frontend.Description},
+			{Action: "manual_edit", Path: "Program.cs", Description: "Enable controllers", Edits: []EditItem{
+				{Line: "Before builder.Build()", Add: "builder.Services.AddControllers();", Why: "Register MVC controllers"},
+				{Line: "After builder.Build()", Add: "app.MapControllers();", Why: "Map controller routes"},
+			}},
+			{Action: "manual_edit", Path: "appsettings.json", Description: "Add Razorpay section (keep real values in user-secrets)", Edits: []EditItem{
+				{Line: "Top-level object", Add: "\"Razorpay\": { \"KeyId\": \"\", \"KeySecret\": \"\" }", Why: "Configuration shape - never commit real keys"},
+			}},
+			getWirePaymentAction(),
+		},
+		Dependencies:     []Dependency{{Name: "Razorpay", InstallCommand: "dotnet add package Razorpay"}},
+		EnvVars:          []EnvVar{{Name: "RAZORPAY_KEY_ID", Value: keyID}, {Name: "RAZORPAY_KEY_SECRET", Value: keySecret}},
+		TestInstructions: "Use test card: 4111 1111 1111 1111",
+		AIInstructions: `BACKEND SETUP:
+1) dotnet add package Razorpay (provides the Razorpay.Api namespace)
+2) Create Controllers/RazorpayController.cs - change the namespace to match the project
+3) Ensure Program.cs calls builder.Services.AddControllers() and app.MapControllers()
+4) Store keys with user-secrets for development:
+   dotnet user-secrets init
+   dotnet user-secrets set "Razorpay:KeyId" "<key id>"
+   dotnet user-secrets set "Razorpay:KeySecret" "<key secret>"
+   or set RAZORPAY_KEY_ID and RAZORPAY_KEY_SECRET env vars in production` + getFrontendWiringInstructions(frontend),
+		Webhooks:            checkoutWebhooks(),
+		WebhookDashboardURL: webhookDashboardURL,
+	}
+}
+
+// =============================================================================
+// WEBHOOK INTEGRATIONS
+// =============================================================================
+
+func getExpressWebhookIntegration(language string) IntegrateCheckoutOutput {
+	ext := "js"
+	if language == "typescript" {
+		ext = "ts"
+	}
+
+	webhookCode := `const express = require('express');
+const crypto = require('crypto');
+
+const router = express.Router();
+
+// IMPORTANT: express.raw() keeps the body as the exact bytes Razorpay sent.
+// The signature is computed over those bytes - re-serialising parsed JSON
+// changes whitespace/key order and the signature will never match.
+router.post('/webhook', express.raw({ type: 'application/json' }), async (req, res) => {
+  const signature = req.get('X-Razorpay-Signature');
+  if (!signature) {
+    return res.status(400).json({ error: 'Missing signature' });
+  }
+
+  const expectedSignature = crypto
+    .createHmac('sha256', process.env.RAZORPAY_WEBHOOK_SECRET)
+    .update(req.body)
+    .digest('hex');
+
+  const expectedBuffer = Buffer.from(expectedSignature);
+  const receivedBuffer = Buffer.from(signature);
+  if (
+    expectedBuffer.length !== receivedBuffer.length ||
+    !crypto.timingSafeEqual(expectedBuffer, receivedBuffer)
+  ) {
+    return res.status(400).json({ error: 'Invalid signature' });
+  }
+
+  const event = JSON.parse(req.body.toString('utf8'));
+
+  try {
+    switch (event.event) {
+      case 'payment.captured': {
+        const payment = event.payload.payment.entity;
+        // TODO: Mark the order for payment.order_id as paid (idempotently)
+        console.log('Payment captured:', payment.id, payment.amount);
+        break;
+      }
+      case 'payment.failed': {
+        const payment = event.payload.payment.entity;
+        // TODO: Record the failure / notify the customer
+        console.log('Payment failed:', payment.id, payment.error_description);
+        break;
+      }
+      case 'order.paid': {
+        const order = event.payload.order.entity;
+        // TODO: Fulfil the order (ship, grant access, send receipt)
+        console.log('Order paid:', order.id, order.amount_paid);
+        break;
+      }
+      default:
+        console.log('Unhandled webhook event:', event.event);
+    }
+
+    // Respond 2xx quickly - Razorpay retries on any other status
+    res.json({ status: 'ok' });
+  } catch (error) {
+    console.error('Webhook handling failed:', error);
+    res.status(500).json({ error: 'Webhook handling failed' });
+  }
+});
+
+module.exports = router;
+`
+
+	return IntegrateCheckoutOutput{
+		Summary: "Razorpay webhook endpoint for Express",
+		Files: []FileAction{
+			{Action: "create", Path: "routes/razorpayWebhook." + ext, Code: webhookCode, Description: "Express webhook route with raw-body signature verification"},
+			{Action: "manual_edit", Path: "server.js", Description: "Mount the webhook route BEFORE express.json()", Edits: []EditItem{
+				{Line: "With other requires", Add: "const razorpayWebhookRoutes = require('./routes/razorpayWebhook');", Why: "Import webhook routes"},
+				{Line: "BEFORE app.use(express.json())", Add: "app.use('/api/razorpay', razorpayWebhookRoutes);", Why: "express.json() would consume the raw body needed for the signature"},
+			}},
+		},
+		AIInstructions: `WEBHOOK SETUP:
+1) Create routes/razorpayWebhook.` + ext + `
+2) Mount it in server.js BEFORE any app.use(express.json()) / body-parser middleware`,
+		Webhooks:            checkoutWebhooks(),
+		WebhookDashboardURL: webhookDashboardURL,
+	}
+}
+
+func getNextjsWebhookIntegration() IntegrateCheckoutOutput {
+	webhookCode := `import { NextRequest, NextResponse } from 'next/server';
+import crypto from 'crypto';
+
+export async function POST(request: NextRequest) {
+  // IMPORTANT: read the RAW body with request.text(), NOT request.json().
+  // The signature is computed over the exact bytes Razorpay sent.
+  const rawBody = await request.text();
+  const signature = request.headers.get('x-razorpay-signature');
+  if (!signature) {
+    return NextResponse.json({ error: 'Missing signature' }, { status: 400 });
+  }
+
+  const expectedSignature = crypto
+    .createHmac('sha256', process.env.RAZORPAY_WEBHOOK_SECRET!)
+    .update(rawBody)
+    .digest('hex');
+
+  const expectedBuffer = Buffer.from(expectedSignature);
+  const receivedBuffer = Buffer.from(signature);
+  if (
+    expectedBuffer.length !== receivedBuffer.length ||
+    !crypto.timingSafeEqual(expectedBuffer, receivedBuffer)
+  ) {
+    return NextResponse.json({ error: 'Invalid signature' }, { status: 400 });
+  }
+
+  const event = JSON.parse(rawBody);
+
+  switch (event.event) {
+    case 'payment.captured': {
+      const payment = event.payload.payment.entity;
+      // TODO: Mark the order for payment.order_id as paid (idempotently)
+      console.log('Payment captured:', payment.id, payment.amount);
+      break;
+    }
+    case 'payment.failed': {
+      const payment = event.payload.payment.entity;
+      // TODO: Record the failure / notify the customer
+      console.log('Payment failed:', payment.id, payment.error_description);
+      break;
+    }
+    case 'order.paid': {
+      const order = event.payload.order.entity;
+      // TODO: Fulfil the order (ship, grant access, send receipt)
+      console.log('Order paid:', order.id, order.amount_paid);
+      break;
+    }
+    default:
+      console.log('Unhandled webhook event:', event.event);
+  }
+
+  return NextResponse.json({ status: 'ok' });
+}
+`
+
+	return IntegrateCheckoutOutput{
+		Summary: "Razorpay webhook route handler for Next.js",
+		Files: []FileAction{
+			{Action: "create", Path: "app/api/razorpay/webhook/route.ts", Code: webhookCode, Description: "Next.js webhook route with raw-body signature verification"},
+		},
+		AIInstructions: `WEBHOOK SETUP:
+1) Create app/api/razorpay/webhook/route.ts
+2) Add RAZORPAY_WEBHOOK_SECRET to .env.local`,
+		Webhooks:            checkoutWebhooks(),
+		WebhookDashboardURL: webhookDashboardURL,
+	}
+}
+
+func getDjangoWebhookIntegration() IntegrateCheckoutOutput {
+	viewCode := `import hashlib
+import hmac
+import json
+import logging
+import os
+
+from django.http import JsonResponse
+from django.views.decorators.csrf import csrf_exempt
+from django.views.decorators.http import require_POST
+
+logger = logging.getLogger(__name__)
+
+
+@csrf_exempt
+@require_POST
+def razorpay_webhook(request):
+    # IMPORTANT: verify against request.body (raw bytes), never json.loads + dumps
+    signature = request.headers.get('X-Razorpay-Signature', '')
+    expected = hmac.new(
+        os.environ['RAZORPAY_WEBHOOK_SECRET'].encode(),
+        request.body,
+        hashlib.sha256,
+    ).hexdigest()
+
+    if not hmac.compare_digest(expected, signature):
+        return JsonResponse({'error': 'Invalid signature'}, status=400)
+
+    event = json.loads(request.body)
+    event_type = event.get('event')
+
+    if event_type == 'payment.captured':
+        payment = event['payload']['payment']['entity']
+        # TODO: Mark the order for payment['order_id'] as paid (idempotently)
+        logger.info('Payment captured: %s', payment['id'])
+    elif event_type == 'payment.failed':
+        payment = event['payload']['payment']['entity']
+        # TODO: Record the failure / notify the customer
+        logger.info('Payment failed: %s', payment['id'])
+    elif event_type == 'order.paid':
+        order = event['payload']['order']['entity']
+        # TODO: Fulfil the order (ship, grant access, send receipt)
+        logger.info('Order paid: %s', order['id'])
+    else:
+        logger.info('Unhandled webhook event: %s', event_type)
+
+    return JsonResponse({'status': 'ok'})
+`
+
+	return IntegrateCheckoutOutput{
+		Summary: "Razorpay webhook view for Django",
+		Files: []FileAction{
+			{Action: "create", Path: "payments/webhooks.py", Code: viewCode, Description: "Django webhook view with raw-body signature verification"},
+			{Action: "manual_edit", Path: "urls.py", Description: "Add webhook URL", Edits: []EditItem{
+				{Line: "In urlpatterns", Add: "path('api/razorpay/webhook', webhooks.razorpay_webhook)", Why: "Webhook endpoint"},
+			}},
+		},
+		AIInstructions: `WEBHOOK SETUP:
+1) Create payments/webhooks.py (adjust the app name to match the project)
+2) Add the URL pattern - the view is csrf_exempt because Razorpay cannot send a CSRF token`,
+		Webhooks:            checkoutWebhooks(),
+		WebhookDashboardURL: webhookDashboardURL,
+	}
+}
+
+func getFlaskWebhookIntegration() IntegrateCheckoutOutput {
+	webhookCode := `import hashlib
+import hmac
+import os
+
+from flask import Blueprint, jsonify, request
+
+razorpay_webhook_bp = Blueprint('razorpay_webhook', __name__)
+
+
+@razorpay_webhook_bp.route('/api/razorpay/webhook', methods=['POST'])
+def razorpay_webhook():
+    # IMPORTANT: use request.get_data() (raw bytes), not request.json
+    raw_body = request.get_data()
+    signature = request.headers.get('X-Razorpay-Signature', '')
+    expected = hmac.new(
+        os.environ['RAZORPAY_WEBHOOK_SECRET'].encode(),
+        raw_body,
+        hashlib.sha256,
+    ).hexdigest()
+
+    if not hmac.compare_digest(expected, signature):
+        return jsonify({'error': 'Invalid signature'}), 400
+
+    event = request.get_json()
+    event_type = event.get('event')
+
+    if event_type == 'payment.captured':
+        payment = event['payload']['payment']['entity']
+        # TODO: Mark the order for payment['order_id'] as paid (idempotently)
+    elif event_type == 'payment.failed':
+        payment = event['payload']['payment']['entity']
+        # TODO: Record the failure / notify the customer
+    elif event_type == 'order.paid':
+        order = event['payload']['order']['entity']
+        # TODO: Fulfil the order (ship, grant access, send receipt)
+
+    return jsonify({'status': 'ok'})
+`
+
+	return IntegrateCheckoutOutput{
+		Summary: "Razorpay webhook blueprint for Flask",
+		Files: []FileAction{
+			{Action: "create", Path: "razorpay_webhook.py", Code: webhookCode, Description: "Flask webhook blueprint with raw-body signature verification"},
+			{Action: "manual_edit", Path: "app.py", Description: "Register blueprint", Edits: []EditItem{
+				{Line: "After imports", Add: "from razorpay_webhook import razorpay_webhook_bp", Why: "Import blueprint"},
+				{Line: "After app creation", Add: "app.register_blueprint(razorpay_webhook_bp)", Why: "Register webhook route"},
+			}},
+		},
+		AIInstructions: `WEBHOOK SETUP:
+1) Create razorpay_webhook.py
+2) Register the blueprint in app.py (exempt it from CSRF if Flask-WTF CSRFProtect is enabled)`,
+		Webhooks:            checkoutWebhooks(),
+		WebhookDashboardURL: webhookDashboardURL,
+	}
+}
+
+func getFastAPIWebhookIntegration() IntegrateCheckoutOutput {
+	webhookCode := `import hashlib
+import hmac
+import json
+import os
+
+from fastapi import APIRouter, Header, HTTPException, Request
+
+router = APIRouter(prefix="/api/razorpay")
+
+
+@router.post("/webhook")
+async def razorpay_webhook(request: Request, x_razorpay_signature: str = Header(default="", alias="X-Razorpay-Signature")):
+    # IMPORTANT: use await request.body() (raw bytes), not a Pydantic model
+    raw_body = await request.body()
+    expected = hmac.new(
+        os.environ['RAZORPAY_WEBHOOK_SECRET'].encode(),
+        raw_body,
+        hashlib.sha256,
+    ).hexdigest()
+
+    if not hmac.compare_digest(expected, x_razorpay_signature):
+        raise HTTPException(status_code=400, detail="Invalid signature")
+
+    event = json.loads(raw_body)
+    event_type = event.get('event')
+
+    if event_type == 'payment.captured':
+        payment = event['payload']['payment']['entity']
+        # TODO: Mark the order for payment['order_id'] as paid (idempotently)
+    elif event_type == 'payment.failed':
+        payment = event['payload']['payment']['entity']
+        # TODO: Record the failure / notify the customer
+    elif event_type == 'order.paid':
+        order = event['payload']['order']['entity']
+        # TODO: Fulfil the order (ship, grant access, send receipt)
+
+    return {'status': 'ok'}
+`
+
+	return IntegrateCheckoutOutput{
+		Summary: "Razorpay webhook router for FastAPI",
+		Files: []FileAction{
+			{Action: "create", Path: "routers/razorpay_webhook.py", Code: webhookCode, Description: "FastAPI webhook router with raw-body signature verification"},
+			{Action: "manual_edit", Path: "main.py", Description: "Include router", Edits: []EditItem{
+				{Line: "After imports", Add: "from routers.razorpay_webhook import router as razorpay_webhook_router", Why: "Import router"},
+				{Line: "After app creation", Add: "app.include_router(razorpay_webhook_router)", Why: "Include webhook route"},
+			}},
+		},
+		AIInstructions: `WEBHOOK SETUP:
+1) Create routers/razorpay_webhook.py
+2) Include the router in main.py`,
+		Webhooks:            checkoutWebhooks(),
+		WebhookDashboardURL: webhookDashboardURL,
+	}
+}
+
+func getGoWebhookIntegration(framework string) IntegrateCheckoutOutput {
+	// Each framework only differs in how the raw body and header are read and
+	// how the response is written
+	var imports, signature, readBody, respond, route string
+	switch framework {
+	case "echo":
+		imports = "\t\"io\"\n\t\"log\"\n\t\"net/http\"\n\t\"os\"\n\n\t\"github.com/labstack/echo/v4\"\n"
+		signature = "func RazorpayWebhook(c echo.Context) error"
+		readBody = `	rawBody, err := io.ReadAll(c.Request().Body)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Cannot read body"})
+	}
+	signature := c.Request().Header.Get("X-Razorpay-Signature")
+`
+		respond = `c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid signature"})`
+		route = `e.POST("/api/razorpay/webhook", handlers.RazorpayWebhook)`
+	case "fiber":
+		imports = "\t\"log\"\n\t\"os\"\n\n\t\"github.com/gofiber/fiber/v2\"\n"
+		signature = "func RazorpayWebhook(c *fiber.Ctx) error"
+		readBody = `	rawBody := c.Body()
+	signature := c.Get("X-Razorpay-Signature")
+`
+		respond = `c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "Invalid signature"})`
+		route = `app.Post("/api/razorpay/webhook", handlers.RazorpayWebhook)`
+	default: // gin
+		imports = "\t\"log\"\n\t\"net/http\"\n\t\"os\"\n\n\t\"github.com/gin-gonic/gin\"\n"
+		signature = "func RazorpayWebhook(c *gin.Context)"
+		readBody = `	rawBody, err := c.GetRawData()
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Cannot read body"})
+		return
+	}
+	signature := c.GetHeader("X-Razorpay-Signature")
+`
+		respond = `c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid signature"})`
+		route = `r.POST("/api/razorpay/webhook", handlers.RazorpayWebhook)`
+	}
+
+	returnInvalid := "\t\t" + respond + "\n\t\treturn\n"
+	returnOK := "\tc.JSON(http.StatusOK, gin.H{\"status\": \"ok\"})\n"
+	returnHandlingErr := "\t\tc.JSON(http.StatusBadRequest, gin.H{\"error\": \"Invalid payload\"})\n\t\treturn\n"
+	switch framework {
+	case "echo":
+		returnInvalid = "\t\treturn " + respond + "\n"
+		returnOK = "\treturn c.JSON(http.StatusOK, map[string]string{\"status\": \"ok\"})\n"
+		returnHandlingErr = "\t\treturn c.JSON(http.StatusBadRequest, map[string]string{\"error\": \"Invalid payload\"})\n"
+	case "fiber":
+		returnInvalid = "\t\treturn " + respond + "\n"
+		returnOK = "\treturn c.JSON(fiber.Map{\"status\": \"ok\"})\n"
+		returnHandlingErr = "\t\treturn c.Status(fiber.StatusBadRequest).JSON(fiber.Map{\"error\": \"Invalid payload\"})\n"
+	}
+
+	webhookCode := `package handlers
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+` + imports + `)
+
+type webhookEvent struct {
+	Event   string                                ` + "`json:\"event\"`" + `
+	Payload map[string]map[string]json.RawMessage ` + "`json:\"payload\"`" + `
+}
+
+// RazorpayWebhook verifies the X-Razorpay-Signature header and handles
+// payment events. IMPORTANT: the HMAC is computed over the RAW body bytes -
+// never bind to a struct first and re-marshal.
+` + signature + ` {
+` + readBody + `
+	mac := hmac.New(sha256.New, []byte(os.Getenv("RAZORPAY_WEBHOOK_SECRET")))
+	mac.Write(rawBody)
+	expected := hex.EncodeToString(mac.Sum(nil))
+	if !hmac.Equal([]byte(expected), []byte(signature)) {
+` + returnInvalid + `	}
+
+	var event webhookEvent
+	if err := json.Unmarshal(rawBody, &event); err != nil {
+` + returnHandlingErr + `	}
+
+	switch event.Event {
+	case "payment.captured":
+		// TODO: Mark the order for the payment's order_id as paid (idempotently)
+		log.Printf("payment captured: %s", event.Payload["payment"]["entity"])
+	case "payment.failed":
+		// TODO: Record the failure / notify the customer
+		log.Printf("payment failed: %s", event.Payload["payment"]["entity"])
+	case "order.paid":
+		// TODO: Fulfil the order (ship, grant access, send receipt)
+		log.Printf("order paid: %s", event.Payload["order"]["entity"])
+	default:
+		log.Printf("unhandled webhook event: %s", event.Event)
+	}
+
+` + returnOK + `}
+`
+
+	return IntegrateCheckoutOutput{
+		Summary: "Razorpay webhook handler for " + framework,
+		Files: []FileAction{
+			{Action: "create", Path: "handlers/razorpay_webhook.go", Code: webhookCode, Description: "Webhook handler with raw-body signature verification"},
+			{Action: "manual_edit", Path: "main.go", Description: "Register webhook route", Edits: []EditItem{
+				{Line: "In router setup", Add: route, Why: "Webhook endpoint"},
+			}},
+		},
+		AIInstructions: `WEBHOOK SETUP:
+1) Create handlers/razorpay_webhook.go (adjust the package name to match the project)
+2) Register the route in main.go`,
+		Webhooks:            checkoutWebhooks(),
+		WebhookDashboardURL: webhookDashboardURL,
+	}
+}
+
+func getRailsWebhookIntegration() IntegrateCheckoutOutput {
+	controllerCode := `class RazorpayWebhooksController < ApplicationController
+  # Razorpay cannot send a CSRF token
+  skip_forgery_protection
+
+  def create
+    # IMPORTANT: verify against request.raw_post, never params.to_json
+    payload = request.raw_post
+    signature = request.headers['X-Razorpay-Signature'].to_s
+    expected = OpenSSL::HMAC.hexdigest('SHA256', ENV.fetch('RAZORPAY_WEBHOOK_SECRET'), payload)
+
+    unless ActiveSupport::SecurityUtils.secure_compare(expected, signature)
+      return render json: { error: 'Invalid signature' }, status: :bad_request
+    end
+
+    event = JSON.parse(payload)
+
+    case event['event']
+    when 'payment.captured'
+      payment = event.dig('payload', 'payment', 'entity')
+      # TODO: Mark the order for payment['order_id'] as paid (idempotently)
+      Rails.logger.info("Payment captured: #{payment['id']}")
+    when 'payment.failed'
+      payment = event.dig('payload', 'payment', 'entity')
+      # TODO: Record the failure / notify the customer
+      Rails.logger.info("Payment failed: #{payment['id']}")
+    when 'order.paid'
+      order = event.dig('payload', 'order', 'entity')
+      # TODO: Fulfil the order (ship, grant access, send receipt)
+      Rails.logger.info("Order paid: #{order['id']}")
+    else
+      Rails.logger.info("Unhandled webhook event: #{event['event']}")
+    end
+
+    render json: { status: 'ok' }
+  end
+end
+`
+
+	return IntegrateCheckoutOutput{
+		Summary: "Razorpay webhook controller for Rails",
+		Files: []FileAction{
+			{Action: "create", Path: "app/controllers/razorpay_webhooks_controller.rb", Code: controllerCode, Description: "Rails webhook controller with raw-body signature verification"},
+			{Action: "manual_edit", Path: "config/routes.rb", Description: "Add webhook route", Edits: []EditItem{
+				{Line: "Inside Rails.application.routes.draw", Add: "post '/api/razorpay/webhook', to: 'razorpay_webhooks#create'", Why: "Webhook endpoint"},
+			}},
+		},
+		AIInstructions: `WEBHOOK SETUP:
+1) Create app/controllers/razorpay_webhooks_controller.rb
+2) Add the route to config/routes.rb`,
+		Webhooks:            checkoutWebhooks(),
+		WebhookDashboardURL: webhookDashboardURL,
+	}
+}
+
+func getSpringBootWebhookIntegration() IntegrateCheckoutOutput {
+	controllerCode := `package com.example.payments;
+
+import com.razorpay.Utils;
+import org.json.JSONObject;
+import org.slf4j.Logger;
+import org.slf4j.LoggerFactory;
+import org.springframework.beans.factory.annotation.Value;
+import org.springframework.http.ResponseEntity;
+import org.springframework.web.bind.annotation.*;
+
+import java.util.Map;
+
+@RestController
+@RequestMapping("/api/razorpay")
+public class RazorpayWebhookController {
+
+    private static final Logger log = LoggerFactory.getLogger(RazorpayWebhookController.class);
+
+    @Value("${razorpay.webhook-secret}")
+    private String webhookSecret;
+
+    // IMPORTANT: bind the body as a String so the signature is checked against
+    // the exact bytes Razorpay sent - never a deserialised DTO
+    @PostMapping("/webhook")
+    public ResponseEntity<Map<String, Object>> handleWebhook(
+            @RequestBody String payload,
+            @RequestHeader(value = "X-Razorpay-Signature", required = false) String signature) {
+        try {
+            if (signature == null || !Utils.verifyWebhookSignature(payload, signature, webhookSecret)) {
+                return ResponseEntity.badRequest().body(Map.of("error", "Invalid signature"));
+            }
+        } catch (Exception e) {
+            return ResponseEntity.badRequest().body(Map.of("error", "Invalid signature"));
+        }
+
+        JSONObject event = new JSONObject(payload);
+        switch (event.getString("event")) {
+            case "payment.captured" -> {
+                JSONObject payment = event.getJSONObject("payload").getJSONObject("payment").getJSONObject("entity");
+                // TODO: Mark the order for payment's order_id as paid (idempotently)
+                log.info("Payment captured: {}", payment.getString("id"));
+            }
+            case "payment.failed" -> {
+                JSONObject payment = event.getJSONObject("payload").getJSONObject("payment").getJSONObject("entity");
+                // TODO: Record the failure / notify the customer
+                log.info("Payment failed: {}", payment.getString("id"));
+            }
+            case "order.paid" -> {
+                JSONObject order = event.getJSONObject("payload").getJSONObject("order").getJSONObject("entity");
+                // TODO: Fulfil the order (ship, grant access, send receipt)
+                log.info("Order paid: {}", order.getString("id"));
+            }
+            default -> log.info("Unhandled webhook event: {}", event.getString("event"));
+        }
+
+        return ResponseEntity.ok(Map.of("status", "ok"));
+    }
+}
+`
+
+	return IntegrateCheckoutOutput{
+		Summary: "Razorpay webhook controller for Spring Boot",
+		Files: []FileAction{
+			{Action: "create", Path: "src/main/java/com/example/payments/RazorpayWebhookController.java", Code: controllerCode, Description: "Spring Boot webhook controller with raw-body signature verification"},
+			{Action: "manual_edit", Path: "src/main/resources/application.properties", Description: "Add webhook secret", Edits: []EditItem{
+				{Line: "Anywhere", Add: "razorpay.webhook-secret=${RAZORPAY_WEBHOOK_SECRET}", Why: "Read the secret from the environment"},
+			}},
+		},
+		AIInstructions: `WEBHOOK SETUP:
+1) Create RazorpayWebhookController.java (change the package to match the project)
+2) Add razorpay.webhook-secret to application.properties
+3) If Spring Security is enabled, permit POST /api/razorpay/webhook and disable CSRF for it`,
+		Webhooks:            checkoutWebhooks(),
+		WebhookDashboardURL: webhookDashboardURL,
+	}
+}
+
+func getAspNetWebhookIntegration() IntegrateCheckoutOutput {
+	controllerCode := `using System.Security.Cryptography;
+using System.Text;
+using System.Text.Json;
+using Microsoft.AspNetCore.Mvc;
+
+namespace Payments.Controllers;
+
+[ApiController]
+[Route("api/razorpay")]
+public class RazorpayWebhookController : ControllerBase
+{
+    private readonly string _webhookSecret;
+    private readonly ILogger<RazorpayWebhookController> _logger;
+
+    public RazorpayWebhookController(IConfiguration configuration, ILogger<RazorpayWebhookController> logger)
+    {
+        _webhookSecret = configuration["Razorpay:WebhookSecret"]
+            ?? Environment.GetEnvironmentVariable("RAZORPAY_WEBHOOK_SECRET")
+            ?? throw new InvalidOperationException("Razorpay:WebhookSecret is not configured");
+        _logger = logger;
+    }
+
+    [HttpPost("webhook")]
+    public async Task<IActionResult> HandleWebhook()
+    {
+        // IMPORTANT: read the RAW body - do not bind to a model with [FromBody]
+        using var reader = new StreamReader(Request.Body, Encoding.UTF8);
+        var payload = await reader.ReadToEndAsync();
+        var signature = Request.Headers["X-Razorpay-Signature"].ToString();
+
+        using var hmac = new HMACSHA256(Encoding.UTF8.GetBytes(_webhookSecret));
+        var expected = Convert.ToHexString(hmac.ComputeHash(Encoding.UTF8.GetBytes(payload))).ToLowerInvariant();
+        if (!CryptographicOperations.FixedTimeEquals(Encoding.UTF8.GetBytes(expected), Encoding.UTF8.GetBytes(signature)))
+        {
+            return BadRequest(new { error = "Invalid signature" });
+        }
+
+        using var evt = JsonDocument.Parse(payload);
+        var eventType = evt.RootElement.GetProperty("event").GetString();
+        var entities = evt.RootElement.GetProperty("payload");
+
+        switch (eventType)
+        {
+            case "payment.captured":
+                // TODO: Mark the order for the payment's order_id as paid (idempotently)
+                _logger.LogInformation("Payment captured: {Id}", entities.GetProperty("payment").GetProperty("entity").GetProperty("id").GetString());
+                break;
+            case "payment.failed":
+                // TODO: Record the failure / notify the customer
+                _logger.LogInformation("Payment failed: {Id}", entities.GetProperty("payment").GetProperty("entity").GetProperty("id").GetString());
+                break;
+            case "order.paid":
+                // TODO: Fulfil the order (ship, grant access, send receipt)
+                _logger.LogInformation("Order paid: {Id}", entities.GetProperty("order").GetProperty("entity").GetProperty("id").GetString());
+                break;
+            default:
+                _logger.LogInformation("Unhandled webhook event: {Event}", eventType);
+                break;
+        }
+
+        return Ok(new { status = "ok" });
+    }
+}
+`
+
+	return IntegrateCheckoutOutput{
+		Summary: "Razorpay webhook controller for ASP.NET Core",
+		Files: []FileAction{
+			{Action: "create", Path: "Controllers/RazorpayWebhookController.cs", Code: controllerCode, Description: "ASP.NET Core webhook controller with raw-body signature verification"},
+		},
+		AIInstructions: `WEBHOOK SETUP:
+1) Create Controllers/RazorpayWebhookController.cs (change the namespace to match the project)
+2) Store the secret: dotnet user-secrets set "Razorpay:WebhookSecret" "<webhook secret>"`,
+		Webhooks:            checkoutWebhooks(),
+		WebhookDashboardURL: webhookDashboardURL,
+	}
+}
+
+// webhookDashboardURL is where webhooks are added, in test or live mode
+// depending on the mode the Dashboard is switched to
+const webhookDashboardURL = "https://dashboard.razorpay.com/app/webhooks"
+
+// checkoutWebhooks returns the events that confirm a checkout payment on the
+// server, independent of the browser reaching the verify route
+func checkoutWebhooks() []WebhookConfig {
+	return []WebhookConfig{
+		{
+			Event:       "payment.captured",
+			Description: "Payment captured - fulfil the order even if the customer closed the tab before verification",
+		},
+		{
+			Event:       "payment.failed",
+			Description: "Payment failed - release held stock or prompt the customer to retry",
+		},
+		{
+			Event:       "order.paid",
+			Description: "Order fully paid - the source of truth for marking an order complete",
+		},
+	}
+}
+
+// subscriptionWebhooks returns the events that track a subscription through
+// its billing cycles
+func subscriptionWebhooks() []WebhookConfig {
+	return []WebhookConfig{
+		{
+			Event:       "subscription.activated",
+			Description: "Authorisation payment succeeded - grant access",
+		},
+		{
+			Event:       "subscription.charged",
+			Description: "A billing cycle was charged - extend access until the next charge_at",
+		},
+		{
+			Event:       "subscription.pending",
+			Description: "A charge failed and Razorpay is retrying - warn the customer",
+		},
+		{
+			Event:       "subscription.halted",
+			Description: "All retries failed - revoke access until the customer updates the payment method",
+		},
+		{
+			Event:       "subscription.cancelled",
+			Description: "Subscription cancelled - revoke access at the end of the current cycle",
+		},
+	}
+}
+
+// getWebhookInstructions returns the dashboard setup steps shared by every
+// webhook integration
+func getWebhookInstructions() string {
+	return `
+
+**WEBHOOK REQUIREMENTS (DO NOT SKIP):**
+- The signature MUST be verified over the RAW request body bytes. Parsing the JSON
+  and re-serialising it changes the bytes and every signature check will fail.
+- Use RAZORPAY_WEBHOOK_SECRET (the secret set on the webhook in the Dashboard),
+  NOT RAZORPAY_KEY_SECRET.
+- Add the webhook in Dashboard -> Account & Settings -> Webhooks pointing at
+  https://<your-domain>/api/razorpay/webhook with events payment.captured,
+  payment.failed and order.paid.
+- Handlers must be idempotent: Razorpay retries until it gets a 2xx, so the same
+  event can arrive more than once (dedupe on the x-razorpay-event-id header).
+- Respond quickly; do slow work (emails, fulfilment) in a background job.`
+}
+
+// =============================================================================
+// SUBSCRIPTION INTEGRATIONS
+// =============================================================================
+
+func getExpressSubscriptionIntegration(language string) IntegrateCheckoutOutput {
+	ext := "js"
+	if language == "typescript" {
+		ext = "ts"
+	}
+
+	routesCode := `const express = require('express');
+const Razorpay = require('razorpay');
+const crypto = require('crypto');
+
+const router = express.Router();
+
+const razorpay = new Razorpay({
+  key_id: process.env.RAZORPAY_KEY_ID,
+  key_secret: process.env.RAZORPAY_KEY_SECRET,
+});
+
+// Run ONCE per pricing tier (e.g. from a script or REPL) and store the
+// returned plan id in RAZORPAY_PLAN_ID. Plans can also be created in the
+// Dashboard under Subscriptions -> Plans.
+async function createPlan({ name, amountInPaise, period = 'monthly', interval = 1 }) {
+  return razorpay.plans.create({
+    period,
+    interval,
+    item: { name, amount: amountInPaise, currency: 'INR' },
+  });
+}
+
+// Create a subscription for the plan
+router.post('/subscription', async (req, res) => {
+  try {
+    const { totalCount = 12 } = req.body;
+
+    const subscription = await razorpay.subscriptions.create({
+      plan_id: process.env.RAZORPAY_PLAN_ID,
+      total_count: totalCount,
+      customer_notify: 1,
+    });
+
+    res.json({
+      success: true,
+      subscriptionId: subscription.id,
+      keyId: process.env.RAZORPAY_KEY_ID,
+    });
+  } catch (error) {
+    console.error('Razorpay subscription creation failed:', error);
+    res.status(500).json({ success: false, error: 'Failed to create subscription' });
+  }
+});
+
+// Verify the subscription payment signature
+router.post('/subscription/verify', (req, res) => {
+  try {
+    const { razorpay_payment_id, razorpay_subscription_id, razorpay_signature } = req.body;
+
+    if (!razorpay_payment_id || !razorpay_subscription_id || !razorpay_signature) {
+      return res.status(400).json({ success: false, error: 'Missing payment details' });
+    }
+
+    // NOTE: for subscriptions the payload is payment_id|subscription_id
+    const expectedSignature = crypto
+      .createHmac('sha256', process.env.RAZORPAY_KEY_SECRET)
+      .update(razorpay_payment_id + '|' + razorpay_subscription_id)
+      .digest('hex');
+
+    const expectedBuffer = Buffer.from(expectedSignature);
+    const receivedBuffer = Buffer.from(String(razorpay_signature));
+    const isValid =
+      expectedBuffer.length === receivedBuffer.length &&
+      crypto.timingSafeEqual(expectedBuffer, receivedBuffer);
+
+    if (!isValid) {
+      return res.status(400).json({ success: false, error: 'Invalid subscription signature' });
+    }
+
+    // TODO: Mark the user's subscription as active in your database
+    res.json({
+      success: true,
+      paymentId: razorpay_payment_id,
+      subscriptionId: razorpay_subscription_id,
+    });
+  } catch (error) {
+    console.error('Subscription verification failed:', error);
+    res.status(500).json({ success: false, error: 'Subscription verification failed' });
+  }
+});
+
+module.exports = router;
+module.exports.createPlan = createPlan;
+`
+
+	return IntegrateCheckoutOutput{
+		Summary: "Razorpay Subscriptions integration for Express",
+		Files: []FileAction{
+			{Action: "create", Path: "routes/razorpaySubscription." + ext, Code: routesCode, Description: "Subscription creation and verification routes"},
+			{Action: "manual_edit", Path: "server.js", Description: "Mount subscription routes", Edits: []EditItem{
+				{Line: "With other requires", Add: "const razorpaySubscriptionRoutes = require('./routes/razorpaySubscription');", Why: "Import routes"},
+				{Line: "With other app.use() calls", Add: "app.use('/api/razorpay', razorpaySubscriptionRoutes);", Why: "Mount routes"},
+			}},
+		},
+		Dependencies: []Dependency{
+			{Name: "razorpay", InstallCommand: "npm install razorpay"},
+			{Name: "dotenv", InstallCommand: "npm install dotenv"},
+		},
+		AIInstructions: `BACKEND SETUP:
+1) npm install razorpay dotenv
+2) Create routes/razorpaySubscription.` + ext + ` and mount it in server.js`,
+		Webhooks:            subscriptionWebhooks(),
+		WebhookDashboardURL: webhookDashboardURL,
+	}
+}
+
+func getNextjsSubscriptionIntegration(strictEnv bool) IntegrateCheckoutOutput {
+	// With strictEnv the routes read the same validated config module as the
+	// one-time checkout routes, instead of asserting env vars with "!"
+	createConfigImport := ""
+	verifyConfigImport := ""
+	keyIDExpr := "process.env.RAZORPAY_KEY_ID!"
+	keyIDResponseExpr := "process.env.RAZORPAY_KEY_ID"
+	keySecretExpr := "process.env.RAZORPAY_KEY_SECRET!"
+	planIDExpr := "process.env.RAZORPAY_PLAN_ID!"
+	if strictEnv {
+		createConfigImport = "import { razorpayConfig, requireEnv } from '../../../../lib/razorpay-config';\n"
+		verifyConfigImport = "import { razorpayConfig } from '../../../../../lib/razorpay-config';\n"
+		keyIDExpr = "razorpayConfig.keyId"
+		keyIDResponseExpr = "razorpayConfig.keyId"
+		keySecretExpr = "razorpayConfig.keySecret"
+		planIDExpr = "requireEnv('RAZORPAY_PLAN_ID')"
+	}
+
+	createRouteCode := `import { NextRequest, NextResponse } from 'next/server';
+import Razorpay from 'razorpay';
+` + createConfigImport + `
+const razorpay = new Razorpay({
+  key_id: ` + keyIDExpr + `,
+  key_secret: ` + keySecretExpr + `,
+});
+
+export async function POST(request: NextRequest) {
+  try {
+    const { totalCount = 12 } = await request.json();
+
+    const subscription = await razorpay.subscriptions.create({
+      plan_id: ` + planIDExpr + `,
+      total_count: totalCount,
+      customer_notify: 1,
+    });
+
+    return NextResponse.json({
+      success: true,
+      subscriptionId: subscription.id,
+      keyId: ` + keyIDResponseExpr + `,
+    });
+  } catch (error) {
+    console.error('Razorpay subscription creation failed:', error);
+    return NextResponse.json({ success: false, error: 'Failed to create subscription' }, { status: 500 });
+  }
+}
+`
+
+	verifyRouteCode := `import { NextRequest, NextResponse } from 'next/server';
+import crypto from 'crypto';
+` + verifyConfigImport + `
+export async function POST(request: NextRequest) {
+  const { razorpay_payment_id, razorpay_subscription_id, razorpay_signature } = await request.json();
+
+  if (!razorpay_payment_id || !razorpay_subscription_id || !razorpay_signature) {
+    return NextResponse.json({ success: false, error: 'Missing payment details' }, { status: 400 });
+  }
+
+  // NOTE: for subscriptions the payload is payment_id|subscription_id
+  const expectedSignature = crypto
+    .createHmac('sha256', ` + keySecretExpr + `)
+    .update(razorpay_payment_id + '|' + razorpay_subscription_id)
+    .digest('hex');
+
+  const expectedBuffer = Buffer.from(expectedSignature);
+  const receivedBuffer = Buffer.from(String(razorpay_signature));
+  const isValid =
+    expectedBuffer.length === receivedBuffer.length &&
+    crypto.timingSafeEqual(expectedBuffer, receivedBuffer);
+
+  if (!isValid) {
+    return NextResponse.json({ success: false, error: 'Invalid subscription signature' }, { status: 400 });
+  }
+
+  // TODO: Mark the user's subscription as active in your database
+  return NextResponse.json({
+    success: true,
+    paymentId: razorpay_payment_id,
+    subscriptionId: razorpay_subscription_id,
+  });
+}
+`
+
+	planScriptCode := `// Run once: node scripts/create-plan.mjs
+// Stores nothing - copy the printed plan id into RAZORPAY_PLAN_ID.
+import Razorpay from 'razorpay';
+
+const razorpay = new Razorpay({
+  key_id: process.env.RAZORPAY_KEY_ID,
+  key_secret: process.env.RAZORPAY_KEY_SECRET,
+});
+
+const plan = await razorpay.plans.create({
+  period: 'monthly',
+  interval: 1,
+  item: { name: 'Pro Monthly', amount: 49900, currency: 'INR' }, // ₹499.00 in paise
+});
+
+console.log('RAZORPAY_PLAN_ID=' + plan.id);
+`
+
+	componentCode := `'use client';
+
+import { useState } from 'react';
+import Script from 'next/script';
+
+interface RazorpaySubscribeProps {
+  onSuccess?: (data: { paymentId: string; subscriptionId: string }) => void;
+  onError?: (error: Error) => void;
+  buttonText?: string;
+}
+
+export function RazorpaySubscribe({ onSuccess, onError, buttonText = 'Subscribe' }: RazorpaySubscribeProps) {
+  const [loading, setLoading] = useState(false);
+  const [scriptLoaded, setScriptLoaded] = useState(false);
+
+  const handleSubscribe = async () => {
+    if (!scriptLoaded || loading) return;
+    setLoading(true);
+
+    try {
+      const res = await fetch('/api/razorpay/subscription', {
+        method: 'POST',
+        headers: { 'Content-Type': 'application/json' },
+        body: JSON.stringify({}),
+      });
+      const data = await res.json();
+      if (!data.success) throw new Error(data.error);
+
+      const razorpay = new (window as any).Razorpay({
+        key: data.keyId,
+        subscription_id: data.subscriptionId, // NOT order_id
+        name: 'Subscription',
+        handler: async (response: any) => {
+          const verifyRes = await fetch('/api/razorpay/subscription/verify', {
+            method: 'POST',
+            headers: { 'Content-Type': 'application/json' },
+            body: JSON.stringify(response),
+          });
+          const verifyData = await verifyRes.json();
+          if (verifyData.success) {
+            onSuccess?.({ paymentId: verifyData.paymentId, subscriptionId: verifyData.subscriptionId });
+          } else {
+            onError?.(new Error(verifyData.error));
+          }
+          setLoading(false);
+        },
+        modal: { ondismiss: () => setLoading(false) },
+      });
+      razorpay.on('payment.failed', (res: any) => {
+        onError?.(new Error(res.error.description));
+        setLoading(false);
+      });
+      razorpay.open();
+    } catch (error) {
+      onError?.(error as Error);
+      setLoading(false);
+    }
+  };
+
+  return (
+    <>
+      <Script src="https://checkout.razorpay.com/v1/checkout.js" onLoad={() => setScriptLoaded(true)} />
+      <button onClick={handleSubscribe} disabled={loading || !scriptLoaded}>
+        {loading ? 'Processing...' : buttonText}
+      </button>
+    </>
+  );
+}
+`
+
+	files := []FileAction{}
+	if strictEnv {
+		files = append(files, FileAction{
+			Action:      "create",
+			Path:        "lib/razorpay-config.ts",
+			Code:        getStrictEnvConfigCode(),
+			Description: "Validated Razorpay config - throws on load if an env var is missing",
+		})
+	}
+
+	output := IntegrateCheckoutOutput{
+		Summary: "Razorpay Subscriptions integration for Next.js + React",
+		Files: append(files, []FileAction{
+			{Action: "create", Path: "app/api/razorpay/subscription/route.ts", Code: createRouteCode, Description: "API route for creating subscriptions"},
+			{Action: "create", Path: "app/api/razorpay/subscription/verify/route.ts", Code: verifyRouteCode, Description: "API route for verifying subscription signatures"},
+			{Action: "create", Path: "scripts/create-plan.mjs", Code: planScriptCode, Description: "One-off script to create a plan"},
+			{Action: "create", Path: "components/RazorpaySubscribe.tsx", Code: componentCode, Description: "React component that opens Checkout for a subscription"},
+		}...),
+		Dependencies: []Dependency{
+			{Name: "razorpay", InstallCommand: "npm install razorpay"},
+		},
+		AIInstructions: `BACKEND SETUP:
+1) npm install razorpay
+2) Create the subscription API routes and the RazorpaySubscribe component
+3) Run scripts/create-plan.mjs once (or create a plan in the Dashboard) and set RAZORPAY_PLAN_ID in .env.local`,
+		Webhooks:            subscriptionWebhooks(),
+		WebhookDashboardURL: webhookDashboardURL,
+	}
+
+	if strictEnv {
+		output.AIInstructions += `
+4) Create lib/razorpay-config.ts (or reuse the one from the checkout integration) and keep
+   the routes importing razorpayConfig - never reintroduce process.env.RAZORPAY_*! assertions`
+	}
 
-	appCode := `import os
-import time
+	return output
+}
+
+func getPythonSubscriptionIntegration(framework string) IntegrateCheckoutOutput {
+	serviceCode := `import hashlib
 import hmac
-import hashlib
-import razorpay
-from flask import Flask, request, jsonify
-from dotenv import load_dotenv
+import os
 
-load_dotenv()
+import razorpay
 
-app = Flask(__name__)
 client = razorpay.Client(auth=(os.environ['RAZORPAY_KEY_ID'], os.environ['RAZORPAY_KEY_SECRET']))
 
-@app.route('/api/razorpay/order', methods=['POST'])
-def create_order():
-    try:
-        data = request.get_json()
-        amount = data.get('amount', 0)
 
-        if amount <= 0:
-            return jsonify({'success': False, 'error': 'Invalid amount'}), 400
+def create_plan(name, amount_in_paise, period='monthly', interval=1):
+    """Run ONCE per pricing tier and store the returned id in RAZORPAY_PLAN_ID."""
+    return client.plan.create({
+        'period': period,
+        'interval': interval,
+        'item': {'name': name, 'amount': amount_in_paise, 'currency': 'INR'},
+    })
+
+
+def create_subscription(total_count=12):
+    subscription = client.subscription.create({
+        'plan_id': os.environ['RAZORPAY_PLAN_ID'],
+        'total_count': total_count,
+        'customer_notify': 1,
+    })
+    return {
+        'success': True,
+        'subscriptionId': subscription['id'],
+        'keyId': os.environ['RAZORPAY_KEY_ID'],
+    }
 
-        order = client.order.create({
-            'amount': int(amount * 100),
-            'currency': data.get('currency', 'INR'),
-            'receipt': data.get('receipt', f'receipt_{int(time.time())}'),
-        })
 
-        return jsonify({
-            'success': True,
-            'orderId': order['id'],
-            'amount': order['amount'],
-            'currency': order['currency'],
-            'keyId': os.environ['RAZORPAY_KEY_ID'],
-        })
-    except Exception as e:
-        return jsonify({'success': False, 'error': str(e)}), 500
+def verify_subscription_signature(payment_id, subscription_id, signature):
+    # NOTE: for subscriptions the payload is payment_id|subscription_id
+    msg = f'{payment_id}|{subscription_id}'
+    expected = hmac.new(os.environ['RAZORPAY_KEY_SECRET'].encode(), msg.encode(), hashlib.sha256).hexdigest()
+    return hmac.compare_digest(expected, signature or '')
+`
 
-@app.route('/api/razorpay/verify', methods=['POST'])
-def verify_payment():
-    try:
-        data = request.get_json()
-        razorpay_order_id = data.get('razorpay_order_id')
-        razorpay_payment_id = data.get('razorpay_payment_id')
-        razorpay_signature = data.get('razorpay_signature')
+	var routesCode, routesPath string
+	var edits []EditItem
+	switch framework {
+	case "django":
+		routesPath = "payments/subscription_views.py"
+		routesCode = `import json
 
-        if not all([razorpay_order_id, razorpay_payment_id, razorpay_signature]):
-            return jsonify({'success': False, 'error': 'Missing payment details'}), 400
+from django.http import JsonResponse
+from django.views.decorators.http import require_POST
 
-        msg = f'{razorpay_order_id}|{razorpay_payment_id}'
-        expected = hmac.new(os.environ['RAZORPAY_KEY_SECRET'].encode(), msg.encode(), hashlib.sha256).hexdigest()
+from .razorpay_subscriptions import create_subscription, verify_subscription_signature
 
-        if hmac.compare_digest(expected, razorpay_signature):
-            return jsonify({'success': True, 'paymentId': razorpay_payment_id, 'orderId': razorpay_order_id})
-        return jsonify({'success': False, 'error': 'Invalid signature'}), 400
-    except Exception as e:
-        return jsonify({'success': False, 'error': str(e)}), 500
 
-if __name__ == '__main__':
-    app.run(debug=True)
+@require_POST
+def subscription_create(request):
+    data = json.loads(request.body or '{}')
+    try:
+        return JsonResponse(create_subscription(data.get('totalCount', 12)))
+    except Exception:
+        return JsonResponse({'success': False, 'error': 'Failed to create subscription'}, status=500)
+
+
+@require_POST
+def subscription_verify(request):
+    data = json.loads(request.body or '{}')
+    if not verify_subscription_signature(
+        data.get('razorpay_payment_id'),
+        data.get('razorpay_subscription_id'),
+        data.get('razorpay_signature'),
+    ):
+        return JsonResponse({'success': False, 'error': 'Invalid subscription signature'}, status=400)
+
+    # TODO: Mark the user's subscription as active in your database
+    return JsonResponse({
+        'success': True,
+        'paymentId': data['razorpay_payment_id'],
+        'subscriptionId': data['razorpay_subscription_id'],
+    })
 `
+		edits = []EditItem{
+			{Line: "In urlpatterns", Add: "path('api/razorpay/subscription', subscription_views.subscription_create)", Why: "Create endpoint"},
+			{Line: "In urlpatterns", Add: "path('api/razorpay/subscription/verify', subscription_views.subscription_verify)", Why: "Verify endpoint"},
+		}
+	case "flask":
+		routesPath = "razorpay_subscription_routes.py"
+		routesCode = `from flask import Blueprint, jsonify, request
 
-	return IntegrateCheckoutOutput{
-		Summary: "Complete Razorpay Standard Checkout integration for Flask + " + frontend.Framework,
-		Files: []FileAction{
-			{Action: "create", Path: "razorpay_routes.py", Code: appCode, Description: "Flask routes for Razorpay"},
-			{Action: "create", Path: frontend.FileName, Code: frontend.Code, Description: frontend.Description},
-			getWirePaymentAction(),
-		},
-		Dependencies: []Dependency{
-			{Name: "razorpay", InstallCommand: "pip install razorpay"},
-			{Name: "python-dotenv", InstallCommand: "pip install python-dotenv"},
-		},
-		EnvVars:          []EnvVar{{Name: "RAZORPAY_KEY_ID", Value: keyID}, {Name: "RAZORPAY_KEY_SECRET", Value: keySecret}},
-		TestInstructions: "Use test card: 4111 1111 1111 1111",
-		AIInstructions: `BACKEND SETUP:
-1) pip install razorpay python-dotenv
-2) Create razorpay_routes.py with the Razorpay endpoints
-3) Import and register the blueprint in your main app.py
-4) Create .env with Razorpay keys` + getFrontendWiringInstructions(frontend),
-	}
-}
+from razorpay_subscriptions import create_subscription, verify_subscription_signature
 
-func getFastAPIIntegration(creds Credentials, frontend FrontendIntegration) IntegrateCheckoutOutput {
-	keyID, keySecret := getKeysOrPlaceholders(creds)
+razorpay_subscription_bp = Blueprint('razorpay_subscription', __name__)
 
-	routerCode := `import os
-import time
-import hmac
-import hashlib
-import razorpay
-from fastapi import APIRouter, HTTPException
+
+@razorpay_subscription_bp.route('/api/razorpay/subscription', methods=['POST'])
+def subscription_create():
+    data = request.get_json(silent=True) or {}
+    try:
+        return jsonify(create_subscription(data.get('totalCount', 12)))
+    except Exception:
+        return jsonify({'success': False, 'error': 'Failed to create subscription'}), 500
+
+
+@razorpay_subscription_bp.route('/api/razorpay/subscription/verify', methods=['POST'])
+def subscription_verify():
+    data = request.get_json(silent=True) or {}
+    if not verify_subscription_signature(
+        data.get('razorpay_payment_id'),
+        data.get('razorpay_subscription_id'),
+        data.get('razorpay_signature'),
+    ):
+        return jsonify({'success': False, 'error': 'Invalid subscription signature'}), 400
+
+    # TODO: Mark the user's subscription as active in your database
+    return jsonify({
+        'success': True,
+        'paymentId': data['razorpay_payment_id'],
+        'subscriptionId': data['razorpay_subscription_id'],
+    })
+`
+		edits = []EditItem{
+			{Line: "After imports", Add: "from razorpay_subscription_routes import razorpay_subscription_bp", Why: "Import blueprint"},
+			{Line: "After app creation", Add: "app.register_blueprint(razorpay_subscription_bp)", Why: "Register routes"},
+		}
+	default: // fastapi
+		routesPath = "routers/razorpay_subscription.py"
+		routesCode = `from fastapi import APIRouter, HTTPException
 from pydantic import BaseModel
-from dotenv import load_dotenv
 
-load_dotenv()
+from razorpay_subscriptions import create_subscription, verify_subscription_signature
 
 router = APIRouter(prefix="/api/razorpay")
-client = razorpay.Client(auth=(os.environ['RAZORPAY_KEY_ID'], os.environ['RAZORPAY_KEY_SECRET']))
 
-class OrderRequest(BaseModel):
-    amount: float
-    currency: str = "INR"
-    receipt: str = None
 
-class VerifyRequest(BaseModel):
-    razorpay_order_id: str
+class SubscriptionRequest(BaseModel):
+    totalCount: int = 12
+
+
+class SubscriptionVerifyRequest(BaseModel):
     razorpay_payment_id: str
+    razorpay_subscription_id: str
     razorpay_signature: str
 
-@router.post("/order")
-async def create_order(req: OrderRequest):
-    if req.amount <= 0:
-        raise HTTPException(status_code=400, detail="Invalid amount")
+
+@router.post("/subscription")
+async def subscription_create(req: SubscriptionRequest):
     try:
-        order = client.order.create({
-            'amount': int(req.amount * 100),
-            'currency': req.currency,
-            'receipt': req.receipt or f'receipt_{int(time.time())}',
-        })
-        return {
-            'success': True,
-            'orderId': order['id'],
-            'amount': order['amount'],
-            'currency': order['currency'],
-            'keyId': os.environ['RAZORPAY_KEY_ID'],
-        }
-    except Exception as e:
-        raise HTTPException(status_code=500, detail=str(e))
+        return create_subscription(req.totalCount)
+    except Exception:
+        raise HTTPException(status_code=500, detail="Failed to create subscription")
 
-@router.post("/verify")
-async def verify_payment(req: VerifyRequest):
-    msg = f'{req.razorpay_order_id}|{req.razorpay_payment_id}'
-    expected = hmac.new(os.environ['RAZORPAY_KEY_SECRET'].encode(), msg.encode(), hashlib.sha256).hexdigest()
 
-    if hmac.compare_digest(expected, req.razorpay_signature):
-        return {'success': True, 'paymentId': req.razorpay_payment_id, 'orderId': req.razorpay_order_id}
-    raise HTTPException(status_code=400, detail="Invalid signature")
+@router.post("/subscription/verify")
+async def subscription_verify(req: SubscriptionVerifyRequest):
+    if not verify_subscription_signature(req.razorpay_payment_id, req.razorpay_subscription_id, req.razorpay_signature):
+        raise HTTPException(status_code=400, detail="Invalid subscription signature")
+
+    # TODO: Mark the user's subscription as active in your database
+    return {'success': True, 'paymentId': req.razorpay_payment_id, 'subscriptionId': req.razorpay_subscription_id}
 `
+		edits = []EditItem{
+			{Line: "After imports", Add: "from routers.razorpay_subscription import router as razorpay_subscription_router", Why: "Import router"},
+			{Line: "After app creation", Add: "app.include_router(razorpay_subscription_router)", Why: "Include routes"},
+		}
+	}
+
+	servicePath := "razorpay_subscriptions.py"
+	editPath := map[string]string{"django": "urls.py", "flask": "app.py", "fastapi": "main.py"}[framework]
+	if framework == "django" {
+		servicePath = "payments/razorpay_subscriptions.py"
+	}
 
 	return IntegrateCheckoutOutput{
-		Summary: "Complete Razorpay Standard Checkout integration for FastAPI + " + frontend.Framework,
+		Summary: "Razorpay Subscriptions integration for " + framework,
 		Files: []FileAction{
-			{Action: "create", Path: "routers/razorpay.py", Code: routerCode, Description: "FastAPI router for Razorpay"},
-			{Action: "create", Path: frontend.FileName, Code: frontend.Code, Description: frontend.Description},
-			{Action: "manual_edit", Path: "main.py", Description: "Add router", Edits: []EditItem{
-				{Line: "After imports", Add: "from routers.razorpay import router as razorpay_router", Why: "Import router"},
-				{Line: "After app creation", Add: "app.include_router(razorpay_router)", Why: "Include router"},
-			}},
-			getWirePaymentAction(),
+			{Action: "create", Path: servicePath, Code: serviceCode, Description: "Plan/subscription helpers and signature verification"},
+			{Action: "create", Path: routesPath, Code: routesCode, Description: "Subscription creation and verification endpoints"},
+			{Action: "manual_edit", Path: editPath, Description: "Register subscription endpoints", Edits: edits},
 		},
 		Dependencies: []Dependency{
 			{Name: "razorpay", InstallCommand: "pip install razorpay"},
-			{Name: "python-dotenv", InstallCommand: "pip install python-dotenv"},
 		},
-		EnvVars:          []EnvVar{{Name: "RAZORPAY_KEY_ID", Value: keyID}, {Name: "RAZORPAY_KEY_SECRET", Value: keySecret}},
-		TestInstructions: "Use test card: 4111 1111 1111 1111",
 		AIInstructions: `BACKEND SETUP:
-1) pip install razorpay python-dotenv
-2) Create routers/razorpay.py with the Razorpay endpoints
-3) Import and include router in main.py
-4) Create .env with Razorpay keys` + getFrontendWiringInstructions(frontend),
+1) pip install razorpay
+2) Create ` + servicePath + ` and ` + routesPath + `
+3) Register the endpoints in ` + editPath + `
+4) Call create_plan() once from a shell (or use the Dashboard) and set RAZORPAY_PLAN_ID`,
+		Webhooks:            subscriptionWebhooks(),
+		WebhookDashboardURL: webhookDashboardURL,
 	}
 }
 
-// =============================================================================
-// GO BACKEND INTEGRATIONS
-// =============================================================================
-
-func getGinIntegration(creds Credentials, frontend FrontendIntegration) IntegrateCheckoutOutput {
-	keyID, keySecret := getKeysOrPlaceholders(creds)
-
-	handlerCode := `package handlers
-
-import (
-	"crypto/hmac"
-	"crypto/sha256"
-	"encoding/hex"
-	"fmt"
-	"net/http"
-	"os"
-	"time"
-
-	"github.com/gin-gonic/gin"
-	razorpay "github.com/razorpay/razorpay-go"
-)
+func getGoSubscriptionIntegration(framework string) IntegrateCheckoutOutput {
+	var imports, handlers string
+	var routes []EditItem
+	switch framework {
+	case "echo":
+		imports = "\t\"net/http\"\n\t\"os\"\n\n\t\"github.com/labstack/echo/v4\"\n\trazorpay \"github.com/razorpay/razorpay-go\"\n"
+		handlers = `func CreateSubscription(c echo.Context) error {
+	subscription, err := createSubscription()
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]interface{}{"success": false, "error": "Failed to create subscription"})
+	}
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"success":        true,
+		"subscriptionId": subscription["id"],
+		"keyId":          os.Getenv("RAZORPAY_KEY_ID"),
+	})
+}
 
-var client = razorpay.NewClient(os.Getenv("RAZORPAY_KEY_ID"), os.Getenv("RAZORPAY_KEY_SECRET"))
+func VerifySubscription(c echo.Context) error {
+	var req SubscriptionVerifyRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{"success": false, "error": err.Error()})
+	}
+	if !verifySubscriptionSignature(req) {
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{"success": false, "error": "Invalid subscription signature"})
+	}
 
-type OrderRequest struct {
-	Amount   float64 ` + "`json:\"amount\"`" + `
-	Currency string  ` + "`json:\"currency\"`" + `
-	Receipt  string  ` + "`json:\"receipt\"`" + `
+	// TODO: Mark the user's subscription as active in your database
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"success":        true,
+		"paymentId":      req.PaymentID,
+		"subscriptionId": req.SubscriptionID,
+	})
 }
-
-type VerifyRequest struct {
-	OrderID   string ` + "`json:\"razorpay_order_id\"`" + `
-	PaymentID string ` + "`json:\"razorpay_payment_id\"`" + `
-	Signature string ` + "`json:\"razorpay_signature\"`" + `
+`
+		routes = []EditItem{
+			{Line: "In router setup", Add: `e.POST("/api/razorpay/subscription", handlers.CreateSubscription)`, Why: "Create endpoint"},
+			{Line: "In router setup", Add: `e.POST("/api/razorpay/subscription/verify", handlers.VerifySubscription)`, Why: "Verify endpoint"},
+		}
+	case "fiber":
+		imports = "\t\"os\"\n\n\t\"github.com/gofiber/fiber/v2\"\n\trazorpay \"github.com/razorpay/razorpay-go\"\n"
+		handlers = `func CreateSubscription(c *fiber.Ctx) error {
+	subscription, err := createSubscription()
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"success": false, "error": "Failed to create subscription"})
+	}
+	return c.JSON(fiber.Map{
+		"success":        true,
+		"subscriptionId": subscription["id"],
+		"keyId":          os.Getenv("RAZORPAY_KEY_ID"),
+	})
 }
 
-func CreateOrder(c *gin.Context) {
-	var req OrderRequest
-	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"success": false, "error": err.Error()})
-		return
-	}
-	if req.Amount <= 0 {
-		c.JSON(http.StatusBadRequest, gin.H{"success": false, "error": "Invalid amount"})
-		return
-	}
-	if req.Currency == "" {
-		req.Currency = "INR"
+func VerifySubscription(c *fiber.Ctx) error {
+	var req SubscriptionVerifyRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"success": false, "error": err.Error()})
 	}
-	if req.Receipt == "" {
-		req.Receipt = fmt.Sprintf("receipt_%d", time.Now().Unix())
+	if !verifySubscriptionSignature(req) {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"success": false, "error": "Invalid subscription signature"})
 	}
 
-	data := map[string]interface{}{
-		"amount":   int(req.Amount * 100),
-		"currency": req.Currency,
-		"receipt":  req.Receipt,
-	}
-	order, err := client.Order.Create(data, nil)
+	// TODO: Mark the user's subscription as active in your database
+	return c.JSON(fiber.Map{
+		"success":        true,
+		"paymentId":      req.PaymentID,
+		"subscriptionId": req.SubscriptionID,
+	})
+}
+`
+		routes = []EditItem{
+			{Line: "In router setup", Add: `app.Post("/api/razorpay/subscription", handlers.CreateSubscription)`, Why: "Create endpoint"},
+			{Line: "In router setup", Add: `app.Post("/api/razorpay/subscription/verify", handlers.VerifySubscription)`, Why: "Verify endpoint"},
+		}
+	default: // gin
+		imports = "\t\"net/http\"\n\t\"os\"\n\n\t\"github.com/gin-gonic/gin\"\n\trazorpay \"github.com/razorpay/razorpay-go\"\n"
+		handlers = `func CreateSubscription(c *gin.Context) {
+	subscription, err := createSubscription()
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"success": false, "error": err.Error()})
+		c.JSON(http.StatusInternalServerError, gin.H{"success": false, "error": "Failed to create subscription"})
 		return
 	}
-
 	c.JSON(http.StatusOK, gin.H{
-		"success":  true,
-		"orderId":  order["id"],
-		"amount":   order["amount"],
-		"currency": order["currency"],
-		"keyId":    os.Getenv("RAZORPAY_KEY_ID"),
+		"success":        true,
+		"subscriptionId": subscription["id"],
+		"keyId":          os.Getenv("RAZORPAY_KEY_ID"),
 	})
 }
 
-func VerifyPayment(c *gin.Context) {
-	var req VerifyRequest
+func VerifySubscription(c *gin.Context) {
+	var req SubscriptionVerifyRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{"success": false, "error": err.Error()})
 		return
 	}
+	if !verifySubscriptionSignature(req) {
+		c.JSON(http.StatusBadRequest, gin.H{"success": false, "error": "Invalid subscription signature"})
+		return
+	}
 
-	msg := req.OrderID + "|" + req.PaymentID
+	// TODO: Mark the user's subscription as active in your database
+	c.JSON(http.StatusOK, gin.H{
+		"success":        true,
+		"paymentId":      req.PaymentID,
+		"subscriptionId": req.SubscriptionID,
+	})
+}
+`
+		routes = []EditItem{
+			{Line: "In router setup", Add: `r.POST("/api/razorpay/subscription", handlers.CreateSubscription)`, Why: "Create endpoint"},
+			{Line: "In router setup", Add: `r.POST("/api/razorpay/subscription/verify", handlers.VerifySubscription)`, Why: "Verify endpoint"},
+		}
+	}
+
+	handlerCode := `package handlers
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+` + imports + `)
+
+var subscriptionClient = razorpay.NewClient(os.Getenv("RAZORPAY_KEY_ID"), os.Getenv("RAZORPAY_KEY_SECRET"))
+
+type SubscriptionVerifyRequest struct {
+	PaymentID      string ` + "`json:\"razorpay_payment_id\"`" + `
+	SubscriptionID string ` + "`json:\"razorpay_subscription_id\"`" + `
+	Signature      string ` + "`json:\"razorpay_signature\"`" + `
+}
+
+// CreatePlan should be run ONCE per pricing tier (e.g. from a small main
+// package); store the returned plan id in RAZORPAY_PLAN_ID.
+func CreatePlan(name string, amountInPaise int64, period string, interval int) (map[string]interface{}, error) {
+	return subscriptionClient.Plan.Create(map[string]interface{}{
+		"period":   period,
+		"interval": interval,
+		"item": map[string]interface{}{
+			"name":     name,
+			"amount":   amountInPaise,
+			"currency": "INR",
+		},
+	}, nil)
+}
+
+func createSubscription() (map[string]interface{}, error) {
+	return subscriptionClient.Subscription.Create(map[string]interface{}{
+		"plan_id":         os.Getenv("RAZORPAY_PLAN_ID"),
+		"total_count":     12,
+		"customer_notify": 1,
+	}, nil)
+}
+
+// verifySubscriptionSignature checks the checkout signature. NOTE: for
+// subscriptions the payload is payment_id|subscription_id
+func verifySubscriptionSignature(req SubscriptionVerifyRequest) bool {
 	h := hmac.New(sha256.New, []byte(os.Getenv("RAZORPAY_KEY_SECRET")))
-	h.Write([]byte(msg))
+	h.Write([]byte(req.PaymentID + "|" + req.SubscriptionID))
 	expected := hex.EncodeToString(h.Sum(nil))
+	return hmac.Equal([]byte(expected), []byte(req.Signature))
+}
 
-	if hmac.Equal([]byte(expected), []byte(req.Signature)) {
-		c.JSON(http.StatusOK, gin.H{"success": true, "paymentId": req.PaymentID, "orderId": req.OrderID})
-	} else {
-		c.JSON(http.StatusBadRequest, gin.H{"success": false, "error": "Invalid signature"})
+` + handlers
+
+	return IntegrateCheckoutOutput{
+		Summary: "Razorpay Subscriptions integration for " + framework,
+		Files: []FileAction{
+			{Action: "create", Path: "handlers/razorpay_subscription.go", Code: handlerCode, Description: "Subscription creation and verification handlers"},
+			{Action: "manual_edit", Path: "main.go", Description: "Register subscription routes", Edits: routes},
+		},
+		Dependencies: []Dependency{
+			{Name: "razorpay-go", InstallCommand: "go get github.com/razorpay/razorpay-go"},
+		},
+		AIInstructions: `BACKEND SETUP:
+1) go get github.com/razorpay/razorpay-go
+2) Create handlers/razorpay_subscription.go (adjust the package name to match the project)
+3) Register the routes in main.go
+4) Call handlers.CreatePlan once (or use the Dashboard) and set RAZORPAY_PLAN_ID`,
+		Webhooks:            subscriptionWebhooks(),
+		WebhookDashboardURL: webhookDashboardURL,
 	}
 }
+
+func getRailsSubscriptionIntegration() IntegrateCheckoutOutput {
+	controllerCode := `class SubscriptionsController < ApplicationController
+  # Run ONCE per pricing tier (e.g. from rails console) and store the
+  # returned id in RAZORPAY_PLAN_ID.
+  def self.create_plan(name:, amount_in_paise:, period: 'monthly', interval: 1)
+    Razorpay::Plan.create(
+      period: period,
+      interval: interval,
+      item: { name: name, amount: amount_in_paise, currency: 'INR' }
+    )
+  end
+
+  def create
+    subscription = Razorpay::Subscription.create(
+      plan_id: ENV.fetch('RAZORPAY_PLAN_ID'),
+      total_count: params.fetch(:totalCount, 12).to_i,
+      customer_notify: 1
+    )
+
+    render json: { success: true, subscriptionId: subscription.id, keyId: ENV.fetch('RAZORPAY_KEY_ID') }
+  rescue Razorpay::Error => e
+    Rails.logger.error("Razorpay subscription creation failed: #{e.message}")
+    render json: { success: false, error: 'Failed to create subscription' }, status: :internal_server_error
+  end
+
+  def verify
+    payment_id = params[:razorpay_payment_id].to_s
+    subscription_id = params[:razorpay_subscription_id].to_s
+
+    # NOTE: for subscriptions the payload is payment_id|subscription_id
+    expected = OpenSSL::HMAC.hexdigest('SHA256', ENV.fetch('RAZORPAY_KEY_SECRET'), "#{payment_id}|#{subscription_id}")
+    unless ActiveSupport::SecurityUtils.secure_compare(expected, params[:razorpay_signature].to_s)
+      return render json: { success: false, error: 'Invalid subscription signature' }, status: :bad_request
+    end
+
+    # TODO: Mark the user's subscription as active in your database
+    render json: { success: true, paymentId: payment_id, subscriptionId: subscription_id }
+  end
+end
 `
 
 	return IntegrateCheckoutOutput{
-		Summary: "Complete Razorpay Standard Checkout integration for Gin + " + frontend.Framework,
+		Summary: "Razorpay Subscriptions integration for Rails",
 		Files: []FileAction{
-			{Action: "create", Path: "handlers/razorpay.go", Code: handlerCode, Description: "Gin handlers for Razorpay"},
-			{Action: "create", Path: frontend.FileName, Code: frontend.Code, Description: frontend.Description},
-			{Action: "manual_edit", Path: "main.go", Description: "Add routes", Edits: []EditItem{
-				{Line: "In router setup", Add: "r.POST(\"/api/razorpay/order\", handlers.CreateOrder)", Why: "Order endpoint"},
-				{Line: "After order route", Add: "r.POST(\"/api/razorpay/verify\", handlers.VerifyPayment)", Why: "Verify endpoint"},
+			{Action: "create", Path: "app/controllers/subscriptions_controller.rb", Code: controllerCode, Description: "Subscription creation and verification controller"},
+			{Action: "manual_edit", Path: "config/routes.rb", Description: "Add subscription routes", Edits: []EditItem{
+				{Line: "Inside Rails.application.routes.draw", Add: "post '/api/razorpay/subscription', to: 'subscriptions#create'", Why: "Create endpoint"},
+				{Line: "Inside Rails.application.routes.draw", Add: "post '/api/razorpay/subscription/verify', to: 'subscriptions#verify'", Why: "Verify endpoint"},
 			}},
-			getWirePaymentAction(),
 		},
-		Dependencies:     []Dependency{{Name: "razorpay-go", InstallCommand: "go get github.com/razorpay/razorpay-go"}},
-		EnvVars:          []EnvVar{{Name: "RAZORPAY_KEY_ID", Value: keyID}, {Name: "RAZORPAY_KEY_SECRET", Value: keySecret}},
-		TestInstructions: "Use test card: 4111 1111 1111 1111",
+		Dependencies: []Dependency{
+			{Name: "razorpay", InstallCommand: "bundle add razorpay"},
+		},
 		AIInstructions: `BACKEND SETUP:
-1) go get github.com/razorpay/razorpay-go
-2) Create handlers/razorpay.go with the Razorpay handlers
-3) Add routes in main.go to wire up the handlers
-4) Set RAZORPAY_KEY_ID and RAZORPAY_KEY_SECRET env vars` + getFrontendWiringInstructions(frontend),
+1) bundle add razorpay (config/initializers/razorpay.rb must call Razorpay.setup - see integrate_razorpay_checkout)
+2) Create app/controllers/subscriptions_controller.rb and add the routes
+3) Run SubscriptionsController.create_plan once from rails console (or use the Dashboard) and set RAZORPAY_PLAN_ID`,
+		Webhooks:            subscriptionWebhooks(),
+		WebhookDashboardURL: webhookDashboardURL,
 	}
 }
 
-func getEchoIntegration(creds Credentials, frontend FrontendIntegration) IntegrateCheckoutOutput {
-	keyID, keySecret := getKeysOrPlaceholders(creds)
-
-	handlerCode := `package handlers
+func getSpringBootSubscriptionIntegration() IntegrateCheckoutOutput {
+	controllerCode := `package com.example.payments;
+
+import com.razorpay.Plan;
+import com.razorpay.RazorpayClient;
+import com.razorpay.RazorpayException;
+import com.razorpay.Subscription;
+import com.razorpay.Utils;
+import org.json.JSONObject;
+import org.springframework.beans.factory.annotation.Value;
+import org.springframework.http.ResponseEntity;
+import org.springframework.web.bind.annotation.*;
+
+import java.util.Map;
+
+@RestController
+@RequestMapping("/api/razorpay/subscription")
+public class RazorpaySubscriptionController {
+
+    @Value("${razorpay.key-id}")
+    private String keyId;
+
+    @Value("${razorpay.key-secret}")
+    private String keySecret;
+
+    @Value("${razorpay.plan-id}")
+    private String planId;
+
+    // Run ONCE per pricing tier and store the returned id in RAZORPAY_PLAN_ID.
+    public static Plan createPlan(RazorpayClient client, String name, long amountInPaise) throws RazorpayException {
+        JSONObject item = new JSONObject();
+        item.put("name", name);
+        item.put("amount", amountInPaise);
+        item.put("currency", "INR");
+
+        JSONObject request = new JSONObject();
+        request.put("period", "monthly");
+        request.put("interval", 1);
+        request.put("item", item);
+        return client.plans.create(request);
+    }
 
-import (
-	"crypto/hmac"
-	"crypto/sha256"
-	"encoding/hex"
-	"fmt"
-	"net/http"
-	"os"
-	"time"
+    @PostMapping
+    public ResponseEntity<Map<String, Object>> createSubscription(@RequestBody(required = false) Map<String, Object> body) {
+        try {
+            RazorpayClient client = new RazorpayClient(keyId, keySecret);
+
+            JSONObject request = new JSONObject();
+            request.put("plan_id", planId);
+            request.put("total_count", body != null && body.get("totalCount") instanceof Number n ? n.intValue() : 12);
+            request.put("customer_notify", 1);
+
+            Subscription subscription = client.subscriptions.create(request);
+            return ResponseEntity.ok(Map.of(
+                "success", true,
+                "subscriptionId", subscription.get("id"),
+                "keyId", keyId));
+        } catch (RazorpayException e) {
+            return ResponseEntity.internalServerError().body(Map.of("success", false, "error", "Failed to create subscription"));
+        }
+    }
 
-	"github.com/labstack/echo/v4"
-	razorpay "github.com/razorpay/razorpay-go"
-)
+    @PostMapping("/verify")
+    public ResponseEntity<Map<String, Object>> verifySubscription(@RequestBody Map<String, String> body) {
+        String paymentId = body.get("razorpay_payment_id");
+        String subscriptionId = body.get("razorpay_subscription_id");
+        String signature = body.get("razorpay_signature");
+        if (paymentId == null || subscriptionId == null || signature == null) {
+            return ResponseEntity.badRequest().body(Map.of("success", false, "error", "Missing payment details"));
+        }
 
-var client = razorpay.NewClient(os.Getenv("RAZORPAY_KEY_ID"), os.Getenv("RAZORPAY_KEY_SECRET"))
+        try {
+            // NOTE: for subscriptions the payload is payment_id|subscription_id
+            if (!Utils.verifySignature(paymentId + "|" + subscriptionId, signature, keySecret)) {
+                return ResponseEntity.badRequest().body(Map.of("success", false, "error", "Invalid subscription signature"));
+            }
+        } catch (RazorpayException e) {
+            return ResponseEntity.badRequest().body(Map.of("success", false, "error", "Invalid subscription signature"));
+        }
 
-type OrderRequest struct {
-	Amount   float64 ` + "`json:\"amount\"`" + `
-	Currency string  ` + "`json:\"currency\"`" + `
-	Receipt  string  ` + "`json:\"receipt\"`" + `
+        // TODO: Mark the user's subscription as active in your database
+        return ResponseEntity.ok(Map.of("success", true, "paymentId", paymentId, "subscriptionId", subscriptionId));
+    }
 }
+`
 
-type VerifyRequest struct {
-	OrderID   string ` + "`json:\"razorpay_order_id\"`" + `
-	PaymentID string ` + "`json:\"razorpay_payment_id\"`" + `
-	Signature string ` + "`json:\"razorpay_signature\"`" + `
+	return IntegrateCheckoutOutput{
+		Summary: "Razorpay Subscriptions integration for Spring Boot",
+		Files: []FileAction{
+			{Action: "create", Path: "src/main/java/com/example/payments/RazorpaySubscriptionController.java", Code: controllerCode, Description: "Subscription creation and verification controller"},
+			{Action: "manual_edit", Path: "src/main/resources/application.properties", Description: "Add Razorpay properties", Edits: []EditItem{
+				{Line: "Anywhere", Add: "razorpay.key-id=${RAZORPAY_KEY_ID}", Why: "Key id from env"},
+				{Line: "Anywhere", Add: "razorpay.key-secret=${RAZORPAY_KEY_SECRET}", Why: "Key secret from env"},
+				{Line: "Anywhere", Add: "razorpay.plan-id=${RAZORPAY_PLAN_ID}", Why: "Plan id from env"},
+			}},
+		},
+		Dependencies: []Dependency{
+			{Name: "com.razorpay:razorpay-java", InstallCommand: "Add com.razorpay:razorpay-java:1.4.8 to pom.xml or build.gradle"},
+		},
+		AIInstructions: `BACKEND SETUP:
+1) Add the razorpay-java dependency
+2) Create RazorpaySubscriptionController.java (change the package to match the project)
+3) Call createPlan once (or use the Dashboard) and set RAZORPAY_PLAN_ID`,
+		Webhooks:            subscriptionWebhooks(),
+		WebhookDashboardURL: webhookDashboardURL,
+	}
 }
 
-func CreateOrder(c echo.Context) error {
-	var req OrderRequest
-	if err := c.Bind(&req); err != nil {
-		return c.JSON(http.StatusBadRequest, map[string]interface{}{"success": false, "error": err.Error()})
-	}
-	if req.Amount <= 0 {
-		return c.JSON(http.StatusBadRequest, map[string]interface{}{"success": false, "error": "Invalid amount"})
-	}
-	if req.Currency == "" { req.Currency = "INR" }
-	if req.Receipt == "" { req.Receipt = fmt.Sprintf("receipt_%d", time.Now().Unix()) }
+func getAspNetSubscriptionIntegration() IntegrateCheckoutOutput {
+	controllerCode := `using System.Security.Cryptography;
+using System.Text;
+using System.Text.Json.Serialization;
+using Microsoft.AspNetCore.Mvc;
+using Razorpay.Api;
+
+namespace Payments.Controllers;
+
+[ApiController]
+[Route("api/razorpay/subscription")]
+public class RazorpaySubscriptionController : ControllerBase
+{
+    private readonly string _keyId;
+    private readonly string _keySecret;
+    private readonly string _planId;
+
+    public RazorpaySubscriptionController(IConfiguration configuration)
+    {
+        _keyId = configuration["Razorpay:KeyId"] ?? Environment.GetEnvironmentVariable("RAZORPAY_KEY_ID")
+            ?? throw new InvalidOperationException("Razorpay:KeyId is not configured");
+        _keySecret = configuration["Razorpay:KeySecret"] ?? Environment.GetEnvironmentVariable("RAZORPAY_KEY_SECRET")
+            ?? throw new InvalidOperationException("Razorpay:KeySecret is not configured");
+        _planId = configuration["Razorpay:PlanId"] ?? Environment.GetEnvironmentVariable("RAZORPAY_PLAN_ID")
+            ?? throw new InvalidOperationException("Razorpay:PlanId is not configured");
+    }
 
-	data := map[string]interface{}{"amount": int(req.Amount * 100), "currency": req.Currency, "receipt": req.Receipt}
-	order, err := client.Order.Create(data, nil)
-	if err != nil {
-		return c.JSON(http.StatusInternalServerError, map[string]interface{}{"success": false, "error": err.Error()})
-	}
+    public record VerifyRequest(
+        [property: JsonPropertyName("razorpay_payment_id")] string? PaymentId,
+        [property: JsonPropertyName("razorpay_subscription_id")] string? SubscriptionId,
+        [property: JsonPropertyName("razorpay_signature")] string? Signature);
+
+    // Run ONCE per pricing tier and store the returned id in RAZORPAY_PLAN_ID.
+    public static Plan CreatePlan(RazorpayClient client, string name, long amountInPaise)
+    {
+        return client.Plan.Create(new Dictionary<string, object>
+        {
+            { "period", "monthly" },
+            { "interval", 1 },
+            { "item", new Dictionary<string, object> { { "name", name }, { "amount", amountInPaise }, { "currency", "INR" } } },
+        });
+    }
 
-	return c.JSON(http.StatusOK, map[string]interface{}{
-		"success": true, "orderId": order["id"], "amount": order["amount"],
-		"currency": order["currency"], "keyId": os.Getenv("RAZORPAY_KEY_ID"),
-	})
-}
+    [HttpPost]
+    public IActionResult CreateSubscription()
+    {
+        try
+        {
+            var client = new RazorpayClient(_keyId, _keySecret);
+            Subscription subscription = client.Subscription.Create(new Dictionary<string, object>
+            {
+                { "plan_id", _planId },
+                { "total_count", 12 },
+                { "customer_notify", 1 },
+            });
+
+            return Ok(new { success = true, subscriptionId = subscription["id"].ToString(), keyId = _keyId });
+        }
+        catch (Exception)
+        {
+            return StatusCode(500, new { success = false, error = "Failed to create subscription" });
+        }
+    }
 
-func VerifyPayment(c echo.Context) error {
-	var req VerifyRequest
-	if err := c.Bind(&req); err != nil {
-		return c.JSON(http.StatusBadRequest, map[string]interface{}{"success": false, "error": err.Error()})
-	}
+    [HttpPost("verify")]
+    public IActionResult VerifySubscription([FromBody] VerifyRequest request)
+    {
+        if (string.IsNullOrEmpty(request.PaymentId) ||
+            string.IsNullOrEmpty(request.SubscriptionId) ||
+            string.IsNullOrEmpty(request.Signature))
+        {
+            return BadRequest(new { success = false, error = "Missing payment details" });
+        }
 
-	msg := req.OrderID + "|" + req.PaymentID
-	h := hmac.New(sha256.New, []byte(os.Getenv("RAZORPAY_KEY_SECRET")))
-	h.Write([]byte(msg))
-	expected := hex.EncodeToString(h.Sum(nil))
+        // NOTE: for subscriptions the payload is payment_id|subscription_id
+        using var hmac = new HMACSHA256(Encoding.UTF8.GetBytes(_keySecret));
+        var hash = hmac.ComputeHash(Encoding.UTF8.GetBytes(request.PaymentId + "|" + request.SubscriptionId));
+        var expected = Convert.ToHexString(hash).ToLowerInvariant();
 
-	if hmac.Equal([]byte(expected), []byte(req.Signature)) {
-		return c.JSON(http.StatusOK, map[string]interface{}{"success": true, "paymentId": req.PaymentID, "orderId": req.OrderID})
-	}
-	return c.JSON(http.StatusBadRequest, map[string]interface{}{"success": false, "error": "Invalid signature"})
+        if (!CryptographicOperations.FixedTimeEquals(Encoding.UTF8.GetBytes(expected), Encoding.UTF8.GetBytes(request.Signature)))
+        {
+            return BadRequest(new { success = false, error = "Invalid subscription signature" });
+        }
+
+        // TODO: Mark the user's subscription as active in your database
+        return Ok(new { success = true, paymentId = request.PaymentId, subscriptionId = request.SubscriptionId });
+    }
 }
 `
 
 	return IntegrateCheckoutOutput{
-		Summary: "Complete Razorpay Standard Checkout integration for Echo + " + frontend.Framework,
+		Summary: "Razorpay Subscriptions integration for ASP.NET Core",
 		Files: []FileAction{
-			{Action: "create", Path: "handlers/razorpay.go", Code: handlerCode, Description: "Echo handlers for Razorpay"},
-			{Action: "create", Path: frontend.FileName, Code: frontend.Code, Description: frontend.Description},
-			{Action: "manual_edit", Path: "main.go", Description: "Add routes", Edits: []EditItem{
-				{Line: "In router setup", Add: "e.POST(\"/api/razorpay/order\", handlers.CreateOrder)", Why: "Order endpoint"},
-				{Line: "After order route", Add: "e.POST(\"/api/razorpay/verify\", handlers.VerifyPayment)", Why: "Verify endpoint"},
-			}},
-			getWirePaymentAction(),
+			{Action: "create", Path: "Controllers/RazorpaySubscriptionController.cs", Code: controllerCode, Description: "Subscription creation and verification controller"},
+		},
+		Dependencies: []Dependency{
+			{Name: "Razorpay", InstallCommand: "dotnet add package Razorpay"},
 		},
-		Dependencies:     []Dependency{{Name: "razorpay-go", InstallCommand: "go get github.com/razorpay/razorpay-go"}},
-		EnvVars:          []EnvVar{{Name: "RAZORPAY_KEY_ID", Value: keyID}, {Name: "RAZORPAY_KEY_SECRET", Value: keySecret}},
-		TestInstructions: "Use test card: 4111 1111 1111 1111",
 		AIInstructions: `BACKEND SETUP:
-1) go get github.com/razorpay/razorpay-go
-2) Create handlers/razorpay.go with the Razorpay handlers
-3) Add routes in main.go to wire up the handlers
-4) Set RAZORPAY_KEY_ID and RAZORPAY_KEY_SECRET env vars` + getFrontendWiringInstructions(frontend),
+1) dotnet add package Razorpay
+2) Create Controllers/RazorpaySubscriptionController.cs (change the namespace to match the project)
+3) Call CreatePlan once (or use the Dashboard) and set Razorpay:PlanId / RAZORPAY_PLAN_ID`,
+		Webhooks:            subscriptionWebhooks(),
+		WebhookDashboardURL: webhookDashboardURL,
 	}
 }
 
-func getFiberIntegration(creds Credentials, frontend FrontendIntegration) IntegrateCheckoutOutput {
-	keyID, keySecret := getKeysOrPlaceholders(creds)
+// getVanillaSubscriptionFrontend returns a framework-agnostic helper that
+// opens Checkout for a subscription
+func getVanillaSubscriptionFrontend() string {
+	return `// Razorpay Subscription Integration
+async function initiateRazorpaySubscription(onSuccess, onError) {
+  try {
+    if (!window.Razorpay) {
+      await new Promise((resolve, reject) => {
+        const script = document.createElement('script');
+        script.src = 'https://checkout.razorpay.com/v1/checkout.js';
+        script.onload = resolve;
+        script.onerror = reject;
+        document.head.appendChild(script);
+      });
+    }
 
-	handlerCode := `package handlers
+    const response = await fetch('/api/razorpay/subscription', {
+      method: 'POST',
+      headers: { 'Content-Type': 'application/json' },
+      body: JSON.stringify({}),
+    });
+    const data = await response.json();
+    if (!data.success) throw new Error(data.error || 'Failed to create subscription');
+
+    const razorpay = new window.Razorpay({
+      key: data.keyId,
+      subscription_id: data.subscriptionId, // NOT order_id
+      name: document.title || 'Subscription',
+      handler: async function(checkoutResponse) {
+        const verifyResponse = await fetch('/api/razorpay/subscription/verify', {
+          method: 'POST',
+          headers: { 'Content-Type': 'application/json' },
+          body: JSON.stringify(checkoutResponse),
+        });
+        const verifyData = await verifyResponse.json();
+        if (verifyData.success) { if (onSuccess) onSuccess(verifyData); }
+        else { if (onError) onError(new Error(verifyData.error)); }
+      },
+      modal: { ondismiss: () => { if (onError) onError(new Error('Subscription cancelled')); } },
+      theme: { color: '#528FF0' },
+    });
+    razorpay.on('payment.failed', (r) => { if (onError) onError(new Error(r.error.description)); });
+    razorpay.open();
+  } catch (error) {
+    console.error('Subscription failed:', error);
+    if (onError) onError(error);
+  }
+}
+`
+}
+
+// getSubscriptionInstructions returns the steps shared by every
+// subscription integration
+func getSubscriptionInstructions() string {
+	return `
+
+**SUBSCRIPTION REQUIREMENTS (DO NOT SKIP):**
+- Create the plan ONCE (helper in the generated code, or Dashboard -> Subscriptions -> Plans)
+  and put its id in RAZORPAY_PLAN_ID. Do NOT create a plan per checkout.
+- Checkout must be opened with subscription_id, NOT order_id and NOT amount.
+- The signature payload is razorpay_payment_id|razorpay_subscription_id (the reverse
+  order of one-time payments) - do not reuse the order verify endpoint.
+- Wire the subscribe button to initiateRazorpaySubscription(onSuccess, onError)
+  (or the RazorpaySubscribe component for Next.js).
+- Recurring charges happen without the customer present; use the subscription.charged
+  and subscription.halted webhooks (integrate_razorpay_webhook) to keep access in sync.`
+}
+
+// getGitignoreAction returns the .gitignore lines that keep the env files
+// holding the Razorpay keys out of version control. .env.example only has
+// placeholders and stays tracked
+func getGitignoreAction() FileAction {
+	return FileAction{
+		Action:      "append",
+		Path:        ".gitignore",
+		Description: "Ignore the env files that hold the Razorpay keys (create .gitignore or append the missing lines)",
+		Code: `# Razorpay keys - never commit these
+.env
+.env.local
+.env.*
+!.env.example
+`,
+	}
+}
+
+// getEnvCheckScript returns a dependency-free pre-flight script, in the
+// backend's language, that checks the Razorpay keys in the env file
+func getEnvCheckScript(backendFramework string) (FileAction, string) {
+	envFile := ".env"
+	if backendFramework == "nextjs" {
+		envFile = ".env.local"
+	}
+
+	switch backendFramework {
+	case "express", "nextjs", "nuxt", "remix", "astro", "adonis", "bun":
+		runner := "node"
+		if backendFramework == "bun" {
+			runner = "bun"
+		}
+		return FileAction{
+			Action:      "create",
+			Path:        "scripts/check-env.cjs",
+			Description: "Pre-flight check for the Razorpay keys in " + envFile,
+			Code: `// Pre-flight check for Razorpay keys: ` + runner + ` scripts/check-env.cjs
+const fs = require('fs');
+
+const ENV_FILE = '` + envFile + `';
+
+function readEnvFile(path) {
+  const values = {};
+  if (!fs.existsSync(path)) return values;
+  for (const line of fs.readFileSync(path, 'utf8').split('\n')) {
+    const match = line.match(/^\s*([A-Za-z_][A-Za-z0-9_]*)\s*=\s*(.*)\s*$/);
+    if (match) values[match[1]] = match[2].replace(/^['"]|['"]$/g, '');
+  }
+  return values;
+}
+
+const env = { ...process.env, ...readEnvFile(ENV_FILE) };
+const keyId = env.RAZORPAY_KEY_ID || '';
+const keySecret = env.RAZORPAY_KEY_SECRET || '';
+const problems = [];
+
+if (!keyId) problems.push('RAZORPAY_KEY_ID is not set');
+else if (!keyId.startsWith('rzp_')) problems.push('RAZORPAY_KEY_ID must start with rzp_');
+else if (keyId.includes('YOUR_')) problems.push('RAZORPAY_KEY_ID is still a placeholder');
+
+if (!keySecret) problems.push('RAZORPAY_KEY_SECRET is not set');
+else if (keySecret.includes('YOUR_')) problems.push('RAZORPAY_KEY_SECRET is still a placeholder');
+
+if (problems.length > 0) {
+  console.error('FAIL: ' + ENV_FILE);
+  problems.forEach((p) => console.error('  - ' + p));
+  console.error('Get your keys from https://dashboard.razorpay.com/app/website-app-settings/api-keys');
+  process.exit(1);
+}
+
+console.log('PASS: Razorpay keys look valid (' + (keyId.startsWith('rzp_live_') ? 'live' : 'test') + ' mode)');
+`,
+		}, runner + " scripts/check-env.cjs"
+	case "django", "flask", "fastapi":
+		return FileAction{
+			Action:      "create",
+			Path:        "scripts/check_env.py",
+			Description: "Pre-flight check for the Razorpay keys in " + envFile,
+			Code: `"""Pre-flight check for Razorpay keys: python scripts/check_env.py"""
+import os
+import sys
+
+ENV_FILE = '` + envFile + `'
+
+
+def read_env_file(path):
+    values = {}
+    if not os.path.exists(path):
+        return values
+    with open(path) as f:
+        for line in f:
+            line = line.strip()
+            if not line or line.startswith('#') or '=' not in line:
+                continue
+            key, value = line.split('=', 1)
+            values[key.strip()] = value.strip().strip('\'"')
+    return values
+
+
+def main():
+    env = {**os.environ, **read_env_file(ENV_FILE)}
+    key_id = env.get('RAZORPAY_KEY_ID', '')
+    key_secret = env.get('RAZORPAY_KEY_SECRET', '')
+    problems = []
+
+    if not key_id:
+        problems.append('RAZORPAY_KEY_ID is not set')
+    elif not key_id.startswith('rzp_'):
+        problems.append('RAZORPAY_KEY_ID must start with rzp_')
+    elif 'YOUR_' in key_id:
+        problems.append('RAZORPAY_KEY_ID is still a placeholder')
+
+    if not key_secret:
+        problems.append('RAZORPAY_KEY_SECRET is not set')
+    elif 'YOUR_' in key_secret:
+        problems.append('RAZORPAY_KEY_SECRET is still a placeholder')
+
+    if problems:
+        print(f'FAIL: {ENV_FILE}', file=sys.stderr)
+        for problem in problems:
+            print(f'  - {problem}', file=sys.stderr)
+        print('Get your keys from https://dashboard.razorpay.com/app/website-app-settings/api-keys', file=sys.stderr)
+        sys.exit(1)
+
+    mode = 'live' if key_id.startswith('rzp_live_') else 'test'
+    print(f'PASS: Razorpay keys look valid ({mode} mode)')
+
+
+if __name__ == '__main__':
+    main()
+`,
+		}, "python scripts/check_env.py"
+	case "gin", "echo", "fiber", "chi":
+		return FileAction{
+			Action:      "create",
+			Path:        "scripts/checkenv/main.go",
+			Description: "Pre-flight check for the Razorpay keys in " + envFile,
+			Code: `// Command checkenv is a pre-flight check for Razorpay keys:
+// go run ./scripts/checkenv
+package main
 
 import (
-	"crypto/hmac"
-	"crypto/sha256"
-	"encoding/hex"
+	"bufio"
 	"fmt"
 	"os"
-	"time"
-
-	"github.com/gofiber/fiber/v2"
-	razorpay "github.com/razorpay/razorpay-go"
+	"strings"
 )
 
-var client = razorpay.NewClient(os.Getenv("RAZORPAY_KEY_ID"), os.Getenv("RAZORPAY_KEY_SECRET"))
+const envFile = "` + envFile + `"
 
-type OrderRequest struct {
-	Amount   float64 ` + "`json:\"amount\"`" + `
-	Currency string  ` + "`json:\"currency\"`" + `
-	Receipt  string  ` + "`json:\"receipt\"`" + `
+func readEnvFile(path string) map[string]string {
+	values := map[string]string{}
+	f, err := os.Open(path)
+	if err != nil {
+		return values
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		key, value, ok := strings.Cut(line, "=")
+		if !ok {
+			continue
+		}
+		values[strings.TrimSpace(key)] = strings.Trim(strings.TrimSpace(value), "'\"")
+	}
+	return values
 }
 
-type VerifyRequest struct {
-	OrderID   string ` + "`json:\"razorpay_order_id\"`" + `
-	PaymentID string ` + "`json:\"razorpay_payment_id\"`" + `
-	Signature string ` + "`json:\"razorpay_signature\"`" + `
+func lookup(fileValues map[string]string, key string) string {
+	if value, ok := fileValues[key]; ok {
+		return value
+	}
+	return os.Getenv(key)
 }
 
-func CreateOrder(c *fiber.Ctx) error {
-	var req OrderRequest
-	if err := c.BodyParser(&req); err != nil {
-		return c.Status(400).JSON(fiber.Map{"success": false, "error": err.Error()})
+func main() {
+	fileValues := readEnvFile(envFile)
+	keyID := lookup(fileValues, "RAZORPAY_KEY_ID")
+	keySecret := lookup(fileValues, "RAZORPAY_KEY_SECRET")
+	var problems []string
+
+	switch {
+	case keyID == "":
+		problems = append(problems, "RAZORPAY_KEY_ID is not set")
+	case !strings.HasPrefix(keyID, "rzp_"):
+		problems = append(problems, "RAZORPAY_KEY_ID must start with rzp_")
+	case strings.Contains(keyID, "YOUR_"):
+		problems = append(problems, "RAZORPAY_KEY_ID is still a placeholder")
 	}
-	if req.Amount <= 0 {
-		return c.Status(400).JSON(fiber.Map{"success": false, "error": "Invalid amount"})
+
+	switch {
+	case keySecret == "":
+		problems = append(problems, "RAZORPAY_KEY_SECRET is not set")
+	case strings.Contains(keySecret, "YOUR_"):
+		problems = append(problems, "RAZORPAY_KEY_SECRET is still a placeholder")
 	}
-	if req.Currency == "" { req.Currency = "INR" }
-	if req.Receipt == "" { req.Receipt = fmt.Sprintf("receipt_%d", time.Now().Unix()) }
 
-	data := map[string]interface{}{"amount": int(req.Amount * 100), "currency": req.Currency, "receipt": req.Receipt}
-	order, err := client.Order.Create(data, nil)
-	if err != nil {
-		return c.Status(500).JSON(fiber.Map{"success": false, "error": err.Error()})
+	if len(problems) > 0 {
+		fmt.Fprintf(os.Stderr, "FAIL: %s\n", envFile)
+		for _, problem := range problems {
+			fmt.Fprintf(os.Stderr, "  - %s\n", problem)
+		}
+		fmt.Fprintln(os.Stderr, "Get your keys from https://dashboard.razorpay.com/app/website-app-settings/api-keys")
+		os.Exit(1)
 	}
 
-	return c.JSON(fiber.Map{
-		"success": true, "orderId": order["id"], "amount": order["amount"],
-		"currency": order["currency"], "keyId": os.Getenv("RAZORPAY_KEY_ID"),
-	})
+	mode := "test"
+	if strings.HasPrefix(keyID, "rzp_live_") {
+		mode = "live"
+	}
+	fmt.Printf("PASS: Razorpay keys look valid (%s mode)\n", mode)
+}
+`,
+		}, "go run ./scripts/checkenv"
+	default: // rails, spring, aspnet
+		return FileAction{
+			Action:      "create",
+			Path:        "scripts/check-env.sh",
+			Description: "Pre-flight check for the Razorpay keys in " + envFile,
+			Code: `#!/bin/sh
+# Pre-flight check for Razorpay keys: sh scripts/check-env.sh
+ENV_FILE="` + envFile + `"
+
+read_var() {
+  value=""
+  if [ -f "$ENV_FILE" ]; then
+    value=$(grep -E "^[[:space:]]*$1[[:space:]]*=" "$ENV_FILE" | tail -n 1 | cut -d= -f2- | sed -e "s/^[[:space:]'\"]*//" -e "s/[[:space:]'\"]*$//")
+  fi
+  if [ -z "$value" ]; then
+    value=$(printenv "$1")
+  fi
+  printf '%s' "$value"
 }
 
-func VerifyPayment(c *fiber.Ctx) error {
-	var req VerifyRequest
-	if err := c.BodyParser(&req); err != nil {
-		return c.Status(400).JSON(fiber.Map{"success": false, "error": err.Error()})
+KEY_ID=$(read_var RAZORPAY_KEY_ID)
+KEY_SECRET=$(read_var RAZORPAY_KEY_SECRET)
+PROBLEMS=""
+
+if [ -z "$KEY_ID" ]; then
+  PROBLEMS="$PROBLEMS\n  - RAZORPAY_KEY_ID is not set"
+else
+  case "$KEY_ID" in
+    rzp_*YOUR_*) PROBLEMS="$PROBLEMS\n  - RAZORPAY_KEY_ID is still a placeholder" ;;
+    rzp_*) ;;
+    *) PROBLEMS="$PROBLEMS\n  - RAZORPAY_KEY_ID must start with rzp_" ;;
+  esac
+fi
+
+if [ -z "$KEY_SECRET" ]; then
+  PROBLEMS="$PROBLEMS\n  - RAZORPAY_KEY_SECRET is not set"
+else
+  case "$KEY_SECRET" in
+    *YOUR_*) PROBLEMS="$PROBLEMS\n  - RAZORPAY_KEY_SECRET is still a placeholder" ;;
+  esac
+fi
+
+if [ -n "$PROBLEMS" ]; then
+  printf 'FAIL: %s%b\n' "$ENV_FILE" "$PROBLEMS" >&2
+  echo "Get your keys from https://dashboard.razorpay.com/app/website-app-settings/api-keys" >&2
+  exit 1
+fi
+
+case "$KEY_ID" in
+  rzp_live_*) echo "PASS: Razorpay keys look valid (live mode)" ;;
+  *) echo "PASS: Razorpay keys look valid (test mode)" ;;
+esac
+`,
+		}, "sh scripts/check-env.sh"
 	}
+}
 
-	msg := req.OrderID + "|" + req.PaymentID
-	h := hmac.New(sha256.New, []byte(os.Getenv("RAZORPAY_KEY_SECRET")))
-	h.Write([]byte(msg))
-	expected := hex.EncodeToString(h.Sum(nil))
+// wirePaymentSectionStart matches the first line of each section of the
+// wire_payment discovery text: a numbered step or an all-caps heading
+var wirePaymentSectionStart = regexp.MustCompile(`(?m)^(\d+\. [A-Z]|[A-Z][A-Z ,'/-]+:$)`)
+
+// applyIntegrationSteps fills output.Steps from the files, dependencies and
+// env vars of the output, followed by the wire_payment discovery sections and
+// one note per paragraph of the free-text instructions. AIInstructions is then
+// rendered from the steps, so both fields always describe the same process.
+func applyIntegrationSteps(output *IntegrateCheckoutOutput) {
+	var steps []IntegrationStep
+	add := func(action, target, detail string) {
+		steps = append(steps, IntegrationStep{
+			Order:  len(steps) + 1,
+			Action: action,
+			Target: target,
+			Detail: strings.TrimSpace(detail),
+		})
+	}
 
-	if hmac.Equal([]byte(expected), []byte(req.Signature)) {
-		return c.JSON(fiber.Map{"success": true, "paymentId": req.PaymentID, "orderId": req.OrderID})
+	for _, d := range output.Dependencies {
+		add("install", d.Name, d.InstallCommand)
+	}
+	for _, e := range output.EnvVars {
+		add("set_env", e.Name, "Add "+e.Name+"="+e.Value+" to the env file")
 	}
-	return c.Status(400).JSON(fiber.Map{"success": false, "error": "Invalid signature"})
-}
-`
 
-	return IntegrateCheckoutOutput{
-		Summary: "Complete Razorpay Standard Checkout integration for Fiber + " + frontend.Framework,
-		Files: []FileAction{
-			{Action: "create", Path: "handlers/razorpay.go", Code: handlerCode, Description: "Fiber handlers for Razorpay"},
-			{Action: "create", Path: frontend.FileName, Code: frontend.Code, Description: frontend.Description},
-			{Action: "manual_edit", Path: "main.go", Description: "Add routes", Edits: []EditItem{
-				{Line: "In router setup", Add: "app.Post(\"/api/razorpay/order\", handlers.CreateOrder)", Why: "Order endpoint"},
-				{Line: "After order route", Add: "app.Post(\"/api/razorpay/verify\", handlers.VerifyPayment)", Why: "Verify endpoint"},
-			}},
-			getWirePaymentAction(),
-		},
-		Dependencies:     []Dependency{{Name: "razorpay-go", InstallCommand: "go get github.com/razorpay/razorpay-go"}},
-		EnvVars:          []EnvVar{{Name: "RAZORPAY_KEY_ID", Value: keyID}, {Name: "RAZORPAY_KEY_SECRET", Value: keySecret}},
-		TestInstructions: "Use test card: 4111 1111 1111 1111",
-		AIInstructions: `BACKEND SETUP:
-1) go get github.com/razorpay/razorpay-go
-2) Create handlers/razorpay.go with the Razorpay handlers
-3) Add routes in main.go to wire up the handlers
-4) Set RAZORPAY_KEY_ID and RAZORPAY_KEY_SECRET env vars` + getFrontendWiringInstructions(frontend),
+	var discovery []string
+	for _, f := range output.Files {
+		switch f.Action {
+		case "create":
+			add("create_file", f.Path, f.Description)
+		case "append":
+			add("edit_file", f.Path, f.Description+":\n"+f.Code)
+		case "wire_payment":
+			if f.Code == "" {
+				add("wire_payment", f.Path, f.Description)
+			}
+			discovery = append(discovery, f.Code)
+		default: // manual_edit, insert_code
+			if len(f.Edits) == 0 {
+				add("edit_file", f.Path, f.Description)
+			}
+			for _, e := range f.Edits {
+				add("edit_file", f.Path, e.Line+": add "+e.Add+" ("+e.Why+")")
+			}
+		}
+	}
+
+	for _, code := range discovery {
+		starts := wirePaymentSectionStart.FindAllStringIndex(code, -1)
+		for i, start := range starts {
+			end := len(code)
+			if i+1 < len(starts) {
+				end = starts[i+1][0]
+			}
+			section := strings.TrimSpace(code[start[0]:end])
+			// Headings with nothing under them introduce the list
+			if !strings.Contains(section, "\n") {
+				continue
+			}
+			add("wire_payment", "DISCOVER", section)
+		}
+	}
+
+	for _, paragraph := range strings.Split(output.AIInstructions, "\n\n") {
+		if strings.TrimSpace(paragraph) != "" {
+			add("note", "", paragraph)
+		}
 	}
+
+	output.Steps = steps
+	output.AIInstructions = renderIntegrationSteps(
+		"Apply ALL of these steps in order (the same steps are in the steps field):",
+		steps)
+}
+
+// applyDryRun reduces the output to a plan: the files keep their path, action
+// and description but lose their code and edits, so the steps only name what
+// would change
+func applyDryRun(output *IntegrateCheckoutOutput) {
+	for i, f := range output.Files {
+		output.Files[i] = FileAction{
+			Action:      f.Action,
+			Path:        f.Path,
+			Description: f.Description,
+		}
+	}
+	output.AIInstructions = ""
+	applyIntegrationSteps(output)
+
+	output.Summary = "Dry run: " + output.Summary
+	output.AIInstructions = renderIntegrationSteps(
+		"DRY RUN - nothing has been generated to apply. Show this plan to the user and, once "+
+			"they approve it, call integrate_razorpay_checkout again with the same arguments "+
+			"and dryRun=false to get the code. Planned steps:",
+		output.Steps)
+}
+
+// renderIntegrationSteps formats the steps as a numbered list for agents that
+// only read AIInstructions
+func renderIntegrationSteps(header string, steps []IntegrationStep) string {
+	var b strings.Builder
+	b.WriteString(header)
+	for _, s := range steps {
+		b.WriteString(fmt.Sprintf("\n\n%d. [%s]", s.Order, s.Action))
+		if s.Target != "" {
+			b.WriteString(" " + s.Target + ":")
+		}
+		if strings.Contains(s.Detail, "\n") {
+			b.WriteString("\n" + s.Detail)
+		} else {
+			b.WriteString(" " + s.Detail)
+		}
+	}
+	return b.String()
 }
 
 // Helper to get keys or placeholders
@@ -1810,7 +7791,814 @@ DO NOT give "Next Steps" - complete EVERYTHING including frontend wiring.`
 // DETECT STACK HELPER
 // =============================================================================
 
+// frameworkMatch maps an npm package to the framework it indicates
+type frameworkMatch struct {
+	pkg       string
+	framework string
+}
+
+// nodeFrameworks is checked in order, so meta-frameworks that are often
+// installed alongside a plain server (e.g. next with a custom express
+// server) must come first
+var nodeFrameworks = []frameworkMatch{
+	{pkg: "next", framework: "nextjs"},
+	{pkg: "nuxt", framework: "nuxt"},
+	{pkg: "@remix-run/react", framework: "remix"},
+	{pkg: "@remix-run/node", framework: "remix"},
+	{pkg: "astro", framework: "astro"},
+	{pkg: "@nestjs/core", framework: "nestjs"},
+	{pkg: "@adonisjs/core", framework: "adonis"},
+	{pkg: "express", framework: "express"},
+	{pkg: "fastify", framework: "fastify"},
+	{pkg: "koa", framework: "koa"},
+	{pkg: "hono", framework: "hono"},
+}
+
+// frontendFrameworks is checked in order; react-native and expo come before
+// react since React Native apps also depend on react
+var frontendFrameworks = []frameworkMatch{
+	{pkg: "react-native", framework: "react-native"},
+	{pkg: "expo", framework: "react-native"},
+	{pkg: "react", framework: "react"},
+	{pkg: "vue", framework: "vue"},
+	{pkg: "@angular/core", framework: "angular"},
+	{pkg: "svelte", framework: "svelte"},
+	{pkg: "solid-js", framework: "solid"},
+}
+
+// checkoutBranding holds the values shown in the checkout modal. Empty fields
+// keep the generator's defaults
+type checkoutBranding struct {
+	Name       string
+	Image      string
+	ThemeColor string
+}
+
+var (
+	checkoutDefaultNameLine = regexp.MustCompile(
+		`(?m)^[ \t]*name: (document\.title \|\| )?'Payment',\n`)
+	checkoutDefaultThemeLine = regexp.MustCompile(
+		`(?m)^[ \t]*theme: \{ color: '#528FF0' \},\n`)
+)
+
+// applyCheckoutBranding replaces the hardcoded name and theme color in the
+// generated checkout options with the account's branding
+func applyCheckoutBranding(
+	output *IntegrateCheckoutOutput,
+	branding checkoutBranding,
+) {
+	if branding == (checkoutBranding{}) {
+		return
+	}
+
+	for i := range output.Files {
+		code := output.Files[i].Code
+		if !checkoutOrderIDLine.MatchString(code) {
+			continue
+		}
+
+		var lines strings.Builder
+		if branding.Name != "" {
+			code = checkoutDefaultNameLine.ReplaceAllString(code, "")
+			lines.WriteString("${1}name: " + jsString(branding.Name) + ",\n")
+		}
+		if branding.Image != "" {
+			lines.WriteString("${1}image: " + jsString(branding.Image) + ",\n")
+		}
+		if branding.ThemeColor != "" {
+			code = checkoutDefaultThemeLine.ReplaceAllString(code, "")
+			lines.WriteString("${1}theme: { color: " +
+				jsString(branding.ThemeColor) + " },\n")
+		}
+
+		code = checkoutOrderIDLine.ReplaceAllString(code,
+			"${0}"+lines.String())
+		output.Files[i].Code = code
+	}
+}
+
+// jsString quotes s as a single-quoted JavaScript string literal, escaped
+// for use in a regexp replacement template
+func jsString(s string) string {
+	s = strings.NewReplacer(`\`, `\\`, `'`, `\'`, "\n", `\n`).Replace(s)
+	return "'" + strings.ReplaceAll(s, "$", "$$") + "'"
+}
+
+// defaultCheckoutRetryMaxCount is the number of retries Razorpay recommends
+// for the checkout retry option
+const defaultCheckoutRetryMaxCount = 4
+
+var (
+	checkoutOrderIDLine = regexp.MustCompile(
+		`(?m)^([ \t]*)order_id: \w+\.orderId,\n`)
+	checkoutInstanceLine = regexp.MustCompile(
+		`(?m)^([ \t]*)const razorpay = new .*Razorpay\(options\);\n`)
+	checkoutFailedHandler = regexp.MustCompile(
+		`razorpay\.on\('payment\.failed', \(\w+(: any)?\) => \{(\n[ \t]*)?`)
+)
+
+// applyCheckoutRetry enables in-modal retries in the generated frontend code.
+// Each failed attempt fires payment.failed while the modal stays open, so the
+// failure handlers are guarded to only report once the retries are used up.
+func applyCheckoutRetry(output *IntegrateCheckoutOutput, maxCount int) {
+	for i := range output.Files {
+		code := output.Files[i].Code
+		if !checkoutOrderIDLine.MatchString(code) {
+			continue
+		}
+
+		code = checkoutOrderIDLine.ReplaceAllString(code, fmt.Sprintf(
+			"${0}${1}retry: { enabled: true, max_count: %d },\n", maxCount))
+
+		if checkoutFailedHandler.MatchString(code) {
+			code = checkoutInstanceLine.ReplaceAllString(code,
+				"${1}let failedAttempts = 0;\n${0}")
+			code = checkoutFailedHandler.ReplaceAllStringFunc(code,
+				func(m string) string {
+					guard := fmt.Sprintf(
+						"if (++failedAttempts <= %d) return;", maxCount)
+					sub := checkoutFailedHandler.FindStringSubmatch(m)
+					if sub[2] == "" {
+						return m + " " + guard
+					}
+					return m + guard + sub[2]
+				})
+		}
+
+		output.Files[i].Code = code
+	}
+
+	output.Summary += fmt.Sprintf(
+		" Failed payments can be retried up to %d times in the same checkout.",
+		maxCount)
+	output.AIInstructions += fmt.Sprintf(`
+
+PAYMENT RETRY: The checkout options include retry: { enabled: true, max_count: %d }.
+Every attempt creates a NEW payment id on the SAME order, so:
+- Verify the signature with razorpay_order_id and the razorpay_payment_id returned
+  to the success handler - never with a payment id saved from an earlier attempt
+- Mark the order paid idempotently, keyed by order_id, so a late webhook for an
+  earlier attempt cannot double-fulfil it
+- Do NOT mark the order failed on a payment.failed webhook - the customer may still
+  succeed on a retry. Only treat it as failed once the order expires unpaid
+- Keep the "if (++failedAttempts <= %d) return;" guard in the payment.failed handler
+  so intermediate failures are not shown to the customer as final`, maxCount, maxCount)
+}
+
+// orderRateLimitHints name a rate limiter for the backends whose order route
+// is not rewritten by applyOrderRateLimit
+var orderRateLimitHints = map[string]string{
+	"nextjs":  "an in-memory limiter keyed by the x-forwarded-for header in the route handler, or @upstash/ratelimit on serverless hosts",
+	"nuxt":    "an in-memory limiter keyed by getRequestIP(event) in the route, or nuxt-security's rateLimiter",
+	"remix":   "an in-memory limiter keyed by the x-forwarded-for header in the action",
+	"astro":   "an in-memory limiter keyed by clientAddress in the API route",
+	"adonis":  "@adonisjs/limiter with a throttle middleware on the route",
+	"bun":     "an in-memory limiter keyed by server.requestIP(req) in the fetch handler",
+	"django":  "django-ratelimit's @ratelimit(key='ip', rate='10/m', block=True) on the view",
+	"rails":   "Rack::Attack with throttle('razorpay/order', limit: 10, period: 1.minute)",
+	"spring":  "a Bucket4j bucket per client IP in a filter on /api/razorpay/order",
+	"aspnet":  "the built-in rate limiter (AddRateLimiter with a fixed window policy) and RequireRateLimiting on the endpoint",
+	"flutter": "the rate limiter of the paired backend",
+}
+
+// publicKeyEnv is the public env var, and the client-side expression reading
+// it, for the frontends whose bundler exposes env vars at build time
+var publicKeyEnv = map[string]struct{ Name, Expr string }{
+	"nextjs": {"NEXT_PUBLIC_RAZORPAY_KEY_ID", "process.env.NEXT_PUBLIC_RAZORPAY_KEY_ID"},
+	"astro":  {"PUBLIC_RAZORPAY_KEY_ID", "import.meta.env.PUBLIC_RAZORPAY_KEY_ID"},
+	"react":  {"VITE_RAZORPAY_KEY_ID", "import.meta.env.VITE_RAZORPAY_KEY_ID"},
+	"vue":    {"VITE_RAZORPAY_KEY_ID", "import.meta.env.VITE_RAZORPAY_KEY_ID"},
+	"svelte": {"VITE_RAZORPAY_KEY_ID", "import.meta.env.VITE_RAZORPAY_KEY_ID"},
+}
+
+var checkoutKeyLine = regexp.MustCompile(`(?m)^([ \t]*)key: \w+\.keyId,\n`)
+
+// applyPublicKey makes the checkout options read the key id from the
+// client's public env var and adds that env var. It returns the env var name,
+// or false when the client has no public env vars.
+func applyPublicKey(
+	output *IntegrateCheckoutOutput,
+	client string,
+	keyID string,
+) (string, bool) {
+	env, ok := publicKeyEnv[client]
+	if !ok {
+		return "", false
+	}
+
+	for i := range output.Files {
+		output.Files[i].Code = checkoutKeyLine.ReplaceAllString(
+			output.Files[i].Code, "${1}key: "+env.Expr+",\n")
+	}
+	output.EnvVars = append(output.EnvVars, EnvVar{Name: env.Name, Value: keyID})
+	return env.Name, true
+}
+
+// applyOrderRateLimit limits the generated order endpoint to 10 requests a
+// minute per client IP, using the usual limiter for the backend. It returns
+// false when the backend's order route is not rewritten.
+func applyOrderRateLimit(output *IntegrateCheckoutOutput, backendFramework string) bool {
+	var dependency Dependency
+	rewritten := false
+
+	for i := range output.Files {
+		file := &output.Files[i]
+		switch {
+		// Express is also the fallback for unknown backends
+		case strings.HasPrefix(file.Path, "routes/razorpay.") &&
+			strings.Contains(file.Code, "router.post('/order', async"):
+			file.Code = strings.Replace(file.Code, "const crypto = require('crypto');\n",
+				"const crypto = require('crypto');\nconst rateLimit = require('express-rate-limit');\n", 1)
+			file.Code = strings.Replace(file.Code, "import crypto from 'crypto';\n",
+				"import crypto from 'crypto';\nimport rateLimit from 'express-rate-limit';\n", 1)
+			file.Code = strings.Replace(file.Code, "// Create Razorpay Order\n", `// Every call creates a Razorpay order, so the endpoint is limited per client
+// IP. 10 a minute leaves room for a customer retrying a failed payment.
+const orderRateLimit = rateLimit({
+  windowMs: 60 * 1000,
+  limit: 10,
+  standardHeaders: 'draft-7',
+  legacyHeaders: false,
+  message: { success: false, error: 'Too many payment attempts, please try again in a minute' },
+});
+
+// Create Razorpay Order
+`, 1)
+			file.Code = strings.Replace(file.Code, "router.post('/order', async",
+				"router.post('/order', orderRateLimit, async", 1)
+			dependency = Dependency{Name: "express-rate-limit", InstallCommand: "npm install express-rate-limit"}
+			rewritten = true
+
+		case backendFramework == "fastapi" && file.Path == "routers/razorpay.py":
+			file.Code = strings.Replace(file.Code, "from fastapi import APIRouter, HTTPException\n",
+				"from fastapi import APIRouter, HTTPException, Request\nfrom slowapi import Limiter\nfrom slowapi.util import get_remote_address\n", 1)
+			file.Code = strings.Replace(file.Code, "router = APIRouter(prefix=\"/api/razorpay\")\n", `router = APIRouter(prefix="/api/razorpay")
+
+# Every call creates a Razorpay order, so the endpoint is limited per client
+# IP. 10 a minute leaves room for a customer retrying a failed payment.
+limiter = Limiter(key_func=get_remote_address)
+`, 1)
+			file.Code = strings.Replace(file.Code, "@router.post(\"/order\")\nasync def create_order(req: OrderRequest):",
+				"@router.post(\"/order\")\n@limiter.limit(\"10/minute\")\nasync def create_order(request: Request, req: OrderRequest):", 1)
+			dependency = Dependency{Name: "slowapi", InstallCommand: "pip install slowapi"}
+			rewritten = true
+
+		case backendFramework == "fastapi" && file.Path == "main.py" && file.Action == "manual_edit":
+			file.Edits = append(file.Edits,
+				EditItem{Line: "After imports", Add: "from slowapi import _rate_limit_exceeded_handler\nfrom slowapi.errors import RateLimitExceeded\nfrom routers.razorpay import limiter", Why: "Rate limiter for the order endpoint"},
+				EditItem{Line: "After app creation", Add: "app.state.limiter = limiter\napp.add_exception_handler(RateLimitExceeded, _rate_limit_exceeded_handler)", Why: "slowapi reads the limiter from app.state and answers 429 when it is exceeded"},
+			)
+
+		case backendFramework == "flask" && file.Action == "create" &&
+			strings.Contains(file.Code, "@app.route('/api/razorpay/order', methods=['POST'])\n"):
+			file.Code = strings.Replace(file.Code, "from flask import Flask, request, jsonify\n",
+				"from flask import Flask, request, jsonify\nfrom flask_limiter import Limiter\nfrom flask_limiter.util import get_remote_address\n", 1)
+			file.Code = strings.Replace(file.Code, "app = Flask(__name__)\n", `app = Flask(__name__)
+
+# Every call creates a Razorpay order, so the endpoint is limited per client
+# IP. 10 a minute leaves room for a customer retrying a failed payment.
+limiter = Limiter(get_remote_address, app=app, storage_uri='memory://')
+`, 1)
+			file.Code = strings.Replace(file.Code, "@app.route('/api/razorpay/order', methods=['POST'])\n",
+				"@app.route('/api/razorpay/order', methods=['POST'])\n@limiter.limit('10 per minute')\n", 1)
+			dependency = Dependency{Name: "Flask-Limiter", InstallCommand: "pip install Flask-Limiter"}
+			rewritten = true
+
+		case backendFramework == "chi" && file.Path == "handlers/razorpay.go":
+			file.Code = strings.Replace(file.Code, "\t\"github.com/go-chi/chi/v5\"\n",
+				"\t\"github.com/go-chi/chi/v5\"\n\t\"github.com/go-chi/httprate\"\n", 1)
+			file.Code = strings.Replace(file.Code, "\tr.Post(\"/api/razorpay/order\", CreateOrder)\n", `	// Every call creates a Razorpay order, so the endpoint is limited per
+	// client IP. 10 a minute leaves room for a customer retrying a payment.
+	r.With(httprate.Limit(10, time.Minute,
+		httprate.WithKeyFuncs(httprate.KeyByIP),
+		httprate.WithLimitHandler(func(w http.ResponseWriter, r *http.Request) {
+			writeJSON(w, http.StatusTooManyRequests, map[string]interface{}{
+				"success": false, "error": "Too many payment attempts, please try again in a minute",
+			})
+		}),
+	)).Post("/api/razorpay/order", CreateOrder)
+`, 1)
+			dependency = Dependency{Name: "httprate", InstallCommand: "go get github.com/go-chi/httprate"}
+			rewritten = true
+
+		case file.Path == "main.go" && file.Action == "manual_edit":
+			routes := map[string][2]string{
+				"gin":   {`r.POST("/api/razorpay/order", handlers.CreateOrder)`, `r.POST("/api/razorpay/order", handlers.OrderRateLimit(), handlers.CreateOrder)`},
+				"echo":  {`e.POST("/api/razorpay/order", handlers.CreateOrder)`, `e.POST("/api/razorpay/order", handlers.CreateOrder, handlers.OrderRateLimit())`},
+				"fiber": {`app.Post("/api/razorpay/order", handlers.CreateOrder)`, `app.Post("/api/razorpay/order", handlers.OrderRateLimit(), handlers.CreateOrder)`},
+			}
+			route, ok := routes[backendFramework]
+			if !ok {
+				continue
+			}
+			for j := range file.Edits {
+				if file.Edits[j].Add == route[0] {
+					file.Edits[j].Add = route[1]
+					file.Edits[j].Why = "Order endpoint, rate limited per client IP"
+					rewritten = true
+				}
+			}
+		}
+	}
+
+	if !rewritten {
+		return false
+	}
+
+	switch backendFramework {
+	case "gin":
+		output.Files = append(output.Files, FileAction{
+			Action:      "create",
+			Path:        "handlers/ratelimit.go",
+			Code:        getGinOrderRateLimitCode(),
+			Description: "Token-bucket rate limiter for the order endpoint",
+		})
+		dependency = Dependency{Name: "x/time", InstallCommand: "go get golang.org/x/time/rate"}
+	case "echo":
+		output.Files = append(output.Files, FileAction{
+			Action: "create",
+			Path:   "handlers/ratelimit.go",
+			Code: `package handlers
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/labstack/echo/v4/middleware"
+	"golang.org/x/time/rate"
+)
+
+// OrderRateLimit limits order creation per client IP. Every call creates a
+// Razorpay order; 10 a minute leaves room for a customer retrying a payment.
+func OrderRateLimit() echo.MiddlewareFunc {
+	return middleware.RateLimiterWithConfig(middleware.RateLimiterConfig{
+		Store: middleware.NewRateLimiterMemoryStoreWithConfig(middleware.RateLimiterMemoryStoreConfig{
+			Rate:      rate.Every(time.Minute / 10),
+			Burst:     10,
+			ExpiresIn: 3 * time.Minute,
+		}),
+		IdentifierExtractor: func(c echo.Context) (string, error) {
+			return c.RealIP(), nil
+		},
+		DenyHandler: func(c echo.Context, _ string, _ error) error {
+			return c.JSON(http.StatusTooManyRequests, map[string]interface{}{
+				"success": false, "error": "Too many payment attempts, please try again in a minute",
+			})
+		},
+	})
+}
+`,
+			Description: "Echo rate limiter for the order endpoint",
+		})
+		dependency = Dependency{Name: "x/time", InstallCommand: "go get golang.org/x/time/rate"}
+	case "fiber":
+		output.Files = append(output.Files, FileAction{
+			Action: "create",
+			Path:   "handlers/ratelimit.go",
+			Code: `package handlers
+
+import (
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/middleware/limiter"
+)
+
+// OrderRateLimit limits order creation per client IP. Every call creates a
+// Razorpay order; 10 a minute leaves room for a customer retrying a payment.
+func OrderRateLimit() fiber.Handler {
+	return limiter.New(limiter.Config{
+		Max:        10,
+		Expiration: time.Minute,
+		KeyGenerator: func(c *fiber.Ctx) string {
+			return c.IP()
+		},
+		LimitReached: func(c *fiber.Ctx) error {
+			return c.Status(fiber.StatusTooManyRequests).JSON(fiber.Map{
+				"success": false, "error": "Too many payment attempts, please try again in a minute",
+			})
+		},
+	})
+}
+`,
+			Description: "Fiber rate limiter for the order endpoint",
+		})
+	}
+	if dependency.Name != "" {
+		output.Dependencies = append(output.Dependencies, dependency)
+	}
+
+	output.Summary += " The order endpoint is rate limited to 10 requests a minute per client IP."
+	output.AIInstructions += `
+
+RATE LIMIT: The order endpoint allows 10 requests a minute per client IP and answers
+HTTP 429 with { success: false, error } beyond that. Keep the limiter on the order route
+only - the verify route must stay reachable for payments that are already made. Behind
+a proxy or load balancer, make the framework trust the forwarded client IP (e.g.
+app.set('trust proxy', 1) in Express), otherwise every customer shares one limit. The
+limits are kept in memory per process; use a shared store (e.g. Redis) when several
+instances serve the endpoint.`
+	return true
+}
+
+// getGinOrderRateLimitCode returns a per-IP token-bucket middleware for Gin
+func getGinOrderRateLimitCode() string {
+	return `package handlers
+
+import (
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"golang.org/x/time/rate"
+)
+
+// Every call to the order endpoint creates a Razorpay order, so each client
+// IP gets a token bucket of 10 that refills at 10 a minute, leaving room for
+// a customer retrying a failed payment.
+const (
+	orderRateLimitBurst = 10
+	orderRateLimitEvery = time.Minute / 10
+	orderRateLimitIdle  = 3 * time.Minute
+)
+
+type orderLimiter struct {
+	limiter  *rate.Limiter
+	lastSeen time.Time
+}
+
+// OrderRateLimit rejects order requests beyond the client's token bucket
+// with HTTP 429
+func OrderRateLimit() gin.HandlerFunc {
+	var (
+		mu        sync.Mutex
+		limiters  = map[string]*orderLimiter{}
+		lastSweep = time.Now()
+	)
+
+	return func(c *gin.Context) {
+		now := time.Now()
+		ip := c.ClientIP()
+
+		mu.Lock()
+		// Forget idle clients so the map does not grow without bound
+		if now.Sub(lastSweep) > orderRateLimitIdle {
+			for key, l := range limiters {
+				if now.Sub(l.lastSeen) > orderRateLimitIdle {
+					delete(limiters, key)
+				}
+			}
+			lastSweep = now
+		}
+		l, ok := limiters[ip]
+		if !ok {
+			l = &orderLimiter{limiter: rate.NewLimiter(rate.Every(orderRateLimitEvery), orderRateLimitBurst)}
+			limiters[ip] = l
+		}
+		l.lastSeen = now
+		allowed := l.limiter.Allow()
+		mu.Unlock()
+
+		if !allowed {
+			c.Header("Retry-After", "6")
+			c.AbortWithStatusJSON(http.StatusTooManyRequests, gin.H{
+				"success": false, "error": "Too many payment attempts, please try again in a minute",
+			})
+			return
+		}
+		c.Next()
+	}
+}
+`
+}
+
+// orderTelemetryHints name the OpenTelemetry integration for the backends
+// whose handlers are not rewritten by applyOrderTelemetry
+var orderTelemetryHints = map[string]string{
+	"nextjs":  "@vercel/otel registered in instrumentation.ts and tracer.startActiveSpan in the route handlers",
+	"nuxt":    "@opentelemetry/sdk-node started from a Nitro plugin and tracer.startActiveSpan in the routes",
+	"remix":   "@opentelemetry/sdk-node loaded with node --require and tracer.startActiveSpan in the action",
+	"astro":   "@opentelemetry/sdk-node loaded with node --require and tracer.startActiveSpan in the API routes",
+	"adonis":  "@adonisjs/otel or @opentelemetry/sdk-node and tracer.startActiveSpan in the controller",
+	"bun":     "@opentelemetry/sdk-node loaded with bun --preload and tracer.startActiveSpan in the fetch handler",
+	"django":  "opentelemetry-instrumentation-django (run under opentelemetry-instrument) and tracer.start_as_current_span in the views",
+	"gin":     "otelgin.Middleware on the router and trace.SpanFromContext(c.Request.Context()) in the handlers",
+	"echo":    "otelecho.Middleware on the router and trace.SpanFromContext(c.Request().Context()) in the handlers",
+	"fiber":   "otelfiber.Middleware on the app and trace.SpanFromContext(c.UserContext()) in the handlers",
+	"chi":     "otelhttp.NewHandler around the router and trace.SpanFromContext(r.Context()) in the handlers",
+	"rails":   "opentelemetry-sdk with opentelemetry-instrumentation-rails and a tracer.in_span block in the controller",
+	"spring":  "the OpenTelemetry Java agent or Micrometer Tracing and Span.current() in the controller",
+	"aspnet":  "OpenTelemetry.Extensions.Hosting with AddAspNetCoreInstrumentation and an ActivitySource in the endpoints",
+	"flutter": "the OpenTelemetry setup of the paired backend",
+}
+
+// applyOrderTelemetry wraps the generated order and verify handlers in
+// OpenTelemetry spans tagged with the order id and outcome. It returns false
+// when the backend's handlers are not rewritten.
+func applyOrderTelemetry(output *IntegrateCheckoutOutput, backendFramework string) bool {
+	var dependencies []Dependency
+	var setup FileAction
+	rewritten := false
+
+	for i := range output.Files {
+		file := &output.Files[i]
+		switch {
+		// Express is also the fallback for unknown backends
+		case strings.HasPrefix(file.Path, "routes/razorpay.") &&
+			strings.Contains(file.Code, "router.post('/order', "):
+			file.Code = strings.Replace(file.Code, "const crypto = require('crypto');\n",
+				"const crypto = require('crypto');\nconst { context, trace, SpanStatusCode } = require('@opentelemetry/api');\n", 1)
+			file.Code = strings.Replace(file.Code, "import crypto from 'crypto';\n",
+				"import crypto from 'crypto';\nimport { context, trace, SpanStatusCode } from '@opentelemetry/api';\n", 1)
+			file.Code = strings.Replace(file.Code, "// Create Razorpay Order\n", `const tracer = trace.getTracer('razorpay-checkout');
+
+// traceRazorpay runs a route in a span tagged with the Razorpay order id and
+// whether the request succeeded. Razorpay API calls made by the handler are
+// recorded as child spans.
+function traceRazorpay(name) {
+  return (req, res, next) => {
+    const span = tracer.startSpan(name);
+    const json = res.json.bind(res);
+    res.json = (body) => {
+      const orderId = (body && body.orderId) || (req.body && req.body.razorpay_order_id);
+      if (orderId) span.setAttribute('razorpay.order_id', orderId);
+      span.setAttribute('razorpay.outcome', body && body.success ? 'success' : 'failure');
+      return json(body);
+    };
+    res.on('finish', () => {
+      span.setAttribute('http.response.status_code', res.statusCode);
+      if (res.statusCode >= 500) span.setStatus({ code: SpanStatusCode.ERROR });
+      span.end();
+    });
+    context.with(trace.setSpan(context.active(), span), next);
+  };
+}
+
+// Create Razorpay Order
+`, 1)
+			if strings.HasSuffix(file.Path, ".ts") {
+				file.Code = strings.NewReplacer(
+					"import express, { Request, Response } from 'express';",
+					"import express, { NextFunction, Request, Response } from 'express';",
+					"function traceRazorpay(name) {\n  return (req, res, next) => {",
+					"function traceRazorpay(name: string) {\n  return (req: Request, res: Response, next: NextFunction) => {",
+					"res.json = (body) => {",
+					"res.json = (body: { success?: boolean; orderId?: string }) => {",
+				).Replace(file.Code)
+			}
+			file.Code = strings.Replace(file.Code, "router.post('/order', ",
+				"router.post('/order', traceRazorpay('razorpay.order.create'), ", 1)
+			file.Code = strings.Replace(file.Code, "router.post('/verify', ",
+				"router.post('/verify', traceRazorpay('razorpay.payment.verify'), ", 1)
+			setup = FileAction{
+				Action: "create",
+				Path:   "tracing.js",
+				Code: `// Starts the OpenTelemetry SDK. Load it before anything else so the HTTP
+// clients are instrumented: node --require ./tracing.js server.js
+const { NodeSDK } = require('@opentelemetry/sdk-node');
+const { OTLPTraceExporter } = require('@opentelemetry/exporter-trace-otlp-http');
+const { getNodeAutoInstrumentations } = require('@opentelemetry/auto-instrumentations-node');
+
+// The exporter reads OTEL_EXPORTER_OTLP_ENDPOINT and the service name comes
+// from OTEL_SERVICE_NAME
+const sdk = new NodeSDK({
+  traceExporter: new OTLPTraceExporter(),
+  instrumentations: [getNodeAutoInstrumentations()],
+});
+
+sdk.start();
+
+process.on('SIGTERM', () => {
+  sdk.shutdown().finally(() => process.exit(0));
+});
+`,
+				Description: "OpenTelemetry SDK setup, loaded before the server starts",
+			}
+			dependencies = []Dependency{{
+				Name: "@opentelemetry/sdk-node",
+				InstallCommand: "npm install @opentelemetry/api @opentelemetry/sdk-node " +
+					"@opentelemetry/exporter-trace-otlp-http @opentelemetry/auto-instrumentations-node",
+			}}
+			rewritten = true
+
+		case backendFramework == "fastapi" && file.Path == "routers/razorpay.py":
+			file.Code = strings.Replace(file.Code, "import razorpay\n",
+				"import razorpay\nfrom functools import wraps\nfrom opentelemetry import trace\n", 1)
+			file.Code = strings.Replace(file.Code, "class OrderRequest(BaseModel):\n", `tracer = trace.get_tracer("razorpay-checkout")
+
+def traced(name):
+    """Run a route in a span tagged with the Razorpay order id and outcome.
+    Razorpay API calls made by the route are recorded as child spans."""
+    def decorator(fn):
+        @wraps(fn)
+        async def wrapper(*args, **kwargs):
+            with tracer.start_as_current_span(name) as span:
+                order_id = getattr(kwargs.get('req'), 'razorpay_order_id', None)
+                if order_id:
+                    span.set_attribute('razorpay.order_id', order_id)
+                try:
+                    result = await fn(*args, **kwargs)
+                except HTTPException as e:
+                    span.set_attribute('razorpay.outcome', 'failure')
+                    span.set_attribute('http.response.status_code', e.status_code)
+                    raise
+                if result.get('orderId'):
+                    span.set_attribute('razorpay.order_id', result['orderId'])
+                span.set_attribute('razorpay.outcome', 'success' if result.get('success') else 'failure')
+                return result
+        return wrapper
+    return decorator
+
+class OrderRequest(BaseModel):
+`, 1)
+			file.Code = strings.Replace(file.Code, "@router.post(\"/order\")\n",
+				"@router.post(\"/order\")\n@traced(\"razorpay.order.create\")\n", 1)
+			file.Code = strings.Replace(file.Code, "@router.post(\"/verify\")\n",
+				"@router.post(\"/verify\")\n@traced(\"razorpay.payment.verify\")\n", 1)
+			dependencies = getPythonTelemetryDependencies()
+			rewritten = true
+
+		case backendFramework == "flask" && file.Action == "create" &&
+			strings.Contains(file.Code, "@app.route('/api/razorpay/order', methods=['POST'])\n"):
+			file.Code = strings.Replace(file.Code, "import razorpay\n",
+				"import razorpay\nfrom functools import wraps\nfrom opentelemetry import trace\n", 1)
+			file.Code = strings.Replace(file.Code, "# Razorpay expects amounts", `tracer = trace.get_tracer('razorpay-checkout')
+
+def traced(name):
+    """Run a route in a span tagged with the Razorpay order id and outcome.
+    Razorpay API calls made by the route are recorded as child spans."""
+    def decorator(fn):
+        @wraps(fn)
+        def wrapper(*args, **kwargs):
+            with tracer.start_as_current_span(name) as span:
+                data = request.get_json(silent=True) or {}
+                if data.get('razorpay_order_id'):
+                    span.set_attribute('razorpay.order_id', data['razorpay_order_id'])
+                rv = fn(*args, **kwargs)
+                response, status = rv if isinstance(rv, tuple) else (rv, 200)
+                body = response.get_json(silent=True) or {}
+                if body.get('orderId'):
+                    span.set_attribute('razorpay.order_id', body['orderId'])
+                span.set_attribute('razorpay.outcome', 'success' if body.get('success') else 'failure')
+                span.set_attribute('http.response.status_code', status)
+                return rv
+        return wrapper
+    return decorator
+
+# Razorpay expects amounts`, 1)
+			file.Code = strings.Replace(file.Code, "@app.route('/api/razorpay/order', methods=['POST'])\n",
+				"@app.route('/api/razorpay/order', methods=['POST'])\n@traced('razorpay.order.create')\n", 1)
+			file.Code = strings.Replace(file.Code, "@app.route('/api/razorpay/verify', methods=['POST'])\n",
+				"@app.route('/api/razorpay/verify', methods=['POST'])\n@traced('razorpay.payment.verify')\n", 1)
+			dependencies = getPythonTelemetryDependencies()
+			rewritten = true
+		}
+	}
+
+	if !rewritten {
+		return false
+	}
+
+	if setup.Path != "" {
+		output.Files = append(output.Files, setup)
+	}
+	output.Dependencies = append(output.Dependencies, dependencies...)
+	output.EnvVars = append(output.EnvVars,
+		EnvVar{Name: "OTEL_SERVICE_NAME", Value: "razorpay-checkout"},
+		EnvVar{Name: "OTEL_EXPORTER_OTLP_ENDPOINT", Value: "http://localhost:4318"},
+	)
+
+	start := "node --require ./tracing.js server.js"
+	if setup.Path == "" {
+		start = "opentelemetry-instrument followed by the usual start command " +
+			"(e.g. opentelemetry-instrument uvicorn main:app)"
+	}
+	output.Summary += " The order and verify handlers are traced with OpenTelemetry."
+	output.AIInstructions += `
+
+TELEMETRY: The order and verify handlers run in OpenTelemetry spans named
+razorpay.order.create and razorpay.payment.verify, tagged with razorpay.order_id and
+razorpay.outcome (success or failure). Start the server with ` + start + `
+so the SDK is set up before the app loads; without it the spans are no-ops and nothing
+breaks. Spans go to OTEL_EXPORTER_OTLP_ENDPOINT (an OTLP/HTTP collector such as the
+OpenTelemetry Collector, Jaeger or Grafana Tempo). Never add the key secret, signature
+or card details as span attributes.`
+	return true
+}
+
+// getPythonTelemetryDependencies returns the OpenTelemetry packages for the
+// Python backends, which are set up by the opentelemetry-instrument launcher
+func getPythonTelemetryDependencies() []Dependency {
+	return []Dependency{
+		{Name: "opentelemetry-distro", InstallCommand: "pip install opentelemetry-distro opentelemetry-exporter-otlp"},
+		{Name: "opentelemetry-instrumentation", InstallCommand: "opentelemetry-bootstrap -a install"},
+	}
+}
+
+// usesTailwind reports whether package.json lists tailwindcss in its
+// dependencies or devDependencies
+func usesTailwind(packageJson map[string]interface{}) bool {
+	for _, key := range []string{"dependencies", "devDependencies"} {
+		if d, ok := packageJson[key].(map[string]interface{}); ok {
+			if _, ok := d["tailwindcss"]; ok {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// detectProjectStack detects the stack of the project at the root of the
+// files, and lists its workspaces when the root is a monorepo
 func detectProjectStack(args map[string]interface{}) DetectStackOutput {
+	output := detectRootStack(args)
+
+	files := []string{}
+	if f, ok := args["files"].([]interface{}); ok {
+		for _, v := range f {
+			if s, ok := v.(string); ok {
+				files = append(files, s)
+			}
+		}
+	}
+	packageJson, _ := args["packageJson"].(map[string]interface{})
+
+	if tool := detectMonorepoTool(files, packageJson); tool != "" {
+		output.Signals = append(output.Signals, DetectionSignal{
+			Kind: "workspace", Value: tool + " configured at the root", Weight: 0.9,
+		})
+		output.IsMonorepo = true
+		output.Workspaces = detectWorkspaces(files)
+		note := tool + " monorepo detected"
+		if len(output.Workspaces) > 0 {
+			note += " with workspaces " + strings.Join(output.Workspaces, ", ")
+		}
+		output.Notes = append(output.Notes, note+". Run detect_stack on the "+
+			"app that should take payments and generate the integration there")
+	}
+	return output
+}
+
+// detectMonorepoTool returns the workspace tool a monorepo root is set up
+// with, or "" for a single project
+func detectMonorepoTool(files []string, packageJson map[string]interface{}) string {
+	switch {
+	case containsRootFile(files, "pnpm-workspace.yaml"):
+		return "pnpm workspaces"
+	case containsRootFile(files, "turbo.json"):
+		return "Turborepo"
+	case containsRootFile(files, "nx.json"):
+		return "Nx"
+	}
+
+	// workspaces is either a list of globs or {"packages": [...]}
+	switch w := packageJson["workspaces"].(type) {
+	case []interface{}:
+		if len(w) > 0 {
+			return "package.json workspaces"
+		}
+	case map[string]interface{}:
+		if packages, ok := w["packages"].([]interface{}); ok && len(packages) > 0 {
+			return "package.json workspaces"
+		}
+	}
+	return ""
+}
+
+// detectWorkspaces lists the directories below the root that have their own
+// package.json, sorted
+func detectWorkspaces(files []string) []string {
+	seen := map[string]bool{}
+	var workspaces []string
+	for _, f := range files {
+		f = strings.TrimPrefix(f, "./")
+		dir, name := path.Split(f)
+		dir = strings.TrimSuffix(dir, "/")
+		if name != "package.json" || dir == "" || seen[dir] ||
+			strings.Contains("/"+dir+"/", "/node_modules/") {
+			continue
+		}
+		seen[dir] = true
+		workspaces = append(workspaces, dir)
+	}
+	sort.Strings(workspaces)
+	return workspaces
+}
+
+// containsRootFile reports whether name is among the files at the project
+// root
+func containsRootFile(files []string, name string) bool {
+	for _, f := range files {
+		if strings.TrimPrefix(f, "./") == name {
+			return true
+		}
+	}
+	return false
+}
+
+// detectRootStack detects the stack of the project at the root of the files
+func detectRootStack(args map[string]interface{}) DetectStackOutput {
 	files := []string{}
 	if f, ok := args["files"].([]interface{}); ok {
 		for _, v := range f {
@@ -1824,30 +8612,48 @@ func detectProjectStack(args map[string]interface{}) DetectStackOutput {
 	requirementsTxt, _ := args["requirementsTxt"].(string)
 	goMod, _ := args["goMod"].(string)
 	pubspecYaml, _ := args["pubspecYaml"].(string)
+	gemfile, _ := args["gemfile"].(string)
+	pomXml, _ := args["pomXml"].(string)
+	buildGradle, _ := args["buildGradle"].(string)
 
 	notes := []string{}
+	signals := []DetectionSignal{}
+	signal := func(kind, value string, weight float64) {
+		signals = append(signals, DetectionSignal{Kind: kind, Value: value, Weight: weight})
+	}
 
 	// Flutter detection
 	if pubspecYaml != "" || containsSuffix(files, "pubspec.yaml") {
+		signal("manifest", "found pubspec.yaml", 0.95)
 		return DetectStackOutput{
 			Language:       "dart",
 			Framework:      "flutter",
 			PackageManager: "pub",
 			IsFullStack:    false,
 			Confidence:     0.95,
-			Notes:          []string{"Flutter mobile app detected"},
+			Signals:        signals,
+			Notes: []string{
+				"Flutter mobile app detected",
+				detectFlutterBackend(args, files),
+			},
 		}
 	}
 
 	// Go detection
 	if goMod != "" || containsSuffix(files, "go.mod") {
+		signal("manifest", "found go.mod", 0.7)
 		framework := "go-stdlib"
-		if contains(goMod, "github.com/gin-gonic/gin") {
-			framework = "gin"
-		} else if contains(goMod, "github.com/labstack/echo") {
-			framework = "echo"
-		} else if contains(goMod, "github.com/gofiber/fiber") {
-			framework = "fiber"
+		for _, fw := range []struct{ module, framework string }{
+			{"github.com/gin-gonic/gin", "gin"},
+			{"github.com/labstack/echo", "echo"},
+			{"github.com/gofiber/fiber", "fiber"},
+			{"github.com/go-chi/chi", "chi"},
+		} {
+			if contains(goMod, fw.module) {
+				framework = fw.framework
+				signal("dependency", fw.framework+" in go.mod", 0.2)
+				break
+			}
 		}
 
 		return DetectStackOutput{
@@ -1856,26 +8662,35 @@ func detectProjectStack(args map[string]interface{}) DetectStackOutput {
 			PackageManager: "go-mod",
 			IsFullStack:    true,
 			Confidence:     0.9,
+			Signals:        signals,
 			Notes:          []string{"Go project with " + framework},
 		}
 	}
 
 	// Python detection
 	if requirementsTxt != "" || containsSuffix(files, "requirements.txt") || containsSuffix(files, "pyproject.toml") {
+		if requirementsTxt != "" || containsSuffix(files, "requirements.txt") {
+			signal("manifest", "found requirements.txt", 0.6)
+		} else {
+			signal("manifest", "found pyproject.toml", 0.6)
+		}
 		framework := "python-stdlib"
 		pythonFrameworks := []string{"django", "flask", "fastapi", "starlette"}
 		for _, fw := range pythonFrameworks {
 			if contains(requirementsTxt, fw) {
 				framework = fw
+				signal("dependency", fw+" in requirements.txt", 0.25)
 				break
 			}
 		}
 
 		if containsPath(files, "manage.py") {
 			framework = "django"
+			signal("file", "found manage.py", 0.25)
 		}
-		if containsSuffix(files, "app.py") && framework == "python-stdlib" {
+		if containsPath(files, "app.py") && framework == "python-stdlib" {
 			framework = "flask"
+			signal("file", "found app.py", 0.1)
 		}
 
 		return DetectStackOutput{
@@ -1884,12 +8699,101 @@ func detectProjectStack(args map[string]interface{}) DetectStackOutput {
 			PackageManager: "pip",
 			IsFullStack:    true,
 			Confidence:     0.85,
+			Signals:        signals,
 			Notes:          []string{"Python project with " + framework},
 		}
 	}
 
-	// Node.js detection
-	if hasPackageJson || containsSuffix(files, "package.json") {
+	// Java detection
+	hasGradle := buildGradle != "" || containsSuffix(files, "build.gradle") || containsSuffix(files, "build.gradle.kts")
+	if pomXml != "" || containsSuffix(files, "pom.xml") || hasGradle {
+		framework := "java"
+		if contains(pomXml, "spring-boot-starter") || contains(buildGradle, "spring-boot-starter") {
+			framework = "spring"
+		}
+
+		packageManager := "maven"
+		if hasGradle {
+			packageManager = "gradle"
+			signal("manifest", "found build.gradle", 0.7)
+		} else {
+			signal("manifest", "found pom.xml", 0.7)
+		}
+		if framework == "spring" {
+			signal("dependency", "spring-boot-starter in the build file", 0.2)
+		}
+
+		return DetectStackOutput{
+			Language:       "java",
+			Framework:      framework,
+			PackageManager: packageManager,
+			IsFullStack:    true,
+			Confidence:     0.9,
+			Signals:        signals,
+			Notes:          []string{"Java project with " + framework},
+		}
+	}
+
+	// .NET detection. Program.cs is also the entry point of a plain C# console
+	// app, so only a project file or Startup.cs is trusted as ASP.NET Core
+	if containsSuffix(files, ".csproj") || containsSuffix(files, "Program.cs") || containsSuffix(files, "Startup.cs") {
+		confidence := 0.85
+		note := "ASP.NET Core project detected"
+		switch {
+		case containsSuffix(files, ".csproj"):
+			signal("file", "found .csproj", 0.85)
+		case containsSuffix(files, "Startup.cs"):
+			signal("file", "found Startup.cs", 0.85)
+		default:
+			confidence = 0.5
+			signal("file", "found Program.cs", 0.5)
+			note = "Found Program.cs without a .csproj; it may be a C# " +
+				"console app rather than ASP.NET Core, confirm before integrating"
+		}
+		return DetectStackOutput{
+			Language:       "csharp",
+			Framework:      "aspnet",
+			PackageManager: "nuget",
+			IsFullStack:    true,
+			Confidence:     confidence,
+			Signals:        signals,
+			Notes:          []string{note},
+		}
+	}
+
+	// Ruby detection
+	if gemfile != "" || containsSuffix(files, "Gemfile") || containsPath(files, "config/routes.rb") {
+		if gemfile != "" || containsSuffix(files, "Gemfile") {
+			signal("manifest", "found Gemfile", 0.7)
+		}
+		framework := "ruby"
+		if contains(gemfile, "rails") {
+			framework = "rails"
+			signal("dependency", "rails in Gemfile", 0.2)
+		}
+		if containsPath(files, "config/routes.rb") {
+			framework = "rails"
+			signal("file", "found config/routes.rb", 0.2)
+		}
+
+		return DetectStackOutput{
+			Language:       "ruby",
+			Framework:      framework,
+			PackageManager: "bundler",
+			IsFullStack:    true,
+			Confidence:     0.9,
+			Signals:        signals,
+			Notes:          []string{"Ruby project with " + framework},
+		}
+	}
+
+	// JavaScript runtime (Node.js, Deno or Bun) detection. Deno projects
+	// need not have a package.json.
+	isDeno := containsPath(files, "deno.json") || containsPath(files, "deno.jsonc")
+	if hasPackageJson || containsSuffix(files, "package.json") || isDeno {
+		if hasPackageJson || containsSuffix(files, "package.json") {
+			signal("manifest", "found package.json", 0.5)
+		}
 		deps := map[string]bool{}
 		if packageJsonRaw != nil {
 			if d, ok := packageJsonRaw["dependencies"].(map[string]interface{}); ok {
@@ -1904,60 +8808,89 @@ func detectProjectStack(args map[string]interface{}) DetectStackOutput {
 			}
 		}
 
-		isTypeScript := containsSuffix(files, ".ts") || containsSuffix(files, ".tsx") || deps["typescript"]
+		hasTsconfig := containsPath(files, "tsconfig.json")
+		isTypeScript := containsSuffix(files, ".ts") || containsSuffix(files, ".tsx") ||
+			deps["typescript"] || hasTsconfig
 		language := "javascript"
 		if isTypeScript {
 			language = "typescript"
+			switch {
+			case deps["typescript"]:
+				signal("dependency", "typescript in package.json", 0.1)
+			case hasTsconfig:
+				signal("file", "found tsconfig.json", 0.1)
+			default:
+				signal("file", "found .ts or .tsx files", 0.1)
+			}
 		}
 
 		// Detect package manager
 		packageManager := "npm"
 		if containsPath(files, "yarn.lock") {
 			packageManager = "yarn"
+			signal("lockfile", "found yarn.lock", 0.05)
 		} else if containsPath(files, "pnpm-lock.yaml") {
 			packageManager = "pnpm"
+			signal("lockfile", "found pnpm-lock.yaml", 0.05)
 		} else if containsPath(files, "bun.lockb") {
 			packageManager = "bun"
+			signal("lockfile", "found bun.lockb", 0.05)
+		}
+
+		runtime := "node"
+		switch {
+		case isDeno:
+			runtime = "deno"
+			if packageManager == "npm" {
+				packageManager = "deno"
+			}
+			signal("manifest", "found deno.json", 0.5)
+			notes = append(notes, "Deno runtime: import razorpay with an npm: specifier "+
+				"and read secrets with Deno.env.get")
+		case packageManager == "bun" || containsPath(files, "bunfig.toml"):
+			runtime = "bun"
+			if containsPath(files, "bunfig.toml") {
+				signal("file", "found bunfig.toml", 0.05)
+			}
 		}
 
 		// Detect backend framework
 		framework := "node"
-		nodeFrameworks := map[string]string{
-			"next":         "nextjs",
-			"express":      "express",
-			"fastify":      "fastify",
-			"koa":          "koa",
-			"hono":         "hono",
-			"nuxt":         "nuxt",
-			"@nestjs/core": "nestjs",
-		}
-		for pkg, fw := range nodeFrameworks {
-			if deps[pkg] {
-				framework = fw
-				notes = append(notes, "Found "+pkg+" in dependencies")
+		for _, fw := range nodeFrameworks {
+			if deps[fw.pkg] {
+				framework = fw.framework
+				notes = append(notes, "Found "+fw.pkg+" in dependencies")
+				signal("dependency", fw.pkg+" in package.json", 0.3)
 				break
 			}
 		}
+		if framework == "node" && runtime == "bun" {
+			framework = "bun"
+			notes = append(notes, "Bun project without a server framework, "+
+				"use backendFramework=bun for a Bun.serve integration")
+		}
 
 		// Detect frontend framework
 		frontend := ""
-		frontendFrameworks := map[string]string{
-			"react":        "react",
-			"vue":          "vue",
-			"@angular/core": "angular",
-			"svelte":       "svelte",
-			"solid-js":     "solid",
-			"react-native": "react-native",
-			"expo":         "react-native",
-		}
-		for pkg, fw := range frontendFrameworks {
-			if deps[pkg] {
-				frontend = fw
-				notes = append(notes, "Found "+pkg+" for frontend")
+		for _, fw := range frontendFrameworks {
+			if deps[fw.pkg] {
+				frontend = fw.framework
+				notes = append(notes, "Found "+fw.pkg+" for frontend")
+				signal("dependency", fw.pkg+" in package.json", 0.1)
+				if fw.framework == "vue" && vueMajorVersion(packageJsonRaw) == 2 {
+					notes = append(notes, "Vue 2 project, use vueStyle=options for Options API components")
+				}
 				break
 			}
 		}
 
+		styling := "inline"
+		if usesTailwind(packageJsonRaw) {
+			styling = "tailwind"
+			notes = append(notes, "Found tailwindcss, generated buttons can use Tailwind classes")
+			signal("dependency", "tailwindcss in package.json", 0.05)
+		}
+
 		// React Native special case
 		if frontend == "react-native" {
 			return DetectStackOutput{
@@ -1966,21 +8899,44 @@ func detectProjectStack(args map[string]interface{}) DetectStackOutput {
 				PackageManager: packageManager,
 				IsFullStack:    false,
 				Confidence:     0.95,
-				Notes:          []string{"React Native mobile app detected"},
+				Signals:        signals,
+				Notes: []string{
+					"React Native mobile app detected",
+					"Use frontendFramework=react-native with the backend that creates the orders",
+				},
+			}
+		}
+
+		nextRouter := ""
+		if framework == "nextjs" {
+			var found bool
+			nextRouter, found = detectNextRouter(files)
+			if found {
+				signal("file", "found the Next.js "+nextRouter+"/ directory", 0.05)
+			} else {
+				signal("default", "no app/ or pages/ directory, assuming the App Router", 0)
+			}
+			if nextRouter == "pages" {
+				notes = append(notes, "Next.js Pages Router project, use nextRouter=pages")
 			}
 		}
 
 		// Determine if fullstack
-		isFullStack := framework == "nextjs" || framework == "nuxt" || framework == "nestjs" ||
+		isFullStack := framework == "nextjs" || framework == "remix" || framework == "astro" ||
+			framework == "nuxt" || framework == "nestjs" ||
 			(framework != "node" && frontend == "")
 
 		return DetectStackOutput{
 			Language:       language,
+			Runtime:        runtime,
 			Framework:      framework,
 			Frontend:       frontend,
 			PackageManager: packageManager,
 			IsFullStack:    isFullStack,
+			Styling:        styling,
+			NextRouter:     nextRouter,
 			Confidence:     0.9,
+			Signals:        signals,
 			Notes:          notes,
 		}
 	}
@@ -1992,10 +8948,58 @@ func detectProjectStack(args map[string]interface{}) DetectStackOutput {
 		PackageManager: "unknown",
 		IsFullStack:    false,
 		Confidence:     0.1,
+		Signals:        signals,
 		Notes:          []string{"Could not detect project stack"},
 	}
 }
 
+// detectNextRouter reports which Next.js router a project uses and whether
+// an app/ or pages/ directory was seen. Projects that have both are migrating
+// to the App Router, so new routes go there; projects with neither default to
+// the App Router.
+func detectNextRouter(files []string) (string, bool) {
+	hasPages := false
+	for _, f := range files {
+		f = strings.TrimPrefix(f, "./")
+		f = strings.TrimPrefix(f, "src/")
+		if strings.HasPrefix(f, "app/") {
+			return "app", true
+		}
+		if strings.HasPrefix(f, "pages/") {
+			hasPages = true
+		}
+	}
+	if hasPages {
+		return "pages", true
+	}
+	return "app", false
+}
+
+// detectFlutterBackend looks for a backend next to a Flutter app, e.g. in a
+// monorepo, and returns a note on which backendFramework to pair it with
+func detectFlutterBackend(args map[string]interface{}, files []string) string {
+	rest := map[string]interface{}{}
+	for k, v := range args {
+		if k != "pubspecYaml" && k != "files" {
+			rest[k] = v
+		}
+	}
+	others := []interface{}{}
+	for _, f := range files {
+		if !strings.HasSuffix(f, "pubspec.yaml") {
+			others = append(others, f)
+		}
+	}
+	rest["files"] = others
+
+	if backend := flutterBackendFor(detectProjectStack(rest).Framework); backend != "" {
+		return "Flutter is client-only: pair it with the " + backend +
+			" backend found alongside it (backendFramework=" + backend + ")"
+	}
+	return "Flutter is client-only: orders must be created by a separate backend. " +
+		"None was found - use backendFramework=express unless the team already runs another"
+}
+
 // Helper functions
 func containsSuffix(files []string, suffix string) bool {
 	for _, f := range files {
@@ -2006,9 +9010,17 @@ func containsSuffix(files []string, suffix string) bool {
 	return false
 }
 
+// containsPath reports whether any file is path or ends with it on a path
+// segment boundary, so "app.py" matches "src/app.py" but not "src/webapp.py"
 func containsPath(files []string, path string) bool {
+	want := strings.Split(strings.Trim(path, "/"), "/")
 	for _, f := range files {
-		if f == path || contains(f, path) {
+		segments := strings.Split(strings.Trim(f, "/"), "/")
+		if len(segments) < len(want) {
+			continue
+		}
+		tail := segments[len(segments)-len(want):]
+		if strings.Join(tail, "/") == strings.Join(want, "/") {
 			return true
 		}
 	}
@@ -0,0 +1,2497 @@
+package razorpay
+
+import (
+	"context"
+	"encoding/json"
+	"go/parser"
+	"go/token"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/razorpay/razorpay-mcp-server/pkg/mcpgo"
+)
+
+func TestDetectProjectStack(t *testing.T) {
+	tests := []struct {
+		name               string
+		args               map[string]interface{}
+		wantFramework      string
+		wantFrontend       string
+		wantPackageManager string
+	}{
+		{
+			name: "next with custom express server",
+			args: map[string]interface{}{
+				"files": []interface{}{"package.json", "server.js"},
+				"packageJson": map[string]interface{}{
+					"dependencies": map[string]interface{}{
+						"express": "^4.18.2",
+						"next":    "14.0.0",
+						"react":   "18.2.0",
+					},
+				},
+			},
+			wantFramework: "nextjs",
+			wantFrontend:  "react",
+		},
+		{
+			name: "express with vue frontend",
+			args: map[string]interface{}{
+				"files": []interface{}{"package.json"},
+				"packageJson": map[string]interface{}{
+					"dependencies": map[string]interface{}{
+						"koa":     "^2.14.0",
+						"express": "^4.18.2",
+						"vue":     "^3.3.0",
+					},
+				},
+			},
+			wantFramework: "express",
+			wantFrontend:  "vue",
+		},
+		{
+			name: "adonis",
+			args: map[string]interface{}{
+				"files": []interface{}{"package.json", "ace"},
+				"packageJson": map[string]interface{}{
+					"dependencies": map[string]interface{}{
+						"@adonisjs/core": "^5.9.0",
+					},
+				},
+			},
+			wantFramework: "adonis",
+		},
+		{
+			name: "remix with the express adapter",
+			args: map[string]interface{}{
+				"files": []interface{}{"package.json", "remix.config.js"},
+				"packageJson": map[string]interface{}{
+					"dependencies": map[string]interface{}{
+						"@remix-run/express": "^2.8.0",
+						"@remix-run/node":    "^2.8.0",
+						"@remix-run/react":   "^2.8.0",
+						"express":            "^4.18.2",
+						"react":              "18.2.0",
+					},
+				},
+			},
+			wantFramework: "remix",
+			wantFrontend:  "react",
+		},
+		{
+			name: "nuxt",
+			args: map[string]interface{}{
+				"files": []interface{}{"package.json", "nuxt.config.ts"},
+				"packageJson": map[string]interface{}{
+					"dependencies": map[string]interface{}{
+						"nuxt": "^3.11.0",
+						"vue":  "^3.4.0",
+					},
+				},
+			},
+			wantFramework: "nuxt",
+			wantFrontend:  "vue",
+		},
+		{
+			name: "astro with the node adapter",
+			args: map[string]interface{}{
+				"files": []interface{}{"package.json", "astro.config.mjs"},
+				"packageJson": map[string]interface{}{
+					"dependencies": map[string]interface{}{
+						"@astrojs/node": "^8.2.0",
+						"astro":         "^4.5.0",
+						"express":       "^4.18.2",
+					},
+				},
+			},
+			wantFramework: "astro",
+			wantFrontend:  "",
+		},
+		{
+			name: "chi",
+			args: map[string]interface{}{
+				"files": []interface{}{"go.mod", "main.go"},
+				"goMod": "module shop\n\nrequire github.com/go-chi/chi/v5 v5.0.12",
+			},
+			wantFramework: "chi",
+		},
+		{
+			name: "plain ruby",
+			args: map[string]interface{}{
+				"files":   []interface{}{"Gemfile", "app.rb"},
+				"gemfile": "source 'https://rubygems.org'\ngem 'sinatra'",
+			},
+			wantFramework: "ruby",
+		},
+		{
+			name: "rails from the gemfile",
+			args: map[string]interface{}{
+				"files":   []interface{}{"Gemfile"},
+				"gemfile": "source 'https://rubygems.org'\ngem 'rails', '~> 7.1'",
+			},
+			wantFramework: "rails",
+		},
+		{
+			name: "rails from config/routes.rb",
+			args: map[string]interface{}{
+				"files": []interface{}{"Gemfile", "config/routes.rb"},
+			},
+			wantFramework: "rails",
+		},
+		{
+			name: "rails app with a package.json for its assets",
+			args: map[string]interface{}{
+				"files": []interface{}{
+					"Gemfile", "config/routes.rb", "package.json",
+				},
+				"gemfile": "gem 'rails', '~> 7.1'",
+				"packageJson": map[string]interface{}{
+					"dependencies": map[string]interface{}{
+						"@hotwired/turbo-rails": "^8.0.0",
+						"react":                 "18.2.0",
+					},
+				},
+			},
+			wantFramework: "rails",
+		},
+		{
+			name: "spring boot with maven",
+			args: map[string]interface{}{
+				"files":  []interface{}{"pom.xml"},
+				"pomXml": "<artifactId>spring-boot-starter-web</artifactId>",
+			},
+			wantFramework:      "spring",
+			wantPackageManager: "maven",
+		},
+		{
+			name: "spring boot with gradle",
+			args: map[string]interface{}{
+				"files": []interface{}{"build.gradle"},
+				"buildGradle": "implementation " +
+					"'org.springframework.boot:spring-boot-starter-web'",
+			},
+			wantFramework:      "spring",
+			wantPackageManager: "gradle",
+		},
+		{
+			name: "kotlin gradle build without spring",
+			args: map[string]interface{}{
+				"files": []interface{}{"build.gradle.kts"},
+			},
+			wantFramework:      "java",
+			wantPackageManager: "gradle",
+		},
+		{
+			name: "plain java with maven",
+			args: map[string]interface{}{
+				"files":  []interface{}{"pom.xml"},
+				"pomXml": "<artifactId>junit</artifactId>",
+			},
+			wantFramework:      "java",
+			wantPackageManager: "maven",
+		},
+		{
+			name: "aspnet from a project file",
+			args: map[string]interface{}{
+				"files": []interface{}{"Shop.csproj", "Program.cs"},
+			},
+			wantFramework:      "aspnet",
+			wantPackageManager: "nuget",
+		},
+		{
+			name: "aspnet from Startup.cs",
+			args: map[string]interface{}{
+				"files": []interface{}{"src/Startup.cs"},
+			},
+			wantFramework:      "aspnet",
+			wantPackageManager: "nuget",
+		},
+		{
+			name: "react native takes priority over react",
+			args: map[string]interface{}{
+				"files": []interface{}{"package.json"},
+				"packageJson": map[string]interface{}{
+					"dependencies": map[string]interface{}{
+						"react":        "18.2.0",
+						"react-native": "0.73.0",
+					},
+				},
+			},
+			wantFramework: "react-native",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			want := detectProjectStack(tt.args)
+			assert.Equal(t, tt.wantFramework, want.Framework)
+			assert.Equal(t, tt.wantFrontend, want.Frontend)
+			if tt.wantPackageManager != "" {
+				assert.Equal(t, tt.wantPackageManager, want.PackageManager)
+			}
+
+			// Detection must not depend on map iteration order
+			for i := 0; i < 100; i++ {
+				assert.Equal(t, want, detectProjectStack(tt.args))
+			}
+		})
+	}
+}
+
+func TestDetectProjectStack_DotNetConfidence(t *testing.T) {
+	withProject := detectProjectStack(map[string]interface{}{
+		"files": []interface{}{"Shop.csproj", "Program.cs"},
+	})
+	assert.Equal(t, 0.85, withProject.Confidence)
+
+	// A lone Program.cs could just as well be a console app
+	programOnly := detectProjectStack(map[string]interface{}{
+		"files": []interface{}{"Program.cs"},
+	})
+	assert.Equal(t, "aspnet", programOnly.Framework)
+	assert.Equal(t, 0.5, programOnly.Confidence)
+	assert.Contains(t, strings.Join(programOnly.Notes, "\n"), "console app")
+}
+
+func TestDetectProjectStack_FlutterBackend(t *testing.T) {
+	tests := []struct {
+		name     string
+		args     map[string]interface{}
+		wantNote string
+	}{
+		{
+			name: "flutter with a go backend in the same repo",
+			args: map[string]interface{}{
+				"files":       []interface{}{"app/pubspec.yaml", "server/go.mod"},
+				"pubspecYaml": "name: shop",
+				"goMod":       "require github.com/labstack/echo/v4 v4.11.0",
+			},
+			wantNote: "pair it with the echo backend found alongside it",
+		},
+		{
+			name: "flutter with a plain node backend",
+			args: map[string]interface{}{
+				"files": []interface{}{"pubspec.yaml", "api/package.json"},
+			},
+			wantNote: "backendFramework=express",
+		},
+		{
+			name: "flutter only",
+			args: map[string]interface{}{
+				"files": []interface{}{"pubspec.yaml", "lib/main.dart"},
+			},
+			wantNote: "None was found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			out := detectProjectStack(tt.args)
+			assert.Equal(t, "flutter", out.Framework)
+			assert.False(t, out.IsFullStack)
+			assert.Contains(t, strings.Join(out.Notes, "\n"), tt.wantNote)
+		})
+	}
+}
+
+func TestDetectProjectStack_Styling(t *testing.T) {
+	args := map[string]interface{}{
+		"files": []interface{}{"package.json"},
+		"packageJson": map[string]interface{}{
+			"dependencies": map[string]interface{}{"next": "14.0.0"},
+		},
+	}
+	assert.Equal(t, "inline", detectProjectStack(args).Styling)
+
+	args["packageJson"].(map[string]interface{})["devDependencies"] =
+		map[string]interface{}{"tailwindcss": "^3.4.0"}
+	assert.Equal(t, "tailwind", detectProjectStack(args).Styling)
+}
+
+func TestIntegrateRazorpayCheckout_Styling(t *testing.T) {
+	tailwindClasses := "bg-blue-600 text-white"
+	withTailwind := map[string]interface{}{
+		"devDependencies": map[string]interface{}{"tailwindcss": "^3.4.0"},
+	}
+	withoutTailwind := map[string]interface{}{
+		"dependencies": map[string]interface{}{"next": "14.0.0"},
+	}
+
+	tests := []struct {
+		name         string
+		styling      string
+		packageJson  map[string]interface{}
+		wantContains string
+		wantTailwind bool
+	}{
+		{name: "default without package.json", wantTailwind: true},
+		{
+			name:         "default with tailwindcss",
+			packageJson:  withTailwind,
+			wantTailwind: true,
+		},
+		{
+			name:         "default without tailwindcss",
+			packageJson:  withoutTailwind,
+			wantContains: "style={className ? undefined : {",
+		},
+		{
+			name:         "explicit inline",
+			styling:      "inline",
+			packageJson:  withTailwind,
+			wantContains: "backgroundColor: '#2563eb'",
+		},
+		{
+			name:         "none",
+			styling:      "none",
+			wantContains: "className={className || undefined}",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			args := map[string]interface{}{
+				"language":          "typescript",
+				"backendFramework":  "nextjs",
+				"frontendFramework": "nextjs",
+			}
+			if tt.styling != "" {
+				args["styling"] = tt.styling
+			}
+			if tt.packageJson != nil {
+				args["packageJson"] = tt.packageJson
+			}
+
+			var component string
+			for _, f := range runIntegrateCheckout(t, args).Files {
+				if f.Path == "components/RazorpayCheckout.tsx" {
+					component = f.Code
+				}
+			}
+			if !assert.NotEmpty(t, component) {
+				return
+			}
+
+			if tt.wantTailwind {
+				assert.Contains(t, component, tailwindClasses)
+			} else {
+				assert.NotContains(t, component, tailwindClasses)
+				assert.Contains(t, component, tt.wantContains)
+			}
+		})
+	}
+}
+
+func TestIntegrateRazorpayCheckout_TypeDeclaration(t *testing.T) {
+	tests := []struct {
+		name            string
+		language        string
+		frontend        string
+		wantDeclaration bool
+	}{
+		{
+			name:            "typescript with vanilla frontend",
+			language:        "typescript",
+			frontend:        "vanilla",
+			wantDeclaration: true,
+		},
+		{
+			name:            "javascript with vanilla frontend",
+			language:        "javascript",
+			frontend:        "vanilla",
+			wantDeclaration: false,
+		},
+		{
+			name:            "typescript with react frontend",
+			language:        "typescript",
+			frontend:        "react",
+			wantDeclaration: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			output := runIntegrateCheckout(t, map[string]interface{}{
+				"language":          tt.language,
+				"backendFramework":  "express",
+				"frontendFramework": tt.frontend,
+			})
+
+			found := false
+			for _, f := range output.Files {
+				if f.Path == "types/razorpay.d.ts" {
+					found = true
+				}
+			}
+			assert.Equal(t, tt.wantDeclaration, found)
+		})
+	}
+}
+
+func TestIntegrateRazorpayCheckout_AllowRetry(t *testing.T) {
+	tests := []struct {
+		name        string
+		backend     string
+		frontend    string
+		path        string
+		maxCount    interface{}
+		wantRetry   string
+		wantGuarded bool
+	}{
+		{
+			name:        "vanilla with default max count",
+			backend:     "express",
+			frontend:    "vanilla",
+			path:        "public/js/razorpay.js",
+			wantRetry:   "retry: { enabled: true, max_count: 4 },",
+			wantGuarded: true,
+		},
+		{
+			name:        "nextjs with custom max count",
+			backend:     "nextjs",
+			frontend:    "nextjs",
+			path:        "components/RazorpayCheckout.tsx",
+			maxCount:    float64(2),
+			wantRetry:   "retry: { enabled: true, max_count: 2 },",
+			wantGuarded: true,
+		},
+		{
+			name:      "react without a failure handler",
+			backend:   "express",
+			frontend:  "react",
+			path:      "src/components/RazorpayButton.jsx",
+			wantRetry: "retry: { enabled: true, max_count: 4 },",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			args := map[string]interface{}{
+				"language":          "typescript",
+				"backendFramework":  tt.backend,
+				"frontendFramework": tt.frontend,
+				"allowRetry":        true,
+			}
+			if tt.maxCount != nil {
+				args["retryMaxCount"] = tt.maxCount
+			}
+
+			output := runIntegrateCheckout(t, args)
+
+			var code string
+			for _, f := range output.Files {
+				if f.Path == tt.path {
+					code = f.Code
+				}
+			}
+			if !assert.NotEmpty(t, code) {
+				return
+			}
+
+			assert.Contains(t, code, tt.wantRetry)
+			assert.Contains(t, output.AIInstructions, "PAYMENT RETRY")
+			if tt.wantGuarded {
+				assert.Contains(t, code, "let failedAttempts = 0;")
+				assert.Contains(t, code, "if (++failedAttempts <=")
+			} else {
+				assert.NotContains(t, code, "failedAttempts")
+			}
+		})
+	}
+
+	t.Run("disabled by default", func(t *testing.T) {
+		output := runIntegrateCheckout(t, map[string]interface{}{
+			"language":          "javascript",
+			"backendFramework":  "express",
+			"frontendFramework": "vanilla",
+		})
+		for _, f := range output.Files {
+			assert.NotContains(t, f.Code, "retry: {")
+		}
+		assert.NotContains(t, output.AIInstructions, "PAYMENT RETRY")
+	})
+}
+
+func TestIntegrateRazorpayCheckout_AmountInput(t *testing.T) {
+	tests := []struct {
+		name     string
+		backend  string
+		frontend string
+		path     string
+		wantUse  string
+	}{
+		{
+			name:     "vanilla",
+			backend:  "express",
+			frontend: "vanilla",
+			path:     "public/js/razorpay-amount-form.js",
+			wantUse:  "initiateRazorpayPayment(",
+		},
+		{
+			name:     "react",
+			backend:  "express",
+			frontend: "react",
+			path:     "src/components/RazorpayAmountForm.jsx",
+			wantUse:  "<RazorpayButton amount={amount}",
+		},
+		{
+			name:     "vue",
+			backend:  "flask",
+			frontend: "vue",
+			path:     "src/components/RazorpayAmountForm.vue",
+			wantUse:  ":amount=\"amount\"",
+		},
+		{
+			name:     "angular",
+			backend:  "django",
+			frontend: "angular",
+			path:     "src/app/components/razorpay-amount-form.component.ts",
+			wantUse:  "[amount]=\"amount\"",
+		},
+		{
+			name:     "svelte",
+			backend:  "gin",
+			frontend: "svelte",
+			path:     "src/components/RazorpayAmountForm.svelte",
+			wantUse:  "<RazorpayButton {amount}",
+		},
+		{
+			name:     "nextjs",
+			backend:  "nextjs",
+			frontend: "nextjs",
+			path:     "components/RazorpayAmountForm.tsx",
+			wantUse:  "<RazorpayCheckout amount={amount}",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			output := runIntegrateCheckout(t, map[string]interface{}{
+				"language":           "javascript",
+				"backendFramework":   tt.backend,
+				"frontendFramework":  tt.frontend,
+				"includeAmountInput": true,
+			})
+
+			var code string
+			for _, f := range output.Files {
+				if f.Path == tt.path {
+					code = f.Code
+				}
+			}
+			if !assert.NotEmpty(t, code) {
+				return
+			}
+
+			assert.Contains(t, code, tt.wantUse)
+			assert.Contains(t, code, `type="number"`)
+			assert.Contains(t, code, "Number.isFinite(amount) && amount > 0")
+			assert.Contains(t, code, "disabled")
+			assert.Contains(t, output.AIInstructions, "AMOUNT INPUT: Create "+tt.path)
+		})
+	}
+
+	t.Run("disabled by default", func(t *testing.T) {
+		output := runIntegrateCheckout(t, map[string]interface{}{
+			"language":          "javascript",
+			"backendFramework":  "express",
+			"frontendFramework": "vanilla",
+		})
+		for _, f := range output.Files {
+			assert.NotContains(t, f.Path, "amount-form")
+		}
+		assert.NotContains(t, output.AIInstructions, "AMOUNT INPUT")
+	})
+}
+
+func TestIntegrateRazorpayCheckout_Flutter(t *testing.T) {
+	tests := []struct {
+		name        string
+		args        map[string]interface{}
+		wantBackend string
+	}{
+		{
+			name: "paired with django",
+			args: map[string]interface{}{
+				"language":          "dart",
+				"backendFramework":  "django",
+				"frontendFramework": "flutter",
+			},
+			wantBackend: "backendFramework=django, language=python",
+		},
+		{
+			name: "flutter frontend with a go backend",
+			args: map[string]interface{}{
+				"language":          "go",
+				"backendFramework":  "gin",
+				"frontendFramework": "flutter",
+			},
+			wantBackend: "backendFramework=gin, language=go",
+		},
+		{
+			name: "dart without a known backend falls back to express",
+			args: map[string]interface{}{
+				"language":          "dart",
+				"backendFramework":  "",
+				"frontendFramework": "flutter",
+			},
+			wantBackend: "backendFramework=express, language=javascript",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			output := runIntegrateCheckout(t, tt.args)
+
+			var code string
+			for _, f := range output.Files {
+				assert.NotContains(t, f.Path, "RazorpayController")
+				assert.NotContains(t, f.Path, ".js")
+				if f.Path == "lib/services/razorpay_service.dart" {
+					code = f.Code
+				}
+			}
+			if !assert.NotEmpty(t, code) {
+				return
+			}
+
+			assert.Contains(t, code, "package:razorpay_flutter/razorpay_flutter.dart")
+			assert.Contains(t, code, "class RazorpayService")
+			assert.Contains(t, code, "'/api/razorpay/order'")
+			assert.Contains(t, code, "'/api/razorpay/verify'")
+			assert.NotContains(t, code, "rzp_")
+			assert.Contains(t, output.AIInstructions, "CLIENT ONLY")
+			assert.Contains(t, output.AIInstructions, tt.wantBackend)
+		})
+	}
+}
+
+func TestIntegrateRazorpayCheckout_ReactNative(t *testing.T) {
+	for _, backend := range []string{"express", "django", "gin"} {
+		t.Run(backend, func(t *testing.T) {
+			output := runIntegrateCheckout(t, map[string]interface{}{
+				"language":           "javascript",
+				"backendFramework":   backend,
+				"frontendFramework":  "react-native",
+				"includeAmountInput": true,
+			})
+
+			var code string
+			for _, f := range output.Files {
+				assert.NotContains(t, f.Path, "RazorpayAmountForm")
+				if f.Path == "src/RazorpayCheckout.js" {
+					code = f.Code
+				}
+			}
+			if !assert.NotEmpty(t, code) {
+				return
+			}
+
+			assert.Contains(t, code, "import RazorpayCheckout from 'react-native-razorpay';")
+			assert.Contains(t, code, "await RazorpayCheckout.open({")
+			assert.Contains(t, code, "API_BASE_URL + path")
+			assert.Contains(t, code, "'/api/razorpay/order'")
+			assert.Contains(t, code, "'/api/razorpay/verify'")
+			assert.NotContains(t, code, "window.")
+			assert.NotContains(t, code, "checkout.razorpay.com")
+			assert.Contains(t, output.AIInstructions, "REACT NATIVE:")
+		})
+	}
+
+	t.Run("detect stack suggests react-native", func(t *testing.T) {
+		out := detectProjectStack(map[string]interface{}{
+			"files": []interface{}{"package.json"},
+			"packageJson": map[string]interface{}{
+				"dependencies": map[string]interface{}{"expo": "~50.0.0"},
+			},
+		})
+		assert.Equal(t, "react-native", out.Framework)
+		assert.Contains(t, strings.Join(out.Notes, "\n"), "frontendFramework=react-native")
+	})
+}
+
+func TestIntegrateRazorpayCheckout_Chi(t *testing.T) {
+	for _, amountSource := range []string{"client", "server"} {
+		t.Run(amountSource, func(t *testing.T) {
+			output := runIntegrateCheckout(t, map[string]interface{}{
+				"language":          "go",
+				"backendFramework":  "chi",
+				"frontendFramework": "vanilla",
+				"amountSource":      amountSource,
+			})
+
+			var code string
+			for _, f := range output.Files {
+				if f.Path == "handlers/razorpay.go" {
+					code = f.Code
+				}
+			}
+			if !assert.NotEmpty(t, code) {
+				return
+			}
+
+			_, err := parser.ParseFile(token.NewFileSet(), "razorpay.go", code, 0)
+			assert.NoError(t, err)
+			assert.Contains(t, code, `"github.com/go-chi/chi/v5"`)
+			assert.Contains(t, code, "func RegisterRoutes(r chi.Router) {")
+			assert.Contains(t, code, `r.Post("/api/razorpay/order", CreateOrder)`)
+			assert.Contains(t, code, `r.Post("/api/razorpay/verify", VerifyPayment)`)
+			assert.Contains(t, code, "json.NewDecoder(r.Body).Decode(&req)")
+			assert.Contains(t, code, "client.Order.Create(data, nil)")
+			assert.Contains(t, output.AIInstructions, "handlers.RegisterRoutes(r)")
+			if amountSource == "server" {
+				assert.Contains(t, code, "productPrices[req.ProductID]")
+				assert.NotContains(t, code, `"math"`)
+			}
+		})
+	}
+}
+
+func TestIntegrateRazorpayCheckout_Remix(t *testing.T) {
+	output := runIntegrateCheckout(t, map[string]interface{}{
+		"language":           "typescript",
+		"backendFramework":   "remix",
+		"frontendFramework":  "react",
+		"brandName":          "Acme",
+		"includeAmountInput": true,
+	})
+
+	files := map[string]string{}
+	for _, f := range output.Files {
+		files[f.Path] = f.Code
+	}
+
+	for _, path := range []string{
+		"app/routes/api.razorpay.order.ts",
+		"app/routes/api.razorpay.verify.ts",
+	} {
+		code := files[path]
+		assert.Contains(t, code, "import { json, type ActionFunctionArgs } from '@remix-run/node';")
+		assert.Contains(t, code, "export async function action({ request }: ActionFunctionArgs) {")
+		assert.NotContains(t, code, "NextResponse")
+	}
+	assert.Contains(t, files["app/routes/api.razorpay.order.ts"], "razorpay.orders.create(")
+	assert.Contains(t, files["app/routes/api.razorpay.verify.ts"], "crypto.timingSafeEqual(")
+
+	component := files["app/components/RazorpayCheckout.tsx"]
+	assert.Contains(t, component, "https://checkout.razorpay.com/v1/checkout.js")
+	assert.Contains(t, component, "name: 'Acme',")
+	assert.NotContains(t, component, "'use client'")
+	assert.Contains(t, files["app/routes/pay.tsx"], "from '~/components/RazorpayCheckout'")
+
+	form := files["app/components/RazorpayAmountForm.tsx"]
+	assert.Contains(t, form, "import { RazorpayCheckout } from './RazorpayCheckout';")
+	assert.NotContains(t, form, "'use client'")
+
+	assert.NotContains(t, files, "types/razorpay.d.ts")
+	assert.NotContains(t, files, "src/components/RazorpayButton.jsx")
+}
+
+func TestIntegrateRazorpayCheckout_Nuxt(t *testing.T) {
+	output := runIntegrateCheckout(t, map[string]interface{}{
+		"language":           "typescript",
+		"backendFramework":   "nuxt",
+		"frontendFramework":  "vue",
+		"brandName":          "Acme",
+		"includeAmountInput": true,
+	})
+
+	files := map[string]string{}
+	for _, f := range output.Files {
+		files[f.Path] = f.Code
+	}
+
+	for _, path := range []string{
+		"server/api/razorpay/order.post.ts",
+		"server/api/razorpay/verify.post.ts",
+	} {
+		code := files[path]
+		assert.Contains(t, code, "export default defineEventHandler(async (event) => {")
+		assert.Contains(t, code, "await readBody(event);")
+	}
+	assert.Contains(t, files["server/api/razorpay/order.post.ts"], "razorpay.orders.create(")
+	assert.Contains(t, files["server/api/razorpay/verify.post.ts"], "crypto.timingSafeEqual(")
+
+	composable := files["composables/useRazorpay.ts"]
+	assert.Contains(t, composable, "export function useRazorpay() {")
+	assert.Contains(t, composable, "https://checkout.razorpay.com/v1/checkout.js")
+	assert.Contains(t, composable, "name: 'Acme',")
+	assert.Contains(t, files["components/RazorpayButton.vue"], "useRazorpay();")
+
+	form := files["components/RazorpayAmountForm.vue"]
+	assert.Contains(t, form, "import RazorpayButton from './RazorpayButton.vue';")
+
+	assert.NotContains(t, files, "src/components/RazorpayButton.vue")
+	assert.NotContains(t, files, "src/components/RazorpayAmountForm.vue")
+}
+
+func TestIntegrateRazorpayCheckout_Astro(t *testing.T) {
+	output := runIntegrateCheckout(t, map[string]interface{}{
+		"language":           "typescript",
+		"backendFramework":   "astro",
+		"frontendFramework":  "vanilla",
+		"brandName":          "Acme",
+		"includeAmountInput": true,
+	})
+
+	files := map[string]string{}
+	for _, f := range output.Files {
+		files[f.Path] = f.Code
+	}
+
+	for _, path := range []string{
+		"src/pages/api/razorpay/order.ts",
+		"src/pages/api/razorpay/verify.ts",
+	} {
+		code := files[path]
+		assert.Contains(t, code, "import type { APIRoute } from 'astro';")
+		assert.Contains(t, code, "export const POST: APIRoute = async ({ request }) => {")
+		assert.Contains(t, code, "export const prerender = false;")
+	}
+	assert.Contains(t, files["src/pages/api/razorpay/order.ts"], "razorpay.orders.create(")
+	assert.Contains(t, files["src/pages/api/razorpay/verify.ts"], "crypto.timingSafeEqual(")
+
+	component := files["src/components/RazorpayCheckout.astro"]
+	assert.Contains(t, component, "https://checkout.razorpay.com/v1/checkout.js")
+	assert.Contains(t, component, "name: 'Acme',")
+	assert.Contains(t, component, "data-amount={amount}")
+
+	form := files["src/components/RazorpayAmountForm.astro"]
+	assert.Contains(t, form, "import RazorpayCheckout from './RazorpayCheckout.astro';")
+	assert.Contains(t, form, "function parseAmount(value)")
+
+	assert.NotContains(t, files, "types/razorpay.d.ts")
+	assert.NotContains(t, files, "public/js/razorpay.js")
+}
+
+func TestIntegrateRazorpayCheckout_Branding(t *testing.T) {
+	tests := []struct {
+		name        string
+		backend     string
+		frontend    string
+		path        string
+		args        map[string]interface{}
+		contains    []string
+		notContains []string
+	}{
+		{
+			name:     "vanilla replaces the defaults",
+			backend:  "express",
+			frontend: "vanilla",
+			path:     "public/js/razorpay.js",
+			args: map[string]interface{}{
+				"brandName":  "Acme's Store",
+				"brandLogo":  "https://cdn.example.com/logo.png",
+				"themeColor": "#F37254",
+			},
+			contains: []string{
+				`name: 'Acme\'s Store',`,
+				"image: 'https://cdn.example.com/logo.png',",
+				"theme: { color: '#F37254' },",
+			},
+			notContains: []string{"document.title || 'Payment'", "#528FF0"},
+		},
+		{
+			name:     "nextjs keeps the default name when only color is set",
+			backend:  "nextjs",
+			frontend: "nextjs",
+			path:     "components/RazorpayCheckout.tsx",
+			args:     map[string]interface{}{"themeColor": "#000000"},
+			contains: []string{
+				"name: 'Payment',",
+				"theme: { color: '#000000' },",
+			},
+			notContains: []string{"#528FF0", "image:"},
+		},
+		{
+			name:     "react gains the name it did not set",
+			backend:  "express",
+			frontend: "react",
+			path:     "src/components/RazorpayButton.jsx",
+			args:     map[string]interface{}{"brandName": "Costs $5"},
+			contains: []string{"name: 'Costs $5',"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			args := map[string]interface{}{
+				"language":          "javascript",
+				"backendFramework":  tt.backend,
+				"frontendFramework": tt.frontend,
+			}
+			for k, v := range tt.args {
+				args[k] = v
+			}
+
+			var code string
+			for _, f := range runIntegrateCheckout(t, args).Files {
+				if f.Path == tt.path {
+					code = f.Code
+				}
+			}
+			if !assert.NotEmpty(t, code) {
+				return
+			}
+
+			for _, want := range tt.contains {
+				assert.Contains(t, code, want)
+			}
+			for _, unwanted := range tt.notContains {
+				assert.NotContains(t, code, unwanted)
+			}
+		})
+	}
+}
+
+func TestIntegrateRazorpayCheckout_Adonis(t *testing.T) {
+	output := runIntegrateCheckout(t, map[string]interface{}{
+		"language":          "typescript",
+		"backendFramework":  "adonis",
+		"frontendFramework": "vanilla",
+	})
+
+	files := map[string]FileAction{}
+	for _, f := range output.Files {
+		files[f.Path] = f
+	}
+
+	controller, ok := files["app/Controllers/Http/RazorpayController.ts"]
+	if !assert.True(t, ok) {
+		return
+	}
+	assert.Contains(t, controller.Code,
+		"import Env from '@ioc:Adonis/Core/Env'")
+	assert.Contains(t, controller.Code,
+		"expectedBuffer.length === receivedBuffer.length")
+	assert.NotContains(t, controller.Code, "process.env")
+
+	routes, ok := files["start/routes.ts"]
+	if assert.True(t, ok) {
+		assert.Equal(t, "manual_edit", routes.Action)
+		assert.Contains(t, routes.Edits[0].Add, "RazorpayController.createOrder")
+		assert.Contains(t, routes.Edits[1].Add, "RazorpayController.verify")
+	}
+	assert.Contains(t, files, "env.ts")
+}
+
+// runIntegrateCheckout calls the integrate_razorpay_checkout tool and decodes
+// its output
+func runIntegrateCheckout(
+	t *testing.T,
+	args map[string]interface{},
+) IntegrateCheckoutOutput {
+	t.Helper()
+
+	tool := IntegrateRazorpayCheckout(nil, nil)
+	result, err := tool.GetHandler()(
+		context.Background(),
+		mcpgo.CallToolRequest{Arguments: args},
+	)
+	assert.NoError(t, err)
+	assert.False(t, result.IsError)
+
+	var output IntegrateCheckoutOutput
+	assert.NoError(t, json.Unmarshal([]byte(result.Text), &output))
+	return output
+}
+
+// findFile returns the generated file with the given path, or nil
+func findFile(files []FileAction, path string) *FileAction {
+	for i := range files {
+		if files[i].Path == path {
+			return &files[i]
+		}
+	}
+	return nil
+}
+
+func TestContainsPath(t *testing.T) {
+	tests := []struct {
+		name  string
+		files []string
+		path  string
+		want  bool
+	}{
+		{
+			name:  "exact filename",
+			files: []string{"app.py"},
+			path:  "app.py",
+			want:  true,
+		},
+		{
+			name:  "filename in subdirectory",
+			files: []string{"src/app.py"},
+			path:  "app.py",
+			want:  true,
+		},
+		{
+			name:  "filename with matching suffix",
+			files: []string{"src/webapp.py"},
+			path:  "app.py",
+			want:  false,
+		},
+		{
+			name:  "filename with matching prefix",
+			files: []string{"app.python_notes.txt"},
+			path:  "app.py",
+			want:  false,
+		},
+		{
+			name:  "directory named like the file",
+			files: []string{"manage.py/readme.md"},
+			path:  "manage.py",
+			want:  false,
+		},
+		{
+			name:  "multi-segment path",
+			files: []string{"backend/config/routes.rb"},
+			path:  "config/routes.rb",
+			want:  true,
+		},
+		{
+			name:  "multi-segment path with partial directory",
+			files: []string{"myconfig/routes.rb"},
+			path:  "config/routes.rb",
+			want:  false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, containsPath(tt.files, tt.path))
+		})
+	}
+}
+
+func TestDetectProjectStack_FlaskAppPy(t *testing.T) {
+	webapp := detectProjectStack(map[string]interface{}{
+		"files": []interface{}{"requirements.txt", "src/webapp.py"},
+	})
+	assert.Equal(t, "python-stdlib", webapp.Framework)
+	for _, s := range webapp.Signals {
+		assert.NotEqual(t, "found app.py", s.Value)
+	}
+
+	app := detectProjectStack(map[string]interface{}{
+		"files": []interface{}{"requirements.txt", "src/app.py"},
+	})
+	assert.Equal(t, "flask", app.Framework)
+}
+
+func TestIntegrateRazorpayCheckout_SignatureLengthGuard(t *testing.T) {
+	tests := []struct {
+		name     string
+		backend  string
+		language string
+	}{
+		{name: "express", backend: "express", language: "javascript"},
+		{name: "nextjs", backend: "nextjs", language: "typescript"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			output := runIntegrateCheckout(t, map[string]interface{}{
+				"language":          tt.language,
+				"backendFramework":  tt.backend,
+				"frontendFramework": "vanilla",
+			})
+
+			found := false
+			for _, f := range output.Files {
+				if !strings.Contains(f.Code, "timingSafeEqual") {
+					continue
+				}
+				found = true
+				assert.Contains(t, f.Code,
+					"expectedBuffer.length === receivedBuffer.length &&")
+			}
+			assert.True(t, found, "no file verifies the signature")
+		})
+	}
+}
+
+func TestIntegrateRazorpayCheckout_ServerOrderDataStrategy(t *testing.T) {
+	tests := []struct {
+		name           string
+		strategy       string
+		wantServerData bool
+	}{
+		{name: "default keeps order data in localStorage", strategy: ""},
+		{name: "client keeps order data in localStorage", strategy: "client"},
+		{
+			name:           "server stores order data by order id",
+			strategy:       "server",
+			wantServerData: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			args := map[string]interface{}{
+				"language":          "javascript",
+				"backendFramework":  "express",
+				"frontendFramework": "vanilla",
+			}
+			if tt.strategy != "" {
+				args["orderDataStrategy"] = tt.strategy
+			}
+			output := runIntegrateCheckout(t, args)
+
+			var routes, wiring string
+			for _, f := range output.Files {
+				switch {
+				case f.Path == "routes/razorpay.js":
+					routes = f.Code
+				case f.Action == "wire_payment":
+					wiring = f.Code
+				}
+			}
+
+			assert.Equal(t, tt.wantServerData,
+				strings.Contains(routes, "pendingOrders.set(order.id"))
+			assert.Equal(t, tt.wantServerData,
+				strings.Contains(routes, "pendingOrders.get(razorpay_order_id)"))
+			assert.Equal(t, !tt.wantServerData,
+				strings.Contains(wiring, "localStorage.setItem"))
+		})
+	}
+}
+
+func TestGetGinIntegration_AmountRounding(t *testing.T) {
+	frontend := getFrontendIntegration("vanilla", Credentials{})
+
+	output := getGinIntegration(Credentials{}, frontend, "client")
+	handler := output.Files[0].Code
+	assert.Contains(t, handler, "int(math.Round(req.Amount * multiplier))")
+	assert.Contains(t, handler, "\t\"math\"\n")
+	assert.NotContains(t, handler, "int(req.Amount * 100)")
+
+	// Server-side amounts are integer paise and must not import math
+	output = getGinIntegration(Credentials{}, frontend, "server")
+	handler = output.Files[0].Code
+	assert.NotContains(t, handler, "math.Round")
+	assert.NotContains(t, handler, "\t\"math\"\n")
+}
+
+func TestGoIntegrations_SDKSignatureVerification(t *testing.T) {
+	frontend := getFrontendIntegration("vanilla", Credentials{})
+	generators := map[string]func(
+		Credentials, FrontendIntegration, string,
+	) IntegrateCheckoutOutput{
+		"gin":   getGinIntegration,
+		"echo":  getEchoIntegration,
+		"fiber": getFiberIntegration,
+	}
+
+	for name, generate := range generators {
+		t.Run(name, func(t *testing.T) {
+			handler := generate(Credentials{}, frontend, "client").Files[0].Code
+			assert.Contains(t, handler, "utils.VerifyPaymentSignature(")
+			assert.Contains(t, handler,
+				"\t\"github.com/razorpay/razorpay-go/utils\"\n")
+			// The manual HMAC survives only as a commented fallback
+			assert.Contains(t, handler, "//\th := hmac.New(sha256.New")
+			assert.NotContains(t, handler, "\t\"crypto/hmac\"\n")
+		})
+	}
+}
+
+func TestPythonIntegrations_SDKSignatureVerification(t *testing.T) {
+	frontend := getFrontendIntegration("vanilla", Credentials{})
+	generators := map[string]func(
+		Credentials, FrontendIntegration,
+	) IntegrateCheckoutOutput{
+		"django":  getDjangoIntegration,
+		"flask":   getFlaskIntegration,
+		"fastapi": getFastAPIIntegration,
+	}
+
+	for name, generate := range generators {
+		t.Run(name, func(t *testing.T) {
+			code := ""
+			for _, f := range generate(Credentials{}, frontend).Files {
+				code += f.Code
+			}
+			assert.Contains(t, code, "client.utility.verify_payment_signature({")
+			assert.Contains(t, code,
+				"except razorpay.errors.SignatureVerificationError:")
+			assert.NotContains(t, code, "hmac.new(")
+			assert.NotContains(t, code, "import hashlib")
+		})
+	}
+}
+
+func TestIntegrateRazorpayCheckout_CurrencyMultipliers(t *testing.T) {
+	tests := []struct {
+		backend  string
+		language string
+		want     string
+	}{
+		{backend: "express", language: "javascript", want: "CURRENCY_MULTIPLIERS"},
+		{backend: "nextjs", language: "typescript", want: "CURRENCY_MULTIPLIERS"},
+		{backend: "django", language: "python", want: "CURRENCY_MULTIPLIERS"},
+		{backend: "flask", language: "python", want: "CURRENCY_MULTIPLIERS"},
+		{backend: "fastapi", language: "python", want: "CURRENCY_MULTIPLIERS"},
+		{backend: "gin", language: "go", want: "currencyMultipliers"},
+		{backend: "echo", language: "go", want: "currencyMultipliers"},
+		{backend: "fiber", language: "go", want: "currencyMultipliers"},
+		{backend: "chi", language: "go", want: "currencyMultipliers"},
+		{backend: "rails", language: "ruby", want: "CURRENCY_MULTIPLIERS"},
+		{backend: "spring", language: "java", want: "CURRENCY_MULTIPLIERS"},
+		{backend: "aspnet", language: "csharp", want: "CurrencyMultipliers"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.backend, func(t *testing.T) {
+			output := runIntegrateCheckout(t, map[string]interface{}{
+				"language":          tt.language,
+				"backendFramework":  tt.backend,
+				"frontendFramework": "vanilla",
+			})
+
+			found := false
+			for _, f := range output.Files {
+				if !strings.Contains(f.Code, tt.want) {
+					continue
+				}
+				found = true
+				assert.Contains(t, f.Code, "INR=100")
+				assert.Contains(t, f.Code, "Unsupported currency")
+				assert.NotRegexp(t, `[Aa]mount \* 100\b`, f.Code)
+			}
+			assert.True(t, found, "no file defines %s", tt.want)
+		})
+	}
+}
+
+func TestIntegrateRazorpayWebhook(t *testing.T) {
+	frameworks := []string{
+		"express", "nextjs", "django", "flask", "fastapi",
+		"gin", "echo", "fiber", "rails", "spring", "aspnet",
+	}
+
+	for _, framework := range frameworks {
+		t.Run(framework, func(t *testing.T) {
+			tool := IntegrateRazorpayWebhook(nil, nil)
+			result, err := tool.GetHandler()(
+				context.Background(),
+				mcpgo.CallToolRequest{Arguments: map[string]interface{}{
+					"backendFramework": framework,
+				}},
+			)
+			assert.NoError(t, err)
+			assert.False(t, result.IsError)
+
+			var output IntegrateCheckoutOutput
+			assert.NoError(t, json.Unmarshal([]byte(result.Text), &output))
+			assert.Contains(t, output.EnvVars, EnvVar{
+				Name:  "RAZORPAY_WEBHOOK_SECRET",
+				Value: "YOUR_WEBHOOK_SECRET",
+			})
+
+			code := output.Files[0].Code
+			assert.Contains(t, strings.ToLower(code), "x-razorpay-signature")
+			for _, event := range []string{
+				"payment.captured", "payment.failed", "order.paid",
+			} {
+				assert.Contains(t, code, event)
+				assert.Contains(t, webhookEvents(output), event)
+			}
+			assert.Equal(t, webhookDashboardURL, output.WebhookDashboardURL)
+
+			if strings.HasSuffix(output.Files[0].Path, ".go") {
+				_, err := parser.ParseFile(
+					token.NewFileSet(), output.Files[0].Path, code, 0)
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestIntegrateRazorpaySubscription(t *testing.T) {
+	frameworks := []string{
+		"express", "nextjs", "django", "flask", "fastapi",
+		"gin", "echo", "fiber", "rails", "spring", "aspnet",
+	}
+
+	for _, framework := range frameworks {
+		t.Run(framework, func(t *testing.T) {
+			tool := IntegrateRazorpaySubscription(nil, nil)
+			result, err := tool.GetHandler()(
+				context.Background(),
+				mcpgo.CallToolRequest{Arguments: map[string]interface{}{
+					"backendFramework": framework,
+				}},
+			)
+			assert.NoError(t, err)
+			assert.False(t, result.IsError)
+
+			var output IntegrateCheckoutOutput
+			assert.NoError(t, json.Unmarshal([]byte(result.Text), &output))
+			assert.Contains(t, output.EnvVars, EnvVar{
+				Name:  "RAZORPAY_PLAN_ID",
+				Value: "plan_YOUR_PLAN_ID",
+			})
+
+			var all strings.Builder
+			for _, f := range output.Files {
+				all.WriteString(f.Code)
+				if f.Action == "create" && strings.HasSuffix(f.Path, ".go") {
+					_, err := parser.ParseFile(token.NewFileSet(), f.Path, f.Code, 0)
+					assert.NoError(t, err)
+				}
+			}
+			code := all.String()
+			assert.Contains(t, code, "subscription_id")
+			assert.Contains(t, code, "razorpay_subscription_id")
+			assert.NotContains(t, code, "razorpay_order_id")
+			assert.Contains(t, webhookEvents(output), "subscription.charged")
+			assert.Contains(t, webhookEvents(output), "subscription.halted")
+		})
+	}
+}
+
+func TestIntegrateRazorpaySubscription_StrictEnv(t *testing.T) {
+	tool := IntegrateRazorpaySubscription(nil, nil)
+	result, err := tool.GetHandler()(
+		context.Background(),
+		mcpgo.CallToolRequest{Arguments: map[string]interface{}{
+			"backendFramework": "nextjs",
+			"strictEnv":        true,
+		}},
+	)
+	assert.NoError(t, err)
+	assert.False(t, result.IsError)
+
+	var output IntegrateCheckoutOutput
+	assert.NoError(t, json.Unmarshal([]byte(result.Text), &output))
+
+	config := findFile(output.Files, "lib/razorpay-config.ts")
+	if assert.NotNil(t, config) {
+		assert.Contains(t, config.Code, "export function requireEnv(")
+	}
+	create := findFile(output.Files, "app/api/razorpay/subscription/route.ts")
+	if assert.NotNil(t, create) {
+		assert.Contains(t, create.Code,
+			"from '../../../../lib/razorpay-config';")
+		assert.Contains(t, create.Code, "plan_id: requireEnv('RAZORPAY_PLAN_ID'),")
+	}
+	verify := findFile(output.Files, "app/api/razorpay/subscription/verify/route.ts")
+	if assert.NotNil(t, verify) {
+		assert.Contains(t, verify.Code,
+			"import { razorpayConfig } from '../../../../../lib/razorpay-config';")
+		assert.Contains(t, verify.Code, "createHmac('sha256', razorpayConfig.keySecret)")
+	}
+	for _, f := range output.Files {
+		for _, name := range []string{"KEY_ID", "KEY_SECRET", "PLAN_ID"} {
+			assert.NotContains(t, f.Code, "process.env.RAZORPAY_"+name+"!", f.Path)
+		}
+	}
+}
+
+func TestIntegrateRazorpayCheckout_EnvCheck(t *testing.T) {
+	tests := []struct {
+		backend  string
+		language string
+		wantPath string
+	}{
+		{backend: "express", language: "javascript", wantPath: "scripts/check-env.cjs"},
+		{backend: "nextjs", language: "typescript", wantPath: "scripts/check-env.cjs"},
+		{backend: "flask", language: "python", wantPath: "scripts/check_env.py"},
+		{backend: "gin", language: "go", wantPath: "scripts/checkenv/main.go"},
+		{backend: "spring", language: "java", wantPath: "scripts/check-env.sh"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.backend, func(t *testing.T) {
+			args := map[string]interface{}{
+				"language":          tt.language,
+				"backendFramework":  tt.backend,
+				"frontendFramework": "vanilla",
+			}
+
+			for _, f := range runIntegrateCheckout(t, args).Files {
+				assert.NotEqual(t, tt.wantPath, f.Path)
+			}
+
+			args["includeEnvCheck"] = true
+			var script *FileAction
+			for _, f := range runIntegrateCheckout(t, args).Files {
+				if f.Path == tt.wantPath {
+					script = &f
+				}
+			}
+			if !assert.NotNil(t, script, "env check script not emitted") {
+				return
+			}
+			assert.Contains(t, script.Code, "rzp_")
+			assert.Contains(t, script.Code, "PASS")
+			assert.Contains(t, script.Code, "FAIL")
+
+			if strings.HasSuffix(script.Path, ".go") {
+				_, err := parser.ParseFile(
+					token.NewFileSet(), script.Path, script.Code, 0)
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestIntegrateRazorpayCheckout_RateLimit(t *testing.T) {
+	tests := []struct {
+		backend    string
+		language   string
+		dependency string
+		contains   []string
+	}{
+		{
+			backend:    "express",
+			language:   "javascript",
+			dependency: "express-rate-limit",
+			contains: []string{
+				"const rateLimit = require('express-rate-limit');",
+				"router.post('/order', orderRateLimit, async (req, res) => {",
+				"router.post('/verify', (req, res) => {",
+			},
+		},
+		{
+			backend:    "fastapi",
+			language:   "python",
+			dependency: "slowapi",
+			contains: []string{
+				"@limiter.limit(\"10/minute\")\nasync def create_order(request: Request, req: OrderRequest):",
+				"app.add_exception_handler(RateLimitExceeded, _rate_limit_exceeded_handler)",
+			},
+		},
+		{
+			backend:    "flask",
+			language:   "python",
+			dependency: "Flask-Limiter",
+			contains: []string{
+				"@app.route('/api/razorpay/order', methods=['POST'])\n@limiter.limit('10 per minute')\n",
+			},
+		},
+		{
+			backend:    "gin",
+			language:   "go",
+			dependency: "x/time",
+			contains: []string{
+				`r.POST("/api/razorpay/order", handlers.OrderRateLimit(), handlers.CreateOrder)`,
+				"rate.NewLimiter(rate.Every(orderRateLimitEvery), orderRateLimitBurst)",
+			},
+		},
+		{
+			backend:    "echo",
+			language:   "go",
+			dependency: "x/time",
+			contains: []string{
+				`e.POST("/api/razorpay/order", handlers.CreateOrder, handlers.OrderRateLimit())`,
+				"middleware.RateLimiterWithConfig(",
+			},
+		},
+		{
+			backend:  "fiber",
+			language: "go",
+			contains: []string{
+				`app.Post("/api/razorpay/order", handlers.OrderRateLimit(), handlers.CreateOrder)`,
+				"limiter.New(limiter.Config{",
+			},
+		},
+		{
+			backend:    "chi",
+			language:   "go",
+			dependency: "httprate",
+			contains: []string{
+				`)).Post("/api/razorpay/order", CreateOrder)`,
+				`r.Post("/api/razorpay/verify", VerifyPayment)`,
+			},
+		},
+		{
+			backend:  "django",
+			language: "python",
+			contains: []string{"django-ratelimit"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.backend, func(t *testing.T) {
+			output := runIntegrateCheckout(t, map[string]interface{}{
+				"language":          tt.language,
+				"backendFramework":  tt.backend,
+				"frontendFramework": "vanilla",
+				"includeRateLimit":  true,
+			})
+
+			var all strings.Builder
+			for _, f := range output.Files {
+				all.WriteString(f.Code)
+				for _, e := range f.Edits {
+					all.WriteString(e.Add + "\n")
+				}
+				if f.Action == "create" && strings.HasSuffix(f.Path, ".go") {
+					_, err := parser.ParseFile(token.NewFileSet(), f.Path, f.Code, 0)
+					assert.NoError(t, err, f.Path)
+				}
+			}
+			all.WriteString(output.AIInstructions)
+			for _, want := range tt.contains {
+				assert.Contains(t, all.String(), want)
+			}
+
+			var deps []string
+			for _, d := range output.Dependencies {
+				deps = append(deps, d.Name)
+			}
+			if tt.dependency != "" {
+				assert.Contains(t, deps, tt.dependency)
+			}
+			assert.Contains(t, output.AIInstructions, "RATE LIMIT:")
+		})
+	}
+}
+
+func TestIntegrateRazorpayCheckout_Telemetry(t *testing.T) {
+	tests := []struct {
+		backend    string
+		language   string
+		rateLimit  bool
+		dependency string
+		contains   []string
+	}{
+		{
+			backend:    "express",
+			language:   "javascript",
+			dependency: "@opentelemetry/sdk-node",
+			contains: []string{
+				"router.post('/order', traceRazorpay('razorpay.order.create'), async (req, res) => {",
+				"router.post('/verify', traceRazorpay('razorpay.payment.verify'), (req, res) => {",
+				"new NodeSDK({",
+				"node --require ./tracing.js server.js",
+			},
+		},
+		{
+			backend:    "express",
+			language:   "javascript",
+			rateLimit:  true,
+			dependency: "express-rate-limit",
+			contains: []string{
+				"router.post('/order', traceRazorpay('razorpay.order.create'), orderRateLimit, async (req, res) => {",
+			},
+		},
+		{
+			backend:    "fastapi",
+			language:   "python",
+			dependency: "opentelemetry-distro",
+			contains: []string{
+				"@router.post(\"/order\")\n@traced(\"razorpay.order.create\")\nasync def create_order(",
+				"@router.post(\"/verify\")\n@traced(\"razorpay.payment.verify\")\n",
+				"opentelemetry-instrument uvicorn main:app",
+			},
+		},
+		{
+			backend:    "flask",
+			language:   "python",
+			dependency: "opentelemetry-distro",
+			contains: []string{
+				"@app.route('/api/razorpay/order', methods=['POST'])\n@traced('razorpay.order.create')\n",
+				"@app.route('/api/razorpay/verify', methods=['POST'])\n@traced('razorpay.payment.verify')\n",
+			},
+		},
+		{
+			backend:  "gin",
+			language: "go",
+			contains: []string{"otelgin.Middleware"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.backend, func(t *testing.T) {
+			output := runIntegrateCheckout(t, map[string]interface{}{
+				"language":          tt.language,
+				"backendFramework":  tt.backend,
+				"frontendFramework": "vanilla",
+				"includeTelemetry":  true,
+				"includeRateLimit":  tt.rateLimit,
+			})
+
+			var all strings.Builder
+			for _, f := range output.Files {
+				all.WriteString(f.Code)
+			}
+			all.WriteString(output.AIInstructions)
+			for _, want := range tt.contains {
+				assert.Contains(t, all.String(), want)
+			}
+
+			var deps []string
+			for _, d := range output.Dependencies {
+				deps = append(deps, d.Name)
+			}
+			if tt.dependency != "" {
+				assert.Contains(t, deps, tt.dependency)
+			}
+			assert.Contains(t, output.AIInstructions, "TELEMETRY:")
+		})
+	}
+
+	output := runIntegrateCheckout(t, map[string]interface{}{
+		"language":          "javascript",
+		"backendFramework":  "express",
+		"frontendFramework": "vanilla",
+	})
+	assert.NotContains(t, output.AIInstructions, "TELEMETRY:")
+	for _, f := range output.Files {
+		assert.NotContains(t, f.Code, "@opentelemetry/api")
+	}
+}
+
+func TestIntegrateRazorpayCheckout_DryRun(t *testing.T) {
+	args := map[string]interface{}{
+		"language":           "javascript",
+		"backendFramework":   "express",
+		"frontendFramework":  "vanilla",
+		"includeAmountInput": true,
+	}
+	full := runIntegrateCheckout(t, args)
+
+	args["dryRun"] = true
+	plan := runIntegrateCheckout(t, args)
+
+	if !assert.Len(t, plan.Files, len(full.Files)) {
+		return
+	}
+	for i, f := range plan.Files {
+		assert.Equal(t, full.Files[i].Path, f.Path)
+		assert.Equal(t, full.Files[i].Action, f.Action)
+		assert.Equal(t, full.Files[i].Description, f.Description)
+		assert.Empty(t, f.Code, f.Path)
+		assert.Empty(t, f.Edits, f.Path)
+	}
+	assert.Equal(t, full.Dependencies, plan.Dependencies)
+	assert.Equal(t, full.EnvVars, plan.EnvVars)
+	assert.True(t, strings.HasPrefix(plan.Summary, "Dry run: "))
+	assert.True(t, strings.HasPrefix(plan.AIInstructions, "DRY RUN"))
+
+	actions := map[string]bool{}
+	for _, step := range plan.Steps {
+		actions[step.Action] = true
+		assert.NotContains(t, step.Detail, "require(")
+	}
+	for _, action := range []string{"install", "set_env", "create_file", "edit_file", "wire_payment"} {
+		assert.True(t, actions[action], action)
+	}
+	assert.False(t, actions["note"])
+
+	raw, err := json.Marshal(plan)
+	assert.NoError(t, err)
+	assert.NotContains(t, string(raw), "razorpay.orders.create")
+}
+
+func TestIntegrateRazorpayCheckout_ExpressTypeScript(t *testing.T) {
+	for _, strategy := range []string{"client", "server"} {
+		t.Run(strategy, func(t *testing.T) {
+			output := runIntegrateCheckout(t, map[string]interface{}{
+				"language":          "typescript",
+				"backendFramework":  "express",
+				"frontendFramework": "vanilla",
+				"orderDataStrategy": strategy,
+			})
+
+			routes := findFile(output.Files, "routes/razorpay.ts")
+			if !assert.NotNil(t, routes) {
+				return
+			}
+			assert.NotContains(t, routes.Code, "require(")
+			assert.NotContains(t, routes.Code, "module.exports")
+			assert.NotContains(t, routes.Code, "return res.")
+			for _, want := range []string{
+				"import express, { Request, Response } from 'express';",
+				"import Razorpay from 'razorpay';",
+				"const CURRENCY_MULTIPLIERS: Record<string, number> = {",
+				"router.post('/order', async (req: Request, res: Response) => {",
+				"= req.body as OrderRequestBody;",
+				"= req.body as VerifyRequestBody;",
+				"export default router;",
+			} {
+				assert.Contains(t, routes.Code, want)
+			}
+			if strategy == "server" {
+				assert.Contains(t, routes.Code, "new Map<string, PendingOrder>()")
+				assert.Contains(t, routes.Code, "orderData?: Record<string, unknown>;")
+			} else {
+				assert.NotContains(t, routes.Code, "orderData")
+			}
+
+			server := findFile(output.Files, "server.ts")
+			if assert.NotNil(t, server) {
+				assert.Equal(t, "import 'dotenv/config';", server.Edits[0].Add)
+				assert.Equal(t, "import razorpayRoutes from './routes/razorpay';", server.Edits[1].Add)
+			}
+			assert.NotContains(t, output.AIInstructions, "require('./routes/razorpay')")
+		})
+	}
+
+	js := runIntegrateCheckout(t, map[string]interface{}{
+		"language":          "javascript",
+		"backendFramework":  "express",
+		"frontendFramework": "vanilla",
+	})
+	routes := findFile(js.Files, "routes/razorpay.js")
+	if assert.NotNil(t, routes) {
+		assert.Contains(t, routes.Code, "const express = require('express');")
+		assert.Contains(t, routes.Code, "module.exports = router;")
+	}
+	assert.NotNil(t, findFile(js.Files, "server.js"))
+}
+
+func TestIntegrateRazorpayCheckout_Steps(t *testing.T) {
+	output := runIntegrateCheckout(t, map[string]interface{}{
+		"language":           "javascript",
+		"backendFramework":   "express",
+		"frontendFramework":  "vanilla",
+		"includeAmountInput": true,
+	})
+
+	if !assert.NotEmpty(t, output.Steps) {
+		return
+	}
+
+	byAction := map[string][]IntegrationStep{}
+	for i, s := range output.Steps {
+		assert.Equal(t, i+1, s.Order)
+		assert.NotEmpty(t, s.Detail)
+		byAction[s.Action] = append(byAction[s.Action], s)
+	}
+
+	assert.Contains(t, byAction["install"], IntegrationStep{
+		Order:  output.Steps[0].Order,
+		Action: "install",
+		Target: "razorpay",
+		Detail: "npm install razorpay",
+	})
+	var created []string
+	for _, s := range byAction["create_file"] {
+		created = append(created, s.Target)
+	}
+	assert.Contains(t, created, "routes/razorpay.js")
+	assert.Contains(t, created, "public/js/razorpay-amount-form.js")
+	assert.NotEmpty(t, byAction["edit_file"])
+	assert.NotEmpty(t, byAction["set_env"])
+
+	// The discovery text is split into one step per numbered section
+	if assert.NotEmpty(t, byAction["wire_payment"]) {
+		assert.True(t, strings.HasPrefix(
+			byAction["wire_payment"][0].Detail, "1. FIND THE CHECKOUT"))
+	}
+
+	var notes strings.Builder
+	for _, s := range byAction["note"] {
+		notes.WriteString(s.Detail + "\n")
+	}
+	assert.Contains(t, notes.String(), "AMOUNT INPUT:")
+
+	// AIInstructions is rendered from the steps
+	assert.True(t, strings.HasPrefix(output.AIInstructions,
+		"Apply ALL of these steps in order"))
+	assert.Contains(t, output.AIInstructions,
+		"1. [install] razorpay: npm install razorpay")
+	assert.Contains(t, output.AIInstructions, "AMOUNT INPUT:")
+}
+
+func TestIntegrateRazorpayCheckout_VueStyle(t *testing.T) {
+	vueDeps := func(version string) map[string]interface{} {
+		return map[string]interface{}{
+			"dependencies": map[string]interface{}{"vue": version},
+		}
+	}
+
+	tests := []struct {
+		name        string
+		args        map[string]interface{}
+		wantOptions bool
+	}{
+		{
+			name:        "defaults to composition",
+			args:        map[string]interface{}{},
+			wantOptions: false,
+		},
+		{
+			name:        "vue 3 uses composition",
+			args:        map[string]interface{}{"packageJson": vueDeps("^3.4.21")},
+			wantOptions: false,
+		},
+		{
+			name:        "vue 2 uses options",
+			args:        map[string]interface{}{"packageJson": vueDeps("~2.6.14")},
+			wantOptions: true,
+		},
+		{
+			name: "explicit style wins over the version",
+			args: map[string]interface{}{
+				"packageJson": vueDeps("^2.7.16"),
+				"vueStyle":    "composition",
+			},
+			wantOptions: false,
+		},
+		{
+			name:        "options on request",
+			args:        map[string]interface{}{"vueStyle": "options"},
+			wantOptions: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			args := map[string]interface{}{
+				"language":           "javascript",
+				"backendFramework":   "express",
+				"frontendFramework":  "vue",
+				"includeAmountInput": true,
+			}
+			for k, v := range tt.args {
+				args[k] = v
+			}
+
+			files := map[string]string{}
+			for _, f := range runIntegrateCheckout(t, args).Files {
+				files[f.Path] = f.Code
+			}
+
+			for _, path := range []string{
+				"src/components/RazorpayButton.vue",
+				"src/components/RazorpayAmountForm.vue",
+			} {
+				code := files[path]
+				if tt.wantOptions {
+					assert.Contains(t, code, "export default {", path)
+					assert.Contains(t, code, "data() {", path)
+					assert.NotContains(t, code, "<script setup>", path)
+				} else {
+					assert.Contains(t, code, "<script setup>", path)
+				}
+			}
+			if tt.wantOptions {
+				assert.Contains(t, files["src/components/RazorpayButton.vue"],
+					"mounted() {")
+			}
+		})
+	}
+}
+
+func TestVueMajorVersion(t *testing.T) {
+	tests := []struct {
+		packageJson map[string]interface{}
+		want        int
+	}{
+		{map[string]interface{}{}, 0},
+		{map[string]interface{}{"dependencies": map[string]interface{}{"vue": "^2.6.14"}}, 2},
+		{map[string]interface{}{"dependencies": map[string]interface{}{"vue": "3.4.0"}}, 3},
+		{map[string]interface{}{"devDependencies": map[string]interface{}{"vue": ">=2.7"}}, 2},
+		{map[string]interface{}{"dependencies": map[string]interface{}{"vue": "latest"}}, 0},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, vueMajorVersion(tt.packageJson), tt.packageJson)
+	}
+}
+
+func TestDetectProjectStack_Vue2Note(t *testing.T) {
+	output := detectProjectStack(map[string]interface{}{
+		"files": []interface{}{"package.json", "server.js"},
+		"packageJson": map[string]interface{}{
+			"dependencies": map[string]interface{}{
+				"express": "^4.18.2",
+				"vue":     "^2.6.14",
+			},
+		},
+	})
+
+	assert.Equal(t, "vue", output.Frontend)
+	assert.Contains(t, output.Notes,
+		"Vue 2 project, use vueStyle=options for Options API components")
+}
+
+func TestIntegrateRazorpayCheckout_NextPagesRouter(t *testing.T) {
+	app := runIntegrateCheckout(t, map[string]interface{}{
+		"language":          "typescript",
+		"backendFramework":  "nextjs",
+		"frontendFramework": "react",
+	})
+	assert.NotNil(t, findFile(app.Files, "app/api/razorpay/order/route.ts"))
+	assert.Nil(t, findFile(app.Files, "pages/api/razorpay/order.ts"))
+
+	output := runIntegrateCheckout(t, map[string]interface{}{
+		"language":          "typescript",
+		"backendFramework":  "nextjs",
+		"frontendFramework": "react",
+		"nextRouter":        "pages",
+		"strictEnv":         true,
+	})
+	assert.Nil(t, findFile(output.Files, "app/api/razorpay/order/route.ts"))
+	for _, path := range []string{
+		"pages/api/razorpay/order.ts",
+		"pages/api/razorpay/verify.ts",
+	} {
+		route := findFile(output.Files, path)
+		if !assert.NotNil(t, route, path) {
+			continue
+		}
+		assert.Contains(t, route.Code,
+			"import type { NextApiRequest, NextApiResponse } from 'next';")
+		assert.Contains(t, route.Code,
+			"function handler(req: NextApiRequest, res: NextApiResponse)")
+		assert.Contains(t, route.Code, "res.setHeader('Allow', 'POST');")
+		assert.Contains(t, route.Code, "'../../../lib/razorpay-config'")
+		assert.NotContains(t, route.Code, "NextResponse")
+	}
+
+	component := findFile(output.Files, "components/RazorpayCheckout.tsx")
+	if assert.NotNil(t, component) {
+		assert.NotContains(t, component.Code, "'use client'")
+	}
+	assert.Contains(t, output.AIInstructions, "Pages Router")
+}
+
+func TestIntegrateRazorpayCheckout_StrictEnv(t *testing.T) {
+	tests := []struct {
+		backendFramework string
+		configPath       string
+	}{
+		{backendFramework: "nextjs", configPath: "lib/razorpay-config.ts"},
+		{backendFramework: "remix", configPath: "app/lib/razorpay-config.server.ts"},
+		{backendFramework: "nuxt", configPath: "server/utils/razorpay-config.ts"},
+		{backendFramework: "express", configPath: "lib/razorpay-config.ts"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.backendFramework, func(t *testing.T) {
+			output := runIntegrateCheckout(t, map[string]interface{}{
+				"language":          "typescript",
+				"backendFramework":  tt.backendFramework,
+				"frontendFramework": "react",
+				"strictEnv":         true,
+			})
+
+			config := findFile(output.Files, tt.configPath)
+			if assert.NotNil(t, config, tt.configPath) {
+				assert.Contains(t, config.Code, "requireEnv('RAZORPAY_KEY_ID')")
+				assert.Contains(t, config.Code, "requireEnv('RAZORPAY_KEY_SECRET')")
+			}
+			usesConfig := false
+			for _, f := range output.Files {
+				assert.NotContains(t, f.Code, "process.env.RAZORPAY_KEY_ID!", f.Path)
+				assert.NotContains(t, f.Code, "process.env.RAZORPAY_KEY_SECRET!", f.Path)
+				if strings.Contains(f.Code, "razorpayConfig.keySecret") {
+					usesConfig = true
+				}
+			}
+			assert.True(t, usesConfig, "no route reads razorpayConfig")
+		})
+	}
+}
+
+func TestDetectProjectStack_NextRouter(t *testing.T) {
+	tests := []struct {
+		name  string
+		files []interface{}
+		want  string
+	}{
+		{
+			name:  "app router",
+			files: []interface{}{"package.json", "app/page.tsx"},
+			want:  "app",
+		},
+		{
+			name:  "pages router",
+			files: []interface{}{"package.json", "pages/index.tsx"},
+			want:  "pages",
+		},
+		{
+			name:  "pages router under src",
+			files: []interface{}{"package.json", "src/pages/_app.tsx"},
+			want:  "pages",
+		},
+		{
+			name: "migrating to the app router",
+			files: []interface{}{
+				"package.json", "pages/index.tsx", "app/layout.tsx",
+			},
+			want: "app",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			output := detectProjectStack(map[string]interface{}{
+				"files": tt.files,
+				"packageJson": map[string]interface{}{
+					"dependencies": map[string]interface{}{"next": "14.0.0"},
+				},
+			})
+			assert.Equal(t, tt.want, output.NextRouter)
+			if tt.want == "pages" {
+				assert.Contains(t, output.Notes,
+					"Next.js Pages Router project, use nextRouter=pages")
+			}
+		})
+	}
+}
+
+func TestDetectProjectStack_Monorepo(t *testing.T) {
+	tests := []struct {
+		name           string
+		files          []interface{}
+		packageJson    map[string]interface{}
+		wantMonorepo   bool
+		wantWorkspaces []string
+		wantNote       string
+	}{
+		{
+			name: "pnpm workspaces",
+			files: []interface{}{
+				"package.json", "pnpm-workspace.yaml", "pnpm-lock.yaml",
+				"apps/web/package.json", "apps/web/app/page.tsx",
+				"packages/ui/package.json",
+			},
+			wantMonorepo:   true,
+			wantWorkspaces: []string{"apps/web", "packages/ui"},
+			wantNote: "pnpm workspaces monorepo detected with workspaces " +
+				"apps/web, packages/ui",
+		},
+		{
+			name: "turborepo",
+			files: []interface{}{
+				"./package.json", "./turbo.json", "./apps/api/package.json",
+			},
+			wantMonorepo:   true,
+			wantWorkspaces: []string{"apps/api"},
+			wantNote:       "Turborepo monorepo detected",
+		},
+		{
+			name: "nx",
+			files: []interface{}{
+				"package.json", "nx.json", "apps/shop/package.json",
+				"node_modules/react/package.json",
+			},
+			wantMonorepo:   true,
+			wantWorkspaces: []string{"apps/shop"},
+			wantNote:       "Nx monorepo detected",
+		},
+		{
+			name:  "package.json workspaces",
+			files: []interface{}{"package.json", "web/package.json"},
+			packageJson: map[string]interface{}{
+				"workspaces": []interface{}{"web"},
+			},
+			wantMonorepo:   true,
+			wantWorkspaces: []string{"web"},
+			wantNote:       "package.json workspaces monorepo detected",
+		},
+		{
+			name:  "yarn workspaces object",
+			files: []interface{}{"package.json", "yarn.lock"},
+			packageJson: map[string]interface{}{
+				"workspaces": map[string]interface{}{
+					"packages": []interface{}{"packages/*"},
+				},
+			},
+			wantMonorepo: true,
+			wantNote: "package.json workspaces monorepo detected. Run " +
+				"detect_stack",
+		},
+		{
+			name: "single project",
+			files: []interface{}{
+				"package.json", "src/index.js", "node_modules/express/package.json",
+			},
+			packageJson: map[string]interface{}{
+				"dependencies": map[string]interface{}{"express": "4.18.0"},
+			},
+		},
+		{
+			name:  "turbo.json below the root",
+			files: []interface{}{"package.json", "tools/turbo.json"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			args := map[string]interface{}{"files": tt.files}
+			if tt.packageJson != nil {
+				args["packageJson"] = tt.packageJson
+			}
+			output := detectProjectStack(args)
+
+			assert.Equal(t, tt.wantMonorepo, output.IsMonorepo)
+			if !tt.wantMonorepo {
+				assert.Nil(t, output.Workspaces)
+				assert.Equal(t, detectRootStack(args), output)
+				return
+			}
+			assert.Equal(t, tt.wantWorkspaces, output.Workspaces)
+			found := false
+			for _, note := range output.Notes {
+				if strings.Contains(note, tt.wantNote) {
+					found = true
+				}
+			}
+			assert.True(t, found, "notes %v lack %q", output.Notes, tt.wantNote)
+		})
+	}
+}
+
+func TestDetectProjectStack_Signals(t *testing.T) {
+	tests := []struct {
+		name string
+		args map[string]interface{}
+		want []DetectionSignal
+	}{
+		{
+			name: "gin",
+			args: map[string]interface{}{
+				"files": []interface{}{"go.mod", "main.go"},
+				"goMod": "module shop\nrequire github.com/gin-gonic/gin v1.9.1",
+			},
+			want: []DetectionSignal{
+				{Kind: "manifest", Value: "found go.mod", Weight: 0.7},
+				{Kind: "dependency", Value: "gin in go.mod", Weight: 0.2},
+			},
+		},
+		{
+			name: "django",
+			args: map[string]interface{}{
+				"files":           []interface{}{"requirements.txt", "manage.py"},
+				"requirementsTxt": "Django==4.2\n",
+			},
+			want: []DetectionSignal{
+				{Kind: "manifest", Value: "found requirements.txt", Weight: 0.6},
+				{Kind: "file", Value: "found manage.py", Weight: 0.25},
+			},
+		},
+		{
+			name: "next.js with tailwind",
+			args: map[string]interface{}{
+				"files": []interface{}{
+					"package.json", "pnpm-lock.yaml", "app/page.tsx",
+				},
+				"packageJson": map[string]interface{}{
+					"dependencies": map[string]interface{}{
+						"next":        "14.0.0",
+						"tailwindcss": "3.4.0",
+					},
+				},
+			},
+			want: []DetectionSignal{
+				{Kind: "manifest", Value: "found package.json", Weight: 0.5},
+				{Kind: "file", Value: "found .ts or .tsx files", Weight: 0.1},
+				{Kind: "lockfile", Value: "found pnpm-lock.yaml", Weight: 0.05},
+				{Kind: "dependency", Value: "next in package.json", Weight: 0.3},
+				{Kind: "dependency", Value: "tailwindcss in package.json", Weight: 0.05},
+				{Kind: "file", Value: "found the Next.js app/ directory", Weight: 0.05},
+			},
+		},
+		{
+			name: "next.js without a router directory",
+			args: map[string]interface{}{
+				"files": []interface{}{"package.json", "web/next.config.js"},
+				"packageJson": map[string]interface{}{
+					"dependencies": map[string]interface{}{"next": "14.0.0"},
+				},
+			},
+			want: []DetectionSignal{
+				{Kind: "manifest", Value: "found package.json", Weight: 0.5},
+				{Kind: "dependency", Value: "next in package.json", Weight: 0.3},
+				{Kind: "default", Value: "no app/ or pages/ directory, assuming the App Router", Weight: 0},
+			},
+		},
+		{
+			name: "monorepo",
+			args: map[string]interface{}{
+				"files": []interface{}{"package.json", "turbo.json"},
+			},
+			want: []DetectionSignal{
+				{Kind: "manifest", Value: "found package.json", Weight: 0.5},
+				{Kind: "workspace", Value: "Turborepo configured at the root", Weight: 0.9},
+			},
+		},
+		{
+			name: "nothing recognised",
+			args: map[string]interface{}{
+				"files": []interface{}{"README.md"},
+			},
+			want: []DetectionSignal{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, detectProjectStack(tt.args).Signals)
+		})
+	}
+}
+
+func TestDetectProjectStack_Runtime(t *testing.T) {
+	tests := []struct {
+		name               string
+		files              []interface{}
+		wantLanguage       string
+		wantRuntime        string
+		wantPackageManager string
+	}{
+		{
+			name:               "node",
+			files:              []interface{}{"package.json", "index.js"},
+			wantLanguage:       "javascript",
+			wantRuntime:        "node",
+			wantPackageManager: "npm",
+		},
+		{
+			name: "typescript from tsconfig.json only",
+			files: []interface{}{
+				"package.json", "tsconfig.json", "src/index.js",
+			},
+			wantLanguage:       "typescript",
+			wantRuntime:        "node",
+			wantPackageManager: "npm",
+		},
+		{
+			name:               "deno without package.json",
+			files:              []interface{}{"deno.json", "main.ts"},
+			wantLanguage:       "typescript",
+			wantRuntime:        "deno",
+			wantPackageManager: "deno",
+		},
+		{
+			name:               "deno with deno.jsonc and package.json",
+			files:              []interface{}{"deno.jsonc", "package.json", "main.js"},
+			wantLanguage:       "javascript",
+			wantRuntime:        "deno",
+			wantPackageManager: "deno",
+		},
+		{
+			name:               "bun",
+			files:              []interface{}{"package.json", "bun.lockb", "index.ts"},
+			wantLanguage:       "typescript",
+			wantRuntime:        "bun",
+			wantPackageManager: "bun",
+		},
+		{
+			name:               "bun from bunfig.toml",
+			files:              []interface{}{"package.json", "bunfig.toml", "index.js"},
+			wantLanguage:       "javascript",
+			wantRuntime:        "bun",
+			wantPackageManager: "npm",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			output := detectProjectStack(map[string]interface{}{
+				"files": tt.files,
+			})
+			assert.Equal(t, tt.wantLanguage, output.Language)
+			assert.Equal(t, tt.wantRuntime, output.Runtime)
+			assert.Equal(t, tt.wantPackageManager, output.PackageManager)
+		})
+	}
+}
+
+func TestDetectProjectStack_BunFramework(t *testing.T) {
+	output := detectProjectStack(map[string]interface{}{
+		"files": []interface{}{"package.json", "bun.lockb", "index.ts"},
+	})
+	assert.Equal(t, "bun", output.Framework)
+	assert.Contains(t, strings.Join(output.Notes, "\n"), "backendFramework=bun")
+
+	output = detectProjectStack(map[string]interface{}{
+		"files": []interface{}{"package.json", "bun.lockb", "index.ts"},
+		"packageJson": map[string]interface{}{
+			"dependencies": map[string]interface{}{"express": "^4.18.0"},
+		},
+	})
+	assert.Equal(t, "express", output.Framework)
+}
+
+func TestIntegrateRazorpayCheckout_Bun(t *testing.T) {
+	tests := []struct {
+		language string
+		path     string
+	}{
+		{"typescript", "server.ts"},
+		{"javascript", "server.js"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.language, func(t *testing.T) {
+			output := runIntegrateCheckout(t, map[string]interface{}{
+				"language":          tt.language,
+				"backendFramework":  "bun",
+				"frontendFramework": "vanilla",
+			})
+
+			server := findFile(output.Files, tt.path)
+			if assert.NotNil(t, server) {
+				assert.Contains(t, server.Code, "Bun.serve({")
+				assert.Contains(t, server.Code, "new Bun.CryptoHasher('sha256'")
+				assert.Contains(t, server.Code, "timingSafeEqual")
+				assert.Contains(t, server.Code, "/api/razorpay/order")
+				assert.Contains(t, server.Code, "/api/razorpay/verify")
+				assert.NotContains(t, server.Code, "require(")
+				assert.NotContains(t, server.Code, "module.exports")
+				assert.NotContains(t, server.Code, "dotenv")
+			}
+			assert.NotNil(t, findFile(output.Files, "public/js/razorpay.js"))
+			assert.Equal(t, "bun add razorpay",
+				output.Dependencies[0].InstallCommand)
+		})
+	}
+}
+
+func TestIntegrateRazorpayCheckout_GitignoresEnvFiles(t *testing.T) {
+	backends := []struct {
+		language string
+		backend  string
+		frontend string
+	}{
+		{"javascript", "express", "vanilla"},
+		{"typescript", "nextjs", "react"},
+		{"python", "flask", "vanilla"},
+		{"go", "gin", "vanilla"},
+		{"ruby", "rails", "vanilla"},
+	}
+
+	for _, tt := range backends {
+		t.Run(tt.backend, func(t *testing.T) {
+			output := runIntegrateCheckout(t, map[string]interface{}{
+				"language":          tt.language,
+				"backendFramework":  tt.backend,
+				"frontendFramework": tt.frontend,
+			})
+
+			gitignore := findFile(output.Files, ".gitignore")
+			if assert.NotNil(t, gitignore) {
+				assert.Equal(t, "append", gitignore.Action)
+				lines := strings.Split(gitignore.Code, "\n")
+				for _, line := range []string{
+					".env", ".env.local", ".env.*", "!.env.example",
+				} {
+					assert.Contains(t, lines, line)
+				}
+			}
+			assert.Contains(t, output.AIInstructions, "SECRETS:")
+		})
+	}
+}
+
+func TestIntegrateRazorpayCheckout_ExposePublicKey(t *testing.T) {
+	tests := []struct {
+		name     string
+		language string
+		backend  string
+		frontend string
+		path     string
+		env      string
+		expr     string
+	}{
+		{"nextjs", "typescript", "nextjs", "react",
+			"components/RazorpayCheckout.tsx", "NEXT_PUBLIC_RAZORPAY_KEY_ID",
+			"key: process.env.NEXT_PUBLIC_RAZORPAY_KEY_ID,"},
+		{"vite react", "javascript", "express", "react",
+			"src/components/RazorpayButton.jsx", "VITE_RAZORPAY_KEY_ID",
+			"key: import.meta.env.VITE_RAZORPAY_KEY_ID,"},
+		{"astro", "typescript", "astro", "vanilla",
+			"src/components/RazorpayCheckout.astro", "PUBLIC_RAZORPAY_KEY_ID",
+			"key: import.meta.env.PUBLIC_RAZORPAY_KEY_ID,"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			output := runIntegrateCheckout(t, map[string]interface{}{
+				"language":          tt.language,
+				"backendFramework":  tt.backend,
+				"frontendFramework": tt.frontend,
+				"exposePublicKey":   true,
+			})
+
+			file := findFile(output.Files, tt.path)
+			if assert.NotNil(t, file) {
+				assert.Contains(t, file.Code, tt.expr)
+				assert.NotContains(t, file.Code, ".keyId,")
+				assert.NotContains(t, file.Code, "RAZORPAY_KEY_SECRET")
+			}
+
+			var names []string
+			for _, e := range output.EnvVars {
+				names = append(names, e.Name)
+			}
+			assert.Contains(t, names, tt.env)
+			assert.Contains(t, names, "RAZORPAY_KEY_SECRET")
+			assert.Contains(t, output.AIInstructions, "PUBLIC KEY:")
+		})
+	}
+
+	t.Run("vanilla keeps the order response key", func(t *testing.T) {
+		output := runIntegrateCheckout(t, map[string]interface{}{
+			"language":          "javascript",
+			"backendFramework":  "express",
+			"frontendFramework": "vanilla",
+			"exposePublicKey":   true,
+		})
+
+		frontend := findFile(output.Files, "public/js/razorpay.js")
+		if assert.NotNil(t, frontend) {
+			assert.Contains(t, frontend.Code, "key: orderData.keyId,")
+		}
+		for _, e := range output.EnvVars {
+			assert.NotContains(t, e.Name, "PUBLIC")
+		}
+		assert.Contains(t, output.AIInstructions,
+			"keeps using\nthe keyId returned by the order endpoint")
+	})
+}
+
+func webhookEvents(output IntegrateCheckoutOutput) []string {
+	var events []string
+	for _, w := range output.Webhooks {
+		events = append(events, w.Event)
+	}
+	return events
+}
+
+func TestIntegrateRazorpayCheckout_Webhooks(t *testing.T) {
+	backends := []struct {
+		language string
+		backend  string
+		frontend string
+	}{
+		{"javascript", "express", "vanilla"},
+		{"typescript", "nextjs", "react"},
+		{"python", "django", "vanilla"},
+		{"go", "gin", "vanilla"},
+		{"ruby", "rails", "vanilla"},
+		{"java", "spring", "vanilla"},
+		{"csharp", "aspnet", "vanilla"},
+	}
+
+	for _, tt := range backends {
+		t.Run(tt.backend, func(t *testing.T) {
+			output := runIntegrateCheckout(t, map[string]interface{}{
+				"language":          tt.language,
+				"backendFramework":  tt.backend,
+				"frontendFramework": tt.frontend,
+			})
+
+			assert.Equal(t,
+				[]string{"payment.captured", "payment.failed", "order.paid"},
+				webhookEvents(output))
+			for _, w := range output.Webhooks {
+				assert.NotEmpty(t, w.Description, w.Event)
+			}
+			assert.Equal(t, "https://dashboard.razorpay.com/app/webhooks",
+				output.WebhookDashboardURL)
+		})
+	}
+}
@@ -0,0 +1,248 @@
+package razorpay
+
+import (
+	"context"
+	"fmt"
+
+	rzpsdk "github.com/razorpay/razorpay-go"
+	"github.com/razorpay/razorpay-go/constants"
+
+	"github.com/razorpay/razorpay-mcp-server/pkg/mcpgo"
+	"github.com/razorpay/razorpay-mcp-server/pkg/observability"
+)
+
+// CreateContact returns a tool that creates a RazorpayX contact
+func CreateContact(
+	obs *observability.Observability,
+	client *rzpsdk.Client,
+) mcpgo.Tool {
+	parameters := []mcpgo.ToolParameter{
+		mcpgo.WithString(
+			"name",
+			mcpgo.Description("Name of the contact"),
+			mcpgo.Required(),
+		),
+		mcpgo.WithString(
+			"email",
+			mcpgo.Description("Email of the contact"),
+		),
+		mcpgo.WithString(
+			"contact",
+			mcpgo.Description("Phone number of the contact"),
+		),
+		mcpgo.WithString(
+			"type",
+			mcpgo.Description("Classification of the contact: vendor, "+
+				"customer, employee or self"),
+			mcpgo.Enum("vendor", "customer", "employee", "self"),
+		),
+		mcpgo.WithString(
+			"reference_id",
+			mcpgo.Description("Your reference for the contact, up to 40 "+
+				"characters"),
+			mcpgo.Max(40),
+		),
+		mcpgo.WithObject(
+			"notes",
+			mcpgo.Description("Key-value pairs that can be used to store "+
+				"additional information about the contact. Maximum 15 pairs, "+
+				"each value up to 256 characters"),
+			mcpgo.MaxProperties(15),
+		),
+	}
+
+	handler := func(
+		ctx context.Context,
+		r mcpgo.CallToolRequest,
+	) (*mcpgo.ToolResult, error) {
+		client, err := getClientFromContextOrDefault(ctx, client)
+		if err != nil {
+			return mcpgo.NewToolResultError(err.Error()), nil
+		}
+
+		payload := make(map[string]interface{})
+
+		validator := NewValidator(&r).
+			ValidateAndAddRequiredString(payload, "name").
+			ValidateAndAddOptionalString(payload, "email").
+			ValidateAndAddOptionalString(payload, "contact").
+			ValidateAndAddOptionalString(payload, "type").
+			ValidateAndAddOptionalString(payload, "reference_id").
+			ValidateAndAddOptionalNotes(payload, "notes")
+
+		if result, err := validator.HandleErrorsIfAny(); result != nil {
+			return result, err
+		}
+
+		url := fmt.Sprintf("/%s/contacts", constants.VERSION_V1)
+		contact, err := client.Request.Post(url, payload, nil)
+		if err != nil {
+			return mcpgo.NewToolResultError(
+				fmt.Sprintf("creating contact failed: %s", err.Error())), nil
+		}
+
+		return mcpgo.NewToolResultJSON(contact)
+	}
+
+	return mcpgo.NewTool(
+		"create_contact",
+		"Create a RazorpayX contact - a vendor, customer, employee or anyone "+
+			"else you pay out to. Creating a contact that matches an existing "+
+			"one returns the existing contact",
+		parameters,
+		handler,
+	)
+}
+
+// FetchContact returns a tool that fetches a contact by ID
+func FetchContact(
+	obs *observability.Observability,
+	client *rzpsdk.Client,
+) mcpgo.Tool {
+	parameters := []mcpgo.ToolParameter{
+		mcpgo.WithString(
+			"contact_id",
+			mcpgo.Description("Unique identifier of the contact. "+
+				"For example, 'cont_00000000000001'"),
+			mcpgo.Required(),
+		),
+	}
+
+	handler := func(
+		ctx context.Context,
+		r mcpgo.CallToolRequest,
+	) (*mcpgo.ToolResult, error) {
+		client, err := getClientFromContextOrDefault(ctx, client)
+		if err != nil {
+			return mcpgo.NewToolResultError(err.Error()), nil
+		}
+
+		params := make(map[string]interface{})
+
+		validator := NewValidator(&r).
+			ValidateAndAddRequiredString(params, "contact_id")
+
+		if result, err := validator.HandleErrorsIfAny(); result != nil {
+			return result, err
+		}
+
+		url := fmt.Sprintf("/%s/contacts/%s",
+			constants.VERSION_V1, params["contact_id"])
+		contact, err := client.Request.Get(url, nil, nil)
+		if err != nil {
+			return mcpgo.NewToolResultError(
+				fmt.Sprintf("fetching contact failed: %s", err.Error())), nil
+		}
+
+		return mcpgo.NewToolResultJSON(contact)
+	}
+
+	return mcpgo.NewTool(
+		"fetch_contact",
+		"Fetch a RazorpayX contact's details using its ID",
+		parameters,
+		handler,
+	)
+}
+
+// FetchAllContacts returns a tool that fetches all contacts
+func FetchAllContacts(
+	obs *observability.Observability,
+	client *rzpsdk.Client,
+) mcpgo.Tool {
+	parameters := []mcpgo.ToolParameter{
+		mcpgo.WithString(
+			"name",
+			mcpgo.Description("Filter contacts by name"),
+		),
+		mcpgo.WithString(
+			"email",
+			mcpgo.Description("Filter contacts by email"),
+		),
+		mcpgo.WithString(
+			"contact",
+			mcpgo.Description("Filter contacts by phone number"),
+		),
+		mcpgo.WithString(
+			"type",
+			mcpgo.Description("Filter contacts by type"),
+			mcpgo.Enum("vendor", "customer", "employee", "self"),
+		),
+		mcpgo.WithString(
+			"reference_id",
+			mcpgo.Description("Filter contacts by your reference"),
+		),
+		mcpgo.WithBoolean(
+			"active",
+			mcpgo.Description("Filter active (true) or inactive (false) "+
+				"contacts"),
+		),
+		mcpgo.WithNumber(
+			"from",
+			mcpgo.Description("Unix timestamp (in seconds) from when "+
+				"contacts are to be fetched"),
+			mcpgo.Min(0),
+		),
+		mcpgo.WithNumber(
+			"to",
+			mcpgo.Description("Unix timestamp (in seconds) up till when "+
+				"contacts are to be fetched"),
+			mcpgo.Min(0),
+		),
+		mcpgo.WithNumber(
+			"count",
+			mcpgo.Description("Number of contacts to be fetched "+
+				"(default: 10, max: 100)"),
+			mcpgo.Min(1),
+			mcpgo.Max(100),
+		),
+		mcpgo.WithNumber(
+			"skip",
+			mcpgo.Description("Number of contacts to be skipped (default: 0)"),
+			mcpgo.Min(0),
+		),
+	}
+
+	handler := func(
+		ctx context.Context,
+		r mcpgo.CallToolRequest,
+	) (*mcpgo.ToolResult, error) {
+		client, err := getClientFromContextOrDefault(ctx, client)
+		if err != nil {
+			return mcpgo.NewToolResultError(err.Error()), nil
+		}
+
+		queryParams := make(map[string]interface{})
+
+		validator := NewValidator(&r).
+			ValidateAndAddOptionalString(queryParams, "name").
+			ValidateAndAddOptionalString(queryParams, "email").
+			ValidateAndAddOptionalString(queryParams, "contact").
+			ValidateAndAddOptionalString(queryParams, "type").
+			ValidateAndAddOptionalString(queryParams, "reference_id").
+			ValidateAndAddOptionalBool(queryParams, "active").
+			ValidateAndAddOptionalInt(queryParams, "from").
+			ValidateAndAddOptionalInt(queryParams, "to").
+			ValidateAndAddPagination(queryParams)
+
+		if result, err := validator.HandleErrorsIfAny(); result != nil {
+			return result, err
+		}
+
+		url := fmt.Sprintf("/%s/contacts", constants.VERSION_V1)
+		contacts, err := client.Request.Get(url, queryParams, nil)
+		if err != nil {
+			return mcpgo.NewToolResultError(
+				fmt.Sprintf("fetching contacts failed: %s", err.Error())), nil
+		}
+
+		return mcpgo.NewToolResultJSON(contacts)
+	}
+
+	return mcpgo.NewTool(
+		"fetch_all_contacts",
+		"Fetch all RazorpayX contacts with optional filtering and pagination",
+		parameters,
+		handler,
+	)
+}
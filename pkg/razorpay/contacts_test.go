@@ -0,0 +1,190 @@
+package razorpay
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/razorpay/razorpay-go/constants"
+
+	"github.com/razorpay/razorpay-mcp-server/pkg/razorpay/mock"
+)
+
+func Test_CreateContact(t *testing.T) {
+	createContactPath := fmt.Sprintf("/%s/contacts", constants.VERSION_V1)
+
+	contactResp := map[string]interface{}{
+		"id":           "cont_00000000000001",
+		"entity":       "contact",
+		"name":         "Gaurav Kumar",
+		"contact":      "9876543210",
+		"email":        "gaurav.kumar@example.com",
+		"type":         "employee",
+		"reference_id": "Acme Contact ID 12345",
+		"active":       true,
+	}
+
+	tests := []RazorpayToolTestCase{
+		{
+			Name: "successful contact creation",
+			Request: map[string]interface{}{
+				"name":         "Gaurav Kumar",
+				"contact":      "9876543210",
+				"email":        "gaurav.kumar@example.com",
+				"type":         "employee",
+				"reference_id": "Acme Contact ID 12345",
+			},
+			MockHttpClient: func() (*http.Client, *httptest.Server) {
+				return mock.NewHTTPClient(
+					mock.Endpoint{
+						Path:     createContactPath,
+						Method:   "POST",
+						Response: contactResp,
+					},
+				)
+			},
+			ExpectError:    false,
+			ExpectedResult: contactResp,
+		},
+		{
+			Name: "contact creation fails",
+			Request: map[string]interface{}{
+				"name":  "Gaurav Kumar",
+				"email": "not-an-email",
+			},
+			MockHttpClient: func() (*http.Client, *httptest.Server) {
+				return mock.NewHTTPClient(
+					mock.Endpoint{
+						Path:   createContactPath,
+						Method: "POST",
+						Response: map[string]interface{}{
+							"error": map[string]interface{}{
+								"code":        "BAD_REQUEST_ERROR",
+								"description": "The email must be a valid email address.",
+							},
+						},
+					},
+				)
+			},
+			ExpectError: true,
+			ExpectedErrMsg: "creating contact failed: " +
+				"The email must be a valid email address.",
+		},
+		{
+			Name:           "missing name parameter",
+			Request:        map[string]interface{}{},
+			MockHttpClient: nil,
+			ExpectError:    true,
+			ExpectedErrMsg: "missing required parameter: name",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.Name, func(t *testing.T) {
+			runToolTest(t, tc, CreateContact, "Contact")
+		})
+	}
+}
+
+func Test_FetchContact(t *testing.T) {
+	fetchContactPath := fmt.Sprintf(
+		"/%s/contacts/%s",
+		constants.VERSION_V1,
+		"cont_00000000000001",
+	)
+
+	contactResp := map[string]interface{}{
+		"id":     "cont_00000000000001",
+		"entity": "contact",
+		"name":   "Gaurav Kumar",
+		"active": true,
+	}
+
+	tests := []RazorpayToolTestCase{
+		{
+			Name: "successful contact fetch",
+			Request: map[string]interface{}{
+				"contact_id": "cont_00000000000001",
+			},
+			MockHttpClient: func() (*http.Client, *httptest.Server) {
+				return mock.NewHTTPClient(
+					mock.Endpoint{
+						Path:     fetchContactPath,
+						Method:   "GET",
+						Response: contactResp,
+					},
+				)
+			},
+			ExpectError:    false,
+			ExpectedResult: contactResp,
+		},
+		{
+			Name:           "missing contact_id parameter",
+			Request:        map[string]interface{}{},
+			MockHttpClient: nil,
+			ExpectError:    true,
+			ExpectedErrMsg: "missing required parameter: contact_id",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.Name, func(t *testing.T) {
+			runToolTest(t, tc, FetchContact, "Contact")
+		})
+	}
+}
+
+func Test_FetchAllContacts(t *testing.T) {
+	fetchAllContactsPath := fmt.Sprintf("/%s/contacts", constants.VERSION_V1)
+
+	contactsResp := map[string]interface{}{
+		"entity": "collection",
+		"count":  float64(1),
+		"items": []interface{}{
+			map[string]interface{}{
+				"id":     "cont_00000000000001",
+				"entity": "contact",
+				"name":   "Gaurav Kumar",
+				"type":   "vendor",
+			},
+		},
+	}
+
+	tests := []RazorpayToolTestCase{
+		{
+			Name: "successful contacts fetch with filters",
+			Request: map[string]interface{}{
+				"type":   "vendor",
+				"active": true,
+				"count":  float64(10),
+			},
+			MockHttpClient: func() (*http.Client, *httptest.Server) {
+				return mock.NewHTTPClient(
+					mock.Endpoint{
+						Path:     fetchAllContactsPath,
+						Method:   "GET",
+						Response: contactsResp,
+					},
+				)
+			},
+			ExpectError:    false,
+			ExpectedResult: contactsResp,
+		},
+		{
+			Name: "invalid active parameter",
+			Request: map[string]interface{}{
+				"active": "yes",
+			},
+			MockHttpClient: nil,
+			ExpectError:    true,
+			ExpectedErrMsg: "invalid parameter type: active",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.Name, func(t *testing.T) {
+			runToolTest(t, tc, FetchAllContacts, "Contacts")
+		})
+	}
+}
@@ -0,0 +1,177 @@
+package razorpay
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"regexp"
+	"strings"
+
+	rzpsdk "github.com/razorpay/razorpay-go"
+
+	"github.com/razorpay/razorpay-mcp-server/pkg/mcpgo"
+	"github.com/razorpay/razorpay-mcp-server/pkg/observability"
+)
+
+// currencyExponents lists the currencies whose minor unit isn't 1/100 of the
+// major unit. Every other currency has an exponent of 2.
+var currencyExponents = map[string]int{
+	// Zero-decimal currencies: the amount is already in the major unit
+	"BIF": 0, "CLP": 0, "DJF": 0, "GNF": 0, "ISK": 0, "JPY": 0,
+	"KMF": 0, "KRW": 0, "PYG": 0, "RWF": 0, "UGX": 0, "VND": 0,
+	"VUV": 0, "XAF": 0, "XOF": 0, "XPF": 0,
+	// Three-decimal currencies
+	"BHD": 3, "IQD": 3, "JOD": 3, "KWD": 3, "OMR": 3, "TND": 3,
+}
+
+var currencyCodePattern = regexp.MustCompile(`^[A-Z]{3}$`)
+
+// currencyExponent returns the number of decimal places between the major
+// and minor unit of currency
+func currencyExponent(currency string) int {
+	if exponent, ok := currencyExponents[currency]; ok {
+		return exponent
+	}
+	return 2
+}
+
+// convertAmount converts amount between the major and minor unit of
+// currency. Minor-unit amounts must be whole numbers, and major-unit amounts
+// can't carry more decimals than the currency has.
+func convertAmount(
+	amount float64,
+	fromUnit, toUnit, currency string,
+) (float64, error) {
+	if amount < 0 {
+		return 0, fmt.Errorf("amount must not be negative")
+	}
+
+	exponent := currencyExponent(currency)
+	factor := math.Pow10(exponent)
+
+	switch {
+	case fromUnit == toUnit:
+		if fromUnit == "minor" && amount != math.Trunc(amount) {
+			return 0, fmt.Errorf(
+				"amount %v in minor units must be a whole number", amount)
+		}
+		return amount, nil
+	case fromUnit == "major":
+		minor := amount * factor
+		rounded := math.Round(minor)
+		// Allow for float error, e.g. 19.99 * 100 = 1998.9999999999998
+		if math.Abs(minor-rounded) > 1e-6 {
+			return 0, fmt.Errorf(
+				"amount %v has more than %d decimal places for %s",
+				amount, exponent, currency)
+		}
+		return rounded, nil
+	default:
+		if amount != math.Trunc(amount) {
+			return 0, fmt.Errorf(
+				"amount %v in minor units must be a whole number", amount)
+		}
+		return amount / factor, nil
+	}
+}
+
+// ConvertAmount returns a tool that converts an amount between the major
+// unit (rupees) and minor unit (paise) of a currency
+func ConvertAmount(
+	obs *observability.Observability,
+	client *rzpsdk.Client,
+) mcpgo.Tool {
+	parameters := []mcpgo.ToolParameter{
+		mcpgo.WithNumber(
+			"amount",
+			mcpgo.Description("Amount to convert, in from_unit"),
+			mcpgo.Required(),
+			mcpgo.Min(0),
+		),
+		mcpgo.WithString(
+			"from_unit",
+			mcpgo.Description("Unit of amount: major (e.g. rupees) or "+
+				"minor (e.g. paise, what the Razorpay API expects)"),
+			mcpgo.Required(),
+			mcpgo.Enum("major", "minor"),
+		),
+		mcpgo.WithString(
+			"to_unit",
+			mcpgo.Description("Unit to convert to: major or minor"),
+			mcpgo.Required(),
+			mcpgo.Enum("major", "minor"),
+		),
+		mcpgo.WithString(
+			"currency",
+			mcpgo.Description("ISO 4217 currency code, e.g. INR. Decides "+
+				"the exponent: 2 for most currencies, 0 for JPY, 3 for KWD"),
+			mcpgo.Required(),
+		),
+	}
+
+	handler := func(
+		ctx context.Context,
+		r mcpgo.CallToolRequest,
+	) (*mcpgo.ToolResult, error) {
+		params := make(map[string]interface{})
+
+		validator := NewValidator(&r).
+			ValidateAndAddRequiredFloat(params, "amount").
+			ValidateAndAddRequiredString(params, "from_unit").
+			ValidateAndAddRequiredString(params, "to_unit").
+			ValidateAndAddRequiredString(params, "currency")
+
+		if result, err := validator.HandleErrorsIfAny(); result != nil {
+			return result, err
+		}
+
+		fromUnit := params["from_unit"].(string)
+		toUnit := params["to_unit"].(string)
+		for _, unit := range []string{fromUnit, toUnit} {
+			if unit != "major" && unit != "minor" {
+				return mcpgo.NewToolResultError(fmt.Sprintf(
+					"unit %s must be major or minor", unit)), nil
+			}
+		}
+
+		currency := strings.ToUpper(params["currency"].(string))
+		if !currencyCodePattern.MatchString(currency) {
+			return mcpgo.NewToolResultError(fmt.Sprintf(
+				"currency %s must be a 3-letter ISO 4217 code",
+				params["currency"])), nil
+		}
+
+		amount := params["amount"].(float64)
+		converted, err := convertAmount(amount, fromUnit, toUnit, currency)
+		if err != nil {
+			return mcpgo.NewToolResultError(err.Error()), nil
+		}
+
+		exponent := currencyExponent(currency)
+		major := converted
+		if toUnit == "minor" {
+			major = converted / math.Pow10(exponent)
+		}
+
+		return mcpgo.NewToolResultJSON(map[string]interface{}{
+			"amount":           amount,
+			"from_unit":        fromUnit,
+			"converted_amount": converted,
+			"to_unit":          toUnit,
+			"currency":         currency,
+			"exponent":         exponent,
+			"display":          fmt.Sprintf("%s %.*f", currency, exponent, major),
+		})
+	}
+
+	return mcpgo.NewTool(
+		"convert_amount",
+		"Convert an amount between the major unit (rupees, dollars) and the "+
+			"minor unit (paise, cents) of a currency. Razorpay APIs take "+
+			"amounts in the minor unit, so ₹499 is 49900. Use this before "+
+			"creating orders or showing amounts to avoid 100x errors. Pure "+
+			"computation, no API call",
+		parameters,
+		handler,
+	)
+}
@@ -0,0 +1,160 @@
+package razorpay
+
+import (
+	"testing"
+)
+
+func Test_ConvertAmount(t *testing.T) {
+	tests := []RazorpayToolTestCase{
+		{
+			Name: "rupees to paise",
+			Request: map[string]interface{}{
+				"amount":    float64(499),
+				"from_unit": "major",
+				"to_unit":   "minor",
+				"currency":  "INR",
+			},
+			ExpectedResult: map[string]interface{}{
+				"amount":           float64(499),
+				"from_unit":        "major",
+				"converted_amount": float64(49900),
+				"to_unit":          "minor",
+				"currency":         "INR",
+				"exponent":         float64(2),
+				"display":          "INR 499.00",
+			},
+		},
+		{
+			Name: "decimal major amount without float error",
+			Request: map[string]interface{}{
+				"amount":    19.99,
+				"from_unit": "major",
+				"to_unit":   "minor",
+				"currency":  "usd",
+			},
+			ExpectedResult: map[string]interface{}{
+				"amount":           19.99,
+				"from_unit":        "major",
+				"converted_amount": float64(1999),
+				"to_unit":          "minor",
+				"currency":         "USD",
+				"exponent":         float64(2),
+				"display":          "USD 19.99",
+			},
+		},
+		{
+			Name: "paise to rupees",
+			Request: map[string]interface{}{
+				"amount":    float64(49950),
+				"from_unit": "minor",
+				"to_unit":   "major",
+				"currency":  "INR",
+			},
+			ExpectedResult: map[string]interface{}{
+				"amount":           float64(49950),
+				"from_unit":        "minor",
+				"converted_amount": 499.5,
+				"to_unit":          "major",
+				"currency":         "INR",
+				"exponent":         float64(2),
+				"display":          "INR 499.50",
+			},
+		},
+		{
+			Name: "zero-decimal currency",
+			Request: map[string]interface{}{
+				"amount":    float64(1500),
+				"from_unit": "major",
+				"to_unit":   "minor",
+				"currency":  "JPY",
+			},
+			ExpectedResult: map[string]interface{}{
+				"amount":           float64(1500),
+				"from_unit":        "major",
+				"converted_amount": float64(1500),
+				"to_unit":          "minor",
+				"currency":         "JPY",
+				"exponent":         float64(0),
+				"display":          "JPY 1500",
+			},
+		},
+		{
+			Name: "three-decimal currency",
+			Request: map[string]interface{}{
+				"amount":    float64(12345),
+				"from_unit": "minor",
+				"to_unit":   "major",
+				"currency":  "KWD",
+			},
+			ExpectedResult: map[string]interface{}{
+				"amount":           float64(12345),
+				"from_unit":        "minor",
+				"converted_amount": 12.345,
+				"to_unit":          "major",
+				"currency":         "KWD",
+				"exponent":         float64(3),
+				"display":          "KWD 12.345",
+			},
+		},
+		{
+			Name: "too many decimals for the currency",
+			Request: map[string]interface{}{
+				"amount":    10.005,
+				"from_unit": "major",
+				"to_unit":   "minor",
+				"currency":  "INR",
+			},
+			ExpectError:    true,
+			ExpectedErrMsg: "amount 10.005 has more than 2 decimal places for INR",
+		},
+		{
+			Name: "fractional minor amount",
+			Request: map[string]interface{}{
+				"amount":    100.5,
+				"from_unit": "minor",
+				"to_unit":   "major",
+				"currency":  "INR",
+			},
+			ExpectError:    true,
+			ExpectedErrMsg: "amount 100.5 in minor units must be a whole number",
+		},
+		{
+			Name: "invalid currency code",
+			Request: map[string]interface{}{
+				"amount":    float64(100),
+				"from_unit": "major",
+				"to_unit":   "minor",
+				"currency":  "RUPEES",
+			},
+			ExpectError:    true,
+			ExpectedErrMsg: "currency RUPEES must be a 3-letter ISO 4217 code",
+		},
+		{
+			Name: "invalid unit",
+			Request: map[string]interface{}{
+				"amount":    float64(100),
+				"from_unit": "paise",
+				"to_unit":   "major",
+				"currency":  "INR",
+			},
+			ExpectError:    true,
+			ExpectedErrMsg: "unit paise must be major or minor",
+		},
+		{
+			Name: "missing amount",
+			Request: map[string]interface{}{
+				"from_unit": "major",
+				"to_unit":   "minor",
+				"currency":  "INR",
+			},
+			ExpectError:    true,
+			ExpectedErrMsg: "missing required parameter: amount",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.Name, func(t *testing.T) {
+			runToolTest(t, tc, ConvertAmount, "Amount")
+		})
+	}
+}
@@ -0,0 +1,275 @@
+package razorpay
+
+import (
+	"context"
+	"fmt"
+
+	rzpsdk "github.com/razorpay/razorpay-go"
+
+	"github.com/razorpay/razorpay-mcp-server/pkg/mcpgo"
+	"github.com/razorpay/razorpay-mcp-server/pkg/observability"
+)
+
+// CreateCustomer returns a tool that creates a new customer
+func CreateCustomer(
+	obs *observability.Observability,
+	client *rzpsdk.Client,
+) mcpgo.Tool {
+	parameters := []mcpgo.ToolParameter{
+		mcpgo.WithString(
+			"name",
+			mcpgo.Description("Customer's name. Alphanumeric, with period (.), "+
+				"apostrophe (') and parentheses allowed. 3-50 characters"),
+		),
+		mcpgo.WithString(
+			"email",
+			mcpgo.Description("Customer's email address"),
+		),
+		mcpgo.WithString(
+			"contact",
+			mcpgo.Description("Customer's phone number, with the country code. "+
+				"For example, '+919000090000'"),
+		),
+		mcpgo.WithString(
+			"gstin",
+			mcpgo.Description("Customer's GST number, if available"),
+		),
+		mcpgo.WithBoolean(
+			"fail_existing",
+			mcpgo.Description("If true (default), the request fails when a "+
+				"customer with the same details already exists. If false, the "+
+				"existing customer is returned instead"),
+		),
+		mcpgo.WithObject(
+			"notes",
+			mcpgo.Description("Key-value pairs that can be used to store "+
+				"additional information about the customer. A maximum of 15 "+
+				"key-value pairs, each of 256 characters (maximum) are allowed"),
+		),
+	}
+
+	handler := func(
+		ctx context.Context,
+		r mcpgo.CallToolRequest,
+	) (*mcpgo.ToolResult, error) {
+		client, err := getClientFromContextOrDefault(ctx, client)
+		if err != nil {
+			return mcpgo.NewToolResultError(err.Error()), nil
+		}
+
+		params := make(map[string]interface{})
+
+		validator := NewValidator(&r).
+			ValidateAndAddOptionalString(params, "name").
+			ValidateAndAddOptionalString(params, "email").
+			ValidateAndAddOptionalString(params, "contact").
+			ValidateAndAddOptionalString(params, "gstin").
+			ValidateAndAddOptionalBool(params, "fail_existing").
+			ValidateAndAddOptionalNotes(params, "notes")
+
+		if result, err := validator.HandleErrorsIfAny(); result != nil {
+			return result, err
+		}
+
+		// The API takes fail_existing as the string "0" or "1"
+		if failExisting, ok := params["fail_existing"].(bool); ok {
+			params["fail_existing"] = "0"
+			if failExisting {
+				params["fail_existing"] = "1"
+			}
+		}
+
+		customer, err := client.Customer.Create(params, nil)
+		if err != nil {
+			return mcpgo.NewToolResultError(
+				fmt.Sprintf("creating customer failed: %s", err.Error())), nil
+		}
+
+		return mcpgo.NewToolResultJSON(customer)
+	}
+
+	return mcpgo.NewTool(
+		"create_customer",
+		"Create a new customer with their name, email and contact details. "+
+			"Customers are needed for saved payment methods and subscriptions",
+		parameters,
+		handler,
+	)
+}
+
+// FetchCustomer returns a tool that fetches a customer by ID
+func FetchCustomer(
+	obs *observability.Observability,
+	client *rzpsdk.Client,
+) mcpgo.Tool {
+	parameters := []mcpgo.ToolParameter{
+		mcpgo.WithString(
+			"customer_id",
+			mcpgo.Description("Unique identifier of the customer. "+
+				"For example, 'cust_00000000000001'"),
+			mcpgo.Required(),
+		),
+	}
+
+	handler := func(
+		ctx context.Context,
+		r mcpgo.CallToolRequest,
+	) (*mcpgo.ToolResult, error) {
+		client, err := getClientFromContextOrDefault(ctx, client)
+		if err != nil {
+			return mcpgo.NewToolResultError(err.Error()), nil
+		}
+
+		params := make(map[string]interface{})
+
+		validator := NewValidator(&r).
+			ValidateAndAddRequiredString(params, "customer_id")
+
+		if result, err := validator.HandleErrorsIfAny(); result != nil {
+			return result, err
+		}
+
+		customer, err := client.Customer.Fetch(
+			params["customer_id"].(string), nil, nil)
+		if err != nil {
+			return mcpgo.NewToolResultError(
+				fmt.Sprintf("fetching customer failed: %s", err.Error())), nil
+		}
+
+		return mcpgo.NewToolResultJSON(customer)
+	}
+
+	return mcpgo.NewTool(
+		"fetch_customer",
+		"Fetch a customer's details using their ID",
+		parameters,
+		handler,
+	)
+}
+
+// FetchAllCustomers returns a tool that fetches all customers
+func FetchAllCustomers(
+	obs *observability.Observability,
+	client *rzpsdk.Client,
+) mcpgo.Tool {
+	parameters := []mcpgo.ToolParameter{
+		mcpgo.WithNumber(
+			"count",
+			mcpgo.Description("Number of customers to be fetched "+
+				"(default: 10, max: 100)"),
+			mcpgo.Min(1),
+			mcpgo.Max(100),
+		),
+		mcpgo.WithNumber(
+			"skip",
+			mcpgo.Description("Number of customers to be skipped (default: 0)"),
+			mcpgo.Min(0),
+		),
+	}
+
+	handler := func(
+		ctx context.Context,
+		r mcpgo.CallToolRequest,
+	) (*mcpgo.ToolResult, error) {
+		client, err := getClientFromContextOrDefault(ctx, client)
+		if err != nil {
+			return mcpgo.NewToolResultError(err.Error()), nil
+		}
+
+		queryParams := make(map[string]interface{})
+
+		validator := NewValidator(&r).
+			ValidateAndAddPagination(queryParams)
+
+		if result, err := validator.HandleErrorsIfAny(); result != nil {
+			return result, err
+		}
+
+		customers, err := client.Customer.All(queryParams, nil)
+		if err != nil {
+			return mcpgo.NewToolResultError(
+				fmt.Sprintf("fetching customers failed: %s", err.Error())), nil
+		}
+
+		return mcpgo.NewToolResultJSON(customers)
+	}
+
+	return mcpgo.NewTool(
+		"fetch_all_customers",
+		"Fetch all customers with pagination",
+		parameters,
+		handler,
+	)
+}
+
+// EditCustomer returns a tool that edits a customer's details
+func EditCustomer(
+	obs *observability.Observability,
+	client *rzpsdk.Client,
+) mcpgo.Tool {
+	parameters := []mcpgo.ToolParameter{
+		mcpgo.WithString(
+			"customer_id",
+			mcpgo.Description("Unique identifier of the customer to be "+
+				"edited. For example, 'cust_00000000000001'"),
+			mcpgo.Required(),
+		),
+		mcpgo.WithString(
+			"name",
+			mcpgo.Description("Customer's new name"),
+		),
+		mcpgo.WithString(
+			"email",
+			mcpgo.Description("Customer's new email address"),
+		),
+		mcpgo.WithString(
+			"contact",
+			mcpgo.Description("Customer's new phone number, with the "+
+				"country code"),
+		),
+	}
+
+	handler := func(
+		ctx context.Context,
+		r mcpgo.CallToolRequest,
+	) (*mcpgo.ToolResult, error) {
+		client, err := getClientFromContextOrDefault(ctx, client)
+		if err != nil {
+			return mcpgo.NewToolResultError(err.Error()), nil
+		}
+
+		params := make(map[string]interface{})
+		data := make(map[string]interface{})
+
+		validator := NewValidator(&r).
+			ValidateAndAddRequiredString(params, "customer_id").
+			ValidateAndAddOptionalString(data, "name").
+			ValidateAndAddOptionalString(data, "email").
+			ValidateAndAddOptionalString(data, "contact")
+
+		if result, err := validator.HandleErrorsIfAny(); result != nil {
+			return result, err
+		}
+
+		if len(data) == 0 {
+			return mcpgo.NewToolResultError(
+				"at least one of name, email or contact must be provided"), nil
+		}
+
+		customer, err := client.Customer.Edit(
+			params["customer_id"].(string), data, nil)
+		if err != nil {
+			return mcpgo.NewToolResultError(
+				fmt.Sprintf("editing customer failed: %s", err.Error())), nil
+		}
+
+		return mcpgo.NewToolResultJSON(customer)
+	}
+
+	return mcpgo.NewTool(
+		"edit_customer",
+		"Edit a customer's name, email or contact number",
+		parameters,
+		handler,
+	)
+}
@@ -0,0 +1,290 @@
+package razorpay
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/razorpay/razorpay-go/constants"
+
+	"github.com/razorpay/razorpay-mcp-server/pkg/razorpay/mock"
+)
+
+func Test_CreateCustomer(t *testing.T) {
+	createCustomerPath := fmt.Sprintf(
+		"/%s%s",
+		constants.VERSION_V1,
+		constants.CUSTOMER_URL,
+	)
+
+	customerResp := map[string]interface{}{
+		"id":      "cust_1Aa00000000001",
+		"entity":  "customer",
+		"name":    "Gaurav Kumar",
+		"email":   "gaurav.kumar@example.com",
+		"contact": "+919000090000",
+		"gstin":   nil,
+		"notes": map[string]interface{}{
+			"source": "mcp",
+		},
+		"created_at": float64(1234567890),
+	}
+
+	tests := []RazorpayToolTestCase{
+		{
+			Name: "successful customer creation",
+			Request: map[string]interface{}{
+				"name":          "Gaurav Kumar",
+				"email":         "gaurav.kumar@example.com",
+				"contact":       "+919000090000",
+				"fail_existing": false,
+				"notes": map[string]interface{}{
+					"source": "mcp",
+				},
+			},
+			MockHttpClient: func() (*http.Client, *httptest.Server) {
+				return mock.NewHTTPClient(
+					mock.Endpoint{
+						Path:     createCustomerPath,
+						Method:   "POST",
+						Response: customerResp,
+					},
+				)
+			},
+			ExpectError:    false,
+			ExpectedResult: customerResp,
+		},
+		{
+			Name: "customer already exists",
+			Request: map[string]interface{}{
+				"contact": "+919000090000",
+			},
+			MockHttpClient: func() (*http.Client, *httptest.Server) {
+				return mock.NewHTTPClient(
+					mock.Endpoint{
+						Path:   createCustomerPath,
+						Method: "POST",
+						Response: map[string]interface{}{
+							"error": map[string]interface{}{
+								"code":        "BAD_REQUEST_ERROR",
+								"description": "Customer already exists for the merchant",
+							},
+						},
+					},
+				)
+			},
+			ExpectError: true,
+			ExpectedErrMsg: "creating customer failed: " +
+				"Customer already exists for the merchant",
+		},
+		{
+			Name: "invalid notes parameter",
+			Request: map[string]interface{}{
+				"notes": "not an object",
+			},
+			MockHttpClient: nil,
+			ExpectError:    true,
+			ExpectedErrMsg: "invalid parameter type: notes",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.Name, func(t *testing.T) {
+			runToolTest(t, tc, CreateCustomer, "Customer")
+		})
+	}
+}
+
+func Test_FetchCustomer(t *testing.T) {
+	fetchCustomerPath := fmt.Sprintf(
+		"/%s%s/%s",
+		constants.VERSION_V1,
+		constants.CUSTOMER_URL,
+		"cust_1Aa00000000001",
+	)
+
+	customerResp := map[string]interface{}{
+		"id":      "cust_1Aa00000000001",
+		"entity":  "customer",
+		"name":    "Gaurav Kumar",
+		"email":   "gaurav.kumar@example.com",
+		"contact": "+919000090000",
+	}
+
+	tests := []RazorpayToolTestCase{
+		{
+			Name: "successful customer fetch",
+			Request: map[string]interface{}{
+				"customer_id": "cust_1Aa00000000001",
+			},
+			MockHttpClient: func() (*http.Client, *httptest.Server) {
+				return mock.NewHTTPClient(
+					mock.Endpoint{
+						Path:     fetchCustomerPath,
+						Method:   "GET",
+						Response: customerResp,
+					},
+				)
+			},
+			ExpectError:    false,
+			ExpectedResult: customerResp,
+		},
+		{
+			Name: "customer not found",
+			Request: map[string]interface{}{
+				"customer_id": "cust_1Aa00000000001",
+			},
+			MockHttpClient: func() (*http.Client, *httptest.Server) {
+				return mock.NewHTTPClient(
+					mock.Endpoint{
+						Path:   fetchCustomerPath,
+						Method: "GET",
+						Response: map[string]interface{}{
+							"error": map[string]interface{}{
+								"code":        "BAD_REQUEST_ERROR",
+								"description": "The id provided does not exist",
+							},
+						},
+					},
+				)
+			},
+			ExpectError: true,
+			ExpectedErrMsg: "fetching customer failed: " +
+				"The id provided does not exist",
+		},
+		{
+			Name:           "missing customer_id parameter",
+			Request:        map[string]interface{}{},
+			MockHttpClient: nil,
+			ExpectError:    true,
+			ExpectedErrMsg: "missing required parameter: customer_id",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.Name, func(t *testing.T) {
+			runToolTest(t, tc, FetchCustomer, "Customer")
+		})
+	}
+}
+
+func Test_FetchAllCustomers(t *testing.T) {
+	fetchAllCustomersPath := fmt.Sprintf(
+		"/%s%s",
+		constants.VERSION_V1,
+		constants.CUSTOMER_URL,
+	)
+
+	customersResp := map[string]interface{}{
+		"entity": "collection",
+		"count":  float64(1),
+		"items": []interface{}{
+			map[string]interface{}{
+				"id":      "cust_1Aa00000000001",
+				"entity":  "customer",
+				"name":    "Gaurav Kumar",
+				"contact": "+919000090000",
+			},
+		},
+	}
+
+	tests := []RazorpayToolTestCase{
+		{
+			Name: "successful customers fetch with pagination",
+			Request: map[string]interface{}{
+				"count": float64(10),
+				"skip":  float64(0),
+			},
+			MockHttpClient: func() (*http.Client, *httptest.Server) {
+				return mock.NewHTTPClient(
+					mock.Endpoint{
+						Path:     fetchAllCustomersPath,
+						Method:   "GET",
+						Response: customersResp,
+					},
+				)
+			},
+			ExpectError:    false,
+			ExpectedResult: customersResp,
+		},
+		{
+			Name: "invalid count parameter",
+			Request: map[string]interface{}{
+				"count": "ten",
+			},
+			MockHttpClient: nil,
+			ExpectError:    true,
+			ExpectedErrMsg: "invalid parameter type: count",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.Name, func(t *testing.T) {
+			runToolTest(t, tc, FetchAllCustomers, "Customers")
+		})
+	}
+}
+
+func Test_EditCustomer(t *testing.T) {
+	editCustomerPath := fmt.Sprintf(
+		"/%s%s/%s",
+		constants.VERSION_V1,
+		constants.CUSTOMER_URL,
+		"cust_1Aa00000000001",
+	)
+
+	customerResp := map[string]interface{}{
+		"id":      "cust_1Aa00000000001",
+		"entity":  "customer",
+		"name":    "Gaurav Kumar",
+		"email":   "gaurav.kumar@example.net",
+		"contact": "+919000090000",
+	}
+
+	tests := []RazorpayToolTestCase{
+		{
+			Name: "successful customer edit",
+			Request: map[string]interface{}{
+				"customer_id": "cust_1Aa00000000001",
+				"email":       "gaurav.kumar@example.net",
+			},
+			MockHttpClient: func() (*http.Client, *httptest.Server) {
+				return mock.NewHTTPClient(
+					mock.Endpoint{
+						Path:     editCustomerPath,
+						Method:   "PUT",
+						Response: customerResp,
+					},
+				)
+			},
+			ExpectError:    false,
+			ExpectedResult: customerResp,
+		},
+		{
+			Name: "nothing to edit",
+			Request: map[string]interface{}{
+				"customer_id": "cust_1Aa00000000001",
+			},
+			MockHttpClient: nil,
+			ExpectError:    true,
+			ExpectedErrMsg: "at least one of name, email or contact " +
+				"must be provided",
+		},
+		{
+			Name: "missing customer_id parameter",
+			Request: map[string]interface{}{
+				"name": "Gaurav Kumar",
+			},
+			MockHttpClient: nil,
+			ExpectError:    true,
+			ExpectedErrMsg: "missing required parameter: customer_id",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.Name, func(t *testing.T) {
+			runToolTest(t, tc, EditCustomer, "Customer")
+		})
+	}
+}
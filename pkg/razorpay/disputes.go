@@ -0,0 +1,170 @@
+package razorpay
+
+import (
+	"context"
+	"fmt"
+
+	rzpsdk "github.com/razorpay/razorpay-go"
+
+	"github.com/razorpay/razorpay-mcp-server/pkg/mcpgo"
+	"github.com/razorpay/razorpay-mcp-server/pkg/observability"
+)
+
+// maxDisputeSummaryRecords caps the number of disputes read for a single
+// summary
+const maxDisputeSummaryRecords = 5000
+
+// FetchDisputeSummary returns a tool that summarizes disputes raised in a
+// time range by status and reason code
+func FetchDisputeSummary(
+	obs *observability.Observability,
+	client *rzpsdk.Client,
+) mcpgo.Tool {
+	parameters := []mcpgo.ToolParameter{
+		mcpgo.WithNumber(
+			"from",
+			mcpgo.Description("Unix timestamp (in seconds) from when "+
+				"disputes are to be summarized"),
+			mcpgo.Min(0),
+		),
+		mcpgo.WithNumber(
+			"to",
+			mcpgo.Description("Unix timestamp (in seconds) up till when "+
+				"disputes are to be summarized"),
+			mcpgo.Min(0),
+		),
+	}
+
+	handler := func(
+		ctx context.Context,
+		r mcpgo.CallToolRequest,
+	) (*mcpgo.ToolResult, error) {
+		client, err := getClientFromContextOrDefault(ctx, client)
+		if err != nil {
+			return mcpgo.NewToolResultError(err.Error()), nil
+		}
+
+		queryParams := make(map[string]interface{})
+
+		validator := NewValidator(&r).
+			ValidateAndAddOptionalInt(queryParams, "from").
+			ValidateAndAddOptionalInt(queryParams, "to")
+
+		if result, err := validator.HandleErrorsIfAny(); result != nil {
+			return result, err
+		}
+
+		from, hasFrom := queryParams["from"].(int64)
+		to, hasTo := queryParams["to"].(int64)
+		if hasFrom && hasTo && from > to {
+			return mcpgo.NewToolResultError("from must not be after to"), nil
+		}
+
+		disputes, truncated, err := collectAllRecords(
+			client.Dispute.All, queryParams, maxDisputeSummaryRecords)
+		if err != nil {
+			return mcpgo.NewToolResultError(
+				fmt.Sprintf("fetching disputes failed: %s", err.Error())), nil
+		}
+
+		summary := summarizeDisputes(disputes)
+		// The record cap was hit, so the totals don't cover the whole range
+		summary["truncated"] = truncated
+		if hasFrom {
+			summary["from"] = from
+		}
+		if hasTo {
+			summary["to"] = to
+		}
+
+		return mcpgo.NewToolResultJSON(summary)
+	}
+
+	return mcpgo.NewTool(
+		"fetch_dispute_summary",
+		"Summarize disputes raised in a time range. Returns the total count "+
+			"and, for each currency, the count and amount along with counts "+
+			"and amounts grouped by status (open, under_review, won, lost) "+
+			"and by reason code. Amounts are in the smallest sub-unit of "+
+			"their currency and are never added across currencies. "+
+			"truncated is set when the record cap was hit before every "+
+			"dispute was read",
+		parameters,
+		handler,
+	)
+}
+
+// summarizeDisputes groups disputes by currency, and within each currency
+// by status and reason code, adding up their counts and amounts. Amounts in
+// different currencies are kept apart.
+func summarizeDisputes(
+	disputes []map[string]interface{},
+) map[string]interface{} {
+	byCurrency := make(map[string]map[string]interface{})
+
+	for _, dispute := range disputes {
+		amount, _ := dispute["amount"].(float64)
+		currency, _ := dispute["currency"].(string)
+		if currency == "" {
+			currency = "INR"
+		}
+		if _, ok := byCurrency[currency]; !ok {
+			byCurrency[currency] = newDisputeCurrencySummary()
+		}
+		summary := byCurrency[currency]
+		addToDisputeBucket(summary, amount)
+
+		byStatus := summary["by_status"].(map[string]map[string]interface{})
+		status, _ := dispute["status"].(string)
+		if status == "" {
+			status = "unknown"
+		}
+		if _, ok := byStatus[status]; !ok {
+			byStatus[status] = newDisputeBucket()
+		}
+		addToDisputeBucket(byStatus[status], amount)
+
+		byReasonCode :=
+			summary["by_reason_code"].(map[string]map[string]interface{})
+		reasonCode, _ := dispute["reason_code"].(string)
+		if reasonCode == "" {
+			reasonCode = "unknown"
+		}
+		if _, ok := byReasonCode[reasonCode]; !ok {
+			byReasonCode[reasonCode] = newDisputeBucket()
+		}
+		addToDisputeBucket(byReasonCode[reasonCode], amount)
+	}
+
+	return map[string]interface{}{
+		"total_count": len(disputes),
+		"by_currency": byCurrency,
+	}
+}
+
+// newDisputeCurrencySummary returns an empty summary for a single currency
+func newDisputeCurrencySummary() map[string]interface{} {
+	summary := newDisputeBucket()
+	summary["by_status"] = map[string]map[string]interface{}{
+		"open":         newDisputeBucket(),
+		"under_review": newDisputeBucket(),
+		"won":          newDisputeBucket(),
+		"lost":         newDisputeBucket(),
+	}
+	summary["by_reason_code"] = map[string]map[string]interface{}{}
+	return summary
+}
+
+// newDisputeBucket returns an empty count/amount bucket
+func newDisputeBucket() map[string]interface{} {
+	return map[string]interface{}{
+		"count":  0,
+		"amount": float64(0),
+	}
+}
+
+// addToDisputeBucket adds a single dispute amount to a bucket
+func addToDisputeBucket(bucket map[string]interface{}, amount float64) {
+	bucket["count"] = bucket["count"].(int) + 1
+	bucket["amount"] = bucket["amount"].(float64) + amount
+}
@@ -0,0 +1,271 @@
+package razorpay
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/razorpay/razorpay-go/constants"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/razorpay/razorpay-mcp-server/pkg/razorpay/mock"
+)
+
+func Test_FetchDisputeSummary(t *testing.T) {
+	fetchDisputesPath := fmt.Sprintf(
+		"/%s%s",
+		constants.VERSION_V1,
+		constants.DISPUTE,
+	)
+
+	disputesResp := map[string]interface{}{
+		"entity": "collection",
+		"count":  float64(5),
+		"items": []interface{}{
+			map[string]interface{}{
+				"id":          "disp_Esz7KAitoYM7PJ",
+				"amount":      float64(10000),
+				"currency":    "INR",
+				"status":      "open",
+				"reason_code": "chargeback",
+			},
+			map[string]interface{}{
+				"id":          "disp_Esz7KAitoYM7PK",
+				"amount":      float64(5000),
+				"currency":    "INR",
+				"status":      "open",
+				"reason_code": "fraud",
+			},
+			map[string]interface{}{
+				"id":          "disp_Esz7KAitoYM7PL",
+				"amount":      float64(2500),
+				"currency":    "INR",
+				"status":      "won",
+				"reason_code": "chargeback",
+			},
+			map[string]interface{}{
+				"id":          "disp_Esz7KAitoYM7PM",
+				"amount":      float64(1000),
+				"currency":    "INR",
+				"status":      "closed",
+				"reason_code": "pre_arbitration",
+			},
+			map[string]interface{}{
+				"id":          "disp_Esz7KAitoYM7PN",
+				"amount":      float64(300),
+				"currency":    "USD",
+				"status":      "lost",
+				"reason_code": "chargeback",
+			},
+		},
+	}
+
+	emptyDisputesResp := map[string]interface{}{
+		"entity": "collection",
+		"count":  float64(0),
+		"items":  []interface{}{},
+	}
+
+	disputesFailedResp := map[string]interface{}{
+		"error": map[string]interface{}{
+			"code":        "BAD_REQUEST_ERROR",
+			"description": "The api key provided is invalid",
+		},
+	}
+
+	emptyBucket := map[string]interface{}{
+		"count":  float64(0),
+		"amount": float64(0),
+	}
+
+	tests := []RazorpayToolTestCase{
+		{
+			Name: "successful dispute summary",
+			Request: map[string]interface{}{
+				"from": float64(1600000000),
+				"to":   float64(1700000000),
+			},
+			MockHttpClient: func() (*http.Client, *httptest.Server) {
+				return mock.NewHTTPClient(
+					mock.Endpoint{
+						Path:     fetchDisputesPath,
+						Method:   "GET",
+						Response: disputesResp,
+					},
+				)
+			},
+			ExpectError: false,
+			ExpectedResult: map[string]interface{}{
+				"from":        float64(1600000000),
+				"to":          float64(1700000000),
+				"total_count": float64(5),
+				"truncated":   false,
+				"by_currency": map[string]interface{}{
+					"INR": map[string]interface{}{
+						"count":  float64(4),
+						"amount": float64(18500),
+						"by_status": map[string]interface{}{
+							"open": map[string]interface{}{
+								"count":  float64(2),
+								"amount": float64(15000),
+							},
+							"under_review": emptyBucket,
+							"won": map[string]interface{}{
+								"count":  float64(1),
+								"amount": float64(2500),
+							},
+							"lost": emptyBucket,
+							"closed": map[string]interface{}{
+								"count":  float64(1),
+								"amount": float64(1000),
+							},
+						},
+						"by_reason_code": map[string]interface{}{
+							"chargeback": map[string]interface{}{
+								"count":  float64(2),
+								"amount": float64(12500),
+							},
+							"fraud": map[string]interface{}{
+								"count":  float64(1),
+								"amount": float64(5000),
+							},
+							"pre_arbitration": map[string]interface{}{
+								"count":  float64(1),
+								"amount": float64(1000),
+							},
+						},
+					},
+					"USD": map[string]interface{}{
+						"count":  float64(1),
+						"amount": float64(300),
+						"by_status": map[string]interface{}{
+							"open":         emptyBucket,
+							"under_review": emptyBucket,
+							"won":          emptyBucket,
+							"lost": map[string]interface{}{
+								"count":  float64(1),
+								"amount": float64(300),
+							},
+						},
+						"by_reason_code": map[string]interface{}{
+							"chargeback": map[string]interface{}{
+								"count":  float64(1),
+								"amount": float64(300),
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			Name:    "no disputes in range",
+			Request: map[string]interface{}{},
+			MockHttpClient: func() (*http.Client, *httptest.Server) {
+				return mock.NewHTTPClient(
+					mock.Endpoint{
+						Path:     fetchDisputesPath,
+						Method:   "GET",
+						Response: emptyDisputesResp,
+					},
+				)
+			},
+			ExpectError: false,
+			ExpectedResult: map[string]interface{}{
+				"total_count": float64(0),
+				"truncated":   false,
+				"by_currency": map[string]interface{}{},
+			},
+		},
+		{
+			Name: "fetching disputes fails",
+			Request: map[string]interface{}{
+				"from": float64(1600000000),
+			},
+			MockHttpClient: func() (*http.Client, *httptest.Server) {
+				return mock.NewHTTPClient(
+					mock.Endpoint{
+						Path:     fetchDisputesPath,
+						Method:   "GET",
+						Response: disputesFailedResp,
+					},
+				)
+			},
+			ExpectError:    true,
+			ExpectedErrMsg: "fetching disputes failed: The api key provided is invalid",
+		},
+		{
+			Name: "from after to",
+			Request: map[string]interface{}{
+				"from": float64(1700000000),
+				"to":   float64(1600000000),
+			},
+			MockHttpClient: nil,
+			ExpectError:    true,
+			ExpectedErrMsg: "from must not be after to",
+		},
+		{
+			Name: "invalid from parameter",
+			Request: map[string]interface{}{
+				"from": "yesterday",
+			},
+			MockHttpClient: nil,
+			ExpectError:    true,
+			ExpectedErrMsg: "invalid parameter type: from",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.Name, func(t *testing.T) {
+			runToolTest(t, tc, FetchDisputeSummary, "Dispute Summary")
+		})
+	}
+}
+
+func Test_FetchDisputeSummary_Truncated(t *testing.T) {
+	fetchDisputesPath := fmt.Sprintf(
+		"/%s%s",
+		constants.VERSION_V1,
+		constants.DISPUTE,
+	)
+
+	// Every page comes back full, so paging only stops at the record cap
+	fullPage := make([]interface{}, 0, autoPaginationPageSize)
+	for i := 0; i < autoPaginationPageSize; i++ {
+		fullPage = append(fullPage, map[string]interface{}{
+			"id":          fmt.Sprintf("disp_%014d", i),
+			"amount":      float64(100),
+			"currency":    "INR",
+			"status":      "open",
+			"reason_code": "chargeback",
+		})
+	}
+
+	client, server := newMockRzpClient(func() (*http.Client, *httptest.Server) {
+		return mock.NewHTTPClient(
+			mock.Endpoint{
+				Path:   fetchDisputesPath,
+				Method: "GET",
+				Response: map[string]interface{}{
+					"entity": "collection",
+					"count":  float64(autoPaginationPageSize),
+					"items":  fullPage,
+				},
+			},
+		)
+	})
+	defer server.Close()
+
+	tool := FetchDisputeSummary(CreateTestObservability(), client)
+	result, err := tool.GetHandler()(
+		context.Background(), createMCPRequest(map[string]interface{}{}))
+	assert.NoError(t, err)
+	assert.False(t, result.IsError, result.Text)
+
+	var summary map[string]interface{}
+	assert.NoError(t, json.Unmarshal([]byte(result.Text), &summary))
+	assert.Equal(t, true, summary["truncated"])
+	assert.Equal(t, float64(maxDisputeSummaryRecords), summary["total_count"])
+}
@@ -0,0 +1,166 @@
+package razorpay
+
+import (
+	"context"
+	"fmt"
+
+	rzpsdk "github.com/razorpay/razorpay-go"
+
+	"github.com/razorpay/razorpay-mcp-server/pkg/mcpgo"
+	"github.com/razorpay/razorpay-mcp-server/pkg/observability"
+)
+
+// FetchPaymentDowntimes returns a tool that lists payment method downtimes,
+// grouped by method, so customers can be routed around them
+func FetchPaymentDowntimes(
+	obs *observability.Observability,
+	client *rzpsdk.Client,
+) mcpgo.Tool {
+	parameters := []mcpgo.ToolParameter{
+		mcpgo.WithString(
+			"method",
+			mcpgo.Description("Only return downtimes for this payment method"),
+			mcpgo.Enum("card", "netbanking", "upi", "wallet", "emi"),
+		),
+		mcpgo.WithBoolean(
+			"include_resolved",
+			mcpgo.Description("Also return downtimes that have already been "+
+				"resolved. By default only scheduled and ongoing ones are returned"),
+			mcpgo.DefaultValue(false),
+		),
+	}
+
+	handler := func(
+		ctx context.Context,
+		r mcpgo.CallToolRequest,
+	) (*mcpgo.ToolResult, error) {
+		client, err := getClientFromContextOrDefault(ctx, client)
+		if err != nil {
+			return mcpgo.NewToolResultError(err.Error()), nil
+		}
+
+		params := make(map[string]interface{})
+
+		validator := NewValidator(&r).
+			ValidateAndAddOptionalString(params, "method").
+			ValidateAndAddOptionalBool(params, "include_resolved")
+
+		if result, err := validator.HandleErrorsIfAny(); result != nil {
+			return result, err
+		}
+
+		downtimes, err := withRetry(ctx, func() (map[string]interface{}, error) {
+			return client.Payment.FetchPaymentDowntime(nil, nil)
+		})
+		if err != nil {
+			return mcpgo.NewToolResultError(
+				fmt.Sprintf("fetching payment downtimes failed: %s",
+					err.Error())), nil
+		}
+
+		method, _ := params["method"].(string)
+		includeResolved, _ := params["include_resolved"].(bool)
+		return mcpgo.NewToolResultJSON(
+			groupDowntimesByMethod(downtimes, method, includeResolved))
+	}
+
+	return mcpgo.NewTool(
+		"fetch_payment_downtimes",
+		"Fetch payment method downtimes (card networks and issuers, "+
+			"netbanking banks, UPI apps and handles, wallets) grouped by "+
+			"method. Check this before routing a customer to a method; "+
+			"instrument names the affected issuer, bank or PSP and severity "+
+			"is low, medium or high",
+		parameters,
+		handler,
+	)
+}
+
+// FetchPaymentDowntimeByID returns a tool that fetches a single payment
+// downtime
+func FetchPaymentDowntimeByID(
+	obs *observability.Observability,
+	client *rzpsdk.Client,
+) mcpgo.Tool {
+	parameters := []mcpgo.ToolParameter{
+		mcpgo.WithString(
+			"downtime_id",
+			mcpgo.Description("Unique identifier of the downtime. "+
+				"ID should have a down_ prefix."),
+			mcpgo.Required(),
+		),
+	}
+
+	handler := func(
+		ctx context.Context,
+		r mcpgo.CallToolRequest,
+	) (*mcpgo.ToolResult, error) {
+		client, err := getClientFromContextOrDefault(ctx, client)
+		if err != nil {
+			return mcpgo.NewToolResultError(err.Error()), nil
+		}
+
+		params := make(map[string]interface{})
+
+		validator := NewValidator(&r).
+			ValidateAndAddRequiredString(params, "downtime_id")
+
+		if result, err := validator.HandleErrorsIfAny(); result != nil {
+			return result, err
+		}
+
+		downtimeID := params["downtime_id"].(string)
+		downtime, err := withRetry(ctx, func() (map[string]interface{}, error) {
+			return client.Payment.FetchPaymentDowntimeById(downtimeID, nil, nil)
+		})
+		if err != nil {
+			return mcpgo.NewToolResultError(
+				fmt.Sprintf("fetching payment downtime failed: %s",
+					err.Error())), nil
+		}
+
+		return mcpgo.NewToolResultJSON(downtime)
+	}
+
+	return mcpgo.NewTool(
+		"fetch_payment_downtime",
+		"Fetch a payment downtime by ID, including its status (scheduled, "+
+			"started or resolved), the affected method and instrument, and "+
+			"when it began and ended",
+		parameters,
+		handler,
+	)
+}
+
+// groupDowntimesByMethod filters the downtime collection to the requested
+// method and, unless includeResolved is set, drops resolved downtimes
+func groupDowntimesByMethod(
+	downtimes map[string]interface{},
+	method string,
+	includeResolved bool,
+) map[string]interface{} {
+	byMethod := make(map[string][]interface{})
+	count := 0
+
+	items, _ := downtimes["items"].([]interface{})
+	for _, item := range items {
+		downtime, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if !includeResolved && downtime["status"] == "resolved" {
+			continue
+		}
+		m, _ := downtime["method"].(string)
+		if method != "" && m != method {
+			continue
+		}
+		byMethod[m] = append(byMethod[m], downtime)
+		count++
+	}
+
+	return map[string]interface{}{
+		"count":     count,
+		"by_method": byMethod,
+	}
+}
@@ -0,0 +1,201 @@
+package razorpay
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/razorpay/razorpay-go/constants"
+
+	"github.com/razorpay/razorpay-mcp-server/pkg/razorpay/mock"
+)
+
+func Test_FetchPaymentDowntimes(t *testing.T) {
+	fetchDowntimesPath := fmt.Sprintf(
+		"/%s%s/downtimes",
+		constants.VERSION_V1,
+		constants.PAYMENT_URL,
+	)
+
+	cardDowntime := map[string]interface{}{
+		"id":       "down_F1cxDoHWD4fkQt",
+		"entity":   "payment.downtime",
+		"method":   "card",
+		"status":   "started",
+		"severity": "high",
+		"instrument": map[string]interface{}{
+			"issuer": "HDFC",
+		},
+		"begin": float64(1591946700),
+		"end":   nil,
+	}
+	upiDowntime := map[string]interface{}{
+		"id":       "down_F1cxDoHWD4fkQu",
+		"entity":   "payment.downtime",
+		"method":   "upi",
+		"status":   "scheduled",
+		"severity": "medium",
+		"instrument": map[string]interface{}{
+			"psp": "googlepay",
+		},
+		"begin": float64(1591956700),
+		"end":   float64(1591960300),
+	}
+	resolvedDowntime := map[string]interface{}{
+		"id":       "down_F1cxDoHWD4fkQv",
+		"entity":   "payment.downtime",
+		"method":   "card",
+		"status":   "resolved",
+		"severity": "low",
+		"instrument": map[string]interface{}{
+			"network": "VISA",
+		},
+		"begin": float64(1591846700),
+		"end":   float64(1591850300),
+	}
+
+	downtimesMock := func() (*http.Client, *httptest.Server) {
+		return mock.NewHTTPClient(
+			mock.Endpoint{
+				Path:   fetchDowntimesPath,
+				Method: "GET",
+				Response: map[string]interface{}{
+					"entity": "collection",
+					"count":  float64(3),
+					"items": []interface{}{
+						cardDowntime, upiDowntime, resolvedDowntime,
+					},
+				},
+			},
+		)
+	}
+
+	tests := []RazorpayToolTestCase{
+		{
+			Name:           "active downtimes grouped by method",
+			Request:        map[string]interface{}{},
+			MockHttpClient: downtimesMock,
+			ExpectedResult: map[string]interface{}{
+				"count": float64(2),
+				"by_method": map[string]interface{}{
+					"card": []interface{}{cardDowntime},
+					"upi":  []interface{}{upiDowntime},
+				},
+			},
+		},
+		{
+			Name: "filtered by method including resolved",
+			Request: map[string]interface{}{
+				"method":           "card",
+				"include_resolved": true,
+			},
+			MockHttpClient: downtimesMock,
+			ExpectedResult: map[string]interface{}{
+				"count": float64(2),
+				"by_method": map[string]interface{}{
+					"card": []interface{}{cardDowntime, resolvedDowntime},
+				},
+			},
+		},
+		{
+			Name:    "api error",
+			Request: map[string]interface{}{},
+			MockHttpClient: func() (*http.Client, *httptest.Server) {
+				return mock.NewHTTPClient(
+					mock.Endpoint{
+						Path:   fetchDowntimesPath,
+						Method: "GET",
+						Response: map[string]interface{}{
+							"error": map[string]interface{}{
+								"code":        "BAD_REQUEST_ERROR",
+								"description": "Access denied",
+							},
+						},
+					},
+				)
+			},
+			ExpectError:    true,
+			ExpectedErrMsg: "fetching payment downtimes failed: Access denied",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.Name, func(t *testing.T) {
+			runToolTest(t, tc, FetchPaymentDowntimes, "Downtimes")
+		})
+	}
+}
+
+func Test_FetchPaymentDowntimeByID(t *testing.T) {
+	fetchDowntimePathFmt := fmt.Sprintf(
+		"/%s%s/downtimes/%%s",
+		constants.VERSION_V1,
+		constants.PAYMENT_URL,
+	)
+
+	downtime := map[string]interface{}{
+		"id":       "down_F1cxDoHWD4fkQt",
+		"entity":   "payment.downtime",
+		"method":   "netbanking",
+		"status":   "started",
+		"severity": "high",
+		"instrument": map[string]interface{}{
+			"bank": "SBIN",
+		},
+	}
+
+	tests := []RazorpayToolTestCase{
+		{
+			Name: "successful fetch",
+			Request: map[string]interface{}{
+				"downtime_id": "down_F1cxDoHWD4fkQt",
+			},
+			MockHttpClient: func() (*http.Client, *httptest.Server) {
+				return mock.NewHTTPClient(
+					mock.Endpoint{
+						Path:     fmt.Sprintf(fetchDowntimePathFmt, "down_F1cxDoHWD4fkQt"),
+						Method:   "GET",
+						Response: downtime,
+					},
+				)
+			},
+			ExpectedResult: downtime,
+		},
+		{
+			Name: "downtime not found",
+			Request: map[string]interface{}{
+				"downtime_id": "down_invalid",
+			},
+			MockHttpClient: func() (*http.Client, *httptest.Server) {
+				return mock.NewHTTPClient(
+					mock.Endpoint{
+						Path:   fmt.Sprintf(fetchDowntimePathFmt, "down_invalid"),
+						Method: "GET",
+						Response: map[string]interface{}{
+							"error": map[string]interface{}{
+								"code":        "BAD_REQUEST_ERROR",
+								"description": "The id provided does not exist",
+							},
+						},
+					},
+				)
+			},
+			ExpectError: true,
+			ExpectedErrMsg: "fetching payment downtime failed: " +
+				"The id provided does not exist",
+		},
+		{
+			Name:           "missing downtime_id",
+			Request:        map[string]interface{}{},
+			ExpectError:    true,
+			ExpectedErrMsg: "missing required parameter: downtime_id",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.Name, func(t *testing.T) {
+			runToolTest(t, tc, FetchPaymentDowntimeByID, "Downtime")
+		})
+	}
+}
@@ -0,0 +1,200 @@
+package razorpay
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"regexp"
+	"slices"
+	"sort"
+	"strconv"
+
+	rzpsdk "github.com/razorpay/razorpay-go"
+
+	"github.com/razorpay/razorpay-mcp-server/pkg/mcpgo"
+	"github.com/razorpay/razorpay-mcp-server/pkg/observability"
+)
+
+// cardIINPattern matches the issuer identification number, the first six
+// digits of a card number
+var cardIINPattern = regexp.MustCompile(`^[0-9]{6}$`)
+
+// FetchEmiPlans returns a tool that lists the card EMI tenures and interest
+// rates available for a card IIN and/or an amount
+func FetchEmiPlans(
+	obs *observability.Observability,
+	client *rzpsdk.Client,
+) mcpgo.Tool {
+	parameters := []mcpgo.ToolParameter{
+		mcpgo.WithString(
+			"card_iin",
+			mcpgo.Description("First 6 digits of the card number. Limits the "+
+				"plans to the card's issuing bank and reports whether the card "+
+				"supports EMI at all"),
+		),
+		mcpgo.WithNumber(
+			"amount",
+			mcpgo.Description("Amount in the smallest currency unit (paise "+
+				"for INR). Drops banks whose EMI minimum is above it and adds "+
+				"the approximate monthly installment to each plan"),
+			mcpgo.Min(100),
+		),
+	}
+
+	handler := func(
+		ctx context.Context,
+		r mcpgo.CallToolRequest,
+	) (*mcpgo.ToolResult, error) {
+		client, err := getClientFromContextOrDefault(ctx, client)
+		if err != nil {
+			return mcpgo.NewToolResultError(err.Error()), nil
+		}
+
+		params := make(map[string]interface{})
+
+		validator := NewValidator(&r).
+			ValidateAndAddOptionalString(params, "card_iin").
+			ValidateAndAddOptionalInt(params, "amount")
+
+		if result, err := validator.HandleErrorsIfAny(); result != nil {
+			return result, err
+		}
+
+		cardIIN, _ := params["card_iin"].(string)
+		amount, hasAmount := params["amount"].(int64)
+		if cardIIN == "" && !hasAmount {
+			return mcpgo.NewToolResultError(
+				"provide card_iin, amount or both to look up EMI plans"), nil
+		}
+		if cardIIN != "" && !cardIINPattern.MatchString(cardIIN) {
+			return mcpgo.NewToolResultError(fmt.Sprintf(
+				"invalid card_iin %q: expected the first 6 digits of the card",
+				cardIIN)), nil
+		}
+
+		result := make(map[string]interface{})
+		banks := []string(nil)
+		if cardIIN != "" {
+			iin, err := withRetry(ctx, func() (map[string]interface{}, error) {
+				return client.Iin.Fetch(cardIIN, nil, nil)
+			})
+			if err != nil {
+				return mcpgo.NewToolResultError(
+					fmt.Sprintf("fetching card IIN failed: %s", err.Error())), nil
+			}
+
+			emi, _ := iin["emi"].(map[string]interface{})
+			available, _ := emi["available"].(bool)
+			result["card_iin"] = cardIIN
+			result["issuer_code"] = iin["issuer_code"]
+			result["issuer_name"] = iin["issuer_name"]
+			result["network"] = iin["network"]
+			result["card_type"] = iin["type"]
+			result["emi_available"] = available
+			if !available {
+				result["plans"] = []interface{}{}
+				return mcpgo.NewToolResultJSON(result)
+			}
+
+			// Debit card EMI plans are listed under the bank code with _DC
+			issuer, _ := iin["issuer_code"].(string)
+			banks = []string{issuer}
+			if iin["type"] == "debit" {
+				banks = []string{issuer + "_DC"}
+			}
+		}
+
+		methods, err := withRetry(ctx, func() (map[string]interface{}, error) {
+			return client.Payment.FetchMethods(nil, nil)
+		})
+		if err != nil {
+			return mcpgo.NewToolResultError(
+				fmt.Sprintf("fetching EMI plans failed: %s", err.Error())), nil
+		}
+
+		emiPlans, _ := methods["emi_plans"].(map[string]interface{})
+		if hasAmount {
+			result["amount"] = amount
+		}
+		result["plans"] = buildEmiPlans(emiPlans, banks, amount)
+
+		return mcpgo.NewToolResultJSON(result)
+	}
+
+	return mcpgo.NewTool(
+		"fetch_emi_plans",
+		"Fetch the card EMI plans available on the account: bank, tenure in "+
+			"months, annual interest rate and the bank's minimum amount. "+
+			"Pass card_iin to check one card and get its bank's plans, and "+
+			"amount (in paise) to keep only eligible plans with their "+
+			"approximate monthly installment, for showing tenures at checkout",
+		parameters,
+		handler,
+	)
+}
+
+// buildEmiPlans flattens the bank-wise emi_plans of the methods response
+// into one entry per bank and tenure. banks limits the result to those bank
+// codes when set, and a non-zero amount drops banks whose minimum is above
+// it and adds the monthly installment
+func buildEmiPlans(
+	emiPlans map[string]interface{},
+	banks []string,
+	amount int64,
+) []map[string]interface{} {
+	plans := make([]map[string]interface{}, 0)
+
+	for bank, raw := range emiPlans {
+		if len(banks) > 0 && !slices.Contains(banks, bank) {
+			continue
+		}
+		bankPlans, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		minAmount, _ := bankPlans["min_amount"].(float64)
+		if amount > 0 && float64(amount) < minAmount {
+			continue
+		}
+
+		tenures, _ := bankPlans["plans"].(map[string]interface{})
+		for tenure, rate := range tenures {
+			months, err := strconv.Atoi(tenure)
+			interestRate, ok := rate.(float64)
+			if err != nil || months <= 0 || !ok {
+				continue
+			}
+
+			plan := map[string]interface{}{
+				"bank":          bank,
+				"tenure_months": months,
+				"interest_rate": interestRate,
+				"min_amount":    minAmount,
+			}
+			if amount > 0 {
+				plan["monthly_installment"] = emiInstallment(
+					float64(amount), interestRate, months)
+			}
+			plans = append(plans, plan)
+		}
+	}
+
+	sort.Slice(plans, func(i, j int) bool {
+		if plans[i]["bank"] != plans[j]["bank"] {
+			return plans[i]["bank"].(string) < plans[j]["bank"].(string)
+		}
+		return plans[i]["tenure_months"].(int) < plans[j]["tenure_months"].(int)
+	})
+	return plans
+}
+
+// emiInstallment returns the monthly installment, rounded to the smallest
+// currency unit, for repaying principal over months at an annual rate
+func emiInstallment(principal, annualRate float64, months int) int64 {
+	monthlyRate := annualRate / 12 / 100
+	if monthlyRate == 0 {
+		return int64(math.Round(principal / float64(months)))
+	}
+	growth := math.Pow(1+monthlyRate, float64(months))
+	return int64(math.Round(principal * monthlyRate * growth / (growth - 1)))
+}
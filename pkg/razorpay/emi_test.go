@@ -0,0 +1,198 @@
+package razorpay
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/razorpay/razorpay-go/constants"
+
+	"github.com/razorpay/razorpay-mcp-server/pkg/razorpay/mock"
+)
+
+func Test_FetchEmiPlans(t *testing.T) {
+	methodsPath := fmt.Sprintf("/%s%s", constants.VERSION_V1,
+		constants.METHODS_URL)
+	iinPathFmt := fmt.Sprintf("/%s%s/%%s", constants.VERSION_V1, constants.IIN)
+
+	methodsResp := map[string]interface{}{
+		"entity": "methods",
+		"card":   true,
+		"emi_plans": map[string]interface{}{
+			"HDFC": map[string]interface{}{
+				"min_amount": float64(300000),
+				"plans": map[string]interface{}{
+					"3": float64(12),
+					"6": float64(12),
+				},
+			},
+			"ICIC": map[string]interface{}{
+				"min_amount": float64(150000),
+				"plans": map[string]interface{}{
+					"3": float64(13),
+				},
+			},
+		},
+	}
+
+	iinResp := func(iin string, emi bool) map[string]interface{} {
+		return map[string]interface{}{
+			"iin":         iin,
+			"entity":      "iin",
+			"network":     "Visa",
+			"type":        "credit",
+			"issuer_code": "HDFC",
+			"issuer_name": "HDFC Bank Ltd",
+			"emi":         map[string]interface{}{"available": emi},
+		}
+	}
+
+	tests := []RazorpayToolTestCase{
+		{
+			Name: "plans for an amount",
+			Request: map[string]interface{}{
+				"amount": float64(200000),
+			},
+			MockHttpClient: func() (*http.Client, *httptest.Server) {
+				return mock.NewHTTPClient(
+					mock.Endpoint{
+						Path:     methodsPath,
+						Method:   "GET",
+						Response: methodsResp,
+					},
+				)
+			},
+			ExpectedResult: map[string]interface{}{
+				"amount": float64(200000),
+				"plans": []interface{}{
+					map[string]interface{}{
+						"bank":                "ICIC",
+						"tenure_months":       float64(3),
+						"interest_rate":       float64(13),
+						"min_amount":          float64(150000),
+						"monthly_installment": float64(68116),
+					},
+				},
+			},
+		},
+		{
+			Name: "plans for a card IIN",
+			Request: map[string]interface{}{
+				"card_iin": "412345",
+			},
+			MockHttpClient: func() (*http.Client, *httptest.Server) {
+				return mock.NewHTTPClient(
+					mock.Endpoint{
+						Path:     fmt.Sprintf(iinPathFmt, "412345"),
+						Method:   "GET",
+						Response: iinResp("412345", true),
+					},
+					mock.Endpoint{
+						Path:     methodsPath,
+						Method:   "GET",
+						Response: methodsResp,
+					},
+				)
+			},
+			ExpectedResult: map[string]interface{}{
+				"card_iin":      "412345",
+				"issuer_code":   "HDFC",
+				"issuer_name":   "HDFC Bank Ltd",
+				"network":       "Visa",
+				"card_type":     "credit",
+				"emi_available": true,
+				"plans": []interface{}{
+					map[string]interface{}{
+						"bank":          "HDFC",
+						"tenure_months": float64(3),
+						"interest_rate": float64(12),
+						"min_amount":    float64(300000),
+					},
+					map[string]interface{}{
+						"bank":          "HDFC",
+						"tenure_months": float64(6),
+						"interest_rate": float64(12),
+						"min_amount":    float64(300000),
+					},
+				},
+			},
+		},
+		{
+			Name: "card without EMI",
+			Request: map[string]interface{}{
+				"card_iin": "512345",
+			},
+			MockHttpClient: func() (*http.Client, *httptest.Server) {
+				return mock.NewHTTPClient(
+					mock.Endpoint{
+						Path:     fmt.Sprintf(iinPathFmt, "512345"),
+						Method:   "GET",
+						Response: iinResp("512345", false),
+					},
+				)
+			},
+			ExpectedResult: map[string]interface{}{
+				"card_iin":      "512345",
+				"issuer_code":   "HDFC",
+				"issuer_name":   "HDFC Bank Ltd",
+				"network":       "Visa",
+				"card_type":     "credit",
+				"emi_available": false,
+				"plans":         []interface{}{},
+			},
+		},
+		{
+			Name: "card IIN that is not 6 digits",
+			Request: map[string]interface{}{
+				"card_iin": "41234",
+			},
+			ExpectError: true,
+			ExpectedErrMsg: "invalid card_iin \"41234\": expected the first " +
+				"6 digits of the card",
+		},
+		{
+			Name:           "neither card IIN nor amount",
+			Request:        map[string]interface{}{},
+			ExpectError:    true,
+			ExpectedErrMsg: "provide card_iin, amount or both",
+		},
+		{
+			Name: "methods api error",
+			Request: map[string]interface{}{
+				"amount": float64(200000),
+			},
+			MockHttpClient: func() (*http.Client, *httptest.Server) {
+				return mock.NewHTTPClient(
+					mock.Endpoint{
+						Path:   methodsPath,
+						Method: "GET",
+						Response: map[string]interface{}{
+							"error": map[string]interface{}{
+								"code":        "BAD_REQUEST_ERROR",
+								"description": "Access denied",
+							},
+						},
+					},
+				)
+			},
+			ExpectError:    true,
+			ExpectedErrMsg: "fetching EMI plans failed: Access denied",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.Name, func(t *testing.T) {
+			runToolTest(t, tc, FetchEmiPlans, "EMI plans")
+		})
+	}
+}
+
+func TestEmiInstallment(t *testing.T) {
+	// ₹10,000 over 12 months at 12% a year is ₹888.49 a month
+	assert.Equal(t, int64(88849), emiInstallment(1000000, 12, 12))
+	// No-cost EMI splits the amount evenly
+	assert.Equal(t, int64(333333), emiInstallment(1000000, 0, 3))
+}
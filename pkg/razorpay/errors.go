@@ -0,0 +1,106 @@
+package razorpay
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+
+	rzperrors "github.com/razorpay/razorpay-go/errors"
+
+	"github.com/razorpay/razorpay-mcp-server/pkg/mcpgo"
+)
+
+// Error codes reported for failed API calls. The first three are Razorpay's
+// own error classes.
+const (
+	errorCodeBadRequest = "BAD_REQUEST_ERROR"
+	errorCodeServer     = "SERVER_ERROR"
+	errorCodeGateway    = "GATEWAY_ERROR"
+	errorCodeNetwork    = "NETWORK_ERROR"
+	errorCodeUnknown    = "UNKNOWN_ERROR"
+)
+
+// RazorpayError is the structured form of a failed API call returned to
+// agents, so they can branch on the code instead of parsing the message
+type RazorpayError struct {
+	// Message keeps the "<action> failed: <description>" text error results
+	// have always carried
+	Message     string `json:"message"`
+	Code        string `json:"code"`
+	Description string `json:"description"`
+	Reason      string `json:"reason,omitempty"`
+	Field       string `json:"field,omitempty"`
+	// Retryable is set for errors on Razorpay's or the network's side,
+	// where the same request may succeed later
+	Retryable bool `json:"retryable"`
+}
+
+// parseRazorpayError classifies an error returned by the SDK. The SDK keeps
+// only the error class and description of the API's error payload, so
+// reason and field are filled in when the payload itself is the error text.
+func parseRazorpayError(err error) RazorpayError {
+	rzpErr := RazorpayError{
+		Code:        errorCodeUnknown,
+		Description: err.Error(),
+	}
+
+	var (
+		badRequest *rzperrors.BadRequestError
+		server     *rzperrors.ServerError
+		gateway    *rzperrors.GatewayError
+		urlErr     *url.Error
+	)
+	switch {
+	case errors.As(err, &badRequest):
+		rzpErr.Code = errorCodeBadRequest
+	case errors.As(err, &server):
+		rzpErr.Code = errorCodeServer
+		rzpErr.Retryable = true
+	case errors.As(err, &gateway):
+		rzpErr.Code = errorCodeGateway
+		rzpErr.Retryable = true
+	case errors.As(err, &urlErr):
+		rzpErr.Code = errorCodeNetwork
+		rzpErr.Retryable = true
+	}
+
+	var payload struct {
+		Error struct {
+			Code        string `json:"code"`
+			Description string `json:"description"`
+			Reason      string `json:"reason"`
+			Field       string `json:"field"`
+		} `json:"error"`
+	}
+	if json.Unmarshal([]byte(err.Error()), &payload) == nil &&
+		payload.Error.Code != "" {
+		rzpErr.Code = payload.Error.Code
+		rzpErr.Description = payload.Error.Description
+		rzpErr.Reason = payload.Error.Reason
+		rzpErr.Field = payload.Error.Field
+		rzpErr.Retryable = payload.Error.Code == errorCodeServer ||
+			payload.Error.Code == errorCodeGateway
+	}
+
+	return rzpErr
+}
+
+// unwrapRazorpayError returns an error result for a failed API call whose
+// text is the RazorpayError as JSON. action is the failed operation, e.g.
+// "capturing payment".
+func unwrapRazorpayError(action string, err error) *mcpgo.ToolResult {
+	rzpErr := parseRazorpayError(err)
+	rzpErr.Message = fmt.Sprintf("%s failed: %s", action, rzpErr.Description)
+
+	// Descriptions are shown as-is, so & and <> must not be escaped
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+	if encodeErr := encoder.Encode(rzpErr); encodeErr != nil {
+		return mcpgo.NewToolResultError(rzpErr.Message)
+	}
+
+	return mcpgo.NewToolResultError(string(bytes.TrimSpace(buf.Bytes())))
+}
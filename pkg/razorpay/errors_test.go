@@ -0,0 +1,168 @@
+package razorpay
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/razorpay/razorpay-go/constants"
+	rzperrors "github.com/razorpay/razorpay-go/errors"
+
+	"github.com/razorpay/razorpay-mcp-server/pkg/mcpgo"
+	"github.com/razorpay/razorpay-mcp-server/pkg/razorpay/mock"
+)
+
+func TestParseRazorpayError(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want RazorpayError
+	}{
+		{
+			name: "bad request",
+			err: &rzperrors.BadRequestError{
+				Message: "The amount must be atleast INR 1.00"},
+			want: RazorpayError{
+				Code:        "BAD_REQUEST_ERROR",
+				Description: "The amount must be atleast INR 1.00",
+			},
+		},
+		{
+			name: "server error",
+			err:  &rzperrors.ServerError{Message: "The server is down"},
+			want: RazorpayError{
+				Code:        "SERVER_ERROR",
+				Description: "The server is down",
+				Retryable:   true,
+			},
+		},
+		{
+			name: "gateway error",
+			err:  &rzperrors.GatewayError{Message: "Bank is down"},
+			want: RazorpayError{
+				Code:        "GATEWAY_ERROR",
+				Description: "Bank is down",
+				Retryable:   true,
+			},
+		},
+		{
+			name: "wrapped SDK error",
+			err: fmt.Errorf("capture: %w",
+				&rzperrors.BadRequestError{Message: "Invalid id"}),
+			want: RazorpayError{
+				Code:        "BAD_REQUEST_ERROR",
+				Description: "capture: Invalid id",
+			},
+		},
+		{
+			name: "network error",
+			err: &url.Error{Op: "Post", URL: "https://api.razorpay.com",
+				Err: errors.New("connection refused")},
+			want: RazorpayError{
+				Code: "NETWORK_ERROR",
+				Description: `Post "https://api.razorpay.com": ` +
+					"connection refused",
+				Retryable: true,
+			},
+		},
+		{
+			name: "error payload with reason and field",
+			err: errors.New(`{"error":{"code":"BAD_REQUEST_ERROR",` +
+				`"description":"The amount field is required.",` +
+				`"reason":"input_validation_failed","field":"amount"}}`),
+			want: RazorpayError{
+				Code:        "BAD_REQUEST_ERROR",
+				Description: "The amount field is required.",
+				Reason:      "input_validation_failed",
+				Field:       "amount",
+			},
+		},
+		{
+			name: "unknown error",
+			err:  errors.New("something broke"),
+			want: RazorpayError{
+				Code:        "UNKNOWN_ERROR",
+				Description: "something broke",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, parseRazorpayError(tt.err))
+		})
+	}
+}
+
+func TestUnwrapRazorpayError(t *testing.T) {
+	result := unwrapRazorpayError("capturing payment",
+		&rzperrors.BadRequestError{Message: "Amount < captured & refunded"})
+	assert.True(t, result.IsError)
+
+	var got RazorpayError
+	assert.NoError(t, json.Unmarshal([]byte(result.Text), &got))
+	assert.Equal(t, RazorpayError{
+		Message:     "capturing payment failed: Amount < captured & refunded",
+		Code:        "BAD_REQUEST_ERROR",
+		Description: "Amount < captured & refunded",
+	}, got)
+	// The message is readable in the raw text too
+	assert.Contains(t, result.Text,
+		"capturing payment failed: Amount < captured & refunded")
+}
+
+func TestUnwrapRazorpayError_WriteTools(t *testing.T) {
+	serverErrorResp := map[string]interface{}{
+		"error": map[string]interface{}{
+			"code":        "SERVER_ERROR",
+			"description": "The server encountered an error",
+			// The SDK picks the error type from this field
+			"internal_error_code": "SERVER_ERROR",
+		},
+	}
+
+	client, server := newMockRzpClient(func() (*http.Client, *httptest.Server) {
+		return mock.NewHTTPClient(mock.Endpoint{
+			Path: fmt.Sprintf("/%s%s/pay_G3P9vcIhRs3NV4",
+				constants.VERSION_V1, constants.PAYMENT_URL),
+			Method: "GET",
+			Response: map[string]interface{}{
+				"id":       "pay_G3P9vcIhRs3NV4",
+				"amount":   float64(1000),
+				"currency": "INR",
+				"status":   "authorized",
+			},
+		}, mock.Endpoint{
+			Path: fmt.Sprintf("/%s%s/pay_G3P9vcIhRs3NV4/capture",
+				constants.VERSION_V1, constants.PAYMENT_URL),
+			Method:   "POST",
+			Response: serverErrorResp,
+		})
+	})
+	defer server.Close()
+
+	tool := CapturePayment(CreateTestObservability(), client)
+	result, err := tool.GetHandler()(context.Background(),
+		mcpgo.CallToolRequest{Arguments: map[string]interface{}{
+			"payment_id": "pay_G3P9vcIhRs3NV4",
+			"amount":     float64(1000),
+			"currency":   "INR",
+		}})
+	assert.NoError(t, err)
+	assert.True(t, result.IsError)
+
+	var got RazorpayError
+	assert.NoError(t, json.Unmarshal([]byte(result.Text), &got))
+	assert.Equal(t, "SERVER_ERROR", got.Code)
+	assert.True(t, got.Retryable)
+	assert.Equal(t,
+		"capturing payment failed: The server encountered an error",
+		got.Message)
+}
@@ -0,0 +1,85 @@
+package razorpay
+
+import (
+	"context"
+	"fmt"
+
+	rzpsdk "github.com/razorpay/razorpay-go"
+	"github.com/razorpay/razorpay-go/constants"
+
+	"github.com/razorpay/razorpay-mcp-server/pkg/mcpgo"
+	"github.com/razorpay/razorpay-mcp-server/pkg/observability"
+)
+
+// FetchFeeBearerConfig returns a tool that fetches who bears the payment
+// gateway fees for the account and the pricing details where available
+func FetchFeeBearerConfig(
+	obs *observability.Observability,
+	client *rzpsdk.Client,
+) mcpgo.Tool {
+	parameters := []mcpgo.ToolParameter{}
+
+	handler := func(
+		ctx context.Context,
+		r mcpgo.CallToolRequest,
+	) (*mcpgo.ToolResult, error) {
+		client, err := getClientFromContextOrDefault(ctx, client)
+		if err != nil {
+			return mcpgo.NewToolResultError(err.Error()), nil
+		}
+
+		url := fmt.Sprintf("/%s/preferences", constants.VERSION_V1)
+		preferences, err := client.Request.Get(
+			url,
+			map[string]interface{}{"key_id": client.Request.Auth.Key},
+			nil,
+		)
+		if err != nil {
+			return mcpgo.NewToolResultError(
+				fmt.Sprintf("fetching fee bearer config failed: %s", err.Error())), nil
+		}
+
+		return mcpgo.NewToolResultJSON(buildFeeBearerConfig(preferences))
+	}
+
+	return mcpgo.NewTool(
+		"fetch_fee_bearer_config",
+		"Fetch the account's fee bearer setting. 'platform' means the merchant "+
+			"absorbs gateway fees and the customer pays the order amount; "+
+			"'customer' means the fee is added on top of the order amount at "+
+			"checkout. Also returns the enabled payment methods and the "+
+			"method-wise MDR when the account exposes it",
+		parameters,
+		handler,
+	)
+}
+
+// buildFeeBearerConfig extracts the fee bearer and pricing details from the
+// account preferences
+func buildFeeBearerConfig(
+	preferences map[string]interface{},
+) map[string]interface{} {
+	feeBearer := "platform"
+	if customerFeeBearer, ok := preferences["fee_bearer"].(bool); ok &&
+		customerFeeBearer {
+		feeBearer = "customer"
+	}
+
+	result := map[string]interface{}{
+		"fee_bearer": feeBearer,
+	}
+
+	if methods, ok := preferences["methods"]; ok {
+		result["methods"] = methods
+	}
+
+	// MDR is only returned for accounts with pricing visibility enabled
+	if mdr, ok := preferences["mdr"]; ok {
+		result["method_wise_mdr"] = mdr
+		result["mdr_available"] = true
+	} else {
+		result["mdr_available"] = false
+	}
+
+	return result
+}
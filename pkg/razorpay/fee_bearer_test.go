@@ -0,0 +1,107 @@
+package razorpay
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/razorpay/razorpay-go/constants"
+
+	"github.com/razorpay/razorpay-mcp-server/pkg/razorpay/mock"
+)
+
+func Test_FetchFeeBearerConfig(t *testing.T) {
+	fetchPreferencesPath := fmt.Sprintf(
+		"/%s/preferences",
+		constants.VERSION_V1,
+	)
+
+	methods := map[string]interface{}{
+		"card":       true,
+		"netbanking": true,
+		"upi":        true,
+		"wallet":     false,
+	}
+
+	mdr := map[string]interface{}{
+		"card": float64(2),
+		"upi":  float64(0),
+	}
+
+	tests := []RazorpayToolTestCase{
+		{
+			Name:    "customer fee bearer with mdr",
+			Request: map[string]interface{}{},
+			MockHttpClient: func() (*http.Client, *httptest.Server) {
+				return mock.NewHTTPClient(
+					mock.Endpoint{
+						Path:   fetchPreferencesPath,
+						Method: "GET",
+						Response: map[string]interface{}{
+							"fee_bearer": true,
+							"methods":    methods,
+							"mdr":        mdr,
+						},
+					},
+				)
+			},
+			ExpectError: false,
+			ExpectedResult: map[string]interface{}{
+				"fee_bearer":      "customer",
+				"methods":         methods,
+				"method_wise_mdr": mdr,
+				"mdr_available":   true,
+			},
+		},
+		{
+			Name:    "platform fee bearer without mdr",
+			Request: map[string]interface{}{},
+			MockHttpClient: func() (*http.Client, *httptest.Server) {
+				return mock.NewHTTPClient(
+					mock.Endpoint{
+						Path:   fetchPreferencesPath,
+						Method: "GET",
+						Response: map[string]interface{}{
+							"fee_bearer": false,
+							"methods":    methods,
+						},
+					},
+				)
+			},
+			ExpectError: false,
+			ExpectedResult: map[string]interface{}{
+				"fee_bearer":    "platform",
+				"methods":       methods,
+				"mdr_available": false,
+			},
+		},
+		{
+			Name:    "fetching preferences fails",
+			Request: map[string]interface{}{},
+			MockHttpClient: func() (*http.Client, *httptest.Server) {
+				return mock.NewHTTPClient(
+					mock.Endpoint{
+						Path:   fetchPreferencesPath,
+						Method: "GET",
+						Response: map[string]interface{}{
+							"error": map[string]interface{}{
+								"code":        "BAD_REQUEST_ERROR",
+								"description": "The api key provided is invalid",
+							},
+						},
+					},
+				)
+			},
+			ExpectError: true,
+			ExpectedErrMsg: "fetching fee bearer config failed: " +
+				"The api key provided is invalid",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.Name, func(t *testing.T) {
+			runToolTest(t, tc, FetchFeeBearerConfig, "Fee Bearer Config")
+		})
+	}
+}
@@ -0,0 +1,202 @@
+package razorpay
+
+import (
+	"context"
+	"fmt"
+
+	rzpsdk "github.com/razorpay/razorpay-go"
+
+	"github.com/razorpay/razorpay-mcp-server/pkg/mcpgo"
+	"github.com/razorpay/razorpay-mcp-server/pkg/observability"
+)
+
+// CreateFundAccount returns a tool that creates a bank account or VPA fund
+// account for a contact
+func CreateFundAccount(
+	obs *observability.Observability,
+	client *rzpsdk.Client,
+) mcpgo.Tool {
+	parameters := []mcpgo.ToolParameter{
+		mcpgo.WithString(
+			"contact_id",
+			mcpgo.Description("The contact the fund account belongs to. "+
+				"For example, 'cont_00000000000001'"),
+			mcpgo.Required(),
+		),
+		mcpgo.WithString(
+			"account_type",
+			mcpgo.Description("Type of fund account: bank_account or vpa"),
+			mcpgo.Required(),
+			mcpgo.Enum("bank_account", "vpa"),
+		),
+		mcpgo.WithString(
+			"bank_account_name",
+			mcpgo.Description("Account holder's name. "+
+				"Required for bank_account"),
+		),
+		mcpgo.WithString(
+			"bank_account_ifsc",
+			mcpgo.Description("IFSC of the bank branch. "+
+				"Required for bank_account"),
+			mcpgo.Pattern("^[A-Z]{4}0[A-Z0-9]{6}$"),
+		),
+		mcpgo.WithString(
+			"bank_account_number",
+			mcpgo.Description("Bank account number. Required for bank_account"),
+			mcpgo.Pattern("^[0-9A-Za-z]{5,35}$"),
+		),
+		mcpgo.WithString(
+			"vpa_address",
+			mcpgo.Description("UPI ID. Required for vpa. "+
+				"For example, 'gaurav.kumar@exampleupi'"),
+		),
+	}
+
+	handler := func(
+		ctx context.Context,
+		r mcpgo.CallToolRequest,
+	) (*mcpgo.ToolResult, error) {
+		client, err := getClientFromContextOrDefault(ctx, client)
+		if err != nil {
+			return mcpgo.NewToolResultError(err.Error()), nil
+		}
+
+		payload := make(map[string]interface{})
+		bankAccount := make(map[string]interface{})
+		vpa := make(map[string]interface{})
+
+		validator := NewValidator(&r).
+			ValidateAndAddRequiredString(payload, "contact_id").
+			ValidateAndAddRequiredString(payload, "account_type").
+			ValidateAndAddOptionalStringToPath(
+				bankAccount, "bank_account_name", "name").
+			ValidateAndAddOptionalStringToPath(
+				bankAccount, "bank_account_ifsc", "ifsc").
+			ValidateAndAddOptionalStringToPath(
+				bankAccount, "bank_account_number", "account_number").
+			ValidateAndAddOptionalStringToPath(vpa, "vpa_address", "address")
+
+		if result, err := validator.HandleErrorsIfAny(); result != nil {
+			return result, err
+		}
+
+		switch payload["account_type"] {
+		case "bank_account":
+			for _, field := range []struct{ param, key string }{
+				{"bank_account_name", "name"},
+				{"bank_account_ifsc", "ifsc"},
+				{"bank_account_number", "account_number"},
+			} {
+				if _, ok := bankAccount[field.key]; !ok {
+					return mcpgo.NewToolResultError(
+						"missing required parameter: " + field.param), nil
+				}
+			}
+			payload["bank_account"] = bankAccount
+		case "vpa":
+			if _, ok := vpa["address"]; !ok {
+				return mcpgo.NewToolResultError(
+					"missing required parameter: vpa_address"), nil
+			}
+			payload["vpa"] = vpa
+		}
+
+		fundAccount, err := client.FundAccount.Create(payload, nil)
+		if err != nil {
+			return mcpgo.NewToolResultError(
+				fmt.Sprintf("creating fund account failed: %s", err.Error())), nil
+		}
+
+		return mcpgo.NewToolResultJSON(fundAccount)
+	}
+
+	return mcpgo.NewTool(
+		"create_fund_account",
+		"Create a RazorpayX fund account - the bank account or UPI ID of a "+
+			"contact that payouts are sent to",
+		parameters,
+		handler,
+	)
+}
+
+// FetchAllFundAccounts returns a tool that fetches all fund accounts
+func FetchAllFundAccounts(
+	obs *observability.Observability,
+	client *rzpsdk.Client,
+) mcpgo.Tool {
+	parameters := []mcpgo.ToolParameter{
+		mcpgo.WithString(
+			"contact_id",
+			mcpgo.Description("Filter fund accounts by contact. "+
+				"For example, 'cont_00000000000001'"),
+		),
+		mcpgo.WithString(
+			"account_type",
+			mcpgo.Description("Filter fund accounts by type"),
+			mcpgo.Enum("bank_account", "vpa"),
+		),
+		mcpgo.WithNumber(
+			"from",
+			mcpgo.Description("Unix timestamp (in seconds) from when "+
+				"fund accounts are to be fetched"),
+			mcpgo.Min(0),
+		),
+		mcpgo.WithNumber(
+			"to",
+			mcpgo.Description("Unix timestamp (in seconds) up till when "+
+				"fund accounts are to be fetched"),
+			mcpgo.Min(0),
+		),
+		mcpgo.WithNumber(
+			"count",
+			mcpgo.Description("Number of fund accounts to be fetched "+
+				"(default: 10, max: 100)"),
+			mcpgo.Min(1),
+			mcpgo.Max(100),
+		),
+		mcpgo.WithNumber(
+			"skip",
+			mcpgo.Description("Number of fund accounts to be skipped "+
+				"(default: 0)"),
+			mcpgo.Min(0),
+		),
+	}
+
+	handler := func(
+		ctx context.Context,
+		r mcpgo.CallToolRequest,
+	) (*mcpgo.ToolResult, error) {
+		client, err := getClientFromContextOrDefault(ctx, client)
+		if err != nil {
+			return mcpgo.NewToolResultError(err.Error()), nil
+		}
+
+		queryParams := make(map[string]interface{})
+
+		validator := NewValidator(&r).
+			ValidateAndAddOptionalString(queryParams, "contact_id").
+			ValidateAndAddOptionalString(queryParams, "account_type").
+			ValidateAndAddOptionalInt(queryParams, "from").
+			ValidateAndAddOptionalInt(queryParams, "to").
+			ValidateAndAddPagination(queryParams)
+
+		if result, err := validator.HandleErrorsIfAny(); result != nil {
+			return result, err
+		}
+
+		fundAccounts, err := client.FundAccount.All(queryParams, nil)
+		if err != nil {
+			return mcpgo.NewToolResultError(
+				fmt.Sprintf("fetching fund accounts failed: %s", err.Error())), nil
+		}
+
+		return mcpgo.NewToolResultJSON(fundAccounts)
+	}
+
+	return mcpgo.NewTool(
+		"fetch_all_fund_accounts",
+		"Fetch all RazorpayX fund accounts, optionally for a single contact",
+		parameters,
+		handler,
+	)
+}
@@ -0,0 +1,196 @@
+package razorpay
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/razorpay/razorpay-go/constants"
+
+	"github.com/razorpay/razorpay-mcp-server/pkg/razorpay/mock"
+)
+
+func Test_CreateFundAccount(t *testing.T) {
+	createFundAccountPath := fmt.Sprintf(
+		"/%s%s",
+		constants.VERSION_V1,
+		constants.FUND_ACCOUNT_URL,
+	)
+
+	bankAccountResp := map[string]interface{}{
+		"id":           "fa_00000000000001",
+		"entity":       "fund_account",
+		"contact_id":   "cont_00000000000001",
+		"account_type": "bank_account",
+		"bank_account": map[string]interface{}{
+			"ifsc":           "HDFC0000053",
+			"name":           "Gaurav Kumar",
+			"account_number": "765432123456789",
+		},
+		"active": true,
+	}
+
+	vpaResp := map[string]interface{}{
+		"id":           "fa_00000000000002",
+		"entity":       "fund_account",
+		"contact_id":   "cont_00000000000001",
+		"account_type": "vpa",
+		"vpa": map[string]interface{}{
+			"address": "gaurav.kumar@exampleupi",
+		},
+		"active": true,
+	}
+
+	tests := []RazorpayToolTestCase{
+		{
+			Name: "successful bank account creation",
+			Request: map[string]interface{}{
+				"contact_id":          "cont_00000000000001",
+				"account_type":        "bank_account",
+				"bank_account_name":   "Gaurav Kumar",
+				"bank_account_ifsc":   "HDFC0000053",
+				"bank_account_number": "765432123456789",
+			},
+			MockHttpClient: func() (*http.Client, *httptest.Server) {
+				return mock.NewHTTPClient(
+					mock.Endpoint{
+						Path:     createFundAccountPath,
+						Method:   "POST",
+						Response: bankAccountResp,
+					},
+				)
+			},
+			ExpectError:    false,
+			ExpectedResult: bankAccountResp,
+		},
+		{
+			Name: "successful vpa creation",
+			Request: map[string]interface{}{
+				"contact_id":   "cont_00000000000001",
+				"account_type": "vpa",
+				"vpa_address":  "gaurav.kumar@exampleupi",
+			},
+			MockHttpClient: func() (*http.Client, *httptest.Server) {
+				return mock.NewHTTPClient(
+					mock.Endpoint{
+						Path:     createFundAccountPath,
+						Method:   "POST",
+						Response: vpaResp,
+					},
+				)
+			},
+			ExpectError:    false,
+			ExpectedResult: vpaResp,
+		},
+		{
+			Name: "bank account without ifsc",
+			Request: map[string]interface{}{
+				"contact_id":          "cont_00000000000001",
+				"account_type":        "bank_account",
+				"bank_account_name":   "Gaurav Kumar",
+				"bank_account_number": "765432123456789",
+			},
+			MockHttpClient: nil,
+			ExpectError:    true,
+			ExpectedErrMsg: "missing required parameter: bank_account_ifsc",
+		},
+		{
+			Name: "vpa without address",
+			Request: map[string]interface{}{
+				"contact_id":   "cont_00000000000001",
+				"account_type": "vpa",
+			},
+			MockHttpClient: nil,
+			ExpectError:    true,
+			ExpectedErrMsg: "missing required parameter: vpa_address",
+		},
+		{
+			Name: "fund account creation fails",
+			Request: map[string]interface{}{
+				"contact_id":   "cont_00000000000001",
+				"account_type": "vpa",
+				"vpa_address":  "gaurav.kumar@exampleupi",
+			},
+			MockHttpClient: func() (*http.Client, *httptest.Server) {
+				return mock.NewHTTPClient(
+					mock.Endpoint{
+						Path:   createFundAccountPath,
+						Method: "POST",
+						Response: map[string]interface{}{
+							"error": map[string]interface{}{
+								"code":        "BAD_REQUEST_ERROR",
+								"description": "The contact id does not exist",
+							},
+						},
+					},
+				)
+			},
+			ExpectError: true,
+			ExpectedErrMsg: "creating fund account failed: " +
+				"The contact id does not exist",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.Name, func(t *testing.T) {
+			runToolTest(t, tc, CreateFundAccount, "Fund Account")
+		})
+	}
+}
+
+func Test_FetchAllFundAccounts(t *testing.T) {
+	fetchAllFundAccountsPath := fmt.Sprintf(
+		"/%s%s",
+		constants.VERSION_V1,
+		constants.FUND_ACCOUNT_URL,
+	)
+
+	fundAccountsResp := map[string]interface{}{
+		"entity": "collection",
+		"count":  float64(1),
+		"items": []interface{}{
+			map[string]interface{}{
+				"id":           "fa_00000000000001",
+				"entity":       "fund_account",
+				"contact_id":   "cont_00000000000001",
+				"account_type": "bank_account",
+			},
+		},
+	}
+
+	tests := []RazorpayToolTestCase{
+		{
+			Name: "successful fetch for a contact",
+			Request: map[string]interface{}{
+				"contact_id": "cont_00000000000001",
+			},
+			MockHttpClient: func() (*http.Client, *httptest.Server) {
+				return mock.NewHTTPClient(
+					mock.Endpoint{
+						Path:     fetchAllFundAccountsPath,
+						Method:   "GET",
+						Response: fundAccountsResp,
+					},
+				)
+			},
+			ExpectError:    false,
+			ExpectedResult: fundAccountsResp,
+		},
+		{
+			Name: "invalid count parameter",
+			Request: map[string]interface{}{
+				"count": "all",
+			},
+			MockHttpClient: nil,
+			ExpectError:    true,
+			ExpectedErrMsg: "invalid parameter type: count",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.Name, func(t *testing.T) {
+			runToolTest(t, tc, FetchAllFundAccounts, "Fund Accounts")
+		})
+	}
+}
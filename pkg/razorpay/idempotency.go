@@ -0,0 +1,39 @@
+package razorpay
+
+import (
+	"github.com/razorpay/razorpay-mcp-server/pkg/mcpgo"
+)
+
+const (
+	// razorpayIdempotencyHeader makes a retried create request return the
+	// entity from the first request instead of creating another one
+	razorpayIdempotencyHeader = "X-Razorpay-Idempotency"
+	// payoutIdempotencyHeader is the RazorpayX equivalent for payouts
+	payoutIdempotencyHeader = "X-Payout-Idempotency"
+)
+
+// idempotencyKeyParameter is the optional idempotency_key parameter of the
+// tools that create orders, refunds, payouts and payment links
+func idempotencyKeyParameter(entity string) mcpgo.ToolParameter {
+	return mcpgo.WithString(
+		"idempotency_key",
+		mcpgo.Description("A unique key for this request, e.g. a UUID. "+
+			"Retrying with the same key returns the "+entity+" created by "+
+			"the first request instead of creating a duplicate. Use a new "+
+			"key for every distinct "+entity+". Omit it to create the "+
+			entity+" without idempotency, as before"),
+	)
+}
+
+// idempotencyHeaders returns the extra headers that send the validated
+// idempotency_key under header, or nil when no key was given
+func idempotencyHeaders(
+	params map[string]interface{},
+	header string,
+) map[string]string {
+	key, _ := params["idempotency_key"].(string)
+	if key == "" {
+		return nil
+	}
+	return map[string]string{header: key}
+}
@@ -0,0 +1,115 @@
+package razorpay
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	rzpsdk "github.com/razorpay/razorpay-go"
+
+	"github.com/razorpay/razorpay-mcp-server/pkg/mcpgo"
+	"github.com/razorpay/razorpay-mcp-server/pkg/observability"
+)
+
+// newIdempotencyServer returns a server that answers every request with the
+// idempotency header it received, so tests can see what was sent
+func newIdempotencyServer() func() (*http.Client, *httptest.Server) {
+	return func() (*http.Client, *httptest.Server) {
+		server := httptest.NewServer(http.HandlerFunc(
+			func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte(`{"id":"ent_1",` +
+					`"razorpay_idempotency":"` +
+					r.Header.Get(razorpayIdempotencyHeader) + `",` +
+					`"payout_idempotency":"` +
+					r.Header.Get(payoutIdempotencyHeader) + `"}`))
+			}))
+		return server.Client(), server
+	}
+}
+
+func Test_IdempotencyKeyForwarded(t *testing.T) {
+	tools := []struct {
+		name    string
+		tool    func(*observability.Observability, *rzpsdk.Client) mcpgo.Tool
+		request map[string]interface{}
+		field   string
+	}{
+		{
+			name: "create_order",
+			tool: CreateOrder,
+			request: map[string]interface{}{
+				"amount":   float64(10000),
+				"currency": "INR",
+			},
+			field: "razorpay_idempotency",
+		},
+		{
+			name: "create_refund",
+			tool: CreateRefund,
+			request: map[string]interface{}{
+				"payment_id": "pay_123",
+				"amount":     float64(10000),
+			},
+			field: "razorpay_idempotency",
+		},
+		{
+			name: "create_payment_link",
+			tool: CreatePaymentLink,
+			request: map[string]interface{}{
+				"amount":   float64(10000),
+				"currency": "INR",
+			},
+			field: "razorpay_idempotency",
+		},
+		{
+			name: "create_payout",
+			tool: CreatePayout,
+			request: map[string]interface{}{
+				"account_number":  "7878780080316316",
+				"fund_account_id": "fa_123",
+				"amount":          float64(10000),
+				"currency":        "INR",
+				"mode":            "IMPS",
+				"purpose":         "payout",
+			},
+			field: "payout_idempotency",
+		},
+	}
+
+	for _, tt := range tools {
+		t.Run(tt.name+" with key", func(t *testing.T) {
+			request := map[string]interface{}{
+				"idempotency_key": "key_42",
+			}
+			for k, v := range tt.request {
+				request[k] = v
+			}
+
+			expected := map[string]interface{}{
+				"id":                   "ent_1",
+				"razorpay_idempotency": "",
+				"payout_idempotency":   "",
+			}
+			expected[tt.field] = "key_42"
+
+			runToolTest(t, RazorpayToolTestCase{
+				Request:        request,
+				MockHttpClient: newIdempotencyServer(),
+				ExpectedResult: expected,
+			}, tt.tool, tt.name)
+		})
+
+		t.Run(tt.name+" without key", func(t *testing.T) {
+			runToolTest(t, RazorpayToolTestCase{
+				Request:        tt.request,
+				MockHttpClient: newIdempotencyServer(),
+				ExpectedResult: map[string]interface{}{
+					"id":                   "ent_1",
+					"razorpay_idempotency": "",
+					"payout_idempotency":   "",
+				},
+			}, tt.tool, tt.name)
+		})
+	}
+}
@@ -0,0 +1,90 @@
+package razorpay
+
+import (
+	"context"
+	"sort"
+
+	"github.com/razorpay/razorpay-mcp-server/pkg/mcpgo"
+	"github.com/razorpay/razorpay-mcp-server/pkg/observability"
+	"github.com/razorpay/razorpay-mcp-server/pkg/toolsets"
+)
+
+// ListToolsets returns a tool that describes the toolsets registered on the
+// server, including their tags, so clients can filter them
+func ListToolsets(
+	obs *observability.Observability,
+	toolsetGroup *toolsets.ToolsetGroup,
+) mcpgo.Tool {
+	parameters := []mcpgo.ToolParameter{
+		mcpgo.WithString(
+			"tag",
+			mcpgo.Description("Only return toolsets with this tag "+
+				"(e.g., 'finance' or 'codegen')"),
+		),
+		mcpgo.WithBoolean(
+			"enabled_only",
+			mcpgo.Description("Only return toolsets that are enabled "+
+				"(default: false)"),
+		),
+	}
+
+	handler := func(
+		ctx context.Context,
+		r mcpgo.CallToolRequest,
+	) (*mcpgo.ToolResult, error) {
+		params := make(map[string]interface{})
+
+		validator := NewValidator(&r).
+			ValidateAndAddOptionalString(params, "tag").
+			ValidateAndAddOptionalBool(params, "enabled_only")
+
+		if result, err := validator.HandleErrorsIfAny(); result != nil {
+			return result, err
+		}
+
+		tag, _ := params["tag"].(string)
+		enabledOnly, _ := params["enabled_only"].(bool)
+
+		names := make([]string, 0, len(toolsetGroup.Toolsets))
+		for name := range toolsetGroup.Toolsets {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		result := make([]map[string]interface{}, 0, len(names))
+		for _, name := range names {
+			ts := toolsetGroup.Toolsets[name]
+			if tag != "" && !ts.HasTag(tag) {
+				continue
+			}
+			if enabledOnly && !ts.Enabled {
+				continue
+			}
+
+			tags := ts.Tags
+			if tags == nil {
+				tags = []string{}
+			}
+			result = append(result, map[string]interface{}{
+				"name":        ts.Name,
+				"description": ts.Description,
+				"tags":        tags,
+				"enabled":     ts.Enabled,
+			})
+		}
+
+		return mcpgo.NewToolResultJSON(map[string]interface{}{
+			"count":    len(result),
+			"toolsets": result,
+		})
+	}
+
+	return mcpgo.NewTool(
+		"list_toolsets",
+		"List the toolsets available on this server with their description, "+
+			"tags and whether they are enabled. Filter by tag to find "+
+			"toolsets relevant to a task",
+		parameters,
+		handler,
+	)
+}
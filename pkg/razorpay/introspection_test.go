@@ -0,0 +1,105 @@
+package razorpay
+
+import (
+	"testing"
+
+	rzpsdk "github.com/razorpay/razorpay-go"
+
+	"github.com/razorpay/razorpay-mcp-server/pkg/mcpgo"
+	"github.com/razorpay/razorpay-mcp-server/pkg/observability"
+	"github.com/razorpay/razorpay-mcp-server/pkg/toolsets"
+)
+
+func Test_ListToolsets(t *testing.T) {
+	toolsetGroup := toolsets.NewToolsetGroup(false)
+	toolsetGroup.AddToolset(
+		toolsets.NewToolset("payouts", "Razorpay Payouts related tools").
+			AddTags("finance"))
+	toolsetGroup.AddToolset(
+		toolsets.NewToolset("checkout_integration", "Checkout codegen").
+			AddTags("codegen"))
+	toolsetGroup.AddToolset(
+		toolsets.NewToolset("disputes", "Razorpay Disputes related tools"))
+	if err := toolsetGroup.EnableToolsets(
+		[]string{"payouts", "disputes"}); err != nil {
+		t.Fatalf("EnableToolsets failed: %v", err)
+	}
+
+	// ListToolsets does not use the client, so adapt it to the signature
+	// runToolTest expects
+	listToolsets := func(
+		obs *observability.Observability,
+		_ *rzpsdk.Client,
+	) mcpgo.Tool {
+		return ListToolsets(obs, toolsetGroup)
+	}
+
+	payouts := map[string]interface{}{
+		"name":        "payouts",
+		"description": "Razorpay Payouts related tools",
+		"tags":        []interface{}{"finance"},
+		"enabled":     true,
+	}
+	checkout := map[string]interface{}{
+		"name":        "checkout_integration",
+		"description": "Checkout codegen",
+		"tags":        []interface{}{"codegen"},
+		"enabled":     false,
+	}
+	disputes := map[string]interface{}{
+		"name":        "disputes",
+		"description": "Razorpay Disputes related tools",
+		"tags":        []interface{}{},
+		"enabled":     true,
+	}
+
+	tests := []RazorpayToolTestCase{
+		{
+			Name:        "lists all toolsets sorted by name",
+			Request:     map[string]interface{}{},
+			ExpectError: false,
+			ExpectedResult: map[string]interface{}{
+				"count": float64(3),
+				"toolsets": []interface{}{
+					checkout, disputes, payouts,
+				},
+			},
+		},
+		{
+			Name: "filters by tag",
+			Request: map[string]interface{}{
+				"tag": "finance",
+			},
+			ExpectError: false,
+			ExpectedResult: map[string]interface{}{
+				"count":    float64(1),
+				"toolsets": []interface{}{payouts},
+			},
+		},
+		{
+			Name: "filters enabled toolsets",
+			Request: map[string]interface{}{
+				"enabled_only": true,
+			},
+			ExpectError: false,
+			ExpectedResult: map[string]interface{}{
+				"count":    float64(2),
+				"toolsets": []interface{}{disputes, payouts},
+			},
+		},
+		{
+			Name: "invalid tag parameter",
+			Request: map[string]interface{}{
+				"tag": 123,
+			},
+			ExpectError:    true,
+			ExpectedErrMsg: "invalid parameter type: tag",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.Name, func(t *testing.T) {
+			runToolTest(t, tc, listToolsets, "Toolsets")
+		})
+	}
+}
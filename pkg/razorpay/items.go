@@ -0,0 +1,337 @@
+package razorpay
+
+import (
+	"context"
+	"fmt"
+
+	rzpsdk "github.com/razorpay/razorpay-go"
+
+	"github.com/razorpay/razorpay-mcp-server/pkg/mcpgo"
+	"github.com/razorpay/razorpay-mcp-server/pkg/observability"
+)
+
+// CreateItem returns a tool that creates a catalog item
+func CreateItem(
+	obs *observability.Observability,
+	client *rzpsdk.Client,
+) mcpgo.Tool {
+	parameters := []mcpgo.ToolParameter{
+		mcpgo.WithString(
+			"name",
+			mcpgo.Description("Name of the item"),
+			mcpgo.Required(),
+		),
+		mcpgo.WithNumber(
+			"amount",
+			mcpgo.Description("Price of the item in the smallest currency "+
+				"sub-unit (e.g., for ₹295, use 29500)"),
+			mcpgo.Required(),
+			mcpgo.Min(0),
+		),
+		mcpgo.WithString(
+			"currency",
+			mcpgo.Description("ISO code for the currency (e.g., INR)"),
+			mcpgo.Required(),
+			mcpgo.Pattern("^[A-Z]{3}$"),
+		),
+		mcpgo.WithString(
+			"description",
+			mcpgo.Description("Description of the item"),
+		),
+	}
+
+	handler := func(
+		ctx context.Context,
+		r mcpgo.CallToolRequest,
+	) (*mcpgo.ToolResult, error) {
+		client, err := getClientFromContextOrDefault(ctx, client)
+		if err != nil {
+			return mcpgo.NewToolResultError(err.Error()), nil
+		}
+
+		payload := make(map[string]interface{})
+
+		validator := NewValidator(&r).
+			ValidateAndAddRequiredString(payload, "name").
+			ValidateAndAddRequiredInt(payload, "amount").
+			ValidateAndAddRequiredString(payload, "currency").
+			ValidateAndAddOptionalString(payload, "description")
+
+		if result, err := validator.HandleErrorsIfAny(); result != nil {
+			return result, err
+		}
+
+		item, err := client.Item.Create(payload, nil)
+		if err != nil {
+			return mcpgo.NewToolResultError(
+				fmt.Sprintf("creating item failed: %s", err.Error())), nil
+		}
+
+		return mcpgo.NewToolResultJSON(item)
+	}
+
+	return mcpgo.NewTool(
+		"create_item",
+		"Create a catalog item with a name and price. Items can be reused "+
+			"in invoices and as subscription add-ons",
+		parameters,
+		handler,
+	)
+}
+
+// FetchItem returns a tool that fetches an item by ID
+func FetchItem(
+	obs *observability.Observability,
+	client *rzpsdk.Client,
+) mcpgo.Tool {
+	parameters := []mcpgo.ToolParameter{
+		mcpgo.WithString(
+			"item_id",
+			mcpgo.Description("Unique identifier of the item. "+
+				"For example, 'item_00000000000001'"),
+			mcpgo.Required(),
+		),
+	}
+
+	handler := func(
+		ctx context.Context,
+		r mcpgo.CallToolRequest,
+	) (*mcpgo.ToolResult, error) {
+		client, err := getClientFromContextOrDefault(ctx, client)
+		if err != nil {
+			return mcpgo.NewToolResultError(err.Error()), nil
+		}
+
+		params := make(map[string]interface{})
+
+		validator := NewValidator(&r).
+			ValidateAndAddRequiredString(params, "item_id")
+
+		if result, err := validator.HandleErrorsIfAny(); result != nil {
+			return result, err
+		}
+
+		item, err := client.Item.Fetch(params["item_id"].(string), nil, nil)
+		if err != nil {
+			return mcpgo.NewToolResultError(
+				fmt.Sprintf("fetching item failed: %s", err.Error())), nil
+		}
+
+		return mcpgo.NewToolResultJSON(item)
+	}
+
+	return mcpgo.NewTool(
+		"fetch_item",
+		"Fetch an item's details using its ID",
+		parameters,
+		handler,
+	)
+}
+
+// FetchAllItems returns a tool that fetches all items
+func FetchAllItems(
+	obs *observability.Observability,
+	client *rzpsdk.Client,
+) mcpgo.Tool {
+	parameters := []mcpgo.ToolParameter{
+		mcpgo.WithNumber(
+			"from",
+			mcpgo.Description("Unix timestamp (in seconds) from when "+
+				"items are to be fetched"),
+			mcpgo.Min(0),
+		),
+		mcpgo.WithNumber(
+			"to",
+			mcpgo.Description("Unix timestamp (in seconds) up till when "+
+				"items are to be fetched"),
+			mcpgo.Min(0),
+		),
+		mcpgo.WithNumber(
+			"count",
+			mcpgo.Description("Number of items to be fetched "+
+				"(default: 10, max: 100)"),
+			mcpgo.Min(1),
+			mcpgo.Max(100),
+		),
+		mcpgo.WithNumber(
+			"skip",
+			mcpgo.Description("Number of items to be skipped (default: 0)"),
+			mcpgo.Min(0),
+		),
+	}
+
+	handler := func(
+		ctx context.Context,
+		r mcpgo.CallToolRequest,
+	) (*mcpgo.ToolResult, error) {
+		client, err := getClientFromContextOrDefault(ctx, client)
+		if err != nil {
+			return mcpgo.NewToolResultError(err.Error()), nil
+		}
+
+		queryParams := make(map[string]interface{})
+
+		validator := NewValidator(&r).
+			ValidateAndAddOptionalInt(queryParams, "from").
+			ValidateAndAddOptionalInt(queryParams, "to").
+			ValidateAndAddPagination(queryParams)
+
+		if result, err := validator.HandleErrorsIfAny(); result != nil {
+			return result, err
+		}
+
+		items, err := client.Item.All(queryParams, nil)
+		if err != nil {
+			return mcpgo.NewToolResultError(
+				fmt.Sprintf("fetching items failed: %s", err.Error())), nil
+		}
+
+		return mcpgo.NewToolResultJSON(items)
+	}
+
+	return mcpgo.NewTool(
+		"fetch_all_items",
+		"Fetch all items with optional filtering and pagination",
+		parameters,
+		handler,
+	)
+}
+
+// UpdateItem returns a tool that updates an item
+func UpdateItem(
+	obs *observability.Observability,
+	client *rzpsdk.Client,
+) mcpgo.Tool {
+	parameters := []mcpgo.ToolParameter{
+		mcpgo.WithString(
+			"item_id",
+			mcpgo.Description("Unique identifier of the item to be updated. "+
+				"For example, 'item_00000000000001'"),
+			mcpgo.Required(),
+		),
+		mcpgo.WithString(
+			"name",
+			mcpgo.Description("New name of the item"),
+		),
+		mcpgo.WithString(
+			"description",
+			mcpgo.Description("New description of the item"),
+		),
+		mcpgo.WithNumber(
+			"amount",
+			mcpgo.Description("New price of the item in the smallest "+
+				"currency sub-unit"),
+			mcpgo.Min(0),
+		),
+		mcpgo.WithString(
+			"currency",
+			mcpgo.Description("New ISO code for the currency (e.g., INR)"),
+			mcpgo.Pattern("^[A-Z]{3}$"),
+		),
+		mcpgo.WithBoolean(
+			"active",
+			mcpgo.Description("Whether the item can be used. Inactive items "+
+				"cannot be added to new invoices or subscriptions"),
+		),
+	}
+
+	handler := func(
+		ctx context.Context,
+		r mcpgo.CallToolRequest,
+	) (*mcpgo.ToolResult, error) {
+		client, err := getClientFromContextOrDefault(ctx, client)
+		if err != nil {
+			return mcpgo.NewToolResultError(err.Error()), nil
+		}
+
+		params := make(map[string]interface{})
+		data := make(map[string]interface{})
+
+		validator := NewValidator(&r).
+			ValidateAndAddRequiredString(params, "item_id").
+			ValidateAndAddOptionalString(data, "name").
+			ValidateAndAddOptionalString(data, "description").
+			ValidateAndAddOptionalInt(data, "amount").
+			ValidateAndAddOptionalString(data, "currency").
+			ValidateAndAddOptionalBool(data, "active")
+
+		if result, err := validator.HandleErrorsIfAny(); result != nil {
+			return result, err
+		}
+
+		if len(data) == 0 {
+			return mcpgo.NewToolResultError(
+				"at least one of name, description, amount, currency or " +
+					"active must be provided"), nil
+		}
+
+		item, err := client.Item.Update(params["item_id"].(string), data, nil)
+		if err != nil {
+			return mcpgo.NewToolResultError(
+				fmt.Sprintf("updating item failed: %s", err.Error())), nil
+		}
+
+		return mcpgo.NewToolResultJSON(item)
+	}
+
+	return mcpgo.NewTool(
+		"update_item",
+		"Update an item's name, description, price or active state",
+		parameters,
+		handler,
+	)
+}
+
+// DeleteItem returns a tool that deletes an item
+func DeleteItem(
+	obs *observability.Observability,
+	client *rzpsdk.Client,
+) mcpgo.Tool {
+	parameters := []mcpgo.ToolParameter{
+		mcpgo.WithString(
+			"item_id",
+			mcpgo.Description("Unique identifier of the item to be deleted. "+
+				"For example, 'item_00000000000001'"),
+			mcpgo.Required(),
+		),
+	}
+
+	handler := func(
+		ctx context.Context,
+		r mcpgo.CallToolRequest,
+	) (*mcpgo.ToolResult, error) {
+		client, err := getClientFromContextOrDefault(ctx, client)
+		if err != nil {
+			return mcpgo.NewToolResultError(err.Error()), nil
+		}
+
+		params := make(map[string]interface{})
+
+		validator := NewValidator(&r).
+			ValidateAndAddRequiredString(params, "item_id")
+
+		if result, err := validator.HandleErrorsIfAny(); result != nil {
+			return result, err
+		}
+
+		itemID := params["item_id"].(string)
+
+		// The API responds with an empty body on success
+		if _, err := client.Item.Delete(itemID, nil, nil); err != nil {
+			return mcpgo.NewToolResultError(
+				fmt.Sprintf("deleting item failed: %s", err.Error())), nil
+		}
+
+		return mcpgo.NewToolResultJSON(map[string]interface{}{
+			"id":      itemID,
+			"deleted": true,
+		})
+	}
+
+	return mcpgo.NewTool(
+		"delete_item",
+		"Delete an item. Deleted items cannot be recovered",
+		parameters,
+		handler,
+	)
+}
@@ -0,0 +1,348 @@
+package razorpay
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/razorpay/razorpay-go/constants"
+
+	"github.com/razorpay/razorpay-mcp-server/pkg/razorpay/mock"
+)
+
+func Test_CreateItem(t *testing.T) {
+	createItemPath := fmt.Sprintf(
+		"/%s%s",
+		constants.VERSION_V1,
+		constants.ITEM_URL,
+	)
+
+	itemResp := map[string]interface{}{
+		"id":          "item_00000000000001",
+		"active":      true,
+		"name":        "Book / English August",
+		"description": "An indian story",
+		"amount":      float64(20000),
+		"currency":    "INR",
+	}
+
+	tests := []RazorpayToolTestCase{
+		{
+			Name: "successful item creation",
+			Request: map[string]interface{}{
+				"name":        "Book / English August",
+				"description": "An indian story",
+				"amount":      float64(20000),
+				"currency":    "INR",
+			},
+			MockHttpClient: func() (*http.Client, *httptest.Server) {
+				return mock.NewHTTPClient(
+					mock.Endpoint{
+						Path:     createItemPath,
+						Method:   "POST",
+						Response: itemResp,
+					},
+				)
+			},
+			ExpectError:    false,
+			ExpectedResult: itemResp,
+		},
+		{
+			Name: "item creation fails",
+			Request: map[string]interface{}{
+				"name":     "Book",
+				"amount":   float64(20000),
+				"currency": "XYZ",
+			},
+			MockHttpClient: func() (*http.Client, *httptest.Server) {
+				return mock.NewHTTPClient(
+					mock.Endpoint{
+						Path:   createItemPath,
+						Method: "POST",
+						Response: map[string]interface{}{
+							"error": map[string]interface{}{
+								"code":        "BAD_REQUEST_ERROR",
+								"description": "The currency is invalid.",
+							},
+						},
+					},
+				)
+			},
+			ExpectError: true,
+			ExpectedErrMsg: "creating item failed: " +
+				"The currency is invalid.",
+		},
+		{
+			Name: "missing name parameter",
+			Request: map[string]interface{}{
+				"amount":   float64(20000),
+				"currency": "INR",
+			},
+			MockHttpClient: nil,
+			ExpectError:    true,
+			ExpectedErrMsg: "missing required parameter: name",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.Name, func(t *testing.T) {
+			runToolTest(t, tc, CreateItem, "Item")
+		})
+	}
+}
+
+func Test_FetchItem(t *testing.T) {
+	fetchItemPath := fmt.Sprintf(
+		"/%s%s/%s",
+		constants.VERSION_V1,
+		constants.ITEM_URL,
+		"item_00000000000001",
+	)
+
+	itemResp := map[string]interface{}{
+		"id":       "item_00000000000001",
+		"active":   true,
+		"name":     "Book / English August",
+		"amount":   float64(20000),
+		"currency": "INR",
+	}
+
+	tests := []RazorpayToolTestCase{
+		{
+			Name: "successful item fetch",
+			Request: map[string]interface{}{
+				"item_id": "item_00000000000001",
+			},
+			MockHttpClient: func() (*http.Client, *httptest.Server) {
+				return mock.NewHTTPClient(
+					mock.Endpoint{
+						Path:     fetchItemPath,
+						Method:   "GET",
+						Response: itemResp,
+					},
+				)
+			},
+			ExpectError:    false,
+			ExpectedResult: itemResp,
+		},
+		{
+			Name: "item not found",
+			Request: map[string]interface{}{
+				"item_id": "item_00000000000001",
+			},
+			MockHttpClient: func() (*http.Client, *httptest.Server) {
+				return mock.NewHTTPClient(
+					mock.Endpoint{
+						Path:   fetchItemPath,
+						Method: "GET",
+						Response: map[string]interface{}{
+							"error": map[string]interface{}{
+								"code":        "BAD_REQUEST_ERROR",
+								"description": "The id provided does not exist",
+							},
+						},
+					},
+				)
+			},
+			ExpectError: true,
+			ExpectedErrMsg: "fetching item failed: " +
+				"The id provided does not exist",
+		},
+		{
+			Name:           "missing item_id parameter",
+			Request:        map[string]interface{}{},
+			MockHttpClient: nil,
+			ExpectError:    true,
+			ExpectedErrMsg: "missing required parameter: item_id",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.Name, func(t *testing.T) {
+			runToolTest(t, tc, FetchItem, "Item")
+		})
+	}
+}
+
+func Test_FetchAllItems(t *testing.T) {
+	fetchAllItemsPath := fmt.Sprintf(
+		"/%s%s",
+		constants.VERSION_V1,
+		constants.ITEM_URL,
+	)
+
+	itemsResp := map[string]interface{}{
+		"entity": "collection",
+		"count":  float64(1),
+		"items": []interface{}{
+			map[string]interface{}{
+				"id":       "item_00000000000001",
+				"active":   true,
+				"name":     "Book / English August",
+				"amount":   float64(20000),
+				"currency": "INR",
+			},
+		},
+	}
+
+	tests := []RazorpayToolTestCase{
+		{
+			Name: "successful items fetch with pagination",
+			Request: map[string]interface{}{
+				"count": float64(10),
+				"skip":  float64(0),
+			},
+			MockHttpClient: func() (*http.Client, *httptest.Server) {
+				return mock.NewHTTPClient(
+					mock.Endpoint{
+						Path:     fetchAllItemsPath,
+						Method:   "GET",
+						Response: itemsResp,
+					},
+				)
+			},
+			ExpectError:    false,
+			ExpectedResult: itemsResp,
+		},
+		{
+			Name: "invalid count parameter",
+			Request: map[string]interface{}{
+				"count": "ten",
+			},
+			MockHttpClient: nil,
+			ExpectError:    true,
+			ExpectedErrMsg: "invalid parameter type: count",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.Name, func(t *testing.T) {
+			runToolTest(t, tc, FetchAllItems, "Items")
+		})
+	}
+}
+
+func Test_UpdateItem(t *testing.T) {
+	updateItemPath := fmt.Sprintf(
+		"/%s%s/%s",
+		constants.VERSION_V1,
+		constants.ITEM_URL,
+		"item_00000000000001",
+	)
+
+	itemResp := map[string]interface{}{
+		"id":       "item_00000000000001",
+		"active":   false,
+		"name":     "Book / Ignited Minds",
+		"amount":   float64(20000),
+		"currency": "INR",
+	}
+
+	tests := []RazorpayToolTestCase{
+		{
+			Name: "successful item update",
+			Request: map[string]interface{}{
+				"item_id": "item_00000000000001",
+				"name":    "Book / Ignited Minds",
+				"active":  false,
+			},
+			MockHttpClient: func() (*http.Client, *httptest.Server) {
+				return mock.NewHTTPClient(
+					mock.Endpoint{
+						Path:     updateItemPath,
+						Method:   "PATCH",
+						Response: itemResp,
+					},
+				)
+			},
+			ExpectError:    false,
+			ExpectedResult: itemResp,
+		},
+		{
+			Name: "no fields to update",
+			Request: map[string]interface{}{
+				"item_id": "item_00000000000001",
+			},
+			MockHttpClient: nil,
+			ExpectError:    true,
+			ExpectedErrMsg: "at least one of name, description, amount, " +
+				"currency or active must be provided",
+		},
+		{
+			Name: "item update fails",
+			Request: map[string]interface{}{
+				"item_id": "item_00000000000001",
+				"amount":  float64(30000),
+			},
+			MockHttpClient: func() (*http.Client, *httptest.Server) {
+				return mock.NewHTTPClient(
+					mock.Endpoint{
+						Path:   updateItemPath,
+						Method: "PATCH",
+						Response: map[string]interface{}{
+							"error": map[string]interface{}{
+								"code":        "BAD_REQUEST_ERROR",
+								"description": "The id provided does not exist",
+							},
+						},
+					},
+				)
+			},
+			ExpectError: true,
+			ExpectedErrMsg: "updating item failed: " +
+				"The id provided does not exist",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.Name, func(t *testing.T) {
+			runToolTest(t, tc, UpdateItem, "Item")
+		})
+	}
+}
+
+func Test_DeleteItem(t *testing.T) {
+	deleteItemPath := fmt.Sprintf(
+		"/%s%s/%s",
+		constants.VERSION_V1,
+		constants.ITEM_URL,
+		"item_00000000000001",
+	)
+
+	tests := []RazorpayToolTestCase{
+		{
+			Name: "successful item deletion",
+			Request: map[string]interface{}{
+				"item_id": "item_00000000000001",
+			},
+			MockHttpClient: func() (*http.Client, *httptest.Server) {
+				return mock.NewHTTPClient(
+					mock.Endpoint{
+						Path:     deleteItemPath,
+						Method:   "DELETE",
+						Response: []byte("[]"),
+					},
+				)
+			},
+			ExpectError: false,
+			ExpectedResult: map[string]interface{}{
+				"id":      "item_00000000000001",
+				"deleted": true,
+			},
+		},
+		{
+			Name:           "missing item_id parameter",
+			Request:        map[string]interface{}{},
+			MockHttpClient: nil,
+			ExpectError:    true,
+			ExpectedErrMsg: "missing required parameter: item_id",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.Name, func(t *testing.T) {
+			runToolTest(t, tc, DeleteItem, "Item")
+		})
+	}
+}
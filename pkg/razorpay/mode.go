@@ -0,0 +1,159 @@
+package razorpay
+
+import (
+	"context"
+	"strings"
+
+	rzpsdk "github.com/razorpay/razorpay-go"
+
+	"github.com/razorpay/razorpay-mcp-server/pkg/mcpgo"
+	"github.com/razorpay/razorpay-mcp-server/pkg/observability"
+	"github.com/razorpay/razorpay-mcp-server/pkg/toolsets"
+)
+
+// Modes an API key can belong to
+const (
+	ModeTest    = "test"
+	ModeLive    = "live"
+	ModeUnknown = "unknown"
+)
+
+// authFailedDescription is the error description the API returns when the
+// key or secret is wrong
+const authFailedDescription = "authentication failed"
+
+// liveWritesBlockedError is returned by write tools when the server runs
+// with --block-live-writes and a live mode key
+const liveWritesBlockedError = "write operations are blocked: the server " +
+	"is using a live mode key (rzp_live_) and was started with " +
+	"--block-live-writes. Use a rzp_test_ key during development, or " +
+	"restart without --block-live-writes to operate on real money"
+
+// KeyMode reports whether key is a test or live mode API key
+func KeyMode(key string) string {
+	switch {
+	case strings.HasPrefix(key, "rzp_test_"):
+		return ModeTest
+	case strings.HasPrefix(key, "rzp_live_"):
+		return ModeLive
+	default:
+		return ModeUnknown
+	}
+}
+
+// clientMode returns the mode of the key client authenticates with
+func clientMode(client *rzpsdk.Client) string {
+	if client == nil || client.Request == nil {
+		return ModeUnknown
+	}
+	return KeyMode(client.Request.Auth.Key)
+}
+
+// WithBlockLiveWrites makes every write tool refuse to run when the client
+// it would call the API with has a live mode key. The key is checked on each
+// call, so clients taken from the request context are covered too.
+func WithBlockLiveWrites(client *rzpsdk.Client) ToolsetOption {
+	return func(tg *toolsets.ToolsetGroup) error {
+		guard := func(handler mcpgo.ToolHandler) mcpgo.ToolHandler {
+			return func(
+				ctx context.Context,
+				r mcpgo.CallToolRequest,
+			) (*mcpgo.ToolResult, error) {
+				c, err := getClientFromContextOrDefault(ctx, client)
+				if err == nil && clientMode(c) == ModeLive {
+					return mcpgo.NewToolResultError(liveWritesBlockedError), nil
+				}
+				return handler(ctx, r)
+			}
+		}
+
+		for _, ts := range tg.Toolsets {
+			ts.WrapWriteTools(guard)
+		}
+		return nil
+	}
+}
+
+// FetchAPIMode returns a tool that reports whether the server is using a
+// test or live mode key and whether live writes are blocked
+func FetchAPIMode(
+	obs *observability.Observability,
+	client *rzpsdk.Client,
+	blockLiveWrites bool,
+) mcpgo.Tool {
+	handler := func(
+		ctx context.Context,
+		r mcpgo.CallToolRequest,
+	) (*mcpgo.ToolResult, error) {
+		client, err := getClientFromContextOrDefault(ctx, client)
+		if err != nil {
+			return mcpgo.NewToolResultError(err.Error()), nil
+		}
+
+		mode := clientMode(client)
+		return mcpgo.NewToolResultJSON(map[string]interface{}{
+			"mode":                mode,
+			"block_live_writes":   blockLiveWrites,
+			"write_tools_enabled": !blockLiveWrites || mode != ModeLive,
+		})
+	}
+
+	return mcpgo.NewTool(
+		"fetch_api_mode",
+		"Report whether the server is using a test mode (rzp_test_) or live "+
+			"mode (rzp_live_) API key. Live mode moves real money; check "+
+			"this before captures, refunds or payouts",
+		[]mcpgo.ToolParameter{},
+		handler,
+	)
+}
+
+// VerifyCredentials returns a tool that checks the configured key and secret
+// with a lightweight authenticated read, without changing any data
+func VerifyCredentials(
+	obs *observability.Observability,
+	client *rzpsdk.Client,
+) mcpgo.Tool {
+	handler := func(
+		ctx context.Context,
+		r mcpgo.CallToolRequest,
+	) (*mcpgo.ToolResult, error) {
+		client, err := getClientFromContextOrDefault(ctx, client)
+		if err != nil {
+			return mcpgo.NewToolResultError(err.Error()), nil
+		}
+
+		mode := clientMode(client)
+		_, err = withRetry(ctx, func() (map[string]interface{}, error) {
+			return client.Payment.All(map[string]interface{}{"count": 1}, nil)
+		})
+		if err != nil {
+			rzpErr := parseRazorpayError(err)
+			if !strings.EqualFold(rzpErr.Description, authFailedDescription) {
+				return unwrapRazorpayError("verifying credentials", err), nil
+			}
+			return mcpgo.NewToolResultJSON(map[string]interface{}{
+				"authenticated": false,
+				"mode":          mode,
+				"error": "authentication failed: the key and secret " +
+					"do not match. Copy both from the same mode on " +
+					"https://dashboard.razorpay.com/app/keys",
+			})
+		}
+
+		return mcpgo.NewToolResultJSON(map[string]interface{}{
+			"authenticated": true,
+			"mode":          mode,
+		})
+	}
+
+	return mcpgo.NewTool(
+		"verify_credentials",
+		"Check that the configured API key and secret are valid by making "+
+			"a lightweight authenticated read (one payment). Returns "+
+			"authenticated and whether the key is test or live mode. Has "+
+			"no side effects; run it first when other calls fail",
+		[]mcpgo.ToolParameter{},
+		handler,
+	)
+}
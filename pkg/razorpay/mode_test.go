@@ -0,0 +1,221 @@
+package razorpay
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	rzpsdk "github.com/razorpay/razorpay-go"
+
+	"github.com/razorpay/razorpay-mcp-server/pkg/contextkey"
+	"github.com/razorpay/razorpay-mcp-server/pkg/mcpgo"
+	"github.com/razorpay/razorpay-mcp-server/pkg/razorpay/mock"
+	"github.com/razorpay/razorpay-mcp-server/pkg/toolsets"
+)
+
+func TestKeyMode(t *testing.T) {
+	tests := []struct {
+		key  string
+		want string
+	}{
+		{"rzp_test_1DP5mmOlF5G5ag", ModeTest},
+		{"rzp_live_1DP5mmOlF5G5ag", ModeLive},
+		{"", ModeUnknown},
+		{"test-key", ModeUnknown},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, KeyMode(tt.key), tt.key)
+	}
+}
+
+func TestWithBlockLiveWrites(t *testing.T) {
+	tests := []struct {
+		name        string
+		key         string
+		fromContext bool
+		wantBlocked bool
+	}{
+		{
+			name:        "live key is blocked",
+			key:         "rzp_live_abc",
+			wantBlocked: true,
+		},
+		{
+			name:        "live key from the request context is blocked",
+			key:         "rzp_live_abc",
+			fromContext: true,
+			wantBlocked: true,
+		},
+		{
+			name: "test key is allowed",
+			key:  "rzp_test_abc",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			obs := CreateTestObservability()
+			client := rzpsdk.NewClient(tt.key, "secret")
+			ctx := context.Background()
+			var defaultClient *rzpsdk.Client
+			if tt.fromContext {
+				ctx = contextkey.WithClient(ctx, client)
+			} else {
+				defaultClient = client
+			}
+
+			writeTool := CapturePayment(obs, defaultClient)
+			readTool := FetchPayment(obs, defaultClient)
+			tg := toolsets.NewToolsetGroup(false)
+			tg.AddToolset(toolsets.NewToolset("payments", "Payments").
+				AddReadTools(readTool).
+				AddWriteTools(writeTool))
+
+			assert.NoError(t, WithBlockLiveWrites(defaultClient)(tg))
+
+			// Missing arguments fail validation, so an allowed call errors
+			// without reaching the API
+			result, err := writeTool.GetHandler()(ctx, mcpgo.CallToolRequest{
+				Arguments: map[string]interface{}{},
+			})
+			assert.NoError(t, err)
+			assert.True(t, result.IsError)
+			if tt.wantBlocked {
+				assert.Equal(t, liveWritesBlockedError, result.Text)
+			} else {
+				assert.NotEqual(t, liveWritesBlockedError, result.Text)
+			}
+
+			result, err = readTool.GetHandler()(ctx, mcpgo.CallToolRequest{
+				Arguments: map[string]interface{}{},
+			})
+			assert.NoError(t, err)
+			assert.NotEqual(t, liveWritesBlockedError, result.Text)
+		})
+	}
+}
+
+func TestFetchAPIMode(t *testing.T) {
+	tests := []struct {
+		name            string
+		key             string
+		blockLiveWrites bool
+		want            map[string]interface{}
+	}{
+		{
+			name:            "live key with writes blocked",
+			key:             "rzp_live_abc",
+			blockLiveWrites: true,
+			want: map[string]interface{}{
+				"mode":                "live",
+				"block_live_writes":   true,
+				"write_tools_enabled": false,
+			},
+		},
+		{
+			name: "live key without the guard",
+			key:  "rzp_live_abc",
+			want: map[string]interface{}{
+				"mode":                "live",
+				"block_live_writes":   false,
+				"write_tools_enabled": true,
+			},
+		},
+		{
+			name:            "test key with the guard",
+			key:             "rzp_test_abc",
+			blockLiveWrites: true,
+			want: map[string]interface{}{
+				"mode":                "test",
+				"block_live_writes":   true,
+				"write_tools_enabled": true,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := rzpsdk.NewClient(tt.key, "secret")
+			tool := FetchAPIMode(nil, client, tt.blockLiveWrites)
+
+			result, err := tool.GetHandler()(
+				context.Background(), mcpgo.CallToolRequest{})
+			assert.NoError(t, err)
+			assert.False(t, result.IsError)
+
+			var got map[string]interface{}
+			assert.NoError(t, json.Unmarshal([]byte(result.Text), &got))
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestVerifyCredentials(t *testing.T) {
+	paymentsMock := func(response interface{}) func() (
+		*http.Client, *httptest.Server,
+	) {
+		return func() (*http.Client, *httptest.Server) {
+			return mock.NewHTTPClient(mock.Endpoint{
+				Path:     "/v1/payments",
+				Method:   "GET",
+				Response: response,
+			})
+		}
+	}
+
+	tests := []RazorpayToolTestCase{
+		{
+			Name:    "valid credentials",
+			Request: map[string]interface{}{},
+			MockHttpClient: paymentsMock(map[string]interface{}{
+				"entity": "collection",
+				"count":  0,
+				"items":  []interface{}{},
+			}),
+			ExpectedResult: map[string]interface{}{
+				"authenticated": true,
+				"mode":          "unknown",
+			},
+		},
+		{
+			Name:    "wrong key or secret",
+			Request: map[string]interface{}{},
+			MockHttpClient: paymentsMock(map[string]interface{}{
+				"error": map[string]interface{}{
+					"code":        "BAD_REQUEST_ERROR",
+					"description": "Authentication failed",
+				},
+			}),
+			ExpectedResult: map[string]interface{}{
+				"authenticated": false,
+				"mode":          "unknown",
+				"error": "authentication failed: the key and secret " +
+					"do not match. Copy both from the same mode on " +
+					"https://dashboard.razorpay.com/app/keys",
+			},
+		},
+		{
+			Name:    "other API errors are reported as errors",
+			Request: map[string]interface{}{},
+			MockHttpClient: paymentsMock(map[string]interface{}{
+				"error": map[string]interface{}{
+					"code":        "BAD_REQUEST_ERROR",
+					"description": "Access denied",
+				},
+			}),
+			ExpectError:    true,
+			ExpectedErrMsg: "verifying credentials failed: Access denied",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.Name, func(t *testing.T) {
+			runToolTest(t, tc, VerifyCredentials, "Credentials")
+		})
+	}
+}
@@ -19,9 +19,11 @@ func CreateOrder(
 		mcpgo.WithNumber(
 			"amount",
 			mcpgo.Description("Payment amount in the smallest "+
-				"currency sub-unit (e.g., for ₹295, use 29500)"),
+				"currency sub-unit (e.g., for ₹295, use 29500). "+
+				"Must be at least 100 for INR; other currencies have "+
+				"their own minimums"),
 			mcpgo.Required(),
-			mcpgo.Min(100), // Minimum amount is 100 (1.00 in currency)
+			mcpgo.Min(0), // Per-currency minimums are checked in the handler
 		),
 		mcpgo.WithString(
 			"currency",
@@ -39,7 +41,8 @@ func CreateOrder(
 		mcpgo.WithObject(
 			"notes",
 			mcpgo.Description("Key-value pairs for additional "+
-				"information (max 15 pairs, 256 chars each)"),
+				"information (max 15 pairs, 256 chars each). Values must be "+
+				"strings; numbers and booleans are converted to strings"),
 			mcpgo.MaxProperties(15),
 		),
 		mcpgo.WithBoolean(
@@ -50,7 +53,8 @@ func CreateOrder(
 		mcpgo.WithNumber(
 			"first_payment_min_amount",
 			mcpgo.Description("Minimum amount for first partial "+
-				"payment (only if partial_payment is true)"),
+				"payment in currency sub-units. Only allowed when "+
+				"partial_payment is true, and must not exceed amount"),
 			mcpgo.Min(100),
 		),
 		mcpgo.WithArray(
@@ -106,6 +110,21 @@ func CreateOrder(
 				"Example: {\"max_amount\": 100, \"frequency\": \"as_presented\", "+
 				"\"type\": \"single_block_multiple_debit\"}"),
 		),
+		mcpgo.WithBoolean(
+			"allow_zero",
+			mcpgo.Description("Allow an amount of 0 for auth-only flows "+
+				"such as mandate registration. The minimum amount check "+
+				"is skipped only for a zero amount"),
+			mcpgo.DefaultValue(false),
+		),
+		mcpgo.WithBoolean(
+			"ensure_unique_receipt",
+			mcpgo.Description("Check that no existing order has this "+
+				"receipt before creating, and fail with the existing "+
+				"order's ID if one does. Requires receipt"),
+			mcpgo.DefaultValue(false),
+		),
+		idempotencyKeyParameter("order"),
 	}
 
 	handler := func(
@@ -119,17 +138,21 @@ func CreateOrder(
 		}
 
 		payload := make(map[string]interface{})
+		params := make(map[string]interface{})
 
 		validator := NewValidator(&r).
 			ValidateAndAddRequiredFloat(payload, "amount").
 			ValidateAndAddRequiredString(payload, "currency").
 			ValidateAndAddOptionalString(payload, "receipt").
-			ValidateAndAddOptionalMap(payload, "notes").
+			ValidateAndAddOptionalNotes(payload, "notes").
 			ValidateAndAddOptionalBool(payload, "partial_payment").
 			ValidateAndAddOptionalArray(payload, "transfers").
 			ValidateAndAddOptionalString(payload, "method").
 			ValidateAndAddOptionalString(payload, "customer_id").
-			ValidateAndAddToken(payload, "token")
+			ValidateAndAddToken(payload, "token").
+			ValidateAndAddOptionalString(params, "idempotency_key").
+			ValidateAndAddOptionalBool(params, "allow_zero").
+			ValidateAndAddOptionalBool(params, "ensure_unique_receipt")
 
 		// Add first_payment_min_amount only if partial_payment is true
 		if payload["partial_payment"] == true {
@@ -140,11 +163,44 @@ func CreateOrder(
 			return result, err
 		}
 
-		order, err := client.Order.Create(payload, nil)
+		if err := validatePartialPayment(&r, payload); err != nil {
+			return mcpgo.NewToolResultError(err.Error()), nil
+		}
+
+		allowZero, _ := params["allow_zero"].(bool)
+		if err := validateOrderAmount(
+			payload["amount"].(float64),
+			payload["currency"].(string),
+			allowZero,
+		); err != nil {
+			return mcpgo.NewToolResultError(err.Error()), nil
+		}
+
+		if unique, _ := params["ensure_unique_receipt"].(bool); unique {
+			receipt, _ := payload["receipt"].(string)
+			if receipt == "" {
+				return mcpgo.NewToolResultError(
+					"ensure_unique_receipt requires a receipt"), nil
+			}
+
+			existing, err := client.Order.All(map[string]interface{}{
+				"receipt": receipt,
+			}, nil)
+			if err != nil {
+				return unwrapRazorpayError("fetching orders by receipt", err), nil
+			}
+			if items, _ := existing["items"].([]interface{}); len(items) > 0 {
+				order, _ := items[0].(map[string]interface{})
+				return mcpgo.NewToolResultError(fmt.Sprintf(
+					"receipt %s already used, order %v exists",
+					receipt, order["id"])), nil
+			}
+		}
+
+		order, err := client.Order.Create(payload,
+			idempotencyHeaders(params, razorpayIdempotencyHeader))
 		if err != nil {
-			return mcpgo.NewToolResultError(
-				fmt.Sprintf("creating order failed: %s", err.Error()),
-			), nil
+			return unwrapRazorpayError("creating order", err), nil
 		}
 
 		return mcpgo.NewToolResultJSON(order)
@@ -155,7 +211,11 @@ func CreateOrder(
 		"Create a new order in Razorpay. Supports both regular orders and "+
 			"mandate orders. "+
 			"\n\nFor REGULAR ORDERS: Provide amount, currency, and optional "+
-			"receipt/notes. "+
+			"receipt/notes. Set ensure_unique_receipt=true to refuse a "+
+			"receipt an existing order already uses. "+
+			"\n\nFor PARTIAL PAYMENTS (installments): Set partial_payment=true "+
+			"and optionally first_payment_min_amount, the smallest first "+
+			"installment in currency sub-units. "+
 			"\n\nFor MANDATE ORDERS (recurring payments): You MUST provide ALL "+
 			"of these fields: "+
 			"amount, currency, method='upi', customer_id (starts with 'cust_'), "+
@@ -178,6 +238,204 @@ func CreateOrder(
 	)
 }
 
+// minOrderAmounts lists the smallest order amount Razorpay accepts, in
+// currency sub-units. Currencies not listed are left to the API to check.
+var minOrderAmounts = map[string]float64{
+	"INR": 100,
+	"USD": 50,
+	"EUR": 50,
+	"GBP": 30,
+	"SGD": 50,
+	"AUD": 50,
+	"CAD": 50,
+	"AED": 200,
+}
+
+// validateOrderAmount rejects order amounts Razorpay would refuse, so the
+// caller gets a clear message without an API round-trip
+func validateOrderAmount(
+	amount float64,
+	currency string,
+	allowZero bool,
+) error {
+	if amount < 0 {
+		return fmt.Errorf("amount must not be negative, got %v", amount)
+	}
+	if amount == 0 && allowZero {
+		return nil
+	}
+	if amount == 0 {
+		return fmt.Errorf(
+			"amount must be greater than 0; set allow_zero " +
+				"for auth-only orders")
+	}
+
+	minAmount, ok := minOrderAmounts[currency]
+	if ok && amount < minAmount {
+		return fmt.Errorf(
+			"amount %v is below the minimum of %v sub-units for %s",
+			amount, minAmount, currency)
+	}
+	return nil
+}
+
+// validatePartialPayment checks first_payment_min_amount against the
+// partial_payment flag and the order amount
+func validatePartialPayment(
+	r *mcpgo.CallToolRequest,
+	payload map[string]interface{},
+) error {
+	if payload["partial_payment"] != true {
+		args, _ := r.Arguments.(map[string]interface{})
+		if _, ok := args["first_payment_min_amount"]; ok {
+			return fmt.Errorf(
+				"first_payment_min_amount requires partial_payment to be true")
+		}
+		return nil
+	}
+
+	minAmount, ok := payload["first_payment_min_amount"].(float64)
+	if !ok {
+		return nil
+	}
+	if minAmount != float64(int64(minAmount)) {
+		return fmt.Errorf(
+			"first_payment_min_amount must be a whole number of sub-units")
+	}
+	if amount := payload["amount"].(float64); minAmount > amount {
+		return fmt.Errorf(
+			"first_payment_min_amount %v must not exceed the order amount %v",
+			minAmount, amount)
+	}
+	return nil
+}
+
+// CreateOrderForReference returns a tool that creates at most one order per
+// internal reference, using the receipt as the idempotency key
+func CreateOrderForReference(
+	obs *observability.Observability,
+	client *rzpsdk.Client,
+) mcpgo.Tool {
+	parameters := []mcpgo.ToolParameter{
+		mcpgo.WithString(
+			"reference_id",
+			mcpgo.Description("Internal order ID, sent to Razorpay as the "+
+				"receipt (max 40 chars). Retrying with the same reference "+
+				"returns the existing order instead of creating a new one"),
+			mcpgo.Required(),
+			mcpgo.Max(40),
+		),
+		mcpgo.WithNumber(
+			"amount",
+			mcpgo.Description("Payment amount in the smallest "+
+				"currency sub-unit (e.g., for ₹295, use 29500). "+
+				"Must be at least 100 for INR; other currencies have "+
+				"their own minimums"),
+			mcpgo.Required(),
+			mcpgo.Min(0), // Per-currency minimums are checked in the handler
+		),
+		mcpgo.WithString(
+			"currency",
+			mcpgo.Description("ISO code for the currency "+
+				"(e.g., INR, USD, SGD)"),
+			mcpgo.Required(),
+			mcpgo.Pattern("^[A-Z]{3}$"),
+		),
+		mcpgo.WithObject(
+			"notes",
+			mcpgo.Description("Key-value pairs for additional "+
+				"information (max 15 pairs, 256 chars each). Only used when "+
+				"a new order is created"),
+			mcpgo.MaxProperties(15),
+		),
+	}
+
+	handler := func(
+		ctx context.Context,
+		r mcpgo.CallToolRequest,
+	) (*mcpgo.ToolResult, error) {
+		client, err := getClientFromContextOrDefault(ctx, client)
+		if err != nil {
+			return mcpgo.NewToolResultError(err.Error()), nil
+		}
+
+		params := make(map[string]interface{})
+		payload := make(map[string]interface{})
+
+		validator := NewValidator(&r).
+			ValidateAndAddRequiredString(params, "reference_id").
+			ValidateAndAddRequiredFloat(payload, "amount").
+			ValidateAndAddRequiredString(payload, "currency").
+			ValidateAndAddOptionalNotes(payload, "notes")
+
+		if result, err := validator.HandleErrorsIfAny(); result != nil {
+			return result, err
+		}
+
+		if err := validateOrderAmount(
+			payload["amount"].(float64),
+			payload["currency"].(string),
+			false,
+		); err != nil {
+			return mcpgo.NewToolResultError(err.Error()), nil
+		}
+
+		referenceID := params["reference_id"].(string)
+
+		existing, err := client.Order.All(map[string]interface{}{
+			"receipt": referenceID,
+		}, nil)
+		if err != nil {
+			return unwrapRazorpayError("fetching orders by receipt", err), nil
+		}
+
+		// Orders are listed newest first
+		items, _ := existing["items"].([]interface{})
+		if len(items) > 0 {
+			order, _ := items[0].(map[string]interface{})
+			if order["amount"] != payload["amount"] ||
+				order["currency"] != payload["currency"] {
+				return mcpgo.NewToolResultError(fmt.Sprintf(
+					"order %v already exists for reference %s with amount "+
+						"%v %v, which differs from the requested %v %v",
+					order["id"], referenceID, order["amount"],
+					order["currency"], payload["amount"],
+					payload["currency"])), nil
+			}
+
+			return mcpgo.NewToolResultJSON(map[string]interface{}{
+				"created": false,
+				"order":   order,
+			})
+		}
+
+		// Two concurrent calls can both miss the lookup; the receipt still
+		// lets the duplicate be found afterwards
+		payload["receipt"] = referenceID
+		order, err := client.Order.Create(payload, nil)
+		if err != nil {
+			return unwrapRazorpayError("creating order", err), nil
+		}
+
+		return mcpgo.NewToolResultJSON(map[string]interface{}{
+			"created": true,
+			"order":   order,
+		})
+	}
+
+	return mcpgo.NewTool(
+		"create_order_for_reference",
+		"Get or create the Razorpay order for an internal order ID. Looks "+
+			"up an existing order whose receipt is the reference_id and "+
+			"returns it if found, otherwise creates one with that receipt. "+
+			"Use this instead of create_order when the caller may retry, so "+
+			"each internal order maps to exactly one Razorpay order. "+
+			"created tells whether a new order was made",
+		parameters,
+		handler,
+	)
+}
+
 // FetchOrder returns a tool to fetch order details by ID
 func FetchOrder(
 	obs *observability.Observability,
@@ -210,7 +468,9 @@ func FetchOrder(
 			return result, err
 		}
 
-		order, err := client.Order.Fetch(payload["order_id"].(string), nil, nil)
+		order, err := withRetry(ctx, func() (map[string]interface{}, error) {
+			return client.Order.Fetch(payload["order_id"].(string), nil, nil)
+		})
 		if err != nil {
 			return mcpgo.NewToolResultError(
 				fmt.Sprintf("fetching order failed: %s", err.Error()),
@@ -286,6 +546,7 @@ func FetchAllOrders(
 			}),
 		),
 	}
+	parameters = append(parameters, autoPaginationParameters("orders")...)
 
 	handler := func(
 		ctx context.Context,
@@ -298,6 +559,7 @@ func FetchAllOrders(
 		}
 
 		queryParams := make(map[string]interface{})
+		paginationOptions := make(map[string]interface{})
 
 		validator := NewValidator(&r).
 			ValidateAndAddPagination(queryParams).
@@ -306,12 +568,30 @@ func FetchAllOrders(
 			ValidateAndAddOptionalInt(queryParams, "authorized").
 			ValidateAndAddOptionalString(queryParams, "receipt").
 			ValidateAndAddExpand(queryParams)
+		validator = validateAndAddAutoPagination(validator, paginationOptions)
 
 		if result, err := validator.HandleErrorsIfAny(); result != nil {
 			return result, err
 		}
 
-		orders, err := client.Order.All(queryParams, nil)
+		fetchAll, maxRecords, err := fetchAllRequested(paginationOptions)
+		if err != nil {
+			return mcpgo.NewToolResultError(err.Error()), nil
+		}
+		if fetchAll {
+			orders, err := collectAllPages(
+				client.Order.All, queryParams, maxRecords)
+			if err != nil {
+				return mcpgo.NewToolResultError(
+					fmt.Sprintf("fetching orders failed: %s", err.Error()),
+				), nil
+			}
+			return mcpgo.NewToolResultJSON(orders)
+		}
+
+		orders, err := withRetry(ctx, func() (map[string]interface{}, error) {
+			return client.Order.All(queryParams, nil)
+		})
 		if err != nil {
 			return mcpgo.NewToolResultError(
 				fmt.Sprintf("fetching orders failed: %s", err.Error()),
@@ -342,6 +622,14 @@ func FetchOrderPayments(
 					" be retrieved. Order id should start with `order_`"),
 			mcpgo.Required(),
 		),
+		mcpgo.WithBoolean(
+			"summarize",
+			mcpgo.Description("Add a summary with total_captured, "+
+				"total_authorized, payment_count and fully_paid, computed "+
+				"against the order amount. Amounts are in the smallest "+
+				"currency unit (paise for INR), like the order amount"),
+			mcpgo.DefaultValue(false),
+		),
 	}
 
 	handler := func(
@@ -357,7 +645,8 @@ func FetchOrderPayments(
 		orderPaymentsReq := make(map[string]interface{})
 
 		validator := NewValidator(&r).
-			ValidateAndAddRequiredString(orderPaymentsReq, "order_id")
+			ValidateAndAddRequiredString(orderPaymentsReq, "order_id").
+			ValidateAndAddOptionalBool(orderPaymentsReq, "summarize")
 
 		if result, err := validator.HandleErrorsIfAny(); result != nil {
 			return result, err
@@ -366,7 +655,9 @@ func FetchOrderPayments(
 		// Fetch payments for the order using Razorpay SDK
 		// Note: Using the Order.Payments method from SDK
 		orderID := orderPaymentsReq["order_id"].(string)
-		payments, err := client.Order.Payments(orderID, nil, nil)
+		payments, err := withRetry(ctx, func() (map[string]interface{}, error) {
+			return client.Order.Payments(orderID, nil, nil)
+		})
 		if err != nil {
 			return mcpgo.NewToolResultError(
 				fmt.Sprintf(
@@ -376,6 +667,17 @@ func FetchOrderPayments(
 			), nil
 		}
 
+		if summarize, _ := orderPaymentsReq["summarize"].(bool); summarize {
+			order, err := withRetry(ctx, func() (map[string]interface{}, error) {
+				return client.Order.Fetch(orderID, nil, nil)
+			})
+			if err != nil {
+				return mcpgo.NewToolResultError(
+					fmt.Sprintf("fetching order failed: %s", err.Error())), nil
+			}
+			payments["summary"] = summarizeOrderPayments(order, payments)
+		}
+
 		// Return the result as JSON
 		return mcpgo.NewToolResultJSON(payments)
 	}
@@ -388,6 +690,39 @@ func FetchOrderPayments(
 	)
 }
 
+// summarizeOrderPayments totals an order's captured and authorized payments
+// and reports whether the captured total covers the order amount
+func summarizeOrderPayments(
+	order map[string]interface{},
+	payments map[string]interface{},
+) map[string]interface{} {
+	var totalCaptured, totalAuthorized float64
+	items, _ := payments["items"].([]interface{})
+	for _, item := range items {
+		payment, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		amount, _ := payment["amount"].(float64)
+		switch payment["status"] {
+		case "captured":
+			totalCaptured += amount
+		case "authorized":
+			totalAuthorized += amount
+		}
+	}
+
+	orderAmount, _ := order["amount"].(float64)
+	return map[string]interface{}{
+		"order_amount":     orderAmount,
+		"currency":         order["currency"],
+		"total_captured":   totalCaptured,
+		"total_authorized": totalAuthorized,
+		"payment_count":    len(items),
+		"fully_paid":       orderAmount > 0 && totalCaptured >= orderAmount,
+	}
+}
+
 // UpdateOrder returns a tool to update an order
 // only the order's notes can be updated
 func UpdateOrder(
@@ -424,7 +759,7 @@ func UpdateOrder(
 
 		validator := NewValidator(&r).
 			ValidateAndAddRequiredString(orderUpdateReq, "order_id").
-			ValidateAndAddRequiredMap(orderUpdateReq, "notes")
+			ValidateAndAddRequiredNotes(orderUpdateReq, "notes")
 
 		if result, err := validator.HandleErrorsIfAny(); result != nil {
 			return result, err
@@ -435,8 +770,7 @@ func UpdateOrder(
 
 		order, err := client.Order.Update(orderID, data, nil)
 		if err != nil {
-			return mcpgo.NewToolResultError(
-				fmt.Sprintf("updating order failed: %s", err.Error())), nil
+			return unwrapRazorpayError("updating order", err), nil
 		}
 
 		return mcpgo.NewToolResultJSON(order)
@@ -450,3 +784,117 @@ func UpdateOrder(
 		handler,
 	)
 }
+
+// FetchOrderPaymentsNeedingAction returns a tool that lists the payments of
+// an order which are still awaiting customer authentication (OTP/3DS)
+func FetchOrderPaymentsNeedingAction(
+	obs *observability.Observability,
+	client *rzpsdk.Client,
+) mcpgo.Tool {
+	parameters := []mcpgo.ToolParameter{
+		mcpgo.WithString(
+			"order_id",
+			mcpgo.Description(
+				"Unique identifier of the order whose pending payments should"+
+					" be retrieved. Order id should start with `order_`"),
+			mcpgo.Required(),
+		),
+	}
+
+	handler := func(
+		ctx context.Context,
+		r mcpgo.CallToolRequest,
+	) (*mcpgo.ToolResult, error) {
+		client, err := getClientFromContextOrDefault(ctx, client)
+		if err != nil {
+			return mcpgo.NewToolResultError(err.Error()), nil
+		}
+
+		orderPaymentsReq := make(map[string]interface{})
+
+		validator := NewValidator(&r).
+			ValidateAndAddRequiredString(orderPaymentsReq, "order_id")
+
+		if result, err := validator.HandleErrorsIfAny(); result != nil {
+			return result, err
+		}
+
+		orderID := orderPaymentsReq["order_id"].(string)
+		payments, err := withRetry(ctx, func() (map[string]interface{}, error) {
+			return client.Order.Payments(orderID, nil, nil)
+		})
+		if err != nil {
+			return mcpgo.NewToolResultError(
+				fmt.Sprintf(
+					"fetching payments for order failed: %s",
+					err.Error(),
+				),
+			), nil
+		}
+
+		return mcpgo.NewToolResultJSON(
+			buildPaymentsNeedingAction(orderID, payments))
+	}
+
+	return mcpgo.NewTool(
+		"fetch_order_payments_needing_action",
+		"Fetch the payments of an order that are still awaiting customer "+
+			"authentication (OTP or 3DS), i.e. in created or pending state, "+
+			"along with the next actions to resume them. Use this before "+
+			"retrying a payment so the existing one can be completed with "+
+			"'resend_otp' or 'submit_otp' instead of creating a duplicate",
+		parameters,
+		handler,
+	)
+}
+
+// buildPaymentsNeedingAction filters an order's payments down to the ones
+// that are still awaiting authentication and attaches the next step for each
+func buildPaymentsNeedingAction(
+	orderID string,
+	payments map[string]interface{},
+) map[string]interface{} {
+	pending := make([]map[string]interface{}, 0)
+
+	items, _ := payments["items"].([]interface{})
+	for _, item := range items {
+		payment, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		status, _ := payment["status"].(string)
+		if status != "created" && status != "pending" {
+			continue
+		}
+
+		paymentID, _ := payment["id"].(string)
+		entry := map[string]interface{}{
+			"payment_id": paymentID,
+			"status":     status,
+			"method":     payment["method"],
+			"amount":     payment["amount"],
+			"currency":   payment["currency"],
+			"created_at": payment["created_at"],
+		}
+		if actions := extractNextActions(payment); len(actions) > 0 {
+			entry["next"] = actions
+		}
+		addNextStepInstructions(entry, paymentID)
+
+		pending = append(pending, entry)
+	}
+
+	result := map[string]interface{}{
+		"order_id": orderID,
+		"count":    len(pending),
+		"payments": pending,
+	}
+	if len(pending) == 0 {
+		result["next_step"] = "No payment for this order is awaiting " +
+			"authentication. A new payment can be initiated if the order " +
+			"is still unpaid."
+	}
+
+	return result
+}
@@ -6,6 +6,8 @@ import (
 	"net/http/httptest"
 	"testing"
 
+	"github.com/stretchr/testify/assert"
+
 	"github.com/razorpay/razorpay-go/constants"
 
 	"github.com/razorpay/razorpay-mcp-server/pkg/razorpay/mock"
@@ -451,6 +453,220 @@ func Test_CreateOrder(t *testing.T) {
 				"id": "order_test_12345",
 			},
 		},
+		{
+			Name: "first_payment_min_amount without partial_payment",
+			Request: map[string]interface{}{
+				"amount":                   float64(10000),
+				"currency":                 "INR",
+				"first_payment_min_amount": float64(5000),
+			},
+			MockHttpClient: nil,
+			ExpectError:    true,
+			ExpectedErrMsg: "first_payment_min_amount requires " +
+				"partial_payment to be true",
+		},
+		{
+			Name: "first_payment_min_amount above the order amount",
+			Request: map[string]interface{}{
+				"amount":                   float64(10000),
+				"currency":                 "INR",
+				"partial_payment":          true,
+				"first_payment_min_amount": float64(20000),
+			},
+			MockHttpClient: nil,
+			ExpectError:    true,
+			ExpectedErrMsg: "first_payment_min_amount 20000 must not exceed " +
+				"the order amount 10000",
+		},
+		{
+			Name: "fractional first_payment_min_amount",
+			Request: map[string]interface{}{
+				"amount":                   float64(10000),
+				"currency":                 "INR",
+				"partial_payment":          true,
+				"first_payment_min_amount": float64(500.5),
+			},
+			MockHttpClient: nil,
+			ExpectError:    true,
+			ExpectedErrMsg: "first_payment_min_amount must be a whole " +
+				"number of sub-units",
+		},
+		{
+			Name: "INR amount below minimum",
+			Request: map[string]interface{}{
+				"amount":   float64(99),
+				"currency": "INR",
+			},
+			MockHttpClient: nil,
+			ExpectError:    true,
+			ExpectedErrMsg: "amount 99 is below the minimum of 100 " +
+				"sub-units for INR",
+		},
+		{
+			Name: "USD amount below currency minimum",
+			Request: map[string]interface{}{
+				"amount":   float64(49),
+				"currency": "USD",
+			},
+			MockHttpClient: nil,
+			ExpectError:    true,
+			ExpectedErrMsg: "amount 49 is below the minimum of 50 " +
+				"sub-units for USD",
+		},
+		{
+			Name: "zero amount without allow_zero",
+			Request: map[string]interface{}{
+				"amount":   float64(0),
+				"currency": "INR",
+			},
+			MockHttpClient: nil,
+			ExpectError:    true,
+			ExpectedErrMsg: "amount must be greater than 0; " +
+				"set allow_zero for auth-only orders",
+		},
+		{
+			Name: "zero amount with allow_zero",
+			Request: map[string]interface{}{
+				"amount":     float64(0),
+				"currency":   "INR",
+				"allow_zero": true,
+			},
+			MockHttpClient: func() (*http.Client, *httptest.Server) {
+				return mock.NewHTTPClient(
+					mock.Endpoint{
+						Path:   createOrderPath,
+						Method: "POST",
+						Response: map[string]interface{}{
+							"id":     "order_test_zero",
+							"amount": float64(0),
+						},
+					},
+				)
+			},
+			ExpectError: false,
+			ExpectedResult: map[string]interface{}{
+				"id":     "order_test_zero",
+				"amount": float64(0),
+			},
+		},
+		{
+			Name: "allow_zero does not relax the minimum for non-zero amounts",
+			Request: map[string]interface{}{
+				"amount":     float64(50),
+				"currency":   "INR",
+				"allow_zero": true,
+			},
+			MockHttpClient: nil,
+			ExpectError:    true,
+			ExpectedErrMsg: "amount 50 is below the minimum of 100 " +
+				"sub-units for INR",
+		},
+		{
+			Name: "unlisted currency is left to the API",
+			Request: map[string]interface{}{
+				"amount":   float64(10),
+				"currency": "MYR",
+			},
+			MockHttpClient: func() (*http.Client, *httptest.Server) {
+				return mock.NewHTTPClient(
+					mock.Endpoint{
+						Path:     createOrderPath,
+						Method:   "POST",
+						Response: map[string]interface{}{"id": "order_myr"},
+					},
+				)
+			},
+			ExpectError:    false,
+			ExpectedResult: map[string]interface{}{"id": "order_myr"},
+		},
+		{
+			Name: "unique receipt is created",
+			Request: map[string]interface{}{
+				"amount":                float64(10000),
+				"currency":              "INR",
+				"receipt":               "receipt-123",
+				"ensure_unique_receipt": true,
+			},
+			MockHttpClient: func() (*http.Client, *httptest.Server) {
+				return mock.NewHTTPClient(
+					mock.Endpoint{
+						Path:   createOrderPath,
+						Method: "GET",
+						Response: map[string]interface{}{
+							"entity": "collection",
+							"count":  float64(0),
+							"items":  []interface{}{},
+						},
+					},
+					mock.Endpoint{
+						Path:     createOrderPath,
+						Method:   "POST",
+						Response: orderWithRequiredParamsResp,
+					},
+				)
+			},
+			ExpectError:    false,
+			ExpectedResult: orderWithRequiredParamsResp,
+		},
+		{
+			Name: "receipt already used",
+			Request: map[string]interface{}{
+				"amount":                float64(10000),
+				"currency":              "INR",
+				"receipt":               "receipt-123",
+				"ensure_unique_receipt": true,
+			},
+			MockHttpClient: func() (*http.Client, *httptest.Server) {
+				return mock.NewHTTPClient(
+					mock.Endpoint{
+						Path:   createOrderPath,
+						Method: "GET",
+						Response: map[string]interface{}{
+							"entity": "collection",
+							"count":  float64(1),
+							"items": []interface{}{
+								orderWithAllParamsResp,
+							},
+						},
+					},
+				)
+			},
+			ExpectError: true,
+			ExpectedErrMsg: "receipt receipt-123 already used, " +
+				"order order_EKwxwAgItmmXdp exists",
+		},
+		{
+			Name: "ensure_unique_receipt without receipt",
+			Request: map[string]interface{}{
+				"amount":                float64(10000),
+				"currency":              "INR",
+				"ensure_unique_receipt": true,
+			},
+			MockHttpClient: nil,
+			ExpectError:    true,
+			ExpectedErrMsg: "ensure_unique_receipt requires a receipt",
+		},
+		{
+			Name: "receipt lookup fails",
+			Request: map[string]interface{}{
+				"amount":                float64(10000),
+				"currency":              "INR",
+				"receipt":               "receipt-123",
+				"ensure_unique_receipt": true,
+			},
+			MockHttpClient: func() (*http.Client, *httptest.Server) {
+				return mock.NewHTTPClient(
+					mock.Endpoint{
+						Path:     createOrderPath,
+						Method:   "GET",
+						Response: errorResp,
+					},
+				)
+			},
+			ExpectError: true,
+			ExpectedErrMsg: "fetching orders by receipt failed: " +
+				"Razorpay API error: Bad request",
+		},
 	}
 
 	for _, tc := range tests {
@@ -817,6 +1033,28 @@ func Test_FetchOrderPayments(t *testing.T) {
 		},
 	}
 
+	orderResp := map[string]interface{}{
+		"id":       "order_N8FRN5zTm5S3wx",
+		"entity":   "order",
+		"amount":   float64(100),
+		"currency": "INR",
+		"status":   "paid",
+	}
+
+	summarizedResp := map[string]interface{}{
+		"entity": paymentsResp["entity"],
+		"count":  paymentsResp["count"],
+		"items":  paymentsResp["items"],
+		"summary": map[string]interface{}{
+			"order_amount":     float64(100),
+			"currency":         "INR",
+			"total_captured":   float64(100),
+			"total_authorized": float64(0),
+			"payment_count":    float64(2),
+			"fully_paid":       true,
+		},
+	}
+
 	tests := []RazorpayToolTestCase{
 		{
 			Name: "successful fetch of order payments",
@@ -858,6 +1096,57 @@ func Test_FetchOrderPayments(t *testing.T) {
 			ExpectError:    true,
 			ExpectedErrMsg: "fetching payments for order failed: order not found",
 		},
+		{
+			Name: "summarize against the order amount",
+			Request: map[string]interface{}{
+				"order_id":  "order_N8FRN5zTm5S3wx",
+				"summarize": true,
+			},
+			MockHttpClient: func() (*http.Client, *httptest.Server) {
+				return mock.NewHTTPClient(
+					mock.Endpoint{
+						Path: fmt.Sprintf(
+							fetchOrderPaymentsPathFmt,
+							"order_N8FRN5zTm5S3wx",
+						),
+						Method:   "GET",
+						Response: paymentsResp,
+					},
+					mock.Endpoint{
+						Path: fmt.Sprintf(
+							"/%s%s/%s",
+							constants.VERSION_V1,
+							constants.ORDER_URL,
+							"order_N8FRN5zTm5S3wx",
+						),
+						Method:   "GET",
+						Response: orderResp,
+					},
+				)
+			},
+			ExpectedResult: summarizedResp,
+		},
+		{
+			Name: "summarize fails when the order cannot be fetched",
+			Request: map[string]interface{}{
+				"order_id":  "order_N8FRN5zTm5S3wx",
+				"summarize": true,
+			},
+			MockHttpClient: func() (*http.Client, *httptest.Server) {
+				return mock.NewHTTPClient(
+					mock.Endpoint{
+						Path: fmt.Sprintf(
+							fetchOrderPaymentsPathFmt,
+							"order_N8FRN5zTm5S3wx",
+						),
+						Method:   "GET",
+						Response: paymentsResp,
+					},
+				)
+			},
+			ExpectError:    true,
+			ExpectedErrMsg: "fetching order failed:",
+		},
 		{
 			Name:           "missing order_id parameter",
 			Request:        map[string]interface{}{},
@@ -978,3 +1267,393 @@ func Test_UpdateOrder(t *testing.T) {
 		})
 	}
 }
+
+func Test_FetchOrderPaymentsNeedingAction(t *testing.T) {
+	fetchOrderPaymentsPath := fmt.Sprintf(
+		"/%s%s/%s/payments",
+		constants.VERSION_V1,
+		constants.ORDER_URL,
+		"order_N8FRN5zTm5S3wx",
+	)
+
+	otpSubmitURL := "https://api.razorpay.com/v1/payments/" +
+		"pay_N8FVRD1DzYzBh1/otp_submit/" +
+		"ac2d415a8be7595de09a24b41661729fd9028fdc?key_id=<YOUR_KEY_ID>"
+
+	paymentsResp := map[string]interface{}{
+		"entity": "collection",
+		"count":  float64(3),
+		"items": []interface{}{
+			map[string]interface{}{
+				"id":         "pay_N8FUmetkCE2hZP",
+				"entity":     "payment",
+				"amount":     float64(100),
+				"currency":   "INR",
+				"status":     "failed",
+				"method":     "card",
+				"created_at": float64(1701688684),
+			},
+			map[string]interface{}{
+				"id":         "pay_N8FVRD1DzYzBh1",
+				"entity":     "payment",
+				"amount":     float64(100),
+				"currency":   "INR",
+				"status":     "created",
+				"method":     "card",
+				"created_at": float64(1701688722),
+				"next": []interface{}{
+					map[string]interface{}{
+						"action": "otp_submit",
+						"url":    otpSubmitURL,
+					},
+				},
+			},
+			map[string]interface{}{
+				"id":         "pay_N8FWdCfRh5c2Kj",
+				"entity":     "payment",
+				"amount":     float64(100),
+				"currency":   "INR",
+				"status":     "captured",
+				"method":     "upi",
+				"created_at": float64(1701688790),
+			},
+		},
+	}
+
+	noPendingResp := map[string]interface{}{
+		"entity": "collection",
+		"count":  float64(1),
+		"items": []interface{}{
+			map[string]interface{}{
+				"id":     "pay_N8FWdCfRh5c2Kj",
+				"entity": "payment",
+				"status": "captured",
+			},
+		},
+	}
+
+	tests := []RazorpayToolTestCase{
+		{
+			Name: "order with a payment awaiting otp",
+			Request: map[string]interface{}{
+				"order_id": "order_N8FRN5zTm5S3wx",
+			},
+			MockHttpClient: func() (*http.Client, *httptest.Server) {
+				return mock.NewHTTPClient(
+					mock.Endpoint{
+						Path:     fetchOrderPaymentsPath,
+						Method:   "GET",
+						Response: paymentsResp,
+					},
+				)
+			},
+			ExpectError: false,
+			ExpectedResult: map[string]interface{}{
+				"order_id": "order_N8FRN5zTm5S3wx",
+				"count":    float64(1),
+				"payments": []interface{}{
+					map[string]interface{}{
+						"payment_id": "pay_N8FVRD1DzYzBh1",
+						"status":     "created",
+						"method":     "card",
+						"amount":     float64(100),
+						"currency":   "INR",
+						"created_at": float64(1701688722),
+						"next": []interface{}{
+							map[string]interface{}{
+								"action": "otp_submit",
+								"url":    otpSubmitURL,
+							},
+						},
+						"next_step": "Use 'resend_otp' to regenerate OTP or " +
+							"'submit_otp' to proceed to enter OTP.",
+						"next_tool": "resend_otp",
+						"next_tool_params": map[string]interface{}{
+							"payment_id": "pay_N8FVRD1DzYzBh1",
+						},
+					},
+				},
+			},
+		},
+		{
+			Name: "order without pending payments",
+			Request: map[string]interface{}{
+				"order_id": "order_N8FRN5zTm5S3wx",
+			},
+			MockHttpClient: func() (*http.Client, *httptest.Server) {
+				return mock.NewHTTPClient(
+					mock.Endpoint{
+						Path:     fetchOrderPaymentsPath,
+						Method:   "GET",
+						Response: noPendingResp,
+					},
+				)
+			},
+			ExpectError: false,
+			ExpectedResult: map[string]interface{}{
+				"order_id": "order_N8FRN5zTm5S3wx",
+				"count":    float64(0),
+				"payments": []interface{}{},
+				"next_step": "No payment for this order is awaiting " +
+					"authentication. A new payment can be initiated if the " +
+					"order is still unpaid.",
+			},
+		},
+		{
+			Name: "fetching order payments fails",
+			Request: map[string]interface{}{
+				"order_id": "order_N8FRN5zTm5S3wx",
+			},
+			MockHttpClient: func() (*http.Client, *httptest.Server) {
+				return mock.NewHTTPClient(
+					mock.Endpoint{
+						Path:   fetchOrderPaymentsPath,
+						Method: "GET",
+						Response: map[string]interface{}{
+							"error": map[string]interface{}{
+								"code":        "BAD_REQUEST_ERROR",
+								"description": "The id provided does not exist",
+							},
+						},
+					},
+				)
+			},
+			ExpectError: true,
+			ExpectedErrMsg: "fetching payments for order failed: " +
+				"The id provided does not exist",
+		},
+		{
+			Name:           "missing order_id parameter",
+			Request:        map[string]interface{}{},
+			MockHttpClient: nil,
+			ExpectError:    true,
+			ExpectedErrMsg: "missing required parameter: order_id",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.Name, func(t *testing.T) {
+			runToolTest(t, tc, FetchOrderPaymentsNeedingAction,
+				"Order Payments Needing Action")
+		})
+	}
+}
+
+func Test_CreateOrderForReference(t *testing.T) {
+	ordersPath := fmt.Sprintf(
+		"/%s%s",
+		constants.VERSION_V1,
+		constants.ORDER_URL,
+	)
+
+	existingOrder := map[string]interface{}{
+		"id":       "order_EKwxwAgItmmXdp",
+		"entity":   "order",
+		"amount":   float64(50000),
+		"currency": "INR",
+		"receipt":  "cart_1001",
+		"status":   "created",
+	}
+	newOrder := map[string]interface{}{
+		"id":       "order_EKwxwAgItmmXdq",
+		"entity":   "order",
+		"amount":   float64(50000),
+		"currency": "INR",
+		"receipt":  "cart_1001",
+		"status":   "created",
+	}
+	usdOrder := map[string]interface{}{
+		"id":       "order_EKwxwAgItmmXdr",
+		"entity":   "order",
+		"amount":   float64(50),
+		"currency": "USD",
+		"receipt":  "cart_1001",
+		"status":   "created",
+	}
+
+	collection := func(items ...interface{}) map[string]interface{} {
+		return map[string]interface{}{
+			"entity": "collection",
+			"count":  float64(len(items)),
+			"items":  items,
+		}
+	}
+
+	request := map[string]interface{}{
+		"reference_id": "cart_1001",
+		"amount":       float64(50000),
+		"currency":     "INR",
+	}
+
+	tests := []RazorpayToolTestCase{
+		{
+			Name:    "returns the existing order for the reference",
+			Request: request,
+			MockHttpClient: func() (*http.Client, *httptest.Server) {
+				return mock.NewHTTPClient(
+					mock.Endpoint{
+						Path:     ordersPath,
+						Method:   "GET",
+						Response: collection(existingOrder),
+					},
+				)
+			},
+			ExpectError: false,
+			ExpectedResult: map[string]interface{}{
+				"created": false,
+				"order":   existingOrder,
+			},
+		},
+		{
+			Name:    "creates an order when none exists",
+			Request: request,
+			MockHttpClient: func() (*http.Client, *httptest.Server) {
+				return mock.NewHTTPClient(
+					mock.Endpoint{
+						Path:     ordersPath,
+						Method:   "GET",
+						Response: collection(),
+					},
+					mock.Endpoint{
+						Path:     ordersPath,
+						Method:   "POST",
+						Response: newOrder,
+					},
+				)
+			},
+			ExpectError: false,
+			ExpectedResult: map[string]interface{}{
+				"created": true,
+				"order":   newOrder,
+			},
+		},
+		{
+			Name: "existing order has a different amount",
+			Request: map[string]interface{}{
+				"reference_id": "cart_1001",
+				"amount":       float64(60000),
+				"currency":     "INR",
+			},
+			MockHttpClient: func() (*http.Client, *httptest.Server) {
+				return mock.NewHTTPClient(
+					mock.Endpoint{
+						Path:     ordersPath,
+						Method:   "GET",
+						Response: collection(existingOrder),
+					},
+				)
+			},
+			ExpectError: true,
+			ExpectedErrMsg: "order order_EKwxwAgItmmXdp already exists for " +
+				"reference cart_1001 with amount 50000 INR, which differs " +
+				"from the requested 60000 INR",
+		},
+		{
+			Name:    "lookup fails",
+			Request: request,
+			MockHttpClient: func() (*http.Client, *httptest.Server) {
+				return mock.NewHTTPClient(
+					mock.Endpoint{
+						Path:   ordersPath,
+						Method: "GET",
+						Response: map[string]interface{}{
+							"error": map[string]interface{}{
+								"code":        "BAD_REQUEST_ERROR",
+								"description": "receipt is invalid",
+							},
+						},
+					},
+				)
+			},
+			ExpectError: true,
+			ExpectedErrMsg: "fetching orders by receipt failed: " +
+				"receipt is invalid",
+		},
+		{
+			Name: "accepts the USD minimum below 100",
+			Request: map[string]interface{}{
+				"reference_id": "cart_1001",
+				"amount":       float64(50),
+				"currency":     "USD",
+			},
+			MockHttpClient: func() (*http.Client, *httptest.Server) {
+				return mock.NewHTTPClient(
+					mock.Endpoint{
+						Path:     ordersPath,
+						Method:   "GET",
+						Response: collection(),
+					},
+					mock.Endpoint{
+						Path:     ordersPath,
+						Method:   "POST",
+						Response: usdOrder,
+					},
+				)
+			},
+			ExpectError: false,
+			ExpectedResult: map[string]interface{}{
+				"created": true,
+				"order":   usdOrder,
+			},
+		},
+		{
+			Name: "amount below the currency minimum",
+			Request: map[string]interface{}{
+				"reference_id": "cart_1001",
+				"amount":       float64(150),
+				"currency":     "AED",
+			},
+			MockHttpClient: nil,
+			ExpectError:    true,
+			ExpectedErrMsg: "amount 150 is below the minimum of 200 " +
+				"sub-units for AED",
+		},
+		{
+			Name: "zero amount",
+			Request: map[string]interface{}{
+				"reference_id": "cart_1001",
+				"amount":       float64(0),
+				"currency":     "INR",
+			},
+			MockHttpClient: nil,
+			ExpectError:    true,
+			ExpectedErrMsg: "amount must be greater than 0",
+		},
+		{
+			Name: "missing reference_id parameter",
+			Request: map[string]interface{}{
+				"amount":   float64(50000),
+				"currency": "INR",
+			},
+			MockHttpClient: nil,
+			ExpectError:    true,
+			ExpectedErrMsg: "missing required parameter: reference_id",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.Name, func(t *testing.T) {
+			runToolTest(t, tc, CreateOrderForReference, "Order")
+		})
+	}
+}
+
+func TestSummarizeOrderPayments(t *testing.T) {
+	payments := map[string]interface{}{
+		"items": []interface{}{
+			map[string]interface{}{"amount": float64(300), "status": "captured"},
+			map[string]interface{}{"amount": float64(200), "status": "authorized"},
+			map[string]interface{}{"amount": float64(500), "status": "failed"},
+		},
+	}
+	order := map[string]interface{}{"amount": float64(500), "currency": "INR"}
+
+	assert.Equal(t, map[string]interface{}{
+		"order_amount":     float64(500),
+		"currency":         "INR",
+		"total_captured":   float64(300),
+		"total_authorized": float64(200),
+		"payment_count":    3,
+		"fully_paid":       false,
+	}, summarizeOrderPayments(order, payments))
+}
@@ -0,0 +1,175 @@
+package razorpay
+
+import (
+	"fmt"
+
+	"github.com/razorpay/razorpay-mcp-server/pkg/mcpgo"
+)
+
+const (
+	// autoPaginationPageSize is the largest page the list APIs return
+	autoPaginationPageSize = 100
+	// maxAutoPaginationRecords caps how many records fetchAll collects, so a
+	// busy account can't turn one tool call into hundreds of API requests
+	maxAutoPaginationRecords = 1000
+)
+
+// autoPaginationParameters returns the fetchAll and maxRecords parameters
+// shared by the list tools
+func autoPaginationParameters(entity string) []mcpgo.ToolParameter {
+	return []mcpgo.ToolParameter{
+		mcpgo.WithBoolean(
+			"fetchAll",
+			mcpgo.Description(fmt.Sprintf("Follow pagination and return all "+
+				"matching %s in one response instead of a single page. count "+
+				"is ignored; skip sets the starting offset. Stops at maxRecords "+
+				"and sets truncated when more records remain (default: false)",
+				entity)),
+		),
+		mcpgo.WithNumber(
+			"maxRecords",
+			mcpgo.Description(fmt.Sprintf("With fetchAll, the most %s to "+
+				"return (default and max: %d)", entity, maxAutoPaginationRecords)),
+			mcpgo.Min(1),
+			mcpgo.Max(maxAutoPaginationRecords),
+		),
+	}
+}
+
+// validateAndAddAutoPagination validates the fetchAll and maxRecords
+// parameters into options
+func validateAndAddAutoPagination(
+	v *Validator,
+	options map[string]interface{},
+) *Validator {
+	return v.ValidateAndAddOptionalBool(options, "fetchAll").
+		ValidateAndAddOptionalInt(options, "maxRecords")
+}
+
+// fetchAllRequested reports whether fetchAll was set, returning the record
+// cap to apply or an error when maxRecords is out of range
+func fetchAllRequested(options map[string]interface{}) (bool, int, error) {
+	if fetchAll, _ := options["fetchAll"].(bool); !fetchAll {
+		return false, 0, nil
+	}
+
+	maxRecords := maxAutoPaginationRecords
+	if value, ok := options["maxRecords"].(int64); ok {
+		if value < 1 || value > maxAutoPaginationRecords {
+			return false, 0, fmt.Errorf(
+				"maxRecords must be between 1 and %d", maxAutoPaginationRecords)
+		}
+		maxRecords = int(value)
+	}
+	return true, maxRecords, nil
+}
+
+// collectAllPages pages through fetch with count/skip, starting at the skip
+// in options, until a short page is returned or maxRecords items have been
+// collected. count in options is ignored. truncated is only set when another
+// record is known to exist past the ones returned.
+func collectAllPages(
+	fetch func(
+		queryParams map[string]interface{},
+		extraHeaders map[string]string,
+	) (map[string]interface{}, error),
+	options map[string]interface{},
+	maxRecords int,
+) (map[string]interface{}, error) {
+	skip := 0
+	if value, ok := options["skip"].(int64); ok {
+		skip = int(value)
+	}
+
+	page := func(count int, skip int) ([]interface{}, error) {
+		queryParams := make(map[string]interface{}, len(options)+2)
+		for key, value := range options {
+			queryParams[key] = value
+		}
+		queryParams["count"] = count
+		queryParams["skip"] = skip
+
+		response, err := fetch(queryParams, nil)
+		if err != nil {
+			return nil, err
+		}
+		items, _ := response["items"].([]interface{})
+		return items, nil
+	}
+
+	items := make([]interface{}, 0)
+	exhausted := false
+	// The page budget bounds the loop even if the API keeps returning full
+	// pages past the point where records should have run out
+	maxPages := (maxRecords + autoPaginationPageSize - 1) /
+		autoPaginationPageSize
+	for i := 0; i < maxPages; i++ {
+		pageItems, err := page(autoPaginationPageSize, skip)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, pageItems...)
+		skip += len(pageItems)
+
+		if len(pageItems) < autoPaginationPageSize {
+			exhausted = true
+			break
+		}
+	}
+
+	truncated := false
+	switch {
+	case len(items) > maxRecords:
+		items = items[:maxRecords]
+		truncated = true
+	case !exhausted:
+		// Every page was full and exactly maxRecords were read, so only
+		// another request can tell whether any records are left
+		next, err := page(1, skip)
+		if err != nil {
+			return nil, err
+		}
+		truncated = len(next) > 0
+	}
+
+	return map[string]interface{}{
+		"entity":      "collection",
+		"count":       len(items),
+		"items":       items,
+		"truncated":   truncated,
+		"max_records": maxRecords,
+	}, nil
+}
+
+// collectAllRecords pages through every record fetch returns for the filters
+// in options, up to maxRecords, for callers that aggregate the records rather
+// than return a page. Pagination in options is ignored.
+func collectAllRecords(
+	fetch func(
+		queryParams map[string]interface{},
+		extraHeaders map[string]string,
+	) (map[string]interface{}, error),
+	options map[string]interface{},
+	maxRecords int,
+) ([]map[string]interface{}, bool, error) {
+	filters := make(map[string]interface{}, len(options))
+	for key, value := range options {
+		if key != "count" && key != "skip" {
+			filters[key] = value
+		}
+	}
+
+	collection, err := collectAllPages(fetch, filters, maxRecords)
+	if err != nil {
+		return nil, false, err
+	}
+
+	items, _ := collection["items"].([]interface{})
+	records := make([]map[string]interface{}, 0, len(items))
+	for _, item := range items {
+		if record, ok := item.(map[string]interface{}); ok {
+			records = append(records, record)
+		}
+	}
+	return records, collection["truncated"].(bool), nil
+}
@@ -0,0 +1,222 @@
+package razorpay
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// pagedFetch serves total records in pages the way the list APIs do and
+// records the count/skip of every request
+type pagedFetch struct {
+	total    int
+	requests []map[string]interface{}
+}
+
+func (f *pagedFetch) fetch(
+	queryParams map[string]interface{},
+	_ map[string]string,
+) (map[string]interface{}, error) {
+	f.requests = append(f.requests, queryParams)
+	count, _ := queryParams["count"].(int)
+	skip, _ := queryParams["skip"].(int)
+
+	items := make([]interface{}, 0)
+	for i := skip; i < f.total && i < skip+count; i++ {
+		items = append(items, map[string]interface{}{"id": fmt.Sprint(i)})
+	}
+	return map[string]interface{}{
+		"entity": "collection",
+		"count":  len(items),
+		"items":  items,
+	}, nil
+}
+
+func TestCollectAllPages(t *testing.T) {
+	tests := []struct {
+		name          string
+		total         int
+		options       map[string]interface{}
+		maxRecords    int
+		wantCount     int
+		wantTruncated bool
+		wantRequests  int
+		wantProbe     bool
+		wantFirstID   string
+	}{
+		{
+			name:         "stops on a short page",
+			total:        250,
+			options:      map[string]interface{}{},
+			maxRecords:   1000,
+			wantCount:    250,
+			wantRequests: 3,
+			wantFirstID:  "0",
+		},
+		{
+			name:         "exact multiple of the page size",
+			total:        200,
+			options:      map[string]interface{}{},
+			maxRecords:   1000,
+			wantCount:    200,
+			wantRequests: 3,
+			wantFirstID:  "0",
+		},
+		{
+			name:          "capped at maxRecords",
+			total:         5000,
+			options:       map[string]interface{}{},
+			maxRecords:    1000,
+			wantCount:     1000,
+			wantTruncated: true,
+			wantRequests:  11,
+			wantProbe:     true,
+			wantFirstID:   "0",
+		},
+		{
+			name:         "exactly maxRecords records",
+			total:        1000,
+			options:      map[string]interface{}{},
+			maxRecords:   1000,
+			wantCount:    1000,
+			wantRequests: 11,
+			wantProbe:    true,
+			wantFirstID:  "0",
+		},
+		{
+			name:          "cap below a page",
+			total:         500,
+			options:       map[string]interface{}{},
+			maxRecords:    30,
+			wantCount:     30,
+			wantTruncated: true,
+			wantRequests:  1,
+			wantFirstID:   "0",
+		},
+		{
+			name:  "starts at skip and ignores count",
+			total: 150,
+			options: map[string]interface{}{
+				"skip":  int64(120),
+				"count": int64(5),
+				"from":  int64(1700000000),
+			},
+			maxRecords:   1000,
+			wantCount:    30,
+			wantRequests: 1,
+			wantFirstID:  "120",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := &pagedFetch{total: tt.total}
+			result, err := collectAllPages(f.fetch, tt.options, tt.maxRecords)
+			assert.NoError(t, err)
+
+			items := result["items"].([]interface{})
+			assert.Len(t, items, tt.wantCount)
+			assert.Equal(t, tt.wantCount, result["count"])
+			assert.Equal(t, tt.wantTruncated, result["truncated"])
+			assert.Equal(t, tt.maxRecords, result["max_records"])
+			assert.Len(t, f.requests, tt.wantRequests)
+			if len(items) > 0 {
+				assert.Equal(t, tt.wantFirstID,
+					items[0].(map[string]interface{})["id"])
+			}
+			requests := f.requests
+			if tt.wantProbe {
+				// A full last page is followed by a single record probe
+				probe := requests[len(requests)-1]
+				assert.Equal(t, 1, probe["count"])
+				assert.Equal(t, tt.wantCount, probe["skip"])
+				requests = requests[:len(requests)-1]
+			}
+			for _, req := range requests {
+				assert.Equal(t, autoPaginationPageSize, req["count"])
+				if from, ok := tt.options["from"]; ok {
+					assert.Equal(t, from, req["from"])
+				}
+			}
+		})
+	}
+
+	t.Run("fetch error", func(t *testing.T) {
+		_, err := collectAllPages(func(
+			map[string]interface{}, map[string]string,
+		) (map[string]interface{}, error) {
+			return nil, errors.New("rate limited")
+		}, map[string]interface{}{}, 1000)
+		assert.EqualError(t, err, "rate limited")
+	})
+}
+
+func TestCollectAllRecords(t *testing.T) {
+	f := &pagedFetch{total: 150}
+	records, truncated, err := collectAllRecords(f.fetch, map[string]interface{}{
+		"skip":  int64(120),
+		"count": int64(5),
+		"from":  int64(1700000000),
+	}, 5000)
+	assert.NoError(t, err)
+	assert.False(t, truncated)
+
+	// Summaries always start from the first record whatever skip says
+	assert.Len(t, records, 150)
+	assert.Equal(t, "0", records[0]["id"])
+	assert.Equal(t, 0, f.requests[0]["skip"])
+	assert.Equal(t, int64(1700000000), f.requests[0]["from"])
+}
+
+func TestFetchAllRequested(t *testing.T) {
+	tests := []struct {
+		name         string
+		options      map[string]interface{}
+		wantFetchAll bool
+		wantMax      int
+		wantErr      string
+	}{
+		{
+			name:    "not requested",
+			options: map[string]interface{}{"maxRecords": int64(50)},
+		},
+		{
+			name:         "default cap",
+			options:      map[string]interface{}{"fetchAll": true},
+			wantFetchAll: true,
+			wantMax:      maxAutoPaginationRecords,
+		},
+		{
+			name: "custom cap",
+			options: map[string]interface{}{
+				"fetchAll":   true,
+				"maxRecords": int64(250),
+			},
+			wantFetchAll: true,
+			wantMax:      250,
+		},
+		{
+			name: "cap above the maximum",
+			options: map[string]interface{}{
+				"fetchAll":   true,
+				"maxRecords": int64(5000),
+			},
+			wantErr: "maxRecords must be between 1 and 1000",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fetchAll, maxRecords, err := fetchAllRequested(tt.options)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantFetchAll, fetchAll)
+			assert.Equal(t, tt.wantMax, maxRecords)
+		})
+	}
+}
@@ -3,6 +3,10 @@ package razorpay
 import (
 	"context"
 	"fmt"
+	"regexp"
+	"strings"
+	"sync"
+	"sync/atomic"
 
 	rzpsdk "github.com/razorpay/razorpay-go"
 
@@ -10,6 +14,16 @@ import (
 	"github.com/razorpay/razorpay-mcp-server/pkg/observability"
 )
 
+// maxBulkPaymentLinks caps the payment links created in one call
+const maxBulkPaymentLinks = 50
+
+// bulkPaymentLinkConcurrency is the number of links created at once. It is
+// a variable so tests can create a batch sequentially
+var bulkPaymentLinkConcurrency = 4
+
+// vpaPattern is a basic check that a VPA has the form name@bank
+var vpaPattern = regexp.MustCompile(`^[a-zA-Z0-9.\-_]{2,256}@[a-zA-Z]{2,64}$`)
+
 // CreatePaymentLink returns a tool that creates payment links in Razorpay
 func CreatePaymentLink(
 	obs *observability.Observability,
@@ -74,7 +88,7 @@ func CreatePaymentLink(
 		),
 		mcpgo.WithObject(
 			"notes",
-			mcpgo.Description("Key-value pairs that can be used to store additional information. Maximum 15 pairs, each value limited to 256 characters."), // nolint:lll
+			mcpgo.Description("Key-value pairs that can be used to store additional information. Maximum 15 pairs, each value limited to 256 characters. Values must be strings; numbers and booleans are converted to strings."), // nolint:lll
 		),
 		mcpgo.WithString(
 			"callback_url",
@@ -85,6 +99,7 @@ func CreatePaymentLink(
 			mcpgo.Description("HTTP method for callback redirection. "+
 				"Must be 'get' if callback_url is set."),
 		),
+		idempotencyKeyParameter("payment link"),
 	}
 
 	handler := func(
@@ -97,45 +112,14 @@ func CreatePaymentLink(
 			return mcpgo.NewToolResultError(err.Error()), nil
 		}
 
-		// Create a parameters map to collect validated parameters
-		plCreateReq := make(map[string]interface{})
-		customer := make(map[string]interface{})
-		notify := make(map[string]interface{})
-		// Validate all parameters with fluent validator
-		validator := NewValidator(&r).
-			ValidateAndAddRequiredInt(plCreateReq, "amount").
-			ValidateAndAddRequiredString(plCreateReq, "currency").
-			ValidateAndAddOptionalString(plCreateReq, "description").
-			ValidateAndAddOptionalBool(plCreateReq, "accept_partial").
-			ValidateAndAddOptionalInt(plCreateReq, "first_min_partial_amount").
-			ValidateAndAddOptionalInt(plCreateReq, "expire_by").
-			ValidateAndAddOptionalString(plCreateReq, "reference_id").
-			ValidateAndAddOptionalStringToPath(customer, "customer_name", "name").
-			ValidateAndAddOptionalStringToPath(customer, "customer_email", "email").
-			ValidateAndAddOptionalStringToPath(customer, "customer_contact", "contact").
-			ValidateAndAddOptionalBoolToPath(notify, "notify_sms", "sms").
-			ValidateAndAddOptionalBoolToPath(notify, "notify_email", "email").
-			ValidateAndAddOptionalBool(plCreateReq, "reminder_enable").
-			ValidateAndAddOptionalMap(plCreateReq, "notes").
-			ValidateAndAddOptionalString(plCreateReq, "callback_url").
-			ValidateAndAddOptionalString(plCreateReq, "callback_method")
-
+		plCreateReq, params, validator := validatePaymentLinkRequest(&r)
 		if result, err := validator.HandleErrorsIfAny(); result != nil {
 			return result, err
 		}
 
-		// Handle customer details
-		if len(customer) > 0 {
-			plCreateReq["customer"] = customer
-		}
-
-		// Handle notification settings
-		if len(notify) > 0 {
-			plCreateReq["notify"] = notify
-		}
-
 		// Create the payment link
-		paymentLink, err := client.PaymentLink.Create(plCreateReq, nil)
+		paymentLink, err := client.PaymentLink.Create(plCreateReq,
+			idempotencyHeaders(params, razorpayIdempotencyHeader))
 		if err != nil {
 			return mcpgo.NewToolResultError(
 				fmt.Sprintf("creating payment link failed: %s", err.Error())), nil
@@ -152,6 +136,50 @@ func CreatePaymentLink(
 	)
 }
 
+// validatePaymentLinkRequest validates the arguments of a standard payment
+// link and builds the create request from them, nesting the customer and
+// notify settings. The idempotency key is returned in params. The request is
+// only complete when the returned validator has no errors.
+func validatePaymentLinkRequest(
+	r *mcpgo.CallToolRequest,
+) (map[string]interface{}, map[string]interface{}, *Validator) {
+	plCreateReq := make(map[string]interface{})
+	customer := make(map[string]interface{})
+	notify := make(map[string]interface{})
+	params := make(map[string]interface{})
+	// Validate all parameters with fluent validator
+	validator := NewValidator(r).
+		ValidateAndAddRequiredInt(plCreateReq, "amount").
+		ValidateAndAddRequiredString(plCreateReq, "currency").
+		ValidateAndAddOptionalString(plCreateReq, "description").
+		ValidateAndAddOptionalBool(plCreateReq, "accept_partial").
+		ValidateAndAddOptionalInt(plCreateReq, "first_min_partial_amount").
+		ValidateAndAddOptionalInt(plCreateReq, "expire_by").
+		ValidateAndAddOptionalString(plCreateReq, "reference_id").
+		ValidateAndAddOptionalStringToPath(customer, "customer_name", "name").
+		ValidateAndAddOptionalStringToPath(customer, "customer_email", "email").
+		ValidateAndAddOptionalStringToPath(customer, "customer_contact", "contact").
+		ValidateAndAddOptionalBoolToPath(notify, "notify_sms", "sms").
+		ValidateAndAddOptionalBoolToPath(notify, "notify_email", "email").
+		ValidateAndAddOptionalBool(plCreateReq, "reminder_enable").
+		ValidateAndAddOptionalNotes(plCreateReq, "notes").
+		ValidateAndAddOptionalString(plCreateReq, "callback_url").
+		ValidateAndAddOptionalString(plCreateReq, "callback_method").
+		ValidateAndAddOptionalString(params, "idempotency_key")
+
+	// Handle customer details
+	if len(customer) > 0 {
+		plCreateReq["customer"] = customer
+	}
+
+	// Handle notification settings
+	if len(notify) > 0 {
+		plCreateReq["notify"] = notify
+	}
+
+	return plCreateReq, params, validator
+}
+
 // CreateUpiPaymentLink returns a tool that creates payment links in Razorpay
 func CreateUpiPaymentLink(
 	obs *observability.Observability,
@@ -215,7 +243,7 @@ func CreateUpiPaymentLink(
 		),
 		mcpgo.WithObject(
 			"notes",
-			mcpgo.Description("Key-value pairs that can be used to store additional information. Maximum 15 pairs, each value limited to 256 characters."), // nolint:lll
+			mcpgo.Description("Key-value pairs that can be used to store additional information. Maximum 15 pairs, each value limited to 256 characters. Values must be strings; numbers and booleans are converted to strings."), // nolint:lll
 		),
 		mcpgo.WithString(
 			"callback_url",
@@ -226,6 +254,28 @@ func CreateUpiPaymentLink(
 			mcpgo.Description("HTTP method for callback redirection. "+
 				"Must be 'get' if callback_url is set."),
 		),
+		mcpgo.WithString(
+			"upi_flow",
+			mcpgo.Description("UPI experience the link triggers: intent opens "+
+				"the customer's UPI app, collect sends a payment request to "+
+				"the vpa. Default: the checkout lets the customer choose"),
+			mcpgo.Enum("intent", "collect"),
+		),
+		mcpgo.WithString(
+			"vpa",
+			mcpgo.Description("Customer's UPI ID (e.g., name@okbank) the "+
+				"collect request is sent to. Required when upi_flow is collect"),
+		),
+		mcpgo.WithBoolean(
+			"include_qr",
+			mcpgo.Description("Also create a single-use UPI QR code for the "+
+				"same amount, for in-person payment, and return it as qr_code "+
+				"alongside the link. The QR code is a separate payment "+
+				"instrument: close it with close_qr_code once the link is "+
+				"paid. If the QR code cannot be created the link is still "+
+				"returned, with qr_error"),
+			mcpgo.DefaultValue(false),
+		),
 	}
 
 	handler := func(
@@ -236,6 +286,8 @@ func CreateUpiPaymentLink(
 		upiPlCreateReq := make(map[string]interface{})
 		customer := make(map[string]interface{})
 		notify := make(map[string]interface{})
+		upi := make(map[string]interface{})
+		params := make(map[string]interface{})
 		// Validate all parameters with fluent validator
 		validator := NewValidator(&r).
 			ValidateAndAddRequiredInt(upiPlCreateReq, "amount").
@@ -251,9 +303,12 @@ func CreateUpiPaymentLink(
 			ValidateAndAddOptionalBoolToPath(notify, "notify_sms", "sms").
 			ValidateAndAddOptionalBoolToPath(notify, "notify_email", "email").
 			ValidateAndAddOptionalBool(upiPlCreateReq, "reminder_enable").
-			ValidateAndAddOptionalMap(upiPlCreateReq, "notes").
+			ValidateAndAddOptionalNotes(upiPlCreateReq, "notes").
 			ValidateAndAddOptionalString(upiPlCreateReq, "callback_url").
-			ValidateAndAddOptionalString(upiPlCreateReq, "callback_method")
+			ValidateAndAddOptionalString(upiPlCreateReq, "callback_method").
+			ValidateAndAddOptionalString(upi, "upi_flow").
+			ValidateAndAddOptionalString(upi, "vpa").
+			ValidateAndAddOptionalBool(params, "include_qr")
 
 		if result, err := validator.HandleErrorsIfAny(); result != nil {
 			return result, err
@@ -262,6 +317,14 @@ func CreateUpiPaymentLink(
 		// Add the required UPI payment link parameters
 		upiPlCreateReq["upi_link"] = "true"
 
+		upiOptions, err := upiFlowOptions(upi)
+		if err != nil {
+			return mcpgo.NewToolResultError(err.Error()), nil
+		}
+		if upiOptions != nil {
+			upiPlCreateReq["upi"] = upiOptions
+		}
+
 		// Handle customer details
 		if len(customer) > 0 {
 			upiPlCreateReq["customer"] = customer
@@ -284,7 +347,21 @@ func CreateUpiPaymentLink(
 				fmt.Sprintf("upi pl create failed: %s", err.Error())), nil
 		}
 
-		return mcpgo.NewToolResultJSON(paymentLink)
+		if includeQR, _ := params["include_qr"].(bool); !includeQR {
+			return mcpgo.NewToolResultJSON(paymentLink)
+		}
+
+		// The link already exists, so a QR code failure must not hide it
+		result := map[string]interface{}{"payment_link": paymentLink}
+		qrCode, err := createPaymentLinkQRCode(client, paymentLink)
+		if err != nil {
+			result["qr_error"] = fmt.Sprintf(
+				"creating QR code failed: %s; share the link's short_url "+
+					"instead", err.Error())
+		} else {
+			result["qr_code"] = qrCode
+		}
+		return mcpgo.NewToolResultJSON(result)
 	}
 
 	return mcpgo.NewTool(
@@ -295,6 +372,475 @@ func CreateUpiPaymentLink(
 	)
 }
 
+// createPaymentLinkQRCode creates a single-use UPI QR code for the amount
+// of a payment link, closing when the link expires
+func createPaymentLinkQRCode(
+	client *rzpsdk.Client,
+	paymentLink map[string]interface{},
+) (map[string]interface{}, error) {
+	linkID, _ := paymentLink["id"].(string)
+	qrCreateReq := map[string]interface{}{
+		"type":           "upi_qr",
+		"name":           "Payment link " + linkID,
+		"usage":          "single_use",
+		"fixed_amount":   true,
+		"payment_amount": paymentLink["amount"],
+		"notes":          map[string]interface{}{"payment_link_id": linkID},
+	}
+	if description, ok := paymentLink["description"].(string); ok &&
+		description != "" {
+		qrCreateReq["description"] = description
+	}
+	if expireBy, ok := paymentLink["expire_by"].(float64); ok && expireBy > 0 {
+		qrCreateReq["close_by"] = int64(expireBy)
+	}
+
+	qrCode, err := client.QrCode.Create(qrCreateReq, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]interface{}{
+		"id":             qrCode["id"],
+		"image_url":      qrCode["image_url"],
+		"status":         qrCode["status"],
+		"payment_amount": qrCode["payment_amount"],
+		"close_by":       qrCode["close_by"],
+	}, nil
+}
+
+// upiFlowOptions builds the upi object of a UPI payment link from the
+// upi_flow and vpa parameters, or returns nil when no flow was chosen. A
+// collect flow needs a vpa of the form name@bank.
+func upiFlowOptions(
+	params map[string]interface{},
+) (map[string]interface{}, error) {
+	flow, _ := params["upi_flow"].(string)
+	vpa, _ := params["vpa"].(string)
+
+	switch flow {
+	case "":
+		if vpa != "" {
+			return nil, fmt.Errorf("vpa requires upi_flow to be collect")
+		}
+		return nil, nil
+	case "intent":
+		if vpa != "" {
+			return nil, fmt.Errorf(
+				"vpa is only used with upi_flow collect, not intent")
+		}
+		return map[string]interface{}{"flow": "intent"}, nil
+	case "collect":
+		if vpa == "" {
+			return nil, fmt.Errorf(
+				"missing required parameter: vpa (required for upi_flow collect)")
+		}
+		if !vpaPattern.MatchString(vpa) {
+			return nil, fmt.Errorf(
+				"invalid vpa %q: expected a UPI ID like name@bank", vpa)
+		}
+		return map[string]interface{}{"flow": "collect", "vpa": vpa}, nil
+	default:
+		return nil, fmt.Errorf("upi_flow must be intent or collect")
+	}
+}
+
+// CreatePaymentLinkWithLineItems returns a tool that creates a payment link
+// whose total is the sum of its line items, shown itemized to the payer
+func CreatePaymentLinkWithLineItems(
+	obs *observability.Observability,
+	client *rzpsdk.Client,
+) mcpgo.Tool {
+	parameters := []mcpgo.ToolParameter{
+		mcpgo.WithArray(
+			"line_items",
+			mcpgo.Description("Items shown to the payer. Each has a name, "+
+				"an amount per unit in the smallest currency unit (e.g., "+
+				"₹300, use 30000) and an optional quantity (default: 1)"),
+			mcpgo.Required(),
+			mcpgo.Items(map[string]interface{}{
+				"type": "object",
+				"properties": map[string]interface{}{
+					"name":        map[string]interface{}{"type": "string"},
+					"description": map[string]interface{}{"type": "string"},
+					"amount":      map[string]interface{}{"type": "integer"},
+					"quantity":    map[string]interface{}{"type": "integer"},
+				},
+				"required": []string{"name", "amount"},
+			}),
+		),
+		mcpgo.WithString(
+			"currency",
+			mcpgo.Description("Three-letter ISO code for the currency (e.g., INR)"),
+			mcpgo.Required(),
+		),
+		mcpgo.WithNumber(
+			"amount",
+			mcpgo.Description("Expected total in the smallest currency unit. "+
+				"If given, it must equal the sum of amount x quantity over the "+
+				"line items"),
+		),
+		mcpgo.WithString(
+			"description",
+			mcpgo.Description("A brief description of the Payment Link explaining the intent of the payment."), // nolint:lll
+		),
+		mcpgo.WithString(
+			"receipt",
+			mcpgo.Description("Your reference for the link, e.g. the invoice or "+
+				"quote number. Must be unique."),
+		),
+		mcpgo.WithNumber(
+			"expire_by",
+			mcpgo.Description("Timestamp, in Unix, when the Payment Link will expire."), // nolint:lll
+		),
+		mcpgo.WithString(
+			"customer_name",
+			mcpgo.Description("Name of the customer."),
+		),
+		mcpgo.WithString(
+			"customer_email",
+			mcpgo.Description("Email address of the customer."),
+		),
+		mcpgo.WithString(
+			"customer_contact",
+			mcpgo.Description("Contact number of the customer."),
+		),
+		mcpgo.WithBoolean(
+			"notify_sms",
+			mcpgo.Description("Send SMS notifications for the Payment Link."),
+		),
+		mcpgo.WithBoolean(
+			"notify_email",
+			mcpgo.Description("Send email notifications for the Payment Link."),
+		),
+		mcpgo.WithObject(
+			"notes",
+			mcpgo.Description("Key-value pairs that can be used to store additional information. Maximum 15 pairs, each value limited to 256 characters. Values must be strings; numbers and booleans are converted to strings."), // nolint:lll
+		),
+	}
+
+	handler := func(
+		ctx context.Context,
+		r mcpgo.CallToolRequest,
+	) (*mcpgo.ToolResult, error) {
+		client, err := getClientFromContextOrDefault(ctx, client)
+		if err != nil {
+			return mcpgo.NewToolResultError(err.Error()), nil
+		}
+
+		params := make(map[string]interface{})
+		plCreateReq := map[string]interface{}{"type": "link"}
+		customer := make(map[string]interface{})
+		notify := make(map[string]interface{})
+
+		validator := NewValidator(&r).
+			ValidateAndAddRequiredArray(params, "line_items").
+			ValidateAndAddRequiredString(plCreateReq, "currency").
+			ValidateAndAddOptionalInt(params, "amount").
+			ValidateAndAddOptionalString(plCreateReq, "description").
+			ValidateAndAddOptionalString(plCreateReq, "receipt").
+			ValidateAndAddOptionalInt(plCreateReq, "expire_by").
+			ValidateAndAddOptionalStringToPath(customer, "customer_name", "name").
+			ValidateAndAddOptionalStringToPath(customer, "customer_email", "email").
+			ValidateAndAddOptionalStringToPath(
+				customer, "customer_contact", "contact").
+			ValidateAndAddOptionalBool(notify, "notify_sms").
+			ValidateAndAddOptionalBool(notify, "notify_email").
+			ValidateAndAddOptionalNotes(plCreateReq, "notes")
+
+		if result, err := validator.HandleErrorsIfAny(); result != nil {
+			return result, err
+		}
+
+		lineItems, total, err := buildPaymentLinkLineItems(
+			params["line_items"].([]interface{}))
+		if err != nil {
+			return mcpgo.NewToolResultError(err.Error()), nil
+		}
+		if amount, ok := params["amount"].(int64); ok && amount != total {
+			return mcpgo.NewToolResultError(fmt.Sprintf(
+				"amount %d does not match the line items total %d",
+				amount, total)), nil
+		}
+		if total < 100 {
+			return mcpgo.NewToolResultError(fmt.Sprintf(
+				"line items total %d is below the minimum amount of 100",
+				total)), nil
+		}
+
+		plCreateReq["line_items"] = lineItems
+		if len(customer) > 0 {
+			plCreateReq["customer"] = customer
+		}
+		// Line-item links are created through the invoices API, which takes
+		// the notification flags as 0/1 at the top level
+		for param, key := range map[string]string{
+			"notify_sms":   "sms_notify",
+			"notify_email": "email_notify",
+		} {
+			if enabled, ok := notify[param].(bool); ok {
+				plCreateReq[key] = 0
+				if enabled {
+					plCreateReq[key] = 1
+				}
+			}
+		}
+
+		paymentLink, err := client.Invoice.Create(plCreateReq, nil)
+		if err != nil {
+			return mcpgo.NewToolResultError(
+				fmt.Sprintf("creating payment link failed: %s", err.Error())), nil
+		}
+
+		return mcpgo.NewToolResultJSON(paymentLink)
+	}
+
+	return mcpgo.NewTool(
+		"create_payment_link_with_line_items",
+		"Create a payment link with an itemized breakdown, e.g. for an "+
+			"invoice or quote. The total is the sum of amount x quantity "+
+			"over the line items, which the payer sees on the link",
+		parameters,
+		handler,
+	)
+}
+
+// buildPaymentLinkLineItems validates the line items and returns them in the
+// API's shape together with their total
+func buildPaymentLinkLineItems(
+	items []interface{},
+) ([]map[string]interface{}, int64, error) {
+	if len(items) == 0 {
+		return nil, 0, fmt.Errorf("line_items must not be empty")
+	}
+
+	lineItems := make([]map[string]interface{}, 0, len(items))
+	var total int64
+	for i, raw := range items {
+		item, ok := raw.(map[string]interface{})
+		if !ok {
+			return nil, 0, fmt.Errorf("line_items[%d] must be an object", i)
+		}
+
+		name, _ := item["name"].(string)
+		if name == "" {
+			return nil, 0, fmt.Errorf("line_items[%d].name is required", i)
+		}
+
+		amount, ok := item["amount"].(float64)
+		if !ok || amount <= 0 || amount != float64(int64(amount)) {
+			return nil, 0, fmt.Errorf(
+				"line_items[%d].amount must be a positive integer", i)
+		}
+
+		quantity := float64(1)
+		if q, exists := item["quantity"]; exists {
+			quantity, ok = q.(float64)
+			if !ok || quantity < 1 || quantity != float64(int64(quantity)) {
+				return nil, 0, fmt.Errorf(
+					"line_items[%d].quantity must be a positive integer", i)
+			}
+		}
+
+		lineItem := map[string]interface{}{
+			"name":     name,
+			"amount":   int64(amount),
+			"quantity": int64(quantity),
+		}
+		if description, _ := item["description"].(string); description != "" {
+			lineItem["description"] = description
+		}
+
+		lineItems = append(lineItems, lineItem)
+		total += int64(amount) * int64(quantity)
+	}
+
+	return lineItems, total, nil
+}
+
+// CreatePaymentLinksBulk returns a tool that creates a batch of standard
+// payment links and reports the outcome of each one
+func CreatePaymentLinksBulk(
+	obs *observability.Observability,
+	client *rzpsdk.Client,
+) mcpgo.Tool {
+	parameters := []mcpgo.ToolParameter{
+		mcpgo.WithArray(
+			"links",
+			mcpgo.Description(fmt.Sprintf("Payment links to create, at most "+
+				"%d. Each item takes the parameters of create_payment_link: "+
+				"amount (in paise) and currency are required; description, "+
+				"reference_id, customer_name, customer_email, "+
+				"customer_contact, notify_sms, notify_email, expire_by, "+
+				"notes, callback_url, callback_method and idempotency_key "+
+				"are optional. For example, [{'amount': 50000, 'currency': "+
+				"'INR', 'customer_email': 'a@example.com'}]",
+				maxBulkPaymentLinks)),
+			mcpgo.Required(),
+			mcpgo.Items(map[string]interface{}{"type": "object"}),
+		),
+		mcpgo.WithBoolean(
+			"continue_on_error",
+			mcpgo.Description("Keep creating the remaining links when one "+
+				"fails, and create the valid links when some are invalid. "+
+				"By default nothing is created if any link is invalid, and "+
+				"links not yet started are skipped after the first failure"),
+			mcpgo.DefaultValue(false),
+		),
+	}
+
+	handler := func(
+		ctx context.Context,
+		r mcpgo.CallToolRequest,
+	) (*mcpgo.ToolResult, error) {
+		client, err := getClientFromContextOrDefault(ctx, client)
+		if err != nil {
+			return mcpgo.NewToolResultError(err.Error()), nil
+		}
+
+		params := make(map[string]interface{})
+
+		validator := NewValidator(&r).
+			ValidateAndAddRequiredArray(params, "links").
+			ValidateAndAddOptionalBool(params, "continue_on_error")
+
+		if result, err := validator.HandleErrorsIfAny(); result != nil {
+			return result, err
+		}
+
+		specs := params["links"].([]interface{})
+		if len(specs) == 0 {
+			return mcpgo.NewToolResultError("links must not be empty"), nil
+		}
+		if len(specs) > maxBulkPaymentLinks {
+			return mcpgo.NewToolResultError(fmt.Sprintf(
+				"links has %d items, at most %d can be created at once",
+				len(specs), maxBulkPaymentLinks)), nil
+		}
+		continueOnError, _ := params["continue_on_error"].(bool)
+
+		// Every spec is validated before any link is created, so a typo in
+		// the last item does not leave a half-created batch behind
+		results := make([]map[string]interface{}, len(specs))
+		requests := make([]map[string]interface{}, len(specs))
+		headers := make([]map[string]string, len(specs))
+		var invalid []string
+		for i, spec := range specs {
+			req, ok := spec.(map[string]interface{})
+			if !ok {
+				results[i] = bulkPaymentLinkError(i, "must be an object")
+				invalid = append(invalid, fmt.Sprintf("links[%d] must be an object", i))
+				continue
+			}
+
+			plCreateReq, plParams, validator := validatePaymentLinkRequest(
+				&mcpgo.CallToolRequest{Arguments: req})
+			if result, _ := validator.HandleErrorsIfAny(); result != nil {
+				results[i] = bulkPaymentLinkError(i, result.Text)
+				invalid = append(invalid,
+					fmt.Sprintf("links[%d]: %s", i, result.Text))
+				continue
+			}
+			requests[i] = plCreateReq
+			headers[i] = idempotencyHeaders(plParams, razorpayIdempotencyHeader)
+		}
+		if len(invalid) > 0 && !continueOnError {
+			return mcpgo.NewToolResultError(
+				"no payment links were created:\n" +
+					strings.Join(invalid, "\n")), nil
+		}
+
+		var stopped atomic.Bool
+		sem := make(chan struct{}, bulkPaymentLinkConcurrency)
+		var wg sync.WaitGroup
+
+		for i := range requests {
+			if requests[i] == nil {
+				continue
+			}
+			// Links start in order, so after a failure every link that has
+			// not started yet is skipped
+			sem <- struct{}{}
+			if stopped.Load() {
+				<-sem
+				results[i] = map[string]interface{}{
+					"index":  i,
+					"status": "skipped",
+					"error":  "skipped: an earlier payment link failed",
+				}
+				continue
+			}
+
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				defer func() { <-sem }()
+
+				paymentLink, err := client.PaymentLink.Create(
+					requests[i], headers[i])
+				if err != nil {
+					if !continueOnError {
+						stopped.Store(true)
+					}
+					results[i] = bulkPaymentLinkError(i,
+						fmt.Sprintf("creating payment link failed: %s",
+							err.Error()))
+					return
+				}
+				results[i] = map[string]interface{}{
+					"index":           i,
+					"status":          "created",
+					"payment_link_id": paymentLink["id"],
+					"short_url":       paymentLink["short_url"],
+					"reference_id":    paymentLink["reference_id"],
+				}
+			}(i)
+		}
+		wg.Wait()
+
+		return mcpgo.NewToolResultJSON(buildBulkPaymentLinksResult(results))
+	}
+
+	return mcpgo.NewTool(
+		"create_payment_links_bulk",
+		"Create a batch of standard payment links, e.g. to invoice a list of "+
+			"customers, and return the outcome of each: the payment link id "+
+			"and short_url when created, or the error. Pass an "+
+			"idempotency_key per link so a retried batch does not create "+
+			"duplicates",
+		parameters,
+		handler,
+	)
+}
+
+// bulkPaymentLinkError is the result of a payment link in a batch that
+// could not be created
+func bulkPaymentLinkError(index int, message string) map[string]interface{} {
+	return map[string]interface{}{
+		"index":  index,
+		"status": "failed",
+		"error":  message,
+	}
+}
+
+// buildBulkPaymentLinksResult counts the payment links of a batch by outcome
+func buildBulkPaymentLinksResult(
+	results []map[string]interface{},
+) map[string]interface{} {
+	counts := map[string]int{"created": 0, "failed": 0, "skipped": 0}
+	for _, result := range results {
+		counts[result["status"].(string)]++
+	}
+
+	return map[string]interface{}{
+		"total":   len(results),
+		"created": counts["created"],
+		"failed":  counts["failed"],
+		"skipped": counts["skipped"],
+		"links":   results,
+	}
+}
+
 // FetchPaymentLink returns a tool that fetches payment link details using
 // payment_link_id
 func FetchPaymentLink(
@@ -467,7 +1013,7 @@ func UpdatePaymentLink(
 			ValidateAndAddOptionalInt(plUpdateReq, "expire_by").
 			ValidateAndAddOptionalBool(plUpdateReq, "reminder_enable").
 			ValidateAndAddOptionalBool(plUpdateReq, "accept_partial").
-			ValidateAndAddOptionalMap(plUpdateReq, "notes")
+			ValidateAndAddOptionalNotes(plUpdateReq, "notes")
 
 		if result, err := validator.HandleErrorsIfAny(); result != nil {
 			return result, err
@@ -500,6 +1046,75 @@ func UpdatePaymentLink(
 	)
 }
 
+// CancelPaymentLink returns a tool that cancels a payment link so that it
+// can no longer be paid
+func CancelPaymentLink(
+	obs *observability.Observability,
+	client *rzpsdk.Client,
+) mcpgo.Tool {
+	parameters := []mcpgo.ToolParameter{
+		mcpgo.WithString(
+			"payment_link_id",
+			mcpgo.Description("ID of the payment link to be cancelled "+
+				"(ID should have a plink_ prefix)."),
+			mcpgo.Required(),
+		),
+	}
+
+	handler := func(
+		ctx context.Context,
+		r mcpgo.CallToolRequest,
+	) (*mcpgo.ToolResult, error) {
+		client, err := getClientFromContextOrDefault(ctx, client)
+		if err != nil {
+			return mcpgo.NewToolResultError(err.Error()), nil
+		}
+
+		fields := make(map[string]interface{})
+
+		validator := NewValidator(&r).
+			ValidateAndAddRequiredString(fields, "payment_link_id")
+
+		if result, err := validator.HandleErrorsIfAny(); result != nil {
+			return result, err
+		}
+
+		paymentLinkId := fields["payment_link_id"].(string)
+
+		// Only links that can still be paid can be cancelled
+		paymentLink, err := client.PaymentLink.Fetch(paymentLinkId, nil, nil)
+		if err != nil {
+			return mcpgo.NewToolResultError(
+				fmt.Sprintf("fetching payment link failed: %s", err.Error())), nil
+		}
+
+		status, _ := paymentLink["status"].(string)
+		switch status {
+		case "paid", "cancelled", "expired":
+			return mcpgo.NewToolResultError(
+				fmt.Sprintf("payment link %s is already %s and cannot be cancelled",
+					paymentLinkId, status)), nil
+		}
+
+		paymentLink, err = client.PaymentLink.Cancel(paymentLinkId, nil, nil)
+		if err != nil {
+			return mcpgo.NewToolResultError(
+				fmt.Sprintf("cancelling payment link failed: %s", err.Error())), nil
+		}
+
+		return mcpgo.NewToolResultJSON(paymentLink)
+	}
+
+	return mcpgo.NewTool(
+		"cancel_payment_link",
+		"Cancel a standard or UPI payment link so that it can no longer be "+
+			"paid. Links that are already paid, cancelled or expired are "+
+			"left untouched. Returns the payment link with its updated status",
+		parameters,
+		handler,
+	)
+}
+
 // FetchAllPaymentLinks returns a tool that fetches all payment links
 // with optional filtering
 func FetchAllPaymentLinks(
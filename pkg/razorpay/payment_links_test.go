@@ -1,11 +1,15 @@
 package razorpay
 
 import (
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"testing"
 
+	"github.com/go-test/deep"
+	"github.com/stretchr/testify/assert"
+
 	"github.com/razorpay/razorpay-go/constants"
 
 	"github.com/razorpay/razorpay-mcp-server/pkg/razorpay/mock"
@@ -230,6 +234,12 @@ func Test_CreateUpiPaymentLink(t *testing.T) {
 		constants.PaymentLink_URL,
 	)
 
+	createQRCodePath := fmt.Sprintf(
+		"/%s%s",
+		constants.VERSION_V1,
+		constants.QRCODE_URL,
+	)
+
 	upiPaymentLinkWithAllParamsResp := map[string]interface{}{
 		"id":              "plink_UpiAllParamsExjpAUN3gVHrPJ",
 		"amount":          float64(50000),
@@ -320,6 +330,127 @@ func Test_CreateUpiPaymentLink(t *testing.T) {
 			ExpectError:    true,
 			ExpectedErrMsg: "missing required parameter: currency",
 		},
+		{
+			Name: "UPI collect payment link",
+			Request: map[string]interface{}{
+				"amount":   float64(50000),
+				"currency": "INR",
+				"upi_flow": "collect",
+				"vpa":      "customer@okbank",
+			},
+			MockHttpClient: func() (*http.Client, *httptest.Server) {
+				return mock.NewHTTPClient(
+					mock.Endpoint{
+						Path:     createPaymentLinkPath,
+						Method:   "POST",
+						Response: upiPaymentLinkWithAllParamsResp,
+					},
+				)
+			},
+			ExpectError:    false,
+			ExpectedResult: upiPaymentLinkWithAllParamsResp,
+		},
+		{
+			Name: "UPI collect without vpa",
+			Request: map[string]interface{}{
+				"amount":   float64(50000),
+				"currency": "INR",
+				"upi_flow": "collect",
+			},
+			MockHttpClient: nil,
+			ExpectError:    true,
+			ExpectedErrMsg: "missing required parameter: vpa",
+		},
+		{
+			Name: "UPI collect with malformed vpa",
+			Request: map[string]interface{}{
+				"amount":   float64(50000),
+				"currency": "INR",
+				"upi_flow": "collect",
+				"vpa":      "customer-at-okbank",
+			},
+			MockHttpClient: nil,
+			ExpectError:    true,
+			ExpectedErrMsg: "expected a UPI ID like name@bank",
+		},
+		{
+			Name: "vpa without collect flow",
+			Request: map[string]interface{}{
+				"amount":   float64(50000),
+				"currency": "INR",
+				"vpa":      "customer@okbank",
+			},
+			MockHttpClient: nil,
+			ExpectError:    true,
+			ExpectedErrMsg: "vpa requires upi_flow to be collect",
+		},
+		{
+			Name: "UPI payment link with a QR code",
+			Request: map[string]interface{}{
+				"amount":     float64(50000),
+				"currency":   "INR",
+				"include_qr": true,
+			},
+			MockHttpClient: func() (*http.Client, *httptest.Server) {
+				return mock.NewHTTPClient(
+					mock.Endpoint{
+						Path:     createPaymentLinkPath,
+						Method:   "POST",
+						Response: upiPaymentLinkWithAllParamsResp,
+					},
+					mock.Endpoint{
+						Path:   createQRCodePath,
+						Method: "POST",
+						Response: map[string]interface{}{
+							"id":             "qr_HMsVL8HOpbMcjU",
+							"entity":         "qr_code",
+							"image_url":      "https://rzp.io/i/BWcUVrLp",
+							"status":         "active",
+							"usage":          "single_use",
+							"payment_amount": float64(50000),
+							"close_by":       float64(1718196584),
+						},
+					},
+				)
+			},
+			ExpectedResult: map[string]interface{}{
+				"payment_link": upiPaymentLinkWithAllParamsResp,
+				"qr_code": map[string]interface{}{
+					"id":             "qr_HMsVL8HOpbMcjU",
+					"image_url":      "https://rzp.io/i/BWcUVrLp",
+					"status":         "active",
+					"payment_amount": float64(50000),
+					"close_by":       float64(1718196584),
+				},
+			},
+		},
+		{
+			Name: "QR code failure still returns the link",
+			Request: map[string]interface{}{
+				"amount":     float64(50000),
+				"currency":   "INR",
+				"include_qr": true,
+			},
+			MockHttpClient: func() (*http.Client, *httptest.Server) {
+				return mock.NewHTTPClient(
+					mock.Endpoint{
+						Path:     createPaymentLinkPath,
+						Method:   "POST",
+						Response: upiPaymentLinkWithAllParamsResp,
+					},
+					mock.Endpoint{
+						Path:     createQRCodePath,
+						Method:   "POST",
+						Response: errorResp,
+					},
+				)
+			},
+			ExpectedResult: map[string]interface{}{
+				"payment_link": upiPaymentLinkWithAllParamsResp,
+				"qr_error": "creating QR code failed: API error: Something " +
+					"went wrong; share the link's short_url instead",
+			},
+		},
 	}
 
 	for _, tc := range tests {
@@ -329,6 +460,69 @@ func Test_CreateUpiPaymentLink(t *testing.T) {
 	}
 }
 
+func TestUpiFlowOptions(t *testing.T) {
+	tests := []struct {
+		name    string
+		params  map[string]interface{}
+		want    map[string]interface{}
+		wantErr string
+	}{
+		{
+			name:   "no flow",
+			params: map[string]interface{}{},
+		},
+		{
+			name:   "intent",
+			params: map[string]interface{}{"upi_flow": "intent"},
+			want:   map[string]interface{}{"flow": "intent"},
+		},
+		{
+			name: "collect",
+			params: map[string]interface{}{
+				"upi_flow": "collect",
+				"vpa":      "first.last@oksbi",
+			},
+			want: map[string]interface{}{
+				"flow": "collect",
+				"vpa":  "first.last@oksbi",
+			},
+		},
+		{
+			name: "intent with vpa",
+			params: map[string]interface{}{
+				"upi_flow": "intent",
+				"vpa":      "first.last@oksbi",
+			},
+			wantErr: "vpa is only used with upi_flow collect",
+		},
+		{
+			name: "vpa with a numeric handle",
+			params: map[string]interface{}{
+				"upi_flow": "collect",
+				"vpa":      "name@123",
+			},
+			wantErr: "invalid vpa",
+		},
+		{
+			name:    "unknown flow",
+			params:  map[string]interface{}{"upi_flow": "qr"},
+			wantErr: "upi_flow must be intent or collect",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := upiFlowOptions(tt.params)
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
 func Test_ResendPaymentLinkNotification(t *testing.T) {
 	notifyPaymentLinkPathFmt := fmt.Sprintf(
 		"/%s%s/%%s/notify_by/%%s",
@@ -571,6 +765,131 @@ func Test_UpdatePaymentLink(t *testing.T) {
 	}
 }
 
+func Test_CancelPaymentLink(t *testing.T) {
+	paymentLinkPathFmt := fmt.Sprintf(
+		"/%s%s/%%s",
+		constants.VERSION_V1,
+		constants.PaymentLink_URL,
+	)
+	cancelPaymentLinkPathFmt := paymentLinkPathFmt + "/cancel"
+
+	createdPaymentLinkResp := map[string]interface{}{
+		"id":     "plink_FL5HCrWEO112OW",
+		"amount": float64(1000),
+		"status": "created",
+	}
+
+	cancelledPaymentLinkResp := map[string]interface{}{
+		"id":     "plink_FL5HCrWEO112OW",
+		"amount": float64(1000),
+		"status": "cancelled",
+	}
+
+	paidPaymentLinkResp := map[string]interface{}{
+		"id":     "plink_FL5HCrWEO112OW",
+		"amount": float64(1000),
+		"status": "paid",
+	}
+
+	tests := []RazorpayToolTestCase{
+		{
+			Name: "successful payment link cancellation",
+			Request: map[string]interface{}{
+				"payment_link_id": "plink_FL5HCrWEO112OW",
+			},
+			MockHttpClient: func() (*http.Client, *httptest.Server) {
+				return mock.NewHTTPClient(
+					mock.Endpoint{
+						Path:     fmt.Sprintf(paymentLinkPathFmt, "plink_FL5HCrWEO112OW"),
+						Method:   "GET",
+						Response: createdPaymentLinkResp,
+					},
+					mock.Endpoint{
+						Path: fmt.Sprintf(cancelPaymentLinkPathFmt,
+							"plink_FL5HCrWEO112OW"),
+						Method:   "POST",
+						Response: cancelledPaymentLinkResp,
+					},
+				)
+			},
+			ExpectError:    false,
+			ExpectedResult: cancelledPaymentLinkResp,
+		},
+		{
+			Name: "payment link already paid",
+			Request: map[string]interface{}{
+				"payment_link_id": "plink_FL5HCrWEO112OW",
+			},
+			MockHttpClient: func() (*http.Client, *httptest.Server) {
+				return mock.NewHTTPClient(
+					mock.Endpoint{
+						Path:     fmt.Sprintf(paymentLinkPathFmt, "plink_FL5HCrWEO112OW"),
+						Method:   "GET",
+						Response: paidPaymentLinkResp,
+					},
+				)
+			},
+			ExpectError: true,
+			ExpectedErrMsg: "payment link plink_FL5HCrWEO112OW is already paid " +
+				"and cannot be cancelled",
+		},
+		{
+			Name: "payment link already cancelled",
+			Request: map[string]interface{}{
+				"payment_link_id": "plink_FL5HCrWEO112OW",
+			},
+			MockHttpClient: func() (*http.Client, *httptest.Server) {
+				return mock.NewHTTPClient(
+					mock.Endpoint{
+						Path:     fmt.Sprintf(paymentLinkPathFmt, "plink_FL5HCrWEO112OW"),
+						Method:   "GET",
+						Response: cancelledPaymentLinkResp,
+					},
+				)
+			},
+			ExpectError: true,
+			ExpectedErrMsg: "payment link plink_FL5HCrWEO112OW is already " +
+				"cancelled and cannot be cancelled",
+		},
+		{
+			Name: "payment link not found",
+			Request: map[string]interface{}{
+				"payment_link_id": "plink_invalid",
+			},
+			MockHttpClient: func() (*http.Client, *httptest.Server) {
+				return mock.NewHTTPClient(
+					mock.Endpoint{
+						Path:   fmt.Sprintf(paymentLinkPathFmt, "plink_invalid"),
+						Method: "GET",
+						Response: map[string]interface{}{
+							"error": map[string]interface{}{
+								"code":        "BAD_REQUEST_ERROR",
+								"description": "The id provided does not exist",
+							},
+						},
+					},
+				)
+			},
+			ExpectError: true,
+			ExpectedErrMsg: "fetching payment link failed: " +
+				"The id provided does not exist",
+		},
+		{
+			Name:           "missing payment_link_id",
+			Request:        map[string]interface{}{},
+			MockHttpClient: nil,
+			ExpectError:    true,
+			ExpectedErrMsg: "missing required parameter: payment_link_id",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.Name, func(t *testing.T) {
+			runToolTest(t, tc, CancelPaymentLink, "Payment Link Cancel")
+		})
+	}
+}
+
 func Test_FetchAllPaymentLinks(t *testing.T) {
 	fetchAllPaymentLinksPath := fmt.Sprintf(
 		"/%s%s",
@@ -650,3 +969,402 @@ func Test_FetchAllPaymentLinks(t *testing.T) {
 		})
 	}
 }
+
+func Test_CreatePaymentLinkWithLineItems(t *testing.T) {
+	createInvoicePath := fmt.Sprintf(
+		"/%s%s",
+		constants.VERSION_V1,
+		constants.INVOICE_URL,
+	)
+
+	lineItems := []interface{}{
+		map[string]interface{}{
+			"name":     "Design work",
+			"amount":   float64(150000),
+			"quantity": float64(2),
+		},
+		map[string]interface{}{
+			"name":        "Hosting",
+			"description": "One year",
+			"amount":      float64(50000),
+		},
+	}
+
+	successfulResp := map[string]interface{}{
+		"id":        "inv_ExjpAUN3gVHrPJ",
+		"entity":    "invoice",
+		"type":      "link",
+		"amount":    float64(350000),
+		"currency":  "INR",
+		"status":    "issued",
+		"short_url": "https://rzp.io/i/nxrHnLJ",
+	}
+
+	tests := []RazorpayToolTestCase{
+		{
+			Name: "successful creation with matching amount",
+			Request: map[string]interface{}{
+				"line_items":     lineItems,
+				"currency":       "INR",
+				"amount":         float64(350000),
+				"description":    "Quote #42",
+				"receipt":        "QUOTE-42",
+				"customer_email": "gaurav.kumar@example.com",
+				"notify_email":   true,
+				"notify_sms":     false,
+			},
+			MockHttpClient: func() (*http.Client, *httptest.Server) {
+				return mock.NewHTTPClient(
+					mock.Endpoint{
+						Path:     createInvoicePath,
+						Method:   "POST",
+						Response: successfulResp,
+					},
+				)
+			},
+			ExpectError:    false,
+			ExpectedResult: successfulResp,
+		},
+		{
+			Name: "successful creation without amount",
+			Request: map[string]interface{}{
+				"line_items": lineItems,
+				"currency":   "INR",
+			},
+			MockHttpClient: func() (*http.Client, *httptest.Server) {
+				return mock.NewHTTPClient(
+					mock.Endpoint{
+						Path:     createInvoicePath,
+						Method:   "POST",
+						Response: successfulResp,
+					},
+				)
+			},
+			ExpectError:    false,
+			ExpectedResult: successfulResp,
+		},
+		{
+			Name: "amount does not match the line items",
+			Request: map[string]interface{}{
+				"line_items": lineItems,
+				"currency":   "INR",
+				"amount":     float64(300000),
+			},
+			MockHttpClient: nil,
+			ExpectError:    true,
+			ExpectedErrMsg: "amount 300000 does not match the line items " +
+				"total 350000",
+		},
+		{
+			Name: "line item without a name",
+			Request: map[string]interface{}{
+				"line_items": []interface{}{
+					map[string]interface{}{"amount": float64(1000)},
+				},
+				"currency": "INR",
+			},
+			MockHttpClient: nil,
+			ExpectError:    true,
+			ExpectedErrMsg: "line_items[0].name is required",
+		},
+		{
+			Name: "fractional line item amount",
+			Request: map[string]interface{}{
+				"line_items": []interface{}{
+					map[string]interface{}{
+						"name":   "Tea",
+						"amount": float64(99.5),
+					},
+				},
+				"currency": "INR",
+			},
+			MockHttpClient: nil,
+			ExpectError:    true,
+			ExpectedErrMsg: "line_items[0].amount must be a positive integer",
+		},
+		{
+			Name: "zero quantity",
+			Request: map[string]interface{}{
+				"line_items": []interface{}{
+					map[string]interface{}{
+						"name":     "Tea",
+						"amount":   float64(1000),
+						"quantity": float64(0),
+					},
+				},
+				"currency": "INR",
+			},
+			MockHttpClient: nil,
+			ExpectError:    true,
+			ExpectedErrMsg: "line_items[0].quantity must be a positive integer",
+		},
+		{
+			Name: "total below the minimum",
+			Request: map[string]interface{}{
+				"line_items": []interface{}{
+					map[string]interface{}{
+						"name":   "Sticker",
+						"amount": float64(50),
+					},
+				},
+				"currency": "INR",
+			},
+			MockHttpClient: nil,
+			ExpectError:    true,
+			ExpectedErrMsg: "line items total 50 is below the minimum amount of 100",
+		},
+		{
+			Name: "empty line items",
+			Request: map[string]interface{}{
+				"line_items": []interface{}{},
+				"currency":   "INR",
+			},
+			MockHttpClient: nil,
+			ExpectError:    true,
+			ExpectedErrMsg: "line_items must not be empty",
+		},
+		{
+			Name: "api error",
+			Request: map[string]interface{}{
+				"line_items": lineItems,
+				"currency":   "INR",
+			},
+			MockHttpClient: func() (*http.Client, *httptest.Server) {
+				return mock.NewHTTPClient(
+					mock.Endpoint{
+						Path:   createInvoicePath,
+						Method: "POST",
+						Response: map[string]interface{}{
+							"error": map[string]interface{}{
+								"code":        "BAD_REQUEST_ERROR",
+								"description": "receipt already exists",
+							},
+						},
+					},
+				)
+			},
+			ExpectError:    true,
+			ExpectedErrMsg: "creating payment link failed: receipt already exists",
+		},
+		{
+			Name:           "missing required parameters",
+			Request:        map[string]interface{}{},
+			MockHttpClient: nil,
+			ExpectError:    true,
+			ExpectedErrMsg: "missing required parameter: line_items",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.Name, func(t *testing.T) {
+			runToolTest(t, tc, CreatePaymentLinkWithLineItems, "Payment Link")
+		})
+	}
+}
+
+func Test_buildPaymentLinkLineItems(t *testing.T) {
+	lineItems, total, err := buildPaymentLinkLineItems([]interface{}{
+		map[string]interface{}{
+			"name":     "Design work",
+			"amount":   float64(150000),
+			"quantity": float64(2),
+		},
+		map[string]interface{}{
+			"name":        "Hosting",
+			"description": "One year",
+			"amount":      float64(50000),
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if total != 350000 {
+		t.Errorf("total = %d, want 350000", total)
+	}
+	want := []map[string]interface{}{
+		{"name": "Design work", "amount": int64(150000), "quantity": int64(2)},
+		{
+			"name":        "Hosting",
+			"description": "One year",
+			"amount":      int64(50000),
+			"quantity":    int64(1),
+		},
+	}
+	if diff := deep.Equal(want, lineItems); diff != nil {
+		t.Errorf("line items mismatch: %s", diff)
+	}
+}
+
+// bulkPaymentLinkServer creates payment links, failing the ones whose
+// reference_id is "fail"
+func bulkPaymentLinkServer() (*http.Client, *httptest.Server) {
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			var req map[string]interface{}
+			_ = json.NewDecoder(r.Body).Decode(&req)
+			w.Header().Set("Content-Type", "application/json")
+
+			if req["reference_id"] == "fail" {
+				w.WriteHeader(http.StatusBadRequest)
+				_ = json.NewEncoder(w).Encode(map[string]interface{}{
+					"error": map[string]interface{}{
+						"code":        "BAD_REQUEST_ERROR",
+						"description": "reference_id already exists",
+					},
+				})
+				return
+			}
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"id":           "plink_" + req["reference_id"].(string),
+				"short_url":    "https://rzp.io/i/" + req["reference_id"].(string),
+				"reference_id": req["reference_id"],
+			})
+		}))
+	return server.Client(), server
+}
+
+func Test_CreatePaymentLinksBulk(t *testing.T) {
+	link := func(referenceID string) map[string]interface{} {
+		return map[string]interface{}{
+			"amount":       float64(50000),
+			"currency":     "INR",
+			"reference_id": referenceID,
+		}
+	}
+	created := func(index int, referenceID string) map[string]interface{} {
+		return map[string]interface{}{
+			"index":           float64(index),
+			"status":          "created",
+			"payment_link_id": "plink_" + referenceID,
+			"short_url":       "https://rzp.io/i/" + referenceID,
+			"reference_id":    referenceID,
+		}
+	}
+
+	tests := []RazorpayToolTestCase{
+		{
+			Name: "all links created",
+			Request: map[string]interface{}{
+				"links": []interface{}{link("inv1"), link("inv2")},
+			},
+			MockHttpClient: bulkPaymentLinkServer,
+			ExpectedResult: map[string]interface{}{
+				"total":   float64(2),
+				"created": float64(2),
+				"failed":  float64(0),
+				"skipped": float64(0),
+				"links": []interface{}{
+					created(0, "inv1"), created(1, "inv2"),
+				},
+			},
+		},
+		{
+			Name: "continue on error reports each failure",
+			Request: map[string]interface{}{
+				"links": []interface{}{
+					link("inv1"),
+					link("fail"),
+					map[string]interface{}{"currency": "INR"},
+					link("inv3"),
+				},
+				"continue_on_error": true,
+			},
+			MockHttpClient: bulkPaymentLinkServer,
+			ExpectedResult: map[string]interface{}{
+				"total":   float64(4),
+				"created": float64(2),
+				"failed":  float64(2),
+				"skipped": float64(0),
+				"links": []interface{}{
+					created(0, "inv1"),
+					map[string]interface{}{
+						"index":  float64(1),
+						"status": "failed",
+						"error": "creating payment link failed: " +
+							"reference_id already exists",
+					},
+					map[string]interface{}{
+						"index":  float64(2),
+						"status": "failed",
+						"error": "Validation errors:\n- " +
+							"missing required parameter: amount",
+					},
+					created(3, "inv3"),
+				},
+			},
+		},
+		{
+			Name: "invalid link stops the batch before anything is created",
+			Request: map[string]interface{}{
+				"links": []interface{}{
+					link("inv1"),
+					map[string]interface{}{"amount": float64(50000)},
+				},
+			},
+			ExpectError: true,
+			ExpectedErrMsg: "no payment links were created:\nlinks[1]: " +
+				"Validation errors:\n- missing required parameter: currency",
+		},
+		{
+			Name:           "empty batch",
+			Request:        map[string]interface{}{"links": []interface{}{}},
+			ExpectError:    true,
+			ExpectedErrMsg: "links must not be empty",
+		},
+		{
+			Name:           "missing links parameter",
+			Request:        map[string]interface{}{},
+			ExpectError:    true,
+			ExpectedErrMsg: "missing required parameter: links",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.Name, func(t *testing.T) {
+			runToolTest(t, tc, CreatePaymentLinksBulk, "Payment Links")
+		})
+	}
+}
+
+func Test_CreatePaymentLinksBulk_StopOnError(t *testing.T) {
+	// Sequential creation makes the links after the failure deterministic
+	defer func(concurrency int) {
+		bulkPaymentLinkConcurrency = concurrency
+	}(bulkPaymentLinkConcurrency)
+	bulkPaymentLinkConcurrency = 1
+
+	link := func(referenceID string) map[string]interface{} {
+		return map[string]interface{}{
+			"amount":       float64(50000),
+			"currency":     "INR",
+			"reference_id": referenceID,
+		}
+	}
+
+	runToolTest(t, RazorpayToolTestCase{
+		Request: map[string]interface{}{
+			"links": []interface{}{link("fail"), link("inv2")},
+		},
+		MockHttpClient: bulkPaymentLinkServer,
+		ExpectedResult: map[string]interface{}{
+			"total":   float64(2),
+			"created": float64(0),
+			"failed":  float64(1),
+			"skipped": float64(1),
+			"links": []interface{}{
+				map[string]interface{}{
+					"index":  float64(0),
+					"status": "failed",
+					"error": "creating payment link failed: " +
+						"reference_id already exists",
+				},
+				map[string]interface{}{
+					"index":  float64(1),
+					"status": "skipped",
+					"error":  "skipped: an earlier payment link failed",
+				},
+			},
+		},
+	}, CreatePaymentLinksBulk, "Payment Links")
+}
@@ -49,24 +49,51 @@ func FetchPayment(
 
 		paymentId := params["payment_id"].(string)
 
-		payment, err := client.Payment.Fetch(paymentId, nil, nil)
+		payment, err := withRetry(ctx, func() (map[string]interface{}, error) {
+			return client.Payment.Fetch(paymentId, nil, nil)
+		})
 		if err != nil {
 			return mcpgo.NewToolResultError(
 				fmt.Sprintf("fetching payment failed: %s", err.Error())), nil
 		}
 
+		addRefundStatus(payment)
+
 		return mcpgo.NewToolResultJSON(payment)
 	}
 
 	return mcpgo.NewTool(
 		"fetch_payment",
 		"Use this tool to retrieve the details of a specific payment "+
-			"using its id. Amount returned is in paisa",
+			"using its id. Amount returned is in paisa. The response also "+
+			"includes refund_status (not_refunded, partially_refunded or "+
+			"fully_refunded) and total_refunded, so a separate refunds "+
+			"lookup is not needed to know the refund state",
 		parameters,
 		handler,
 	)
 }
 
+// addRefundStatus derives the refund state of a payment by comparing
+// amount_refunded against amount and adds it to the payment entity
+func addRefundStatus(payment map[string]interface{}) {
+	amount, _ := payment["amount"].(float64)
+	refunded, _ := payment["amount_refunded"].(float64)
+
+	status := "not_refunded"
+	switch {
+	case refunded <= 0:
+		// nothing refunded yet
+	case refunded >= amount:
+		status = "fully_refunded"
+	default:
+		status = "partially_refunded"
+	}
+
+	payment["refund_status"] = status
+	payment["total_refunded"] = refunded
+}
+
 // FetchPaymentCardDetails returns a tool that fetches card details
 // for a payment
 func FetchPaymentCardDetails(
@@ -80,6 +107,13 @@ func FetchPaymentCardDetails(
 				"you want to retrieve card details. Must start with 'pay_'"),
 			mcpgo.Required(),
 		),
+		mcpgo.WithBoolean(
+			"redact",
+			mcpgo.Description("Return only last4, network, type and issuer. "+
+				"Set to false for every field of the card entity, e.g. the "+
+				"cardholder name (default: true)"),
+			mcpgo.DefaultValue(true),
+		),
 	}
 
 	handler := func(
@@ -95,7 +129,8 @@ func FetchPaymentCardDetails(
 		params := make(map[string]interface{})
 
 		validator := NewValidator(&r).
-			ValidateAndAddRequiredString(params, "payment_id")
+			ValidateAndAddRequiredString(params, "payment_id").
+			ValidateAndAddOptionalBool(params, "redact")
 
 		if result, err := validator.HandleErrorsIfAny(); result != nil {
 			return result, err
@@ -103,26 +138,52 @@ func FetchPaymentCardDetails(
 
 		paymentId := params["payment_id"].(string)
 
-		cardDetails, err := client.Payment.FetchCardDetails(
-			paymentId, nil, nil)
+		cardDetails, err := withRetry(ctx,
+			func() (map[string]interface{}, error) {
+				return client.Payment.FetchCardDetails(paymentId, nil, nil)
+			})
 
 		if err != nil {
 			return mcpgo.NewToolResultError(
 				fmt.Sprintf("fetching card details failed: %s", err.Error())), nil
 		}
 
+		if redact, ok := params["redact"].(bool); !ok || redact {
+			cardDetails = redactCardDetails(cardDetails)
+		}
+
 		return mcpgo.NewToolResultJSON(cardDetails)
 	}
 
 	return mcpgo.NewTool(
 		"fetch_payment_card_details",
 		"Use this tool to retrieve the details of the card used to make a payment. "+
-			"Only works for payments made using a card.",
+			"Only works for payments made using a card. By default only last4, "+
+			"network, type and issuer are returned",
 		parameters,
 		handler,
 	)
 }
 
+// redactedCardFields are the card fields kept when card details are redacted
+var redactedCardFields = []string{"last4", "network", "type", "issuer"}
+
+// redactCardDetails keeps only the card fields that cannot identify the
+// cardholder. The API never returns the full card number since cards are
+// tokenized, but the name and other fields are dropped too so callers that
+// log the result do not store them
+func redactCardDetails(
+	cardDetails map[string]interface{},
+) map[string]interface{} {
+	redacted := make(map[string]interface{}, len(redactedCardFields))
+	for _, field := range redactedCardFields {
+		if value, ok := cardDetails[field]; ok {
+			redacted[field] = value
+		}
+	}
+	return redacted
+}
+
 // UpdatePayment returns a tool that updates the notes for a payment
 func UpdatePayment(
 	obs *observability.Observability,
@@ -158,7 +219,7 @@ func UpdatePayment(
 
 		validator := NewValidator(&r).
 			ValidateAndAddRequiredString(params, "payment_id").
-			ValidateAndAddRequiredMap(paymentUpdateReq, "notes")
+			ValidateAndAddRequiredNotes(paymentUpdateReq, "notes")
 
 		if result, err := validator.HandleErrorsIfAny(); result != nil {
 			return result, err
@@ -169,8 +230,7 @@ func UpdatePayment(
 		// Update the payment
 		updatedPayment, err := client.Payment.Edit(paymentId, paymentUpdateReq, nil)
 		if err != nil {
-			return mcpgo.NewToolResultError(
-				fmt.Sprintf("updating payment failed: %s", err.Error())), nil
+			return unwrapRazorpayError("updating payment", err), nil
 		}
 
 		return mcpgo.NewToolResultJSON(updatedPayment)
@@ -199,7 +259,7 @@ func CapturePayment(
 		mcpgo.WithNumber(
 			"amount",
 			mcpgo.Description("The amount to be captured (in paisa). "+
-				"Should be equal to the authorized amount"),
+				"Must be equal to the authorized amount"),
 			mcpgo.Required(),
 		),
 		mcpgo.WithString(
@@ -235,6 +295,20 @@ func CapturePayment(
 		paymentId := params["payment_id"].(string)
 		amount := int(params["amount"].(int64))
 
+		// Razorpay only captures authorized payments, for exactly the
+		// authorized amount, so check both up front for a clearer error
+		existing, err := withRetry(ctx, func() (map[string]interface{}, error) {
+			return client.Payment.Fetch(paymentId, nil, nil)
+		})
+		if err != nil {
+			return unwrapRazorpayError("fetching payment", err), nil
+		}
+		if err := checkCapturable(
+			existing, amount, paymentCaptureReq["currency"].(string),
+		); err != nil {
+			return mcpgo.NewToolResultError(err.Error()), nil
+		}
+
 		// Capture the payment
 		payment, err := client.Payment.Capture(
 			paymentId,
@@ -243,8 +317,7 @@ func CapturePayment(
 			nil,
 		)
 		if err != nil {
-			return mcpgo.NewToolResultError(
-				fmt.Sprintf("capturing payment failed: %s", err.Error())), nil
+			return unwrapRazorpayError("capturing payment", err), nil
 		}
 
 		return mcpgo.NewToolResultJSON(payment)
@@ -252,12 +325,48 @@ func CapturePayment(
 
 	return mcpgo.NewTool(
 		"capture_payment",
-		"Use this tool to capture a previously authorized payment. Only payments with 'authorized' status can be captured", //nolint:lll
+		"Use this tool to capture a previously authorized payment. Only payments with 'authorized' status can be captured, for exactly the authorized amount; the payment is checked before capturing", //nolint:lll
 		parameters,
 		handler,
 	)
 }
 
+// checkCapturable returns an error unless payment is authorized and amount
+// and currency match what was authorized
+func checkCapturable(
+	payment map[string]interface{},
+	amount int,
+	currency string,
+) error {
+	id := payment["id"]
+	switch status := payment["status"]; status {
+	case "authorized":
+	case "captured":
+		return fmt.Errorf("payment %v is already captured", id)
+	default:
+		return fmt.Errorf("payment %v cannot be captured: its status is %v, "+
+			"only authorized payments can be captured", id, status)
+	}
+
+	authorized, _ := payment["amount"].(float64)
+	if float64(amount) != authorized {
+		return fmt.Errorf("capture amount %d does not match the authorized "+
+			"amount %.0f of payment %v; Razorpay only captures the exact "+
+			"authorized amount", amount, authorized, id)
+	}
+
+	if paymentCurrency, ok := payment["currency"].(string); ok &&
+		!strings.EqualFold(currency, paymentCurrency) {
+		return fmt.Errorf("capture currency %s does not match the payment "+
+			"currency %s of payment %v", currency, paymentCurrency, id)
+	}
+
+	return nil
+}
+
+// maxPaymentsPerPage is the largest count the payments list API accepts
+const maxPaymentsPerPage = 100
+
 // FetchAllPayments returns a tool to fetch multiple payments with filtering and pagination
 //
 //nolint:lll
@@ -292,7 +401,21 @@ func FetchAllPayments(
 				"payments are to be fetched"),
 			mcpgo.Min(0),
 		),
+		mcpgo.WithArray(
+			"expand",
+			mcpgo.Description("Used to retrieve additional information. "+
+				"Supported values: card (card details for card payments), "+
+				"emi (EMI plan details for EMI payments)"),
+			mcpgo.Items(map[string]interface{}{
+				"type": "string",
+				"enum": []interface{}{
+					"card",
+					"emi",
+				},
+			}),
+		),
 	}
+	parameters = append(parameters, autoPaginationParameters("payments")...)
 
 	handler := func(
 		ctx context.Context,
@@ -306,18 +429,45 @@ func FetchAllPayments(
 
 		// Create query parameters map
 		paymentListOptions := make(map[string]interface{})
+		paginationOptions := make(map[string]interface{})
 
 		validator := NewValidator(&r).
 			ValidateAndAddPagination(paymentListOptions).
 			ValidateAndAddOptionalInt(paymentListOptions, "from").
-			ValidateAndAddOptionalInt(paymentListOptions, "to")
+			ValidateAndAddOptionalInt(paymentListOptions, "to").
+			ValidateAndAddExpandValues(paymentListOptions, "card", "emi")
+		validator = validateAndAddAutoPagination(validator, paginationOptions)
 
 		if result, err := validator.HandleErrorsIfAny(); result != nil {
 			return result, err
 		}
 
+		fetchAll, maxRecords, err := fetchAllRequested(paginationOptions)
+		if err != nil {
+			return mcpgo.NewToolResultError(err.Error()), nil
+		}
+		if fetchAll {
+			payments, err := collectAllPages(
+				client.Payment.All, paymentListOptions, maxRecords)
+			if err != nil {
+				return mcpgo.NewToolResultError(
+					fmt.Sprintf("fetching payments failed: %s", err.Error())), nil
+			}
+			return mcpgo.NewToolResultJSON(payments)
+		}
+
+		if count, ok := paymentListOptions["count"].(int64); ok &&
+			count > maxPaymentsPerPage {
+			return mcpgo.NewToolResultError(fmt.Sprintf(
+				"count %d exceeds the maximum of %d payments per request; "+
+					"use skip to fetch further pages",
+				count, maxPaymentsPerPage)), nil
+		}
+
 		// Fetch all payments using Razorpay SDK
-		payments, err := client.Payment.All(paymentListOptions, nil)
+		payments, err := withRetry(ctx, func() (map[string]interface{}, error) {
+			return client.Payment.All(paymentListOptions, nil)
+		})
 		if err != nil {
 			return mcpgo.NewToolResultError(
 				fmt.Sprintf("fetching payments failed: %s", err.Error())), nil
@@ -836,8 +986,7 @@ func InitiatePayment(
 		// Create payment
 		payment, err := createPaymentWithParams(client, params, currency, customerID)
 		if err != nil {
-			return mcpgo.NewToolResultError(
-				fmt.Sprintf("initiating payment failed: %s", err.Error())), nil
+			return unwrapRazorpayError("initiating payment", err), nil
 		}
 
 		// Process payment result
@@ -905,8 +1054,7 @@ func ResendOtp(
 		// Resend OTP using Razorpay SDK
 		otpResponse, err := client.Payment.OtpResend(paymentID, nil, nil)
 		if err != nil {
-			return mcpgo.NewToolResultError(
-				fmt.Sprintf("OTP resend failed: %s", err.Error())), nil
+			return unwrapRazorpayError("OTP resend", err), nil
 		}
 
 		// Extract OTP submit URL from response
@@ -1004,8 +1152,7 @@ func SubmitOtp(
 		otpResponse, err := client.Payment.OtpSubmit(paymentID, data, nil)
 
 		if err != nil {
-			return mcpgo.NewToolResultError(
-				fmt.Sprintf("OTP verification failed: %s", err.Error())), nil
+			return unwrapRazorpayError("OTP verification", err), nil
 		}
 
 		// Prepare response
@@ -1032,6 +1179,137 @@ func SubmitOtp(
 	)
 }
 
+// otpNextActions returns the OTP flow actions that can follow for a payment
+// in status, each naming the tool to call and its parameters
+func otpNextActions(
+	paymentID string,
+	status string,
+) (string, []map[string]interface{}) {
+	submit := map[string]interface{}{
+		"action": "otp_submit",
+		"tool":   "submit_otp",
+		"params": map[string]interface{}{
+			"payment_id": paymentID,
+			"otp_string": "{OTP_CODE_FROM_USER}",
+		},
+	}
+	resend := map[string]interface{}{
+		"action": "otp_resend",
+		"tool":   "resend_otp",
+		"params": map[string]interface{}{
+			"payment_id": paymentID,
+		},
+	}
+	capture := map[string]interface{}{
+		"action": "capture",
+		"tool":   "capture_payment",
+		"params": map[string]interface{}{
+			"payment_id": paymentID,
+		},
+	}
+
+	switch status {
+	case "created":
+		return "awaiting_otp", []map[string]interface{}{submit, resend}
+	case "authorized":
+		return "verified", []map[string]interface{}{capture}
+	case "captured", "refunded":
+		return "completed", []map[string]interface{}{}
+	case "failed":
+		return "failed", []map[string]interface{}{}
+	default:
+		return "unknown", []map[string]interface{}{}
+	}
+}
+
+// FetchOtpStatus returns a tool that reports where a payment is in the S2S
+// OTP flow and which tool to call next
+func FetchOtpStatus(
+	obs *observability.Observability,
+	client *rzpsdk.Client,
+) mcpgo.Tool {
+	parameters := []mcpgo.ToolParameter{
+		mcpgo.WithString(
+			"payment_id",
+			mcpgo.Description("Unique identifier of the payment returned by "+
+				"initiate_payment. Must start with 'pay_'"),
+			mcpgo.Required(),
+		),
+	}
+
+	handler := func(
+		ctx context.Context,
+		r mcpgo.CallToolRequest,
+	) (*mcpgo.ToolResult, error) {
+		// Get client from context or use default
+		client, err := getClientFromContextOrDefault(ctx, client)
+		if err != nil {
+			return mcpgo.NewToolResultError(err.Error()), nil
+		}
+
+		params := make(map[string]interface{})
+
+		validator := NewValidator(&r).
+			ValidateAndAddRequiredString(params, "payment_id")
+
+		if result, err := validator.HandleErrorsIfAny(); result != nil {
+			return result, err
+		}
+
+		paymentID := params["payment_id"].(string)
+
+		payment, err := withRetry(ctx, func() (map[string]interface{}, error) {
+			return client.Payment.Fetch(paymentID, nil, nil)
+		})
+		if err != nil {
+			return mcpgo.NewToolResultError(
+				fmt.Sprintf("fetching payment failed: %s", err.Error())), nil
+		}
+
+		status, _ := payment["status"].(string)
+		otpState, next := otpNextActions(paymentID, status)
+
+		response := map[string]interface{}{
+			"razorpay_payment_id": paymentID,
+			"payment_status":      status,
+			"otp_state":           otpState,
+			"next":                next,
+		}
+
+		switch otpState {
+		case "awaiting_otp":
+			response["next_step"] = "Use 'submit_otp' with the OTP the " +
+				"customer received, or 'resend_otp' if it didn't arrive or " +
+				"has expired."
+		case "verified":
+			response["next_step"] = "OTP verified and the payment is " +
+				"authorized. Use 'capture_payment' to capture it."
+		case "completed":
+			response["next_step"] = "The payment is complete; no OTP action " +
+				"is needed."
+		case "failed":
+			response["next_step"] = "The payment failed; use " +
+				"'initiate_payment' to start a new one."
+			response["error_description"] = payment["error_description"]
+		default:
+			response["next_step"] = fmt.Sprintf(
+				"Payment status %q is not part of the OTP flow.", status)
+		}
+
+		return mcpgo.NewToolResultJSON(response)
+	}
+
+	return mcpgo.NewTool(
+		"fetch_otp_status",
+		"Fetch where a payment started with initiate_payment is in the OTP "+
+			"flow. Returns the payment status and the next actions, each "+
+			"naming the tool to call (submit_otp, resend_otp or "+
+			"capture_payment) and its parameters.",
+		parameters,
+		handler,
+	)
+}
+
 // extractOtpSubmitURL extracts the OTP submit URL from the payment response
 func extractOtpSubmitURL(responseData interface{}) string {
 	jsonData, ok := responseData.(map[string]interface{})
@@ -27,6 +27,28 @@ func Test_FetchPayment(t *testing.T) {
 		"status": "captured",
 	}
 
+	expectedPaymentResp := map[string]interface{}{
+		"id":             "pay_MT48CvBhIC98MQ",
+		"amount":         float64(1000),
+		"status":         "captured",
+		"refund_status":  "not_refunded",
+		"total_refunded": float64(0),
+	}
+
+	partiallyRefundedResp := map[string]interface{}{
+		"id":              "pay_MT48CvBhIC98MR",
+		"amount":          float64(1000),
+		"amount_refunded": float64(400),
+		"status":          "captured",
+	}
+
+	fullyRefundedResp := map[string]interface{}{
+		"id":              "pay_MT48CvBhIC98MS",
+		"amount":          float64(1000),
+		"amount_refunded": float64(1000),
+		"status":          "refunded",
+	}
+
 	paymentNotFoundResp := map[string]interface{}{
 		"error": map[string]interface{}{
 			"code":        "BAD_REQUEST_ERROR",
@@ -50,7 +72,55 @@ func Test_FetchPayment(t *testing.T) {
 				)
 			},
 			ExpectError:    false,
-			ExpectedResult: paymentResp,
+			ExpectedResult: expectedPaymentResp,
+		},
+		{
+			Name: "partially refunded payment",
+			Request: map[string]interface{}{
+				"payment_id": "pay_MT48CvBhIC98MR",
+			},
+			MockHttpClient: func() (*http.Client, *httptest.Server) {
+				return mock.NewHTTPClient(
+					mock.Endpoint{
+						Path:     fmt.Sprintf(fetchPaymentPathFmt, "pay_MT48CvBhIC98MR"),
+						Method:   "GET",
+						Response: partiallyRefundedResp,
+					},
+				)
+			},
+			ExpectError: false,
+			ExpectedResult: map[string]interface{}{
+				"id":              "pay_MT48CvBhIC98MR",
+				"amount":          float64(1000),
+				"amount_refunded": float64(400),
+				"status":          "captured",
+				"refund_status":   "partially_refunded",
+				"total_refunded":  float64(400),
+			},
+		},
+		{
+			Name: "fully refunded payment",
+			Request: map[string]interface{}{
+				"payment_id": "pay_MT48CvBhIC98MS",
+			},
+			MockHttpClient: func() (*http.Client, *httptest.Server) {
+				return mock.NewHTTPClient(
+					mock.Endpoint{
+						Path:     fmt.Sprintf(fetchPaymentPathFmt, "pay_MT48CvBhIC98MS"),
+						Method:   "GET",
+						Response: fullyRefundedResp,
+					},
+				)
+			},
+			ExpectError: false,
+			ExpectedResult: map[string]interface{}{
+				"id":              "pay_MT48CvBhIC98MS",
+				"amount":          float64(1000),
+				"amount_refunded": float64(1000),
+				"status":          "refunded",
+				"refund_status":   "fully_refunded",
+				"total_refunded":  float64(1000),
+			},
 		},
 		{
 			Name: "payment not found",
@@ -128,7 +198,29 @@ func Test_FetchPaymentCardDetails(t *testing.T) {
 					},
 				)
 			},
-			ExpectError:    false,
+			ExpectError: false,
+			ExpectedResult: map[string]interface{}{
+				"last4":   "4366",
+				"network": "Visa",
+				"type":    "credit",
+				"issuer":  "UTIB",
+			},
+		},
+		{
+			Name: "card details without redaction",
+			Request: map[string]interface{}{
+				"payment_id": "pay_DtFYPi3IfUTgsL",
+				"redact":     false,
+			},
+			MockHttpClient: func() (*http.Client, *httptest.Server) {
+				return mock.NewHTTPClient(
+					mock.Endpoint{
+						Path:     fmt.Sprintf(fetchCardDetailsPathFmt, "pay_DtFYPi3IfUTgsL"),
+						Method:   "GET",
+						Response: cardDetailsResp,
+					},
+				)
+			},
 			ExpectedResult: cardDetailsResp,
 		},
 		{
@@ -208,11 +300,46 @@ func Test_CapturePayment(t *testing.T) {
 		"created_at": float64(1605871409),
 	}
 
-	alreadyCapturedResp := map[string]interface{}{
-		"error": map[string]interface{}{
-			"code":        "BAD_REQUEST_ERROR",
-			"description": "This payment has already been captured",
-		},
+	fetchPaymentPathFmt := fmt.Sprintf(
+		"/%s%s/%%s",
+		constants.VERSION_V1,
+		constants.PAYMENT_URL,
+	)
+
+	authorizedPaymentResp := map[string]interface{}{
+		"id":       "pay_G3P9vcIhRs3NV4",
+		"entity":   "payment",
+		"amount":   float64(1000),
+		"currency": "INR",
+		"status":   "authorized",
+	}
+
+	paymentWithStatus := func(status string) map[string]interface{} {
+		payment := make(map[string]interface{}, len(authorizedPaymentResp))
+		for key, value := range authorizedPaymentResp {
+			payment[key] = value
+		}
+		payment["status"] = status
+		return payment
+	}
+
+	captureMock := func(
+		payment map[string]interface{},
+	) func() (*http.Client, *httptest.Server) {
+		return func() (*http.Client, *httptest.Server) {
+			return mock.NewHTTPClient(
+				mock.Endpoint{
+					Path:     fmt.Sprintf(fetchPaymentPathFmt, "pay_G3P9vcIhRs3NV4"),
+					Method:   "GET",
+					Response: payment,
+				},
+				mock.Endpoint{
+					Path:     fmt.Sprintf(capturePaymentPathFmt, "pay_G3P9vcIhRs3NV4"),
+					Method:   "POST",
+					Response: successfulCaptureResp,
+				},
+			)
+		}
 	}
 
 	tests := []RazorpayToolTestCase{
@@ -223,15 +350,7 @@ func Test_CapturePayment(t *testing.T) {
 				"amount":     float64(1000),
 				"currency":   "INR",
 			},
-			MockHttpClient: func() (*http.Client, *httptest.Server) {
-				return mock.NewHTTPClient(
-					mock.Endpoint{
-						Path:     fmt.Sprintf(capturePaymentPathFmt, "pay_G3P9vcIhRs3NV4"),
-						Method:   "POST",
-						Response: successfulCaptureResp,
-					},
-				)
-			},
+			MockHttpClient: captureMock(authorizedPaymentResp),
 			ExpectError:    false,
 			ExpectedResult: successfulCaptureResp,
 		},
@@ -242,18 +361,45 @@ func Test_CapturePayment(t *testing.T) {
 				"amount":     float64(1000),
 				"currency":   "INR",
 			},
-			MockHttpClient: func() (*http.Client, *httptest.Server) {
-				return mock.NewHTTPClient(
-					mock.Endpoint{
-						Path:     fmt.Sprintf(capturePaymentPathFmt, "pay_G3P9vcIhRs3NV4"),
-						Method:   "POST",
-						Response: alreadyCapturedResp,
-					},
-				)
+			MockHttpClient: captureMock(paymentWithStatus("captured")),
+			ExpectError:    true,
+			ExpectedErrMsg: "payment pay_G3P9vcIhRs3NV4 is already captured",
+		},
+		{
+			Name: "payment not authorized",
+			Request: map[string]interface{}{
+				"payment_id": "pay_G3P9vcIhRs3NV4",
+				"amount":     float64(1000),
+				"currency":   "INR",
 			},
-			ExpectError: true,
-			ExpectedErrMsg: "capturing payment failed: This payment has already been " +
-				"captured",
+			MockHttpClient: captureMock(paymentWithStatus("failed")),
+			ExpectError:    true,
+			ExpectedErrMsg: "payment pay_G3P9vcIhRs3NV4 cannot be captured: " +
+				"its status is failed",
+		},
+		{
+			Name: "capture amount differs from authorized amount",
+			Request: map[string]interface{}{
+				"payment_id": "pay_G3P9vcIhRs3NV4",
+				"amount":     float64(1500),
+				"currency":   "INR",
+			},
+			MockHttpClient: captureMock(authorizedPaymentResp),
+			ExpectError:    true,
+			ExpectedErrMsg: "capture amount 1500 does not match the " +
+				"authorized amount 1000 of payment pay_G3P9vcIhRs3NV4",
+		},
+		{
+			Name: "capture currency differs from payment currency",
+			Request: map[string]interface{}{
+				"payment_id": "pay_G3P9vcIhRs3NV4",
+				"amount":     float64(1000),
+				"currency":   "USD",
+			},
+			MockHttpClient: captureMock(authorizedPaymentResp),
+			ExpectError:    true,
+			ExpectedErrMsg: "capture currency USD does not match the payment " +
+				"currency INR",
 		},
 		{
 			Name: "missing payment_id parameter",
@@ -579,6 +725,69 @@ func Test_FetchAllPayments(t *testing.T) {
 			ExpectedErrMsg: "fetching payments failed: from must be between " +
 				"946684800 and 4765046400",
 		},
+		{
+			Name: "successful payments fetch with card and emi expanded",
+			Request: map[string]interface{}{
+				"from":   float64(1593320020),
+				"to":     float64(1624856020),
+				"expand": []interface{}{"card", "emi"},
+			},
+			MockHttpClient: func() (*http.Client, *httptest.Server) {
+				return mock.NewHTTPClient(
+					mock.Endpoint{
+						Path:     fetchAllPaymentsPath,
+						Method:   "GET",
+						Response: paymentsListResp,
+					},
+				)
+			},
+			ExpectError:    false,
+			ExpectedResult: paymentsListResp,
+		},
+		{
+			Name: "fetch all pages ignores the count limit",
+			Request: map[string]interface{}{
+				"count":    float64(150),
+				"fetchAll": true,
+			},
+			MockHttpClient: func() (*http.Client, *httptest.Server) {
+				return mock.NewHTTPClient(
+					mock.Endpoint{
+						Path:     fetchAllPaymentsPath,
+						Method:   "GET",
+						Response: paymentsListResp,
+					},
+				)
+			},
+			ExpectError: false,
+			ExpectedResult: map[string]interface{}{
+				"entity":      "collection",
+				"count":       float64(2),
+				"items":       paymentsListResp["items"],
+				"truncated":   false,
+				"max_records": float64(1000),
+			},
+		},
+		{
+			Name: "count above the maximum",
+			Request: map[string]interface{}{
+				"count": float64(150),
+			},
+			MockHttpClient: nil,
+			ExpectError:    true,
+			ExpectedErrMsg: "count 150 exceeds the maximum of 100 payments " +
+				"per request; use skip to fetch further pages",
+		},
+		{
+			Name: "unsupported expand value",
+			Request: map[string]interface{}{
+				"expand": []interface{}{"transfers"},
+			},
+			MockHttpClient: nil,
+			ExpectError:    true,
+			ExpectedErrMsg: "Validation errors:\n- " +
+				"unsupported expand value: transfers (supported: card, emi)",
+		},
 		{
 			Name: "multiple validation errors with wrong types",
 			Request: map[string]interface{}{
@@ -3768,6 +3977,16 @@ func TestPayments100PercentCoverage_CapturePayment(t *testing.T) {
 			},
 			MockHttpClient: func() (*http.Client, *httptest.Server) {
 				return mock.NewHTTPClient(
+					mock.Endpoint{
+						Path:   "/v1/payments/pay_test123",
+						Method: "GET",
+						Response: map[string]interface{}{
+							"id":       "pay_test123",
+							"amount":   float64(1000),
+							"currency": "INR",
+							"status":   "authorized",
+						},
+					},
 					mock.Endpoint{
 						Path:   "/v1/payments/pay_test123/capture",
 						Method: "POST",
@@ -4186,6 +4405,16 @@ func TestPayments100PercentCoverage_ContextErrors4(t *testing.T) {
 			},
 			MockHttpClient: func() (*http.Client, *httptest.Server) {
 				return mock.NewHTTPClient(
+					mock.Endpoint{
+						Path:   "/v1/payments/pay_test123",
+						Method: "GET",
+						Response: map[string]interface{}{
+							"id":       "pay_test123",
+							"amount":   float64(1000),
+							"currency": "INR",
+							"status":   "authorized",
+						},
+					},
 					mock.Endpoint{
 						Path:   "/v1/payments/pay_test123/capture",
 						Method: "POST",
@@ -4330,3 +4559,137 @@ func TestPayments100PercentCoverage_ContextErrors4(t *testing.T) {
 		runToolTest(t, testCase, FetchAllPayments, "Collection")
 	})
 }
+
+func Test_FetchOtpStatus(t *testing.T) {
+	fetchPaymentPath := fmt.Sprintf(
+		"/%s%s/%s",
+		constants.VERSION_V1,
+		constants.PAYMENT_URL,
+		"pay_MT48CvBhIC98MQ",
+	)
+
+	paymentMock := func(
+		payment map[string]interface{},
+	) func() (*http.Client, *httptest.Server) {
+		return func() (*http.Client, *httptest.Server) {
+			return mock.NewHTTPClient(
+				mock.Endpoint{
+					Path:     fetchPaymentPath,
+					Method:   "GET",
+					Response: payment,
+				},
+			)
+		}
+	}
+
+	tests := []RazorpayToolTestCase{
+		{
+			Name: "awaiting OTP",
+			Request: map[string]interface{}{
+				"payment_id": "pay_MT48CvBhIC98MQ",
+			},
+			MockHttpClient: paymentMock(map[string]interface{}{
+				"id":     "pay_MT48CvBhIC98MQ",
+				"status": "created",
+			}),
+			ExpectedResult: map[string]interface{}{
+				"razorpay_payment_id": "pay_MT48CvBhIC98MQ",
+				"payment_status":      "created",
+				"otp_state":           "awaiting_otp",
+				"next": []interface{}{
+					map[string]interface{}{
+						"action": "otp_submit",
+						"tool":   "submit_otp",
+						"params": map[string]interface{}{
+							"payment_id": "pay_MT48CvBhIC98MQ",
+							"otp_string": "{OTP_CODE_FROM_USER}",
+						},
+					},
+					map[string]interface{}{
+						"action": "otp_resend",
+						"tool":   "resend_otp",
+						"params": map[string]interface{}{
+							"payment_id": "pay_MT48CvBhIC98MQ",
+						},
+					},
+				},
+				"next_step": "Use 'submit_otp' with the OTP the customer " +
+					"received, or 'resend_otp' if it didn't arrive or has " +
+					"expired.",
+			},
+		},
+		{
+			Name: "OTP verified",
+			Request: map[string]interface{}{
+				"payment_id": "pay_MT48CvBhIC98MQ",
+			},
+			MockHttpClient: paymentMock(map[string]interface{}{
+				"id":     "pay_MT48CvBhIC98MQ",
+				"status": "authorized",
+			}),
+			ExpectedResult: map[string]interface{}{
+				"razorpay_payment_id": "pay_MT48CvBhIC98MQ",
+				"payment_status":      "authorized",
+				"otp_state":           "verified",
+				"next": []interface{}{
+					map[string]interface{}{
+						"action": "capture",
+						"tool":   "capture_payment",
+						"params": map[string]interface{}{
+							"payment_id": "pay_MT48CvBhIC98MQ",
+						},
+					},
+				},
+				"next_step": "OTP verified and the payment is authorized. " +
+					"Use 'capture_payment' to capture it.",
+			},
+		},
+		{
+			Name: "payment failed",
+			Request: map[string]interface{}{
+				"payment_id": "pay_MT48CvBhIC98MQ",
+			},
+			MockHttpClient: paymentMock(map[string]interface{}{
+				"id":                "pay_MT48CvBhIC98MQ",
+				"status":            "failed",
+				"error_description": "Incorrect OTP entered",
+			}),
+			ExpectedResult: map[string]interface{}{
+				"razorpay_payment_id": "pay_MT48CvBhIC98MQ",
+				"payment_status":      "failed",
+				"otp_state":           "failed",
+				"next":                []interface{}{},
+				"next_step": "The payment failed; use 'initiate_payment' " +
+					"to start a new one.",
+				"error_description": "Incorrect OTP entered",
+			},
+		},
+		{
+			Name: "payment not found",
+			Request: map[string]interface{}{
+				"payment_id": "pay_MT48CvBhIC98MQ",
+			},
+			MockHttpClient: paymentMock(map[string]interface{}{
+				"error": map[string]interface{}{
+					"code":        "BAD_REQUEST_ERROR",
+					"description": "The id provided does not exist",
+				},
+			}),
+			ExpectError: true,
+			ExpectedErrMsg: "fetching payment failed: " +
+				"The id provided does not exist",
+		},
+		{
+			Name:           "missing payment_id parameter",
+			Request:        map[string]interface{}{},
+			ExpectError:    true,
+			ExpectedErrMsg: "missing required parameter: payment_id",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.Name, func(t *testing.T) {
+			runToolTest(t, tc, FetchOtpStatus, "OTP Status")
+		})
+	}
+}
@@ -0,0 +1,359 @@
+package razorpay
+
+import (
+	"context"
+	"fmt"
+
+	rzpsdk "github.com/razorpay/razorpay-go"
+	"github.com/razorpay/razorpay-go/constants"
+
+	"github.com/razorpay/razorpay-mcp-server/pkg/mcpgo"
+	"github.com/razorpay/razorpay-mcp-server/pkg/observability"
+)
+
+// CreatePayoutLink returns a tool that creates a RazorpayX payout link
+func CreatePayoutLink(
+	obs *observability.Observability,
+	client *rzpsdk.Client,
+) mcpgo.Tool {
+	parameters := []mcpgo.ToolParameter{
+		mcpgo.WithString(
+			"account_number",
+			mcpgo.Description("The RazorpayX account number the payout is "+
+				"made from. For example, 7878780080316316"),
+			mcpgo.Required(),
+		),
+		mcpgo.WithNumber(
+			"amount",
+			mcpgo.Description("Amount to be paid out using the link in "+
+				"smallest currency unit (e.g., ₹300, use 30000)"),
+			mcpgo.Required(),
+			mcpgo.Min(100),
+		),
+		mcpgo.WithString(
+			"currency",
+			mcpgo.Description("Currency of the payout. Only INR is supported"),
+			mcpgo.Required(),
+			mcpgo.Enum("INR"),
+		),
+		mcpgo.WithString(
+			"purpose",
+			mcpgo.Description("Purpose of the payout: refund, cashback, payout, "+
+				"salary, utility bill, vendor bill or a custom purpose"),
+			mcpgo.Required(),
+		),
+		mcpgo.WithString(
+			"description",
+			mcpgo.Description("A brief description of the payout shown to "+
+				"the contact"),
+		),
+		mcpgo.WithString(
+			"receipt",
+			mcpgo.Description("Your reference for the payout link, up to 40 "+
+				"characters"),
+			mcpgo.Max(40),
+		),
+		mcpgo.WithString(
+			"contact_id",
+			mcpgo.Description("ID of an existing contact to pay. "+
+				"Use this or contact_name"),
+		),
+		mcpgo.WithString(
+			"contact_name",
+			mcpgo.Description("Name of the contact. Creates a new contact "+
+				"when contact_id is not given"),
+		),
+		mcpgo.WithString(
+			"contact_email",
+			mcpgo.Description("Email address of the contact"),
+		),
+		mcpgo.WithString(
+			"contact_phone",
+			mcpgo.Description("Phone number of the contact"),
+		),
+		mcpgo.WithString(
+			"contact_type",
+			mcpgo.Description("Type of contact: vendor, customer, employee "+
+				"or self"),
+			mcpgo.Enum("vendor", "customer", "employee", "self"),
+		),
+		mcpgo.WithBoolean(
+			"notify_sms",
+			mcpgo.Description("Send the payout link to the contact by SMS"),
+		),
+		mcpgo.WithBoolean(
+			"notify_email",
+			mcpgo.Description("Send the payout link to the contact by email"),
+		),
+		mcpgo.WithNumber(
+			"expire_by",
+			mcpgo.Description("Timestamp, in Unix, when the payout link "+
+				"expires. Needs link expiry enabled on the account"),
+		),
+		mcpgo.WithObject(
+			"notes",
+			mcpgo.Description("Key-value pairs that can be used to store "+
+				"additional information. Maximum 15 pairs, each value limited "+
+				"to 256 characters."),
+			mcpgo.MaxProperties(15),
+		),
+	}
+
+	handler := func(
+		ctx context.Context,
+		r mcpgo.CallToolRequest,
+	) (*mcpgo.ToolResult, error) {
+		client, err := getClientFromContextOrDefault(ctx, client)
+		if err != nil {
+			return mcpgo.NewToolResultError(err.Error()), nil
+		}
+
+		payload := make(map[string]interface{})
+		contact := make(map[string]interface{})
+
+		validator := NewValidator(&r).
+			ValidateAndAddRequiredString(payload, "account_number").
+			ValidateAndAddRequiredInt(payload, "amount").
+			ValidateAndAddRequiredString(payload, "currency").
+			ValidateAndAddRequiredString(payload, "purpose").
+			ValidateAndAddOptionalString(payload, "description").
+			ValidateAndAddOptionalString(payload, "receipt").
+			ValidateAndAddOptionalStringToPath(contact, "contact_id", "id").
+			ValidateAndAddOptionalStringToPath(contact, "contact_name", "name").
+			ValidateAndAddOptionalStringToPath(contact, "contact_email", "email").
+			ValidateAndAddOptionalStringToPath(
+				contact, "contact_phone", "contact").
+			ValidateAndAddOptionalStringToPath(contact, "contact_type", "type").
+			ValidateAndAddOptionalBoolToPath(payload, "notify_sms", "send_sms").
+			ValidateAndAddOptionalBoolToPath(
+				payload, "notify_email", "send_email").
+			ValidateAndAddOptionalInt(payload, "expire_by").
+			ValidateAndAddOptionalNotes(payload, "notes")
+
+		if result, err := validator.HandleErrorsIfAny(); result != nil {
+			return result, err
+		}
+
+		_, hasID := contact["id"]
+		_, hasName := contact["name"]
+		if !hasID && !hasName {
+			return mcpgo.NewToolResultError(
+				"either contact_id or contact_name must be provided"), nil
+		}
+		payload["contact"] = contact
+
+		url := fmt.Sprintf("/%s/payout-links", constants.VERSION_V1)
+		payoutLink, err := client.Request.Post(url, payload, nil)
+		if err != nil {
+			return mcpgo.NewToolResultError(
+				fmt.Sprintf("creating payout link failed: %s", err.Error())), nil
+		}
+
+		return mcpgo.NewToolResultJSON(payoutLink)
+	}
+
+	return mcpgo.NewTool(
+		"create_payout_link",
+		"Create a RazorpayX payout link. The contact opens the link and "+
+			"chooses the bank account or UPI ID the money is sent to",
+		parameters,
+		handler,
+	)
+}
+
+// FetchPayoutLink returns a tool that fetches a payout link by ID
+func FetchPayoutLink(
+	obs *observability.Observability,
+	client *rzpsdk.Client,
+) mcpgo.Tool {
+	parameters := []mcpgo.ToolParameter{
+		mcpgo.WithString(
+			"payout_link_id",
+			mcpgo.Description("ID of the payout link to be fetched "+
+				"(ID should have a poutlk_ prefix)."),
+			mcpgo.Required(),
+		),
+	}
+
+	handler := func(
+		ctx context.Context,
+		r mcpgo.CallToolRequest,
+	) (*mcpgo.ToolResult, error) {
+		client, err := getClientFromContextOrDefault(ctx, client)
+		if err != nil {
+			return mcpgo.NewToolResultError(err.Error()), nil
+		}
+
+		params := make(map[string]interface{})
+
+		validator := NewValidator(&r).
+			ValidateAndAddRequiredString(params, "payout_link_id")
+
+		if result, err := validator.HandleErrorsIfAny(); result != nil {
+			return result, err
+		}
+
+		url := fmt.Sprintf("/%s/payout-links/%s",
+			constants.VERSION_V1, params["payout_link_id"])
+		payoutLink, err := client.Request.Get(url, nil, nil)
+		if err != nil {
+			return mcpgo.NewToolResultError(
+				fmt.Sprintf("fetching payout link failed: %s", err.Error())), nil
+		}
+
+		return mcpgo.NewToolResultJSON(payoutLink)
+	}
+
+	return mcpgo.NewTool(
+		"fetch_payout_link",
+		"Fetch payout link details using its ID. "+
+			"Response contains the basic details like amount, status etc.",
+		parameters,
+		handler,
+	)
+}
+
+// FetchAllPayoutLinks returns a tool that fetches all payout links
+func FetchAllPayoutLinks(
+	obs *observability.Observability,
+	client *rzpsdk.Client,
+) mcpgo.Tool {
+	parameters := []mcpgo.ToolParameter{
+		mcpgo.WithString(
+			"contact_id",
+			mcpgo.Description("Filter payout links by contact"),
+		),
+		mcpgo.WithString(
+			"receipt",
+			mcpgo.Description("Filter payout links by receipt"),
+		),
+		mcpgo.WithString(
+			"purpose",
+			mcpgo.Description("Filter payout links by purpose"),
+		),
+		mcpgo.WithString(
+			"status",
+			mcpgo.Description("Filter payout links by status"),
+			mcpgo.Enum("pending", "issued", "processing", "processed",
+				"cancelled", "rejected", "expired"),
+		),
+		mcpgo.WithNumber(
+			"from",
+			mcpgo.Description("Unix timestamp (in seconds) from when "+
+				"payout links are to be fetched"),
+			mcpgo.Min(0),
+		),
+		mcpgo.WithNumber(
+			"to",
+			mcpgo.Description("Unix timestamp (in seconds) up till when "+
+				"payout links are to be fetched"),
+			mcpgo.Min(0),
+		),
+		mcpgo.WithNumber(
+			"count",
+			mcpgo.Description("Number of payout links to be fetched "+
+				"(default: 10, max: 100)"),
+			mcpgo.Min(1),
+			mcpgo.Max(100),
+		),
+		mcpgo.WithNumber(
+			"skip",
+			mcpgo.Description("Number of payout links to be skipped "+
+				"(default: 0)"),
+			mcpgo.Min(0),
+		),
+	}
+
+	handler := func(
+		ctx context.Context,
+		r mcpgo.CallToolRequest,
+	) (*mcpgo.ToolResult, error) {
+		client, err := getClientFromContextOrDefault(ctx, client)
+		if err != nil {
+			return mcpgo.NewToolResultError(err.Error()), nil
+		}
+
+		queryParams := make(map[string]interface{})
+
+		validator := NewValidator(&r).
+			ValidateAndAddOptionalString(queryParams, "contact_id").
+			ValidateAndAddOptionalString(queryParams, "receipt").
+			ValidateAndAddOptionalString(queryParams, "purpose").
+			ValidateAndAddOptionalString(queryParams, "status").
+			ValidateAndAddOptionalInt(queryParams, "from").
+			ValidateAndAddOptionalInt(queryParams, "to").
+			ValidateAndAddPagination(queryParams)
+
+		if result, err := validator.HandleErrorsIfAny(); result != nil {
+			return result, err
+		}
+
+		url := fmt.Sprintf("/%s/payout-links", constants.VERSION_V1)
+		payoutLinks, err := client.Request.Get(url, queryParams, nil)
+		if err != nil {
+			return mcpgo.NewToolResultError(
+				fmt.Sprintf("fetching payout links failed: %s", err.Error())), nil
+		}
+
+		return mcpgo.NewToolResultJSON(payoutLinks)
+	}
+
+	return mcpgo.NewTool(
+		"fetch_all_payout_links",
+		"Fetch all payout links with optional filtering and pagination",
+		parameters,
+		handler,
+	)
+}
+
+// CancelPayoutLink returns a tool that cancels an issued payout link
+func CancelPayoutLink(
+	obs *observability.Observability,
+	client *rzpsdk.Client,
+) mcpgo.Tool {
+	parameters := []mcpgo.ToolParameter{
+		mcpgo.WithString(
+			"payout_link_id",
+			mcpgo.Description("ID of the payout link to be cancelled "+
+				"(ID should have a poutlk_ prefix)."),
+			mcpgo.Required(),
+		),
+	}
+
+	handler := func(
+		ctx context.Context,
+		r mcpgo.CallToolRequest,
+	) (*mcpgo.ToolResult, error) {
+		client, err := getClientFromContextOrDefault(ctx, client)
+		if err != nil {
+			return mcpgo.NewToolResultError(err.Error()), nil
+		}
+
+		params := make(map[string]interface{})
+
+		validator := NewValidator(&r).
+			ValidateAndAddRequiredString(params, "payout_link_id")
+
+		if result, err := validator.HandleErrorsIfAny(); result != nil {
+			return result, err
+		}
+
+		url := fmt.Sprintf("/%s/payout-links/%s/cancel",
+			constants.VERSION_V1, params["payout_link_id"])
+		payoutLink, err := client.Request.Post(url, nil, nil)
+		if err != nil {
+			return mcpgo.NewToolResultError(
+				fmt.Sprintf("cancelling payout link failed: %s", err.Error())), nil
+		}
+
+		return mcpgo.NewToolResultJSON(payoutLink)
+	}
+
+	return mcpgo.NewTool(
+		"cancel_payout_link",
+		"Cancel a payout link. Only links in the issued state can be "+
+			"cancelled",
+		parameters,
+		handler,
+	)
+}
@@ -0,0 +1,286 @@
+package razorpay
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/razorpay/razorpay-go/constants"
+
+	"github.com/razorpay/razorpay-mcp-server/pkg/razorpay/mock"
+)
+
+func Test_CreatePayoutLink(t *testing.T) {
+	createPayoutLinkPath := fmt.Sprintf("/%s/payout-links", constants.VERSION_V1)
+
+	payoutLinkResp := map[string]interface{}{
+		"id":     "poutlk_00000000000001",
+		"entity": "payout_link",
+		"contact": map[string]interface{}{
+			"name":    "Gaurav Kumar",
+			"email":   "gaurav.kumar@example.com",
+			"contact": "912345678",
+		},
+		"purpose":     "refund",
+		"status":      "issued",
+		"amount":      float64(1000),
+		"currency":    "INR",
+		"description": "Payout link for Gaurav Kumar",
+		"short_url":   "https://rzp.io/i/3b1Bw6F",
+		"send_sms":    true,
+		"send_email":  true,
+	}
+
+	tests := []RazorpayToolTestCase{
+		{
+			Name: "successful payout link creation",
+			Request: map[string]interface{}{
+				"account_number": "7878780080316316",
+				"amount":         float64(1000),
+				"currency":       "INR",
+				"purpose":        "refund",
+				"description":    "Payout link for Gaurav Kumar",
+				"contact_name":   "Gaurav Kumar",
+				"contact_email":  "gaurav.kumar@example.com",
+				"contact_phone":  "912345678",
+				"contact_type":   "customer",
+				"notify_sms":     true,
+				"notify_email":   true,
+			},
+			MockHttpClient: func() (*http.Client, *httptest.Server) {
+				return mock.NewHTTPClient(
+					mock.Endpoint{
+						Path:     createPayoutLinkPath,
+						Method:   "POST",
+						Response: payoutLinkResp,
+					},
+				)
+			},
+			ExpectError:    false,
+			ExpectedResult: payoutLinkResp,
+		},
+		{
+			Name: "missing contact",
+			Request: map[string]interface{}{
+				"account_number": "7878780080316316",
+				"amount":         float64(1000),
+				"currency":       "INR",
+				"purpose":        "refund",
+			},
+			MockHttpClient: nil,
+			ExpectError:    true,
+			ExpectedErrMsg: "either contact_id or contact_name must be provided",
+		},
+		{
+			Name: "payout link creation fails",
+			Request: map[string]interface{}{
+				"account_number": "7878780080316316",
+				"amount":         float64(1000),
+				"currency":       "INR",
+				"purpose":        "refund",
+				"contact_id":     "cont_00000000000001",
+			},
+			MockHttpClient: func() (*http.Client, *httptest.Server) {
+				return mock.NewHTTPClient(
+					mock.Endpoint{
+						Path:   createPayoutLinkPath,
+						Method: "POST",
+						Response: map[string]interface{}{
+							"error": map[string]interface{}{
+								"code":        "BAD_REQUEST_ERROR",
+								"description": "Invalid contact id",
+							},
+						},
+					},
+				)
+			},
+			ExpectError:    true,
+			ExpectedErrMsg: "creating payout link failed: Invalid contact id",
+		},
+		{
+			Name: "missing purpose parameter",
+			Request: map[string]interface{}{
+				"account_number": "7878780080316316",
+				"amount":         float64(1000),
+				"currency":       "INR",
+				"contact_id":     "cont_00000000000001",
+			},
+			MockHttpClient: nil,
+			ExpectError:    true,
+			ExpectedErrMsg: "missing required parameter: purpose",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.Name, func(t *testing.T) {
+			runToolTest(t, tc, CreatePayoutLink, "Payout Link")
+		})
+	}
+}
+
+func Test_FetchPayoutLink(t *testing.T) {
+	fetchPayoutLinkPath := fmt.Sprintf(
+		"/%s/payout-links/%s",
+		constants.VERSION_V1,
+		"poutlk_00000000000001",
+	)
+
+	payoutLinkResp := map[string]interface{}{
+		"id":     "poutlk_00000000000001",
+		"entity": "payout_link",
+		"status": "issued",
+		"amount": float64(1000),
+	}
+
+	tests := []RazorpayToolTestCase{
+		{
+			Name: "successful payout link fetch",
+			Request: map[string]interface{}{
+				"payout_link_id": "poutlk_00000000000001",
+			},
+			MockHttpClient: func() (*http.Client, *httptest.Server) {
+				return mock.NewHTTPClient(
+					mock.Endpoint{
+						Path:     fetchPayoutLinkPath,
+						Method:   "GET",
+						Response: payoutLinkResp,
+					},
+				)
+			},
+			ExpectError:    false,
+			ExpectedResult: payoutLinkResp,
+		},
+		{
+			Name:           "missing payout_link_id parameter",
+			Request:        map[string]interface{}{},
+			MockHttpClient: nil,
+			ExpectError:    true,
+			ExpectedErrMsg: "missing required parameter: payout_link_id",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.Name, func(t *testing.T) {
+			runToolTest(t, tc, FetchPayoutLink, "Payout Link")
+		})
+	}
+}
+
+func Test_FetchAllPayoutLinks(t *testing.T) {
+	fetchAllPayoutLinksPath := fmt.Sprintf(
+		"/%s/payout-links",
+		constants.VERSION_V1,
+	)
+
+	payoutLinksResp := map[string]interface{}{
+		"entity": "collection",
+		"count":  float64(1),
+		"items": []interface{}{
+			map[string]interface{}{
+				"id":     "poutlk_00000000000001",
+				"entity": "payout_link",
+				"status": "issued",
+			},
+		},
+	}
+
+	tests := []RazorpayToolTestCase{
+		{
+			Name: "successful fetch by status",
+			Request: map[string]interface{}{
+				"status": "issued",
+				"count":  float64(10),
+			},
+			MockHttpClient: func() (*http.Client, *httptest.Server) {
+				return mock.NewHTTPClient(
+					mock.Endpoint{
+						Path:     fetchAllPayoutLinksPath,
+						Method:   "GET",
+						Response: payoutLinksResp,
+					},
+				)
+			},
+			ExpectError:    false,
+			ExpectedResult: payoutLinksResp,
+		},
+		{
+			Name: "invalid from parameter",
+			Request: map[string]interface{}{
+				"from": "yesterday",
+			},
+			MockHttpClient: nil,
+			ExpectError:    true,
+			ExpectedErrMsg: "invalid parameter type: from",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.Name, func(t *testing.T) {
+			runToolTest(t, tc, FetchAllPayoutLinks, "Payout Links")
+		})
+	}
+}
+
+func Test_CancelPayoutLink(t *testing.T) {
+	cancelPayoutLinkPath := fmt.Sprintf(
+		"/%s/payout-links/%s/cancel",
+		constants.VERSION_V1,
+		"poutlk_00000000000001",
+	)
+
+	payoutLinkResp := map[string]interface{}{
+		"id":     "poutlk_00000000000001",
+		"entity": "payout_link",
+		"status": "cancelled",
+	}
+
+	tests := []RazorpayToolTestCase{
+		{
+			Name: "successful cancellation",
+			Request: map[string]interface{}{
+				"payout_link_id": "poutlk_00000000000001",
+			},
+			MockHttpClient: func() (*http.Client, *httptest.Server) {
+				return mock.NewHTTPClient(
+					mock.Endpoint{
+						Path:     cancelPayoutLinkPath,
+						Method:   "POST",
+						Response: payoutLinkResp,
+					},
+				)
+			},
+			ExpectError:    false,
+			ExpectedResult: payoutLinkResp,
+		},
+		{
+			Name: "payout link already processed",
+			Request: map[string]interface{}{
+				"payout_link_id": "poutlk_00000000000001",
+			},
+			MockHttpClient: func() (*http.Client, *httptest.Server) {
+				return mock.NewHTTPClient(
+					mock.Endpoint{
+						Path:   cancelPayoutLinkPath,
+						Method: "POST",
+						Response: map[string]interface{}{
+							"error": map[string]interface{}{
+								"code":        "BAD_REQUEST_ERROR",
+								"description": "Payout Link is not in issued state",
+							},
+						},
+					},
+				)
+			},
+			ExpectError: true,
+			ExpectedErrMsg: "cancelling payout link failed: " +
+				"Payout Link is not in issued state",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.Name, func(t *testing.T) {
+			runToolTest(t, tc, CancelPayoutLink, "Payout Link")
+		})
+	}
+}
@@ -3,8 +3,10 @@ package razorpay
 import (
 	"context"
 	"fmt"
+	"sync"
 
 	rzpsdk "github.com/razorpay/razorpay-go"
+	"github.com/razorpay/razorpay-go/constants"
 
 	"github.com/razorpay/razorpay-mcp-server/pkg/mcpgo"
 	"github.com/razorpay/razorpay-mcp-server/pkg/observability"
@@ -126,3 +128,693 @@ func FetchAllPayouts(
 		handler,
 	)
 }
+
+// CreatePayout returns a tool that creates a payout to an existing
+// fund account
+func CreatePayout(
+	obs *observability.Observability,
+	client *rzpsdk.Client,
+) mcpgo.Tool {
+	parameters := []mcpgo.ToolParameter{
+		mcpgo.WithString(
+			"account_number",
+			mcpgo.Description("The RazorpayX account number the payout is "+
+				"made from. For example, 7878780080316316"),
+			mcpgo.Required(),
+		),
+		mcpgo.WithString(
+			"fund_account_id",
+			mcpgo.Description("The fund account the payout is made to. "+
+				"For example, 'fa_00000000000001'"),
+			mcpgo.Required(),
+		),
+		mcpgo.WithNumber(
+			"amount",
+			mcpgo.Description("Payout amount in the smallest currency "+
+				"sub-unit (e.g., for ₹100, use 10000)"),
+			mcpgo.Required(),
+			mcpgo.Min(100),
+		),
+		mcpgo.WithString(
+			"currency",
+			mcpgo.Description("Currency of the payout. Only INR is supported"),
+			mcpgo.Required(),
+			mcpgo.Enum("INR"),
+		),
+		mcpgo.WithString(
+			"mode",
+			mcpgo.Description("Transfer mode. NEFT, RTGS and IMPS need a bank "+
+				"account fund account, UPI needs a VPA fund account"),
+			mcpgo.Required(),
+			mcpgo.Enum("NEFT", "RTGS", "IMPS", "UPI", "card", "amazonpay"),
+		),
+		mcpgo.WithString(
+			"purpose",
+			mcpgo.Description("Purpose of the payout: refund, cashback, payout, "+
+				"salary, utility bill, vendor bill or a custom purpose"),
+			mcpgo.Required(),
+		),
+		mcpgo.WithBoolean(
+			"queue_if_low_balance",
+			mcpgo.Description("Queue the payout instead of failing it when the "+
+				"account balance is low. Default: false"),
+		),
+		mcpgo.WithString(
+			"reference_id",
+			mcpgo.Description("Your reference for the payout, up to 40 "+
+				"characters"),
+			mcpgo.Max(40),
+		),
+		mcpgo.WithString(
+			"narration",
+			mcpgo.Description("Text shown on the beneficiary's bank statement, "+
+				"up to 30 alphanumeric characters"),
+			mcpgo.Max(30),
+		),
+		mcpgo.WithObject(
+			"notes",
+			mcpgo.Description("Key-value pairs that can be used to store "+
+				"additional information about the payout. Maximum 15 pairs, "+
+				"each value up to 256 characters"),
+			mcpgo.MaxProperties(15),
+		),
+		idempotencyKeyParameter("payout"),
+	}
+
+	handler := func(
+		ctx context.Context,
+		r mcpgo.CallToolRequest,
+	) (*mcpgo.ToolResult, error) {
+		client, err := getClientFromContextOrDefault(ctx, client)
+		if err != nil {
+			return mcpgo.NewToolResultError(err.Error()), nil
+		}
+
+		payload := make(map[string]interface{})
+		params := make(map[string]interface{})
+
+		validator := NewValidator(&r).
+			ValidateAndAddRequiredString(payload, "account_number").
+			ValidateAndAddRequiredString(payload, "fund_account_id").
+			ValidateAndAddRequiredInt(payload, "amount").
+			ValidateAndAddRequiredString(payload, "currency").
+			ValidateAndAddRequiredString(payload, "mode").
+			ValidateAndAddRequiredString(payload, "purpose").
+			ValidateAndAddOptionalBool(payload, "queue_if_low_balance").
+			ValidateAndAddOptionalString(payload, "reference_id").
+			ValidateAndAddOptionalString(payload, "narration").
+			ValidateAndAddOptionalNotes(payload, "notes").
+			ValidateAndAddOptionalString(params, "idempotency_key")
+
+		if result, err := validator.HandleErrorsIfAny(); result != nil {
+			return result, err
+		}
+
+		url := fmt.Sprintf("/%s%s", constants.VERSION_V1, constants.PAYOUT_URL)
+		payout, err := client.Request.Post(url, payload,
+			idempotencyHeaders(params, payoutIdempotencyHeader))
+		if err != nil {
+			return mcpgo.NewToolResultError(
+				fmt.Sprintf("creating payout failed: %s", err.Error())), nil
+		}
+
+		return mcpgo.NewToolResultJSON(payout)
+	}
+
+	return mcpgo.NewTool(
+		"create_payout",
+		"Create a RazorpayX payout from a business account to an existing "+
+			"fund account. Money leaves the account as soon as the payout is "+
+			"processed, so confirm the amount and beneficiary first",
+		parameters,
+		handler,
+	)
+}
+
+// CreatePayoutToUpi returns a tool that creates a payout to a UPI ID without
+// creating the contact and fund account first
+func CreatePayoutToUpi(
+	obs *observability.Observability,
+	client *rzpsdk.Client,
+) mcpgo.Tool {
+	parameters := []mcpgo.ToolParameter{
+		mcpgo.WithString(
+			"account_number",
+			mcpgo.Description("The RazorpayX account number the payout is "+
+				"made from. For example, 7878780080316316"),
+			mcpgo.Required(),
+		),
+		mcpgo.WithString(
+			"vpa",
+			mcpgo.Description("UPI ID of the beneficiary. "+
+				"For example, 'gaurav.kumar@exampleupi'"),
+			mcpgo.Required(),
+			mcpgo.Pattern("^[a-zA-Z0-9.\\-_]{2,256}@[a-zA-Z]{2,64}$"),
+		),
+		mcpgo.WithString(
+			"contact_name",
+			mcpgo.Description("Name of the beneficiary"),
+			mcpgo.Required(),
+		),
+		mcpgo.WithString(
+			"contact_email",
+			mcpgo.Description("Email of the beneficiary"),
+		),
+		mcpgo.WithString(
+			"contact_phone",
+			mcpgo.Description("Phone number of the beneficiary"),
+		),
+		mcpgo.WithString(
+			"contact_type",
+			mcpgo.Description("Type of beneficiary: vendor, customer, "+
+				"employee or self"),
+			mcpgo.Enum("vendor", "customer", "employee", "self"),
+		),
+		mcpgo.WithNumber(
+			"amount",
+			mcpgo.Description("Payout amount in the smallest currency "+
+				"sub-unit (e.g., for ₹100, use 10000)"),
+			mcpgo.Required(),
+			mcpgo.Min(100),
+		),
+		mcpgo.WithString(
+			"purpose",
+			mcpgo.Description("Purpose of the payout: refund, cashback, payout, "+
+				"salary, utility bill, vendor bill or a custom purpose"),
+			mcpgo.Required(),
+		),
+		mcpgo.WithBoolean(
+			"queue_if_low_balance",
+			mcpgo.Description("Queue the payout instead of failing it when the "+
+				"account balance is low. Default: false"),
+		),
+		mcpgo.WithString(
+			"reference_id",
+			mcpgo.Description("Your reference for the payout, up to 40 "+
+				"characters"),
+			mcpgo.Max(40),
+		),
+		mcpgo.WithString(
+			"narration",
+			mcpgo.Description("Text shown on the beneficiary's bank statement, "+
+				"up to 30 alphanumeric characters"),
+			mcpgo.Max(30),
+		),
+		mcpgo.WithObject(
+			"notes",
+			mcpgo.Description("Key-value pairs that can be used to store "+
+				"additional information about the payout. Maximum 15 pairs, "+
+				"each value up to 256 characters"),
+			mcpgo.MaxProperties(15),
+		),
+	}
+
+	handler := func(
+		ctx context.Context,
+		r mcpgo.CallToolRequest,
+	) (*mcpgo.ToolResult, error) {
+		client, err := getClientFromContextOrDefault(ctx, client)
+		if err != nil {
+			return mcpgo.NewToolResultError(err.Error()), nil
+		}
+
+		payload := make(map[string]interface{})
+		params := make(map[string]interface{})
+		contact := make(map[string]interface{})
+
+		validator := NewValidator(&r).
+			ValidateAndAddRequiredString(payload, "account_number").
+			ValidateAndAddRequiredString(params, "vpa").
+			ValidateAndAddRequiredString(params, "contact_name").
+			ValidateAndAddOptionalStringToPath(contact, "contact_email", "email").
+			ValidateAndAddOptionalStringToPath(
+				contact, "contact_phone", "contact").
+			ValidateAndAddOptionalStringToPath(contact, "contact_type", "type").
+			ValidateAndAddRequiredInt(payload, "amount").
+			ValidateAndAddRequiredString(payload, "purpose").
+			ValidateAndAddOptionalBool(payload, "queue_if_low_balance").
+			ValidateAndAddOptionalString(payload, "reference_id").
+			ValidateAndAddOptionalString(payload, "narration").
+			ValidateAndAddOptionalNotes(payload, "notes")
+
+		if result, err := validator.HandleErrorsIfAny(); result != nil {
+			return result, err
+		}
+
+		contact["name"] = params["contact_name"]
+		payload["currency"] = "INR"
+		payload["mode"] = "UPI"
+		payload["fund_account"] = map[string]interface{}{
+			"account_type": "vpa",
+			"vpa": map[string]interface{}{
+				"address": params["vpa"],
+			},
+			"contact": contact,
+		}
+
+		url := fmt.Sprintf("/%s%s", constants.VERSION_V1, constants.PAYOUT_URL)
+		payout, err := client.Request.Post(url, payload, nil)
+		if err != nil {
+			return mcpgo.NewToolResultError(
+				fmt.Sprintf("creating payout failed: %s", err.Error())), nil
+		}
+
+		return mcpgo.NewToolResultJSON(payout)
+	}
+
+	return mcpgo.NewTool(
+		"create_payout_to_upi",
+		"Create a RazorpayX UPI payout straight to a UPI ID. The contact and "+
+			"fund account are created along with the payout, so no prior "+
+			"setup is needed",
+		parameters,
+		handler,
+	)
+}
+
+// CancelPayout returns a tool that cancels a queued or scheduled payout
+func CancelPayout(
+	obs *observability.Observability,
+	client *rzpsdk.Client,
+) mcpgo.Tool {
+	parameters := []mcpgo.ToolParameter{
+		mcpgo.WithString(
+			"payout_id",
+			mcpgo.Description(
+				"The unique identifier of the payout to be cancelled. "+
+					"For example, 'pout_00000000000001'",
+			),
+			mcpgo.Required(),
+		),
+	}
+
+	handler := func(
+		ctx context.Context,
+		r mcpgo.CallToolRequest,
+	) (*mcpgo.ToolResult, error) {
+		client, err := getClientFromContextOrDefault(ctx, client)
+		if err != nil {
+			return mcpgo.NewToolResultError(err.Error()), nil
+		}
+
+		params := make(map[string]interface{})
+
+		validator := NewValidator(&r).
+			ValidateAndAddRequiredString(params, "payout_id")
+
+		if result, err := validator.HandleErrorsIfAny(); result != nil {
+			return result, err
+		}
+
+		url := fmt.Sprintf("/%s%s/%s/cancel",
+			constants.VERSION_V1, constants.PAYOUT_URL, params["payout_id"])
+		payout, err := client.Request.Post(url, nil, nil)
+		if err != nil {
+			return mcpgo.NewToolResultError(
+				fmt.Sprintf("cancelling payout failed: %s", err.Error())), nil
+		}
+
+		return mcpgo.NewToolResultJSON(payout)
+	}
+
+	return mcpgo.NewTool(
+		"cancel_payout",
+		"Cancel a payout. Only payouts in the queued or scheduled state can "+
+			"be cancelled",
+		parameters,
+		handler,
+	)
+}
+
+// retryablePayoutStatuses are the payout states in which no money reached
+// the beneficiary, so the payout can be submitted again
+var retryablePayoutStatuses = map[string]bool{
+	"failed":   true,
+	"reversed": true,
+}
+
+// RetryPayout returns a tool that resubmits a failed or reversed payout
+func RetryPayout(
+	obs *observability.Observability,
+	client *rzpsdk.Client,
+) mcpgo.Tool {
+	parameters := []mcpgo.ToolParameter{
+		mcpgo.WithString(
+			"payout_id",
+			mcpgo.Description("The failed or reversed payout to retry. "+
+				"For example, 'pout_00000000000001'"),
+			mcpgo.Required(),
+		),
+		mcpgo.WithString(
+			"account_number",
+			mcpgo.Description("The RazorpayX account number the new payout is "+
+				"made from. For example, 7878780080316316"),
+			mcpgo.Required(),
+		),
+		mcpgo.WithString(
+			"mode",
+			mcpgo.Description("Transfer mode for the new payout. Defaults to "+
+				"the mode of the original payout; set it to switch rails, "+
+				"e.g. from IMPS to NEFT"),
+			mcpgo.Enum("NEFT", "RTGS", "IMPS", "UPI", "card", "amazonpay"),
+		),
+		mcpgo.WithBoolean(
+			"queue_if_low_balance",
+			mcpgo.Description("Queue the payout instead of failing it when the "+
+				"account balance is low. Default: false"),
+		),
+	}
+
+	handler := func(
+		ctx context.Context,
+		r mcpgo.CallToolRequest,
+	) (*mcpgo.ToolResult, error) {
+		client, err := getClientFromContextOrDefault(ctx, client)
+		if err != nil {
+			return mcpgo.NewToolResultError(err.Error()), nil
+		}
+
+		params := make(map[string]interface{})
+
+		validator := NewValidator(&r).
+			ValidateAndAddRequiredString(params, "payout_id").
+			ValidateAndAddRequiredString(params, "account_number").
+			ValidateAndAddOptionalString(params, "mode").
+			ValidateAndAddOptionalBool(params, "queue_if_low_balance")
+
+		if result, err := validator.HandleErrorsIfAny(); result != nil {
+			return result, err
+		}
+
+		payoutID := params["payout_id"].(string)
+		accountNumber := params["account_number"].(string)
+
+		original, err := client.Payout.Fetch(payoutID, nil, nil)
+		if err != nil {
+			return mcpgo.NewToolResultError(
+				fmt.Sprintf("fetching payout failed: %s", err.Error())), nil
+		}
+
+		status, _ := original["status"].(string)
+		if !retryablePayoutStatuses[status] {
+			return mcpgo.NewToolResultError(fmt.Sprintf(
+				"payout %s is %s; only failed or reversed payouts can be "+
+					"retried", payoutID, status)), nil
+		}
+
+		payload, err := buildPayoutRetryPayload(original, accountNumber)
+		if err != nil {
+			return mcpgo.NewToolResultError(err.Error()), nil
+		}
+		if mode, ok := params["mode"]; ok {
+			payload["mode"] = mode
+		}
+		queue, _ := params["queue_if_low_balance"].(bool)
+		payload["queue_if_low_balance"] = queue
+
+		if !queue {
+			balanceURL := fmt.Sprintf("/%s/balance", constants.VERSION_V1)
+			balance, err := client.Request.Get(balanceURL,
+				map[string]interface{}{"account_number": accountNumber}, nil)
+			if err != nil {
+				return mcpgo.NewToolResultError(fmt.Sprintf(
+					"fetching account balance failed: %s", err.Error())), nil
+			}
+
+			available, ok := balance["balance"].(float64)
+			amount := payload["amount"].(int64)
+			if ok && int64(available) < amount {
+				return mcpgo.NewToolResultError(fmt.Sprintf(
+					"account %s has a balance of %d, below the payout amount "+
+						"%d. Add funds, or set queue_if_low_balance to queue "+
+						"the payout until the balance is enough",
+					accountNumber, int64(available), amount)), nil
+			}
+		}
+
+		// The key is derived from the payout being retried, so calling this
+		// tool twice for the same payout cannot pay the beneficiary twice
+		idempotencyKey := "retry-" + payoutID
+
+		url := fmt.Sprintf("/%s%s", constants.VERSION_V1, constants.PAYOUT_URL)
+		payout, err := client.Request.Post(url, payload,
+			map[string]string{payoutIdempotencyHeader: idempotencyKey})
+		if err != nil {
+			return mcpgo.NewToolResultError(
+				fmt.Sprintf("retrying payout failed: %s", err.Error())), nil
+		}
+
+		return mcpgo.NewToolResultJSON(map[string]interface{}{
+			"payout_id":       payout["id"],
+			"retried_from":    payoutID,
+			"idempotency_key": idempotencyKey,
+			"payout":          payout,
+		})
+	}
+
+	return mcpgo.NewTool(
+		"retry_payout",
+		"Resubmit a failed or reversed RazorpayX payout as a new payout to "+
+			"the same fund account, for the same amount and purpose. The "+
+			"account balance is checked first. Returns the new payout id",
+		parameters,
+		handler,
+	)
+}
+
+// buildPayoutRetryPayload copies the fields of the original payout that
+// the new payout needs
+func buildPayoutRetryPayload(
+	original map[string]interface{},
+	accountNumber string,
+) (map[string]interface{}, error) {
+	fundAccountID, _ := original["fund_account_id"].(string)
+	if fundAccountID == "" {
+		if fa, ok := original["fund_account"].(map[string]interface{}); ok {
+			fundAccountID, _ = fa["id"].(string)
+		}
+	}
+	if fundAccountID == "" {
+		return nil, fmt.Errorf("payout %v has no fund account to retry to",
+			original["id"])
+	}
+
+	amount, ok := original["amount"].(float64)
+	if !ok || amount <= 0 {
+		return nil, fmt.Errorf("payout %v has no amount to retry",
+			original["id"])
+	}
+
+	payload := map[string]interface{}{
+		"account_number":  accountNumber,
+		"fund_account_id": fundAccountID,
+		"amount":          int64(amount),
+	}
+	for _, key := range []string{
+		"currency", "mode", "purpose", "reference_id", "narration",
+	} {
+		if v, ok := original[key].(string); ok && v != "" {
+			payload[key] = v
+		}
+	}
+	// The API returns notes as an empty list when there are none
+	if notes, ok := original["notes"].(map[string]interface{}); ok &&
+		len(notes) > 0 {
+		payload["notes"] = notes
+	}
+
+	return payload, nil
+}
+
+const (
+	// maxBulkPayoutStatusIDs caps the payouts looked up in one call
+	maxBulkPayoutStatusIDs = 100
+	// bulkPayoutStatusConcurrency is the number of payouts fetched at once
+	bulkPayoutStatusConcurrency = 5
+)
+
+// FetchBulkPayoutStatus returns a tool that reports the progress of a batch
+// of payouts
+func FetchBulkPayoutStatus(
+	obs *observability.Observability,
+	client *rzpsdk.Client,
+) mcpgo.Tool {
+	parameters := []mcpgo.ToolParameter{
+		mcpgo.WithArray(
+			"payout_ids",
+			mcpgo.Description(fmt.Sprintf("IDs of the payouts in the batch, "+
+				"e.g. the ids returned when the payouts were created. At most "+
+				"%d. For example, ['pout_00000000000001', 'pout_00000000000002']",
+				maxBulkPayoutStatusIDs)),
+			mcpgo.Required(),
+			mcpgo.Items(map[string]interface{}{"type": "string"}),
+		),
+	}
+
+	handler := func(
+		ctx context.Context,
+		r mcpgo.CallToolRequest,
+	) (*mcpgo.ToolResult, error) {
+		client, err := getClientFromContextOrDefault(ctx, client)
+		if err != nil {
+			return mcpgo.NewToolResultError(err.Error()), nil
+		}
+
+		params := make(map[string]interface{})
+
+		validator := NewValidator(&r).
+			ValidateAndAddRequiredArray(params, "payout_ids")
+
+		if result, err := validator.HandleErrorsIfAny(); result != nil {
+			return result, err
+		}
+
+		ids, err := uniquePayoutIDs(params["payout_ids"].([]interface{}))
+		if err != nil {
+			return mcpgo.NewToolResultError(err.Error()), nil
+		}
+
+		tracker := trackRateLimits(client)
+		payouts := make([]map[string]interface{}, len(ids))
+		sem := make(chan struct{}, bulkPayoutStatusConcurrency)
+		var wg sync.WaitGroup
+
+		for i, id := range ids {
+			wg.Add(1)
+			go func(i int, id string) {
+				defer wg.Done()
+				sem <- struct{}{}
+				defer func() { <-sem }()
+
+				// Once the window is used up every further fetch would be
+				// throttled too, so the rest are reported as skipped
+				if tracker.exhausted() {
+					payouts[i] = map[string]interface{}{
+						"payout_id": id,
+						"error": "skipped: the API rate limit is used up, " +
+							"retry this payout later",
+					}
+					return
+				}
+
+				payout, err := client.Payout.Fetch(id, nil, nil)
+				if err != nil {
+					payouts[i] = map[string]interface{}{
+						"payout_id": id,
+						"error":     err.Error(),
+					}
+					return
+				}
+				payouts[i] = summarizePayoutStatus(id, payout)
+			}(i, id)
+		}
+		wg.Wait()
+
+		return mcpgo.NewToolResultJSON(buildBulkPayoutStatus(payouts))
+	}
+
+	return mcpgo.NewTool(
+		"fetch_bulk_payout_status",
+		"Fetch the status of a batch of payouts and return how many are "+
+			"queued, processing, processed and failed, along with the status "+
+			"of each payout. Use this to poll the progress of bulk payouts",
+		parameters,
+		handler,
+	)
+}
+
+// uniquePayoutIDs checks the payout ids and drops duplicates, keeping the
+// order they were given in
+func uniquePayoutIDs(raw []interface{}) ([]string, error) {
+	if len(raw) == 0 {
+		return nil, fmt.Errorf("payout_ids must not be empty")
+	}
+
+	seen := make(map[string]bool, len(raw))
+	ids := make([]string, 0, len(raw))
+	for i, v := range raw {
+		id, ok := v.(string)
+		if !ok || id == "" {
+			return nil, fmt.Errorf(
+				"payout_ids[%d] must be a non-empty string", i)
+		}
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+		ids = append(ids, id)
+	}
+
+	if len(ids) > maxBulkPayoutStatusIDs {
+		return nil, fmt.Errorf("payout_ids has %d payouts, at most %d "+
+			"can be fetched at once", len(ids), maxBulkPayoutStatusIDs)
+	}
+	return ids, nil
+}
+
+// summarizePayoutStatus keeps the fields of a payout that describe where it
+// is in its lifecycle
+func summarizePayoutStatus(
+	id string,
+	payout map[string]interface{},
+) map[string]interface{} {
+	summary := map[string]interface{}{
+		"payout_id": id,
+		"status":    payout["status"],
+		"amount":    payout["amount"],
+	}
+	if utr, ok := payout["utr"].(string); ok && utr != "" {
+		summary["utr"] = utr
+	}
+	if details, ok := payout["status_details"].(map[string]interface{}); ok {
+		if reason, ok := details["description"].(string); ok && reason != "" {
+			summary["status_reason"] = reason
+		}
+	}
+	return summary
+}
+
+// inFlightPayoutStatuses are the states a payout can still move out of
+var inFlightPayoutStatuses = map[string]bool{
+	"pending":    true,
+	"queued":     true,
+	"scheduled":  true,
+	"processing": true,
+}
+
+// buildBulkPayoutStatus counts the payouts by status. queued, processing,
+// processed and failed are always present; other states are counted under
+// their own name, and payouts that could not be fetched under errors.
+func buildBulkPayoutStatus(
+	payouts []map[string]interface{},
+) map[string]interface{} {
+	breakdown := map[string]int{
+		"queued":     0,
+		"processing": 0,
+		"processed":  0,
+		"failed":     0,
+	}
+	errored := 0
+	complete := true
+
+	for _, p := range payouts {
+		status, ok := p["status"].(string)
+		if !ok {
+			errored++
+			complete = false
+			continue
+		}
+		breakdown[status]++
+		if inFlightPayoutStatuses[status] {
+			complete = false
+		}
+	}
+
+	return map[string]interface{}{
+		"total":     len(payouts),
+		"breakdown": breakdown,
+		"errors":    errored,
+		"complete":  complete,
+		"payouts":   payouts,
+	}
+}
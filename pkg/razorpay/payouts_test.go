@@ -6,6 +6,8 @@ import (
 	"net/http/httptest"
 	"testing"
 
+	"github.com/go-test/deep"
+
 	"github.com/razorpay/razorpay-go/constants"
 
 	"github.com/razorpay/razorpay-mcp-server/pkg/razorpay/mock"
@@ -257,3 +259,633 @@ func Test_FetchAllPayouts(t *testing.T) {
 		})
 	}
 }
+
+func Test_CreatePayout(t *testing.T) {
+	createPayoutPath := fmt.Sprintf(
+		"/%s%s",
+		constants.VERSION_V1,
+		constants.PAYOUT_URL,
+	)
+
+	payoutResp := map[string]interface{}{
+		"id":              "pout_00000000000001",
+		"entity":          "payout",
+		"fund_account_id": "fa_00000000000001",
+		"amount":          float64(1000000),
+		"currency":        "INR",
+		"mode":            "IMPS",
+		"purpose":         "refund",
+		"status":          "processing",
+	}
+
+	tests := []RazorpayToolTestCase{
+		{
+			Name: "successful payout creation",
+			Request: map[string]interface{}{
+				"account_number":       "7878780080316316",
+				"fund_account_id":      "fa_00000000000001",
+				"amount":               float64(1000000),
+				"currency":             "INR",
+				"mode":                 "IMPS",
+				"purpose":              "refund",
+				"queue_if_low_balance": true,
+				"reference_id":         "Acme Transaction ID 12345",
+			},
+			MockHttpClient: func() (*http.Client, *httptest.Server) {
+				return mock.NewHTTPClient(
+					mock.Endpoint{
+						Path:     createPayoutPath,
+						Method:   "POST",
+						Response: payoutResp,
+					},
+				)
+			},
+			ExpectError:    false,
+			ExpectedResult: payoutResp,
+		},
+		{
+			Name: "insufficient balance",
+			Request: map[string]interface{}{
+				"account_number":  "7878780080316316",
+				"fund_account_id": "fa_00000000000001",
+				"amount":          float64(1000000),
+				"currency":        "INR",
+				"mode":            "IMPS",
+				"purpose":         "refund",
+			},
+			MockHttpClient: func() (*http.Client, *httptest.Server) {
+				return mock.NewHTTPClient(
+					mock.Endpoint{
+						Path:   createPayoutPath,
+						Method: "POST",
+						Response: map[string]interface{}{
+							"error": map[string]interface{}{
+								"code": "BAD_REQUEST_ERROR",
+								"description": "Your account does not have " +
+									"enough balance to carry out the payout " +
+									"operation.",
+							},
+						},
+					},
+				)
+			},
+			ExpectError: true,
+			ExpectedErrMsg: "creating payout failed: Your account does not " +
+				"have enough balance to carry out the payout operation.",
+		},
+		{
+			Name: "missing fund_account_id parameter",
+			Request: map[string]interface{}{
+				"account_number": "7878780080316316",
+				"amount":         float64(1000000),
+				"currency":       "INR",
+				"mode":           "IMPS",
+				"purpose":        "refund",
+			},
+			MockHttpClient: nil,
+			ExpectError:    true,
+			ExpectedErrMsg: "missing required parameter: fund_account_id",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.Name, func(t *testing.T) {
+			runToolTest(t, tc, CreatePayout, "Payout")
+		})
+	}
+}
+
+func Test_CreatePayoutToUpi(t *testing.T) {
+	createPayoutPath := fmt.Sprintf(
+		"/%s%s",
+		constants.VERSION_V1,
+		constants.PAYOUT_URL,
+	)
+
+	payoutResp := map[string]interface{}{
+		"id":     "pout_00000000000001",
+		"entity": "payout",
+		"fund_account": map[string]interface{}{
+			"account_type": "vpa",
+			"vpa": map[string]interface{}{
+				"address": "gaurav.kumar@exampleupi",
+			},
+		},
+		"amount":   float64(100000),
+		"currency": "INR",
+		"mode":     "UPI",
+		"status":   "processing",
+	}
+
+	tests := []RazorpayToolTestCase{
+		{
+			Name: "successful UPI payout",
+			Request: map[string]interface{}{
+				"account_number": "7878780080316316",
+				"vpa":            "gaurav.kumar@exampleupi",
+				"contact_name":   "Gaurav Kumar",
+				"contact_type":   "vendor",
+				"amount":         float64(100000),
+				"purpose":        "payout",
+			},
+			MockHttpClient: func() (*http.Client, *httptest.Server) {
+				return mock.NewHTTPClient(
+					mock.Endpoint{
+						Path:     createPayoutPath,
+						Method:   "POST",
+						Response: payoutResp,
+					},
+				)
+			},
+			ExpectError:    false,
+			ExpectedResult: payoutResp,
+		},
+		{
+			Name: "missing vpa parameter",
+			Request: map[string]interface{}{
+				"account_number": "7878780080316316",
+				"contact_name":   "Gaurav Kumar",
+				"amount":         float64(100000),
+				"purpose":        "payout",
+			},
+			MockHttpClient: nil,
+			ExpectError:    true,
+			ExpectedErrMsg: "missing required parameter: vpa",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.Name, func(t *testing.T) {
+			runToolTest(t, tc, CreatePayoutToUpi, "Payout")
+		})
+	}
+}
+
+func Test_CancelPayout(t *testing.T) {
+	cancelPayoutPath := fmt.Sprintf(
+		"/%s%s/%s/cancel",
+		constants.VERSION_V1,
+		constants.PAYOUT_URL,
+		"pout_00000000000001",
+	)
+
+	payoutResp := map[string]interface{}{
+		"id":     "pout_00000000000001",
+		"entity": "payout",
+		"status": "cancelled",
+	}
+
+	tests := []RazorpayToolTestCase{
+		{
+			Name: "successful cancellation",
+			Request: map[string]interface{}{
+				"payout_id": "pout_00000000000001",
+			},
+			MockHttpClient: func() (*http.Client, *httptest.Server) {
+				return mock.NewHTTPClient(
+					mock.Endpoint{
+						Path:     cancelPayoutPath,
+						Method:   "POST",
+						Response: payoutResp,
+					},
+				)
+			},
+			ExpectError:    false,
+			ExpectedResult: payoutResp,
+		},
+		{
+			Name: "payout already processed",
+			Request: map[string]interface{}{
+				"payout_id": "pout_00000000000001",
+			},
+			MockHttpClient: func() (*http.Client, *httptest.Server) {
+				return mock.NewHTTPClient(
+					mock.Endpoint{
+						Path:   cancelPayoutPath,
+						Method: "POST",
+						Response: map[string]interface{}{
+							"error": map[string]interface{}{
+								"code": "BAD_REQUEST_ERROR",
+								"description": "Only queued or scheduled " +
+									"payouts can be cancelled",
+							},
+						},
+					},
+				)
+			},
+			ExpectError: true,
+			ExpectedErrMsg: "cancelling payout failed: " +
+				"Only queued or scheduled payouts can be cancelled",
+		},
+		{
+			Name:           "missing payout_id parameter",
+			Request:        map[string]interface{}{},
+			MockHttpClient: nil,
+			ExpectError:    true,
+			ExpectedErrMsg: "missing required parameter: payout_id",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.Name, func(t *testing.T) {
+			runToolTest(t, tc, CancelPayout, "Payout")
+		})
+	}
+}
+
+func Test_RetryPayout(t *testing.T) {
+	fetchPayoutPath := fmt.Sprintf("/%s%s/%s",
+		constants.VERSION_V1, constants.PAYOUT_URL, "pout_failed")
+	createPayoutPath := fmt.Sprintf("/%s%s",
+		constants.VERSION_V1, constants.PAYOUT_URL)
+	balancePath := fmt.Sprintf("/%s/balance", constants.VERSION_V1)
+
+	failedPayout := map[string]interface{}{
+		"id":              "pout_failed",
+		"entity":          "payout",
+		"fund_account_id": "fa_123",
+		"amount":          float64(100000),
+		"currency":        "INR",
+		"mode":            "IMPS",
+		"purpose":         "vendor bill",
+		"reference_id":    "INV-42",
+		"notes":           []interface{}{},
+		"status":          "failed",
+	}
+
+	newPayout := map[string]interface{}{
+		"id":              "pout_new",
+		"entity":          "payout",
+		"fund_account_id": "fa_123",
+		"amount":          float64(100000),
+		"currency":        "INR",
+		"mode":            "NEFT",
+		"purpose":         "vendor bill",
+		"status":          "processing",
+	}
+
+	payoutWithStatus := func(status string) map[string]interface{} {
+		payout := make(map[string]interface{}, len(failedPayout))
+		for k, v := range failedPayout {
+			payout[k] = v
+		}
+		payout["status"] = status
+		return payout
+	}
+
+	tests := []RazorpayToolTestCase{
+		{
+			Name: "retries a failed payout on another mode",
+			Request: map[string]interface{}{
+				"payout_id":      "pout_failed",
+				"account_number": "7878780080316316",
+				"mode":           "NEFT",
+			},
+			MockHttpClient: func() (*http.Client, *httptest.Server) {
+				return mock.NewHTTPClient(
+					mock.Endpoint{
+						Path:     fetchPayoutPath,
+						Method:   "GET",
+						Response: failedPayout,
+					},
+					mock.Endpoint{
+						Path:   balancePath,
+						Method: "GET",
+						Response: map[string]interface{}{
+							"entity":  "balance",
+							"balance": float64(500000),
+						},
+					},
+					mock.Endpoint{
+						Path:     createPayoutPath,
+						Method:   "POST",
+						Response: newPayout,
+					},
+				)
+			},
+			ExpectError: false,
+			ExpectedResult: map[string]interface{}{
+				"payout_id":       "pout_new",
+				"retried_from":    "pout_failed",
+				"idempotency_key": "retry-pout_failed",
+				"payout":          newPayout,
+			},
+		},
+		{
+			Name: "queued retry skips the balance check",
+			Request: map[string]interface{}{
+				"payout_id":            "pout_failed",
+				"account_number":       "7878780080316316",
+				"queue_if_low_balance": true,
+			},
+			MockHttpClient: func() (*http.Client, *httptest.Server) {
+				return mock.NewHTTPClient(
+					mock.Endpoint{
+						Path:     fetchPayoutPath,
+						Method:   "GET",
+						Response: payoutWithStatus("reversed"),
+					},
+					mock.Endpoint{
+						Path:     createPayoutPath,
+						Method:   "POST",
+						Response: newPayout,
+					},
+				)
+			},
+			ExpectError: false,
+			ExpectedResult: map[string]interface{}{
+				"payout_id":       "pout_new",
+				"retried_from":    "pout_failed",
+				"idempotency_key": "retry-pout_failed",
+				"payout":          newPayout,
+			},
+		},
+		{
+			Name: "processed payout is not retried",
+			Request: map[string]interface{}{
+				"payout_id":      "pout_failed",
+				"account_number": "7878780080316316",
+			},
+			MockHttpClient: func() (*http.Client, *httptest.Server) {
+				return mock.NewHTTPClient(
+					mock.Endpoint{
+						Path:     fetchPayoutPath,
+						Method:   "GET",
+						Response: payoutWithStatus("processed"),
+					},
+				)
+			},
+			ExpectError: true,
+			ExpectedErrMsg: "payout pout_failed is processed; only failed or " +
+				"reversed payouts can be retried",
+		},
+		{
+			Name: "low balance",
+			Request: map[string]interface{}{
+				"payout_id":      "pout_failed",
+				"account_number": "7878780080316316",
+			},
+			MockHttpClient: func() (*http.Client, *httptest.Server) {
+				return mock.NewHTTPClient(
+					mock.Endpoint{
+						Path:     fetchPayoutPath,
+						Method:   "GET",
+						Response: failedPayout,
+					},
+					mock.Endpoint{
+						Path:   balancePath,
+						Method: "GET",
+						Response: map[string]interface{}{
+							"entity":  "balance",
+							"balance": float64(5000),
+						},
+					},
+				)
+			},
+			ExpectError: true,
+			ExpectedErrMsg: "account 7878780080316316 has a balance of 5000, " +
+				"below the payout amount 100000",
+		},
+		{
+			Name: "payout not found",
+			Request: map[string]interface{}{
+				"payout_id":      "pout_failed",
+				"account_number": "7878780080316316",
+			},
+			MockHttpClient: func() (*http.Client, *httptest.Server) {
+				return mock.NewHTTPClient(
+					mock.Endpoint{
+						Path:   fetchPayoutPath,
+						Method: "GET",
+						Response: map[string]interface{}{
+							"error": map[string]interface{}{
+								"code":        "BAD_REQUEST_ERROR",
+								"description": "payout not found",
+							},
+						},
+					},
+				)
+			},
+			ExpectError:    true,
+			ExpectedErrMsg: "fetching payout failed: payout not found",
+		},
+		{
+			Name: "missing account_number parameter",
+			Request: map[string]interface{}{
+				"payout_id": "pout_failed",
+			},
+			MockHttpClient: nil,
+			ExpectError:    true,
+			ExpectedErrMsg: "missing required parameter: account_number",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.Name, func(t *testing.T) {
+			runToolTest(t, tc, RetryPayout, "Payout")
+		})
+	}
+}
+
+func Test_buildPayoutRetryPayload(t *testing.T) {
+	payload, err := buildPayoutRetryPayload(map[string]interface{}{
+		"id": "pout_failed",
+		"fund_account": map[string]interface{}{
+			"id":     "fa_123",
+			"entity": "fund_account",
+		},
+		"amount":    float64(100000),
+		"currency":  "INR",
+		"mode":      "IMPS",
+		"purpose":   "payout",
+		"narration": "",
+		"notes":     map[string]interface{}{"batch": "7"},
+		"status":    "reversed",
+	}, "7878780080316316")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := map[string]interface{}{
+		"account_number":  "7878780080316316",
+		"fund_account_id": "fa_123",
+		"amount":          int64(100000),
+		"currency":        "INR",
+		"mode":            "IMPS",
+		"purpose":         "payout",
+		"notes":           map[string]interface{}{"batch": "7"},
+	}
+	if diff := deep.Equal(want, payload); diff != nil {
+		t.Errorf("payload mismatch: %v", diff)
+	}
+
+	_, err = buildPayoutRetryPayload(map[string]interface{}{
+		"id":     "pout_failed",
+		"amount": float64(100000),
+	}, "7878780080316316")
+	if err == nil || err.Error() != "payout pout_failed has no fund "+
+		"account to retry to" {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func Test_FetchBulkPayoutStatus(t *testing.T) {
+	payoutPath := func(id string) string {
+		return fmt.Sprintf("/%s%s/%s",
+			constants.VERSION_V1, constants.PAYOUT_URL, id)
+	}
+
+	payout := func(id, status string) mock.Endpoint {
+		return mock.Endpoint{
+			Path:   payoutPath(id),
+			Method: "GET",
+			Response: map[string]interface{}{
+				"id":     id,
+				"entity": "payout",
+				"amount": float64(10000),
+				"status": status,
+			},
+		}
+	}
+
+	tests := []RazorpayToolTestCase{
+		{
+			Name: "mixed batch",
+			Request: map[string]interface{}{
+				"payout_ids": []interface{}{
+					"pout_1", "pout_2", "pout_3", "pout_2", "pout_missing",
+				},
+			},
+			MockHttpClient: func() (*http.Client, *httptest.Server) {
+				return mock.NewHTTPClient(
+					payout("pout_1", "processed"),
+					payout("pout_2", "processing"),
+					mock.Endpoint{
+						Path:   payoutPath("pout_3"),
+						Method: "GET",
+						Response: map[string]interface{}{
+							"id":     "pout_3",
+							"amount": float64(20000),
+							"status": "reversed",
+							"status_details": map[string]interface{}{
+								"description": "Beneficiary bank is offline",
+							},
+						},
+					},
+					mock.Endpoint{
+						Path:   payoutPath("pout_missing"),
+						Method: "GET",
+						Response: map[string]interface{}{
+							"error": map[string]interface{}{
+								"code":        "BAD_REQUEST_ERROR",
+								"description": "payout not found",
+							},
+						},
+					},
+				)
+			},
+			ExpectError: false,
+			ExpectedResult: map[string]interface{}{
+				"total": float64(4),
+				"breakdown": map[string]interface{}{
+					"queued":     float64(0),
+					"processing": float64(1),
+					"processed":  float64(1),
+					"failed":     float64(0),
+					"reversed":   float64(1),
+				},
+				"errors":   float64(1),
+				"complete": false,
+				"payouts": []interface{}{
+					map[string]interface{}{
+						"payout_id": "pout_1",
+						"status":    "processed",
+						"amount":    float64(10000),
+					},
+					map[string]interface{}{
+						"payout_id": "pout_2",
+						"status":    "processing",
+						"amount":    float64(10000),
+					},
+					map[string]interface{}{
+						"payout_id":     "pout_3",
+						"status":        "reversed",
+						"amount":        float64(20000),
+						"status_reason": "Beneficiary bank is offline",
+					},
+					map[string]interface{}{
+						"payout_id": "pout_missing",
+						"error":     "payout not found",
+					},
+				},
+			},
+		},
+		{
+			Name: "finished batch is complete",
+			Request: map[string]interface{}{
+				"payout_ids": []interface{}{"pout_1", "pout_2"},
+			},
+			MockHttpClient: func() (*http.Client, *httptest.Server) {
+				return mock.NewHTTPClient(
+					payout("pout_1", "processed"),
+					payout("pout_2", "failed"),
+				)
+			},
+			ExpectError: false,
+			ExpectedResult: map[string]interface{}{
+				"total": float64(2),
+				"breakdown": map[string]interface{}{
+					"queued":     float64(0),
+					"processing": float64(0),
+					"processed":  float64(1),
+					"failed":     float64(1),
+				},
+				"errors":   float64(0),
+				"complete": true,
+				"payouts": []interface{}{
+					map[string]interface{}{
+						"payout_id": "pout_1",
+						"status":    "processed",
+						"amount":    float64(10000),
+					},
+					map[string]interface{}{
+						"payout_id": "pout_2",
+						"status":    "failed",
+						"amount":    float64(10000),
+					},
+				},
+			},
+		},
+		{
+			Name: "empty payout_ids",
+			Request: map[string]interface{}{
+				"payout_ids": []interface{}{},
+			},
+			MockHttpClient: nil,
+			ExpectError:    true,
+			ExpectedErrMsg: "payout_ids must not be empty",
+		},
+		{
+			Name: "non-string payout id",
+			Request: map[string]interface{}{
+				"payout_ids": []interface{}{"pout_1", 42},
+			},
+			MockHttpClient: nil,
+			ExpectError:    true,
+			ExpectedErrMsg: "payout_ids[1] must be a non-empty string",
+		},
+		{
+			Name:           "missing payout_ids parameter",
+			Request:        map[string]interface{}{},
+			MockHttpClient: nil,
+			ExpectError:    true,
+			ExpectedErrMsg: "missing required parameter: payout_ids",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.Name, func(t *testing.T) {
+			runToolTest(t, tc, FetchBulkPayoutStatus, "Payout")
+		})
+	}
+}
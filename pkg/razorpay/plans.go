@@ -0,0 +1,233 @@
+package razorpay
+
+import (
+	"context"
+	"fmt"
+
+	rzpsdk "github.com/razorpay/razorpay-go"
+
+	"github.com/razorpay/razorpay-mcp-server/pkg/mcpgo"
+	"github.com/razorpay/razorpay-mcp-server/pkg/observability"
+)
+
+// CreatePlan returns a tool that creates a subscription plan
+func CreatePlan(
+	obs *observability.Observability,
+	client *rzpsdk.Client,
+) mcpgo.Tool {
+	parameters := []mcpgo.ToolParameter{
+		mcpgo.WithString(
+			"period",
+			mcpgo.Description("Frequency unit of the billing cycle. "+
+				"Possible values: 'daily', 'weekly', 'monthly', 'quarterly', "+
+				"'yearly'"),
+			mcpgo.Required(),
+			mcpgo.Enum("daily", "weekly", "monthly", "quarterly", "yearly"),
+		),
+		mcpgo.WithNumber(
+			"interval",
+			mcpgo.Description("Number of periods between two billing cycles. "+
+				"For example, period 'monthly' with interval 2 bills every "+
+				"2 months. For daily plans the interval must be at least 7"),
+			mcpgo.Required(),
+			mcpgo.Min(1),
+		),
+		mcpgo.WithString(
+			"item_name",
+			mcpgo.Description("Name of the plan, shown to the customer"),
+			mcpgo.Required(),
+		),
+		mcpgo.WithNumber(
+			"item_amount",
+			mcpgo.Description("Amount charged per billing cycle in the "+
+				"smallest currency sub-unit (e.g., 69900 for ₹699)"),
+			mcpgo.Required(),
+			mcpgo.Min(100),
+		),
+		mcpgo.WithString(
+			"item_currency",
+			mcpgo.Description("ISO code of the currency (e.g., INR)"),
+			mcpgo.Required(),
+		),
+		mcpgo.WithString(
+			"item_description",
+			mcpgo.Description("Description of the plan"),
+		),
+		mcpgo.WithObject(
+			"notes",
+			mcpgo.Description("Key-value pairs that can be used to store "+
+				"additional information about the plan. A maximum of 15 "+
+				"key-value pairs, each of 256 characters (maximum) are allowed"),
+		),
+	}
+
+	handler := func(
+		ctx context.Context,
+		r mcpgo.CallToolRequest,
+	) (*mcpgo.ToolResult, error) {
+		client, err := getClientFromContextOrDefault(ctx, client)
+		if err != nil {
+			return mcpgo.NewToolResultError(err.Error()), nil
+		}
+
+		params := make(map[string]interface{})
+		itemParams := make(map[string]interface{})
+
+		validator := NewValidator(&r).
+			ValidateAndAddRequiredString(params, "period").
+			ValidateAndAddRequiredInt(params, "interval").
+			ValidateAndAddRequiredString(itemParams, "item_name").
+			ValidateAndAddRequiredInt(itemParams, "item_amount").
+			ValidateAndAddRequiredString(itemParams, "item_currency").
+			ValidateAndAddOptionalString(itemParams, "item_description").
+			ValidateAndAddOptionalNotes(params, "notes")
+
+		if result, err := validator.HandleErrorsIfAny(); result != nil {
+			return result, err
+		}
+
+		item := map[string]interface{}{
+			"name":     itemParams["item_name"],
+			"amount":   itemParams["item_amount"],
+			"currency": itemParams["item_currency"],
+		}
+		if description, ok := itemParams["item_description"]; ok {
+			item["description"] = description
+		}
+		params["item"] = item
+
+		plan, err := client.Plan.Create(params, nil)
+		if err != nil {
+			return mcpgo.NewToolResultError(
+				fmt.Sprintf("creating plan failed: %s", err.Error())), nil
+		}
+
+		return mcpgo.NewToolResultJSON(plan)
+	}
+
+	return mcpgo.NewTool(
+		"create_plan",
+		"Create a plan that defines how much and how often a subscription "+
+			"is billed. Plans cannot be edited once created",
+		parameters,
+		handler,
+	)
+}
+
+// FetchPlan returns a tool that fetches a plan by ID
+func FetchPlan(
+	obs *observability.Observability,
+	client *rzpsdk.Client,
+) mcpgo.Tool {
+	parameters := []mcpgo.ToolParameter{
+		mcpgo.WithString(
+			"plan_id",
+			mcpgo.Description("Unique identifier of the plan. "+
+				"For example, 'plan_00000000000001'"),
+			mcpgo.Required(),
+		),
+	}
+
+	handler := func(
+		ctx context.Context,
+		r mcpgo.CallToolRequest,
+	) (*mcpgo.ToolResult, error) {
+		client, err := getClientFromContextOrDefault(ctx, client)
+		if err != nil {
+			return mcpgo.NewToolResultError(err.Error()), nil
+		}
+
+		params := make(map[string]interface{})
+
+		validator := NewValidator(&r).
+			ValidateAndAddRequiredString(params, "plan_id")
+
+		if result, err := validator.HandleErrorsIfAny(); result != nil {
+			return result, err
+		}
+
+		plan, err := client.Plan.Fetch(params["plan_id"].(string), nil, nil)
+		if err != nil {
+			return mcpgo.NewToolResultError(
+				fmt.Sprintf("fetching plan failed: %s", err.Error())), nil
+		}
+
+		return mcpgo.NewToolResultJSON(plan)
+	}
+
+	return mcpgo.NewTool(
+		"fetch_plan",
+		"Fetch a plan's details using its ID",
+		parameters,
+		handler,
+	)
+}
+
+// FetchAllPlans returns a tool that fetches all plans
+func FetchAllPlans(
+	obs *observability.Observability,
+	client *rzpsdk.Client,
+) mcpgo.Tool {
+	parameters := []mcpgo.ToolParameter{
+		mcpgo.WithNumber(
+			"from",
+			mcpgo.Description("Unix timestamp (in seconds) from when "+
+				"plans are to be fetched"),
+			mcpgo.Min(0),
+		),
+		mcpgo.WithNumber(
+			"to",
+			mcpgo.Description("Unix timestamp (in seconds) up till when "+
+				"plans are to be fetched"),
+			mcpgo.Min(0),
+		),
+		mcpgo.WithNumber(
+			"count",
+			mcpgo.Description("Number of plans to be fetched "+
+				"(default: 10, max: 100)"),
+			mcpgo.Min(1),
+			mcpgo.Max(100),
+		),
+		mcpgo.WithNumber(
+			"skip",
+			mcpgo.Description("Number of plans to be skipped (default: 0)"),
+			mcpgo.Min(0),
+		),
+	}
+
+	handler := func(
+		ctx context.Context,
+		r mcpgo.CallToolRequest,
+	) (*mcpgo.ToolResult, error) {
+		client, err := getClientFromContextOrDefault(ctx, client)
+		if err != nil {
+			return mcpgo.NewToolResultError(err.Error()), nil
+		}
+
+		queryParams := make(map[string]interface{})
+
+		validator := NewValidator(&r).
+			ValidateAndAddOptionalInt(queryParams, "from").
+			ValidateAndAddOptionalInt(queryParams, "to").
+			ValidateAndAddPagination(queryParams)
+
+		if result, err := validator.HandleErrorsIfAny(); result != nil {
+			return result, err
+		}
+
+		plans, err := client.Plan.All(queryParams, nil)
+		if err != nil {
+			return mcpgo.NewToolResultError(
+				fmt.Sprintf("fetching plans failed: %s", err.Error())), nil
+		}
+
+		return mcpgo.NewToolResultJSON(plans)
+	}
+
+	return mcpgo.NewTool(
+		"fetch_all_plans",
+		"Fetch all plans with optional filtering and pagination",
+		parameters,
+		handler,
+	)
+}
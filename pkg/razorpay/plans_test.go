@@ -0,0 +1,233 @@
+package razorpay
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/razorpay/razorpay-go/constants"
+
+	"github.com/razorpay/razorpay-mcp-server/pkg/razorpay/mock"
+)
+
+func Test_CreatePlan(t *testing.T) {
+	createPlanPath := fmt.Sprintf(
+		"/%s%s",
+		constants.VERSION_V1,
+		constants.PLAN_URL,
+	)
+
+	planResp := map[string]interface{}{
+		"id":       "plan_00000000000001",
+		"entity":   "plan",
+		"period":   "monthly",
+		"interval": float64(1),
+		"item": map[string]interface{}{
+			"name":     "Pro Monthly",
+			"amount":   float64(69900),
+			"currency": "INR",
+		},
+		"created_at": float64(1700000000),
+	}
+
+	tests := []RazorpayToolTestCase{
+		{
+			Name: "successful plan creation",
+			Request: map[string]interface{}{
+				"period":           "monthly",
+				"interval":         float64(1),
+				"item_name":        "Pro Monthly",
+				"item_amount":      float64(69900),
+				"item_currency":    "INR",
+				"item_description": "Monthly Pro plan",
+			},
+			MockHttpClient: func() (*http.Client, *httptest.Server) {
+				return mock.NewHTTPClient(
+					mock.Endpoint{
+						Path:     createPlanPath,
+						Method:   "POST",
+						Response: planResp,
+					},
+				)
+			},
+			ExpectError:    false,
+			ExpectedResult: planResp,
+		},
+		{
+			Name: "plan creation fails",
+			Request: map[string]interface{}{
+				"period":        "daily",
+				"interval":      float64(1),
+				"item_name":     "Daily",
+				"item_amount":   float64(1000),
+				"item_currency": "INR",
+			},
+			MockHttpClient: func() (*http.Client, *httptest.Server) {
+				return mock.NewHTTPClient(
+					mock.Endpoint{
+						Path:   createPlanPath,
+						Method: "POST",
+						Response: map[string]interface{}{
+							"error": map[string]interface{}{
+								"code": "BAD_REQUEST_ERROR",
+								"description": "The interval must be " +
+									"atleast 7 for daily plans",
+							},
+						},
+					},
+				)
+			},
+			ExpectError: true,
+			ExpectedErrMsg: "creating plan failed: " +
+				"The interval must be atleast 7 for daily plans",
+		},
+		{
+			Name: "missing item_amount parameter",
+			Request: map[string]interface{}{
+				"period":        "monthly",
+				"interval":      float64(1),
+				"item_name":     "Pro Monthly",
+				"item_currency": "INR",
+			},
+			MockHttpClient: nil,
+			ExpectError:    true,
+			ExpectedErrMsg: "missing required parameter: item_amount",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.Name, func(t *testing.T) {
+			runToolTest(t, tc, CreatePlan, "Plan")
+		})
+	}
+}
+
+func Test_FetchPlan(t *testing.T) {
+	fetchPlanPath := fmt.Sprintf(
+		"/%s%s/%s",
+		constants.VERSION_V1,
+		constants.PLAN_URL,
+		"plan_00000000000001",
+	)
+
+	planResp := map[string]interface{}{
+		"id":       "plan_00000000000001",
+		"entity":   "plan",
+		"period":   "monthly",
+		"interval": float64(1),
+	}
+
+	tests := []RazorpayToolTestCase{
+		{
+			Name: "successful plan fetch",
+			Request: map[string]interface{}{
+				"plan_id": "plan_00000000000001",
+			},
+			MockHttpClient: func() (*http.Client, *httptest.Server) {
+				return mock.NewHTTPClient(
+					mock.Endpoint{
+						Path:     fetchPlanPath,
+						Method:   "GET",
+						Response: planResp,
+					},
+				)
+			},
+			ExpectError:    false,
+			ExpectedResult: planResp,
+		},
+		{
+			Name: "plan not found",
+			Request: map[string]interface{}{
+				"plan_id": "plan_00000000000001",
+			},
+			MockHttpClient: func() (*http.Client, *httptest.Server) {
+				return mock.NewHTTPClient(
+					mock.Endpoint{
+						Path:   fetchPlanPath,
+						Method: "GET",
+						Response: map[string]interface{}{
+							"error": map[string]interface{}{
+								"code":        "BAD_REQUEST_ERROR",
+								"description": "The id provided does not exist",
+							},
+						},
+					},
+				)
+			},
+			ExpectError: true,
+			ExpectedErrMsg: "fetching plan failed: " +
+				"The id provided does not exist",
+		},
+		{
+			Name:           "missing plan_id parameter",
+			Request:        map[string]interface{}{},
+			MockHttpClient: nil,
+			ExpectError:    true,
+			ExpectedErrMsg: "missing required parameter: plan_id",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.Name, func(t *testing.T) {
+			runToolTest(t, tc, FetchPlan, "Plan")
+		})
+	}
+}
+
+func Test_FetchAllPlans(t *testing.T) {
+	fetchAllPlansPath := fmt.Sprintf(
+		"/%s%s",
+		constants.VERSION_V1,
+		constants.PLAN_URL,
+	)
+
+	plansResp := map[string]interface{}{
+		"entity": "collection",
+		"count":  float64(1),
+		"items": []interface{}{
+			map[string]interface{}{
+				"id":       "plan_00000000000001",
+				"entity":   "plan",
+				"period":   "monthly",
+				"interval": float64(1),
+			},
+		},
+	}
+
+	tests := []RazorpayToolTestCase{
+		{
+			Name: "successful plans fetch",
+			Request: map[string]interface{}{
+				"count": float64(10),
+				"skip":  float64(0),
+			},
+			MockHttpClient: func() (*http.Client, *httptest.Server) {
+				return mock.NewHTTPClient(
+					mock.Endpoint{
+						Path:     fetchAllPlansPath,
+						Method:   "GET",
+						Response: plansResp,
+					},
+				)
+			},
+			ExpectError:    false,
+			ExpectedResult: plansResp,
+		},
+		{
+			Name: "invalid count parameter",
+			Request: map[string]interface{}{
+				"count": "ten",
+			},
+			MockHttpClient: nil,
+			ExpectError:    true,
+			ExpectedErrMsg: "invalid parameter type: count",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.Name, func(t *testing.T) {
+			runToolTest(t, tc, FetchAllPlans, "Plans")
+		})
+	}
+}
@@ -2,7 +2,12 @@ package razorpay
 
 import (
 	"context"
+	"encoding/base64"
 	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
 
 	rzpsdk "github.com/razorpay/razorpay-go"
 
@@ -102,7 +107,7 @@ func CreateQRCode(
 			ValidateAndAddOptionalString(qrData, "description").
 			ValidateAndAddOptionalString(qrData, "customer_id").
 			ValidateAndAddOptionalFloat(qrData, "close_by").
-			ValidateAndAddOptionalMap(qrData, "notes")
+			ValidateAndAddOptionalNotes(qrData, "notes")
 
 		if result, err := validator.HandleErrorsIfAny(); result != nil {
 			return result, err
@@ -135,6 +140,184 @@ func CreateQRCode(
 	)
 }
 
+// CreateCustomerQRCode returns a tool that creates a UPI QR code linked to
+// an existing customer
+func CreateCustomerQRCode(
+	obs *observability.Observability,
+	client *rzpsdk.Client,
+) mcpgo.Tool {
+	parameters := []mcpgo.ToolParameter{
+		mcpgo.WithString(
+			"customer_id",
+			mcpgo.Description(
+				"The unique identifier of the customer the QR Code belongs to. "+
+					"The customer must already exist (e.g., 'cust_HKsR5se84c5LTO')",
+			),
+			mcpgo.Required(),
+		),
+		mcpgo.WithString(
+			"usage",
+			mcpgo.Description(
+				"Whether QR should accept single or multiple payments. "+
+					"Use 'multiple_use' for a persistent per-customer QR",
+			),
+			mcpgo.Required(),
+			mcpgo.Enum("single_use", "multiple_use"),
+		),
+		mcpgo.WithString(
+			"reference",
+			mcpgo.Description(
+				"Your own reference for the collection (e.g., an invoice or "+
+					"subscription ID). Stored in notes as 'reference'",
+			),
+		),
+		mcpgo.WithString(
+			"name",
+			mcpgo.Description(
+				"Label to identify the QR Code. Defaults to the customer's name",
+			),
+		),
+		mcpgo.WithBoolean(
+			"fixed_amount",
+			mcpgo.Description(
+				"Whether QR should accept only specific amount (true) or any "+
+					"amount (false)",
+			),
+			mcpgo.DefaultValue(false),
+		),
+		mcpgo.WithNumber(
+			"payment_amount",
+			mcpgo.Description(
+				"The specific amount allowed for transaction in smallest "+
+					"currency unit",
+			),
+			mcpgo.Min(1),
+		),
+		mcpgo.WithString(
+			"description",
+			mcpgo.Description("A brief description about the QR Code"),
+		),
+		mcpgo.WithNumber(
+			"close_by",
+			mcpgo.Description(
+				"Unix timestamp at which QR Code should be automatically "+
+					"closed (min 2 mins after current time)",
+			),
+		),
+		mcpgo.WithObject(
+			"notes",
+			mcpgo.Description(
+				"Key-value pairs for additional information "+
+					"(max 13 pairs with reference or 14 without, 256 chars "+
+					"each). customer_id and reference are added automatically",
+			),
+			mcpgo.MaxProperties(maxNotes-1),
+		),
+	}
+
+	handler := func(
+		ctx context.Context,
+		r mcpgo.CallToolRequest,
+	) (*mcpgo.ToolResult, error) {
+		client, err := getClientFromContextOrDefault(ctx, client)
+		if err != nil {
+			return mcpgo.NewToolResultError(err.Error()), nil
+		}
+
+		qrData := make(map[string]interface{})
+		params := make(map[string]interface{})
+
+		validator := NewValidator(&r).
+			ValidateAndAddRequiredString(qrData, "customer_id").
+			ValidateAndAddRequiredString(qrData, "usage").
+			ValidateAndAddOptionalString(params, "reference").
+			ValidateAndAddOptionalString(qrData, "name").
+			ValidateAndAddOptionalBool(qrData, "fixed_amount").
+			ValidateAndAddOptionalFloat(qrData, "payment_amount").
+			ValidateAndAddOptionalString(qrData, "description").
+			ValidateAndAddOptionalFloat(qrData, "close_by").
+			ValidateAndAddOptionalNotes(qrData, "notes")
+
+		if result, err := validator.HandleErrorsIfAny(); result != nil {
+			return result, err
+		}
+
+		if fixedAmount, exists := qrData["fixed_amount"]; exists &&
+			fixedAmount.(bool) {
+			if _, exists := qrData["payment_amount"]; !exists {
+				return mcpgo.NewToolResultError(
+					"payment_amount is required when fixed_amount is true"), nil
+			}
+		}
+
+		// customer_id and reference are added to the notes below and count
+		// towards Razorpay's limit
+		maxUserNotes := maxNotes - 1
+		if _, exists := params["reference"]; exists {
+			maxUserNotes--
+		}
+		if notes, _ := qrData["notes"].(map[string]interface{}); len(notes) >
+			maxUserNotes {
+			return mcpgo.NewToolResultError(fmt.Sprintf(
+				"notes can have at most %d keys alongside the automatically "+
+					"added customer_id and reference, got %d",
+				maxUserNotes, len(notes))), nil
+		}
+
+		customerID := qrData["customer_id"].(string)
+
+		// Make sure the customer exists before linking the QR code to it
+		customer, err := client.Customer.Fetch(customerID, nil, nil)
+		if err != nil {
+			return mcpgo.NewToolResultError(
+				fmt.Sprintf("fetching customer failed: %s", err.Error())), nil
+		}
+
+		if _, exists := qrData["name"]; !exists {
+			if name, ok := customer["name"].(string); ok && name != "" {
+				qrData["name"] = name
+			}
+		}
+
+		// Tag the QR code so collections can be attributed to the customer
+		notes, _ := qrData["notes"].(map[string]interface{})
+		if notes == nil {
+			notes = make(map[string]interface{})
+		}
+		notes["customer_id"] = customerID
+		if reference, exists := params["reference"]; exists {
+			notes["reference"] = reference
+		}
+		qrData["notes"] = notes
+		qrData["type"] = "upi_qr"
+
+		qrCode, err := client.QrCode.Create(qrData, nil)
+		if err != nil {
+			return mcpgo.NewToolResultError(
+				fmt.Sprintf("creating QR code failed: %s", err.Error())), nil
+		}
+
+		qrCode["customer"] = map[string]interface{}{
+			"id":      customer["id"],
+			"name":    customer["name"],
+			"email":   customer["email"],
+			"contact": customer["contact"],
+		}
+
+		return mcpgo.NewToolResultJSON(qrCode)
+	}
+
+	return mcpgo.NewTool(
+		"create_customer_qr_code",
+		"Create a UPI QR code linked to an existing customer so that "+
+			"collections through it are attributable to that customer. "+
+			"Validates that the customer exists and returns the QR code "+
+			"along with the linked customer's details",
+		parameters,
+		handler,
+	)
+}
+
 // FetchQRCode returns a tool that fetches a specific QR code by ID
 func FetchQRCode(
 	obs *observability.Observability,
@@ -186,6 +369,152 @@ func FetchQRCode(
 	)
 }
 
+const (
+	// qrImageMaxBytes caps the size of a QR image downloaded with inline
+	qrImageMaxBytes = 2 << 20
+	// defaultQRImageMimeType is assumed when the image host doesn't send a
+	// Content-Type
+	defaultQRImageMimeType = "image/png"
+)
+
+// qrImageDownloadTimeout bounds how long an inline QR image download can
+// take before falling back to the URL
+var qrImageDownloadTimeout = 10 * time.Second
+
+// downloadQRImage downloads the image at imageURL, returning it base64
+// encoded along with its MIME type
+func downloadQRImage(
+	ctx context.Context,
+	imageURL string,
+) (string, string, error) {
+	parsedURL, err := url.Parse(imageURL)
+	if err != nil {
+		return "", "", fmt.Errorf("invalid image URL: %s", err.Error())
+	}
+	if parsedURL.Scheme != "https" && parsedURL.Scheme != "http" {
+		return "", "", fmt.Errorf("image URL must use HTTP(S)")
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, qrImageDownloadTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, imageURL, nil)
+	if err != nil {
+		return "", "", fmt.Errorf(
+			"failed to create image request: %s", err.Error())
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return "", "", fmt.Errorf("downloading image failed: %s", err.Error())
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return "", "", fmt.Errorf(
+			"downloading image failed with HTTP status: %d", resp.StatusCode)
+	}
+
+	image, err := io.ReadAll(io.LimitReader(resp.Body, qrImageMaxBytes+1))
+	if err != nil {
+		return "", "", fmt.Errorf("downloading image failed: %s", err.Error())
+	}
+	if len(image) > qrImageMaxBytes {
+		return "", "", fmt.Errorf(
+			"image is larger than %d bytes", qrImageMaxBytes)
+	}
+
+	mimeType := resp.Header.Get("Content-Type")
+	if mimeType == "" {
+		mimeType = defaultQRImageMimeType
+	}
+
+	return base64.StdEncoding.EncodeToString(image), mimeType, nil
+}
+
+// FetchQRCodeImage returns a tool that fetches the scannable image of a QR
+// code, as a URL or inline as base64
+func FetchQRCodeImage(
+	obs *observability.Observability,
+	client *rzpsdk.Client,
+) mcpgo.Tool {
+	parameters := []mcpgo.ToolParameter{
+		mcpgo.WithString(
+			"qr_code_id",
+			mcpgo.Description("Unique identifier of the QR Code whose image "+
+				"is fetched. The QR code id should start with 'qr_'"),
+			mcpgo.Required(),
+		),
+		mcpgo.WithBoolean(
+			"inline",
+			mcpgo.Description("Also download the image and return it base64 "+
+				"encoded, for clients that can't open URLs. If the download "+
+				"fails, only the URL is returned (default: false)"),
+		),
+	}
+
+	handler := func(
+		ctx context.Context,
+		r mcpgo.CallToolRequest,
+	) (*mcpgo.ToolResult, error) {
+		client, err := getClientFromContextOrDefault(ctx, client)
+		if err != nil {
+			return mcpgo.NewToolResultError(err.Error()), nil
+		}
+
+		params := make(map[string]interface{})
+		validator := NewValidator(&r).
+			ValidateAndAddRequiredString(params, "qr_code_id").
+			ValidateAndAddOptionalBool(params, "inline")
+		if result, err := validator.HandleErrorsIfAny(); result != nil {
+			return result, err
+		}
+		qrCodeID := params["qr_code_id"].(string)
+
+		qrCode, err := withRetry(ctx, func() (map[string]interface{}, error) {
+			return client.QrCode.Fetch(qrCodeID, nil, nil)
+		})
+		if err != nil {
+			return mcpgo.NewToolResultError(
+				fmt.Sprintf("fetching QR code failed: %s", err.Error())), nil
+		}
+
+		imageURL, _ := qrCode["image_url"].(string)
+		if imageURL == "" {
+			return mcpgo.NewToolResultError(
+				fmt.Sprintf("QR code %s has no image_url", qrCodeID)), nil
+		}
+
+		result := map[string]interface{}{
+			"qr_code_id": qrCodeID,
+			"status":     qrCode["status"],
+			"image_url":  imageURL,
+		}
+
+		if inline, _ := params["inline"].(bool); inline {
+			image, mimeType, err := downloadQRImage(ctx, imageURL)
+			if err != nil {
+				// The URL still works for clients that can open it
+				result["inline_error"] = err.Error()
+			} else {
+				result["image_base64"] = image
+				result["mime_type"] = mimeType
+			}
+		}
+
+		return mcpgo.NewToolResultJSON(result)
+	}
+
+	return mcpgo.NewTool(
+		"fetch_qr_code_image",
+		"Fetch the scannable image of a QR code. Returns its image_url, and "+
+			"with inline=true the image itself base64 encoded. Falls back to "+
+			"the URL with inline_error if the download fails or times out",
+		parameters,
+		handler,
+	)
+}
+
 // FetchAllQRCodes returns a tool that fetches all QR codes
 // with pagination support
 func FetchAllQRCodes(
@@ -469,6 +798,11 @@ func CloseQRCode(
 			),
 			mcpgo.Required(),
 		),
+		mcpgo.WithBoolean(
+			"include_payment_total",
+			mcpgo.Description("Also return the total captured on the QR "+
+				"code, summed over its payments (default: false)"),
+		),
 	}
 
 	handler := func(
@@ -482,12 +816,37 @@ func CloseQRCode(
 
 		params := make(map[string]interface{})
 		validator := NewValidator(&r).
-			ValidateAndAddRequiredString(params, "qr_code_id")
+			ValidateAndAddRequiredString(params, "qr_code_id").
+			ValidateAndAddOptionalBool(params, "include_payment_total")
 		if result, err := validator.HandleErrorsIfAny(); result != nil {
 			return result, err
 		}
 		qrCodeID := params["qr_code_id"].(string)
 
+		// Closing a closed QR code fails with an unhelpful API error, so
+		// check its status first
+		existing, err := client.QrCode.Fetch(qrCodeID, nil, nil)
+		if err != nil {
+			return mcpgo.NewToolResultError(
+				fmt.Sprintf("fetching QR code failed: %s", err.Error())), nil
+		}
+		if existing["status"] == "closed" {
+			return mcpgo.NewToolResultError(fmt.Sprintf(
+				"QR code %s is already closed (close_reason: %v)",
+				qrCodeID, existing["close_reason"])), nil
+		}
+
+		// The total is taken before closing, so a failure here leaves the
+		// QR code open
+		var paymentTotal map[string]interface{}
+		if include, _ := params["include_payment_total"].(bool); include {
+			paymentTotal, err = qrCodePaymentTotal(client, qrCodeID)
+			if err != nil {
+				return mcpgo.NewToolResultError(fmt.Sprintf(
+					"fetching payments for QR code failed: %s", err.Error())), nil
+			}
+		}
+
 		// Close QR code by ID using Razorpay SDK
 		qrCode, err := client.QrCode.Close(qrCodeID, nil, nil)
 		if err != nil {
@@ -495,13 +854,65 @@ func CloseQRCode(
 				fmt.Sprintf("closing QR code failed: %s", err.Error())), nil
 		}
 
-		return mcpgo.NewToolResultJSON(qrCode)
+		if paymentTotal == nil {
+			return mcpgo.NewToolResultJSON(qrCode)
+		}
+		return mcpgo.NewToolResultJSON(map[string]interface{}{
+			"qr_code":       qrCode,
+			"payment_total": paymentTotal,
+		})
 	}
 
 	return mcpgo.NewTool(
 		"close_qr_code",
-		"Close a QR Code that's no longer needed",
+		"Close a QR Code that's no longer needed. Fails with a clear error "+
+			"if it's already closed. With include_payment_total, also "+
+			"returns the amount captured on it before closing",
 		parameters,
 		handler,
 	)
 }
+
+// qrCodePaymentTotal sums the captured payments made on a QR code
+func qrCodePaymentTotal(
+	client *rzpsdk.Client,
+	qrCodeID string,
+) (map[string]interface{}, error) {
+	fetch := func(
+		queryParams map[string]interface{},
+		extraHeaders map[string]string,
+	) (map[string]interface{}, error) {
+		return client.QrCode.FetchPayments(qrCodeID, queryParams, extraHeaders)
+	}
+
+	payments, err := collectAllPages(
+		fetch, map[string]interface{}{}, maxAutoPaginationRecords)
+	if err != nil {
+		return nil, err
+	}
+
+	var amountCaptured float64
+	capturedCount := 0
+	currency := ""
+	items, _ := payments["items"].([]interface{})
+	for _, item := range items {
+		payment, ok := item.(map[string]interface{})
+		if !ok || payment["status"] != "captured" {
+			continue
+		}
+		amount, _ := payment["amount"].(float64)
+		amountCaptured += amount
+		capturedCount++
+		if c, ok := payment["currency"].(string); ok && currency == "" {
+			currency = c
+		}
+	}
+
+	return map[string]interface{}{
+		"amount_captured": amountCaptured,
+		"captured_count":  capturedCount,
+		"payments_count":  len(items),
+		"currency":        currency,
+		"truncated":       payments["truncated"],
+	}, nil
+}
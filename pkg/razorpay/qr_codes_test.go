@@ -1,10 +1,12 @@
 package razorpay
 
 import (
+	"encoding/base64"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/razorpay/razorpay-go/constants"
 
@@ -207,6 +209,170 @@ func Test_CreateQRCode(t *testing.T) {
 	}
 }
 
+func Test_CreateCustomerQRCode(t *testing.T) {
+	customerID := "cust_HKsR5se84c5LTO"
+	fetchCustomerPath := fmt.Sprintf(
+		"/%s%s/%s",
+		constants.VERSION_V1,
+		constants.CUSTOMER_URL,
+		customerID,
+	)
+	createQRCodePath := fmt.Sprintf(
+		"/%s%s",
+		constants.VERSION_V1,
+		constants.QRCODE_URL,
+	)
+
+	customerResp := map[string]interface{}{
+		"id":      customerID,
+		"entity":  "customer",
+		"name":    "Gaurav Kumar",
+		"email":   "gaurav.kumar@example.com",
+		"contact": "9000090000",
+	}
+
+	qrCodeResp := map[string]interface{}{
+		"id":          "qr_HMsVL8HOpbMcjU",
+		"entity":      "qr_code",
+		"name":        "Gaurav Kumar",
+		"usage":       "multiple_use",
+		"type":        "upi_qr",
+		"image_url":   "https://rzp.io/i/BWcUVrLp",
+		"status":      "active",
+		"customer_id": customerID,
+		"close_by":    float64(1681615838),
+		"notes": map[string]interface{}{
+			"customer_id": customerID,
+			"reference":   "sub_00000000000001",
+		},
+	}
+
+	expectedQRCode := map[string]interface{}{
+		"customer": map[string]interface{}{
+			"id":      customerID,
+			"name":    "Gaurav Kumar",
+			"email":   "gaurav.kumar@example.com",
+			"contact": "9000090000",
+		},
+	}
+	for k, v := range qrCodeResp {
+		expectedQRCode[k] = v
+	}
+
+	customerNotFoundResp := map[string]interface{}{
+		"error": map[string]interface{}{
+			"code":        "BAD_REQUEST_ERROR",
+			"description": "The id provided does not exist",
+		},
+	}
+
+	notesOfSize := func(n int) map[string]interface{} {
+		notes := make(map[string]interface{}, n)
+		for i := 0; i < n; i++ {
+			notes[fmt.Sprintf("key_%d", i)] = "value"
+		}
+		return notes
+	}
+
+	tests := []RazorpayToolTestCase{
+		{
+			Name: "successful customer QR code creation",
+			Request: map[string]interface{}{
+				"customer_id": customerID,
+				"usage":       "multiple_use",
+				"reference":   "sub_00000000000001",
+				"close_by":    float64(1681615838),
+			},
+			MockHttpClient: func() (*http.Client, *httptest.Server) {
+				return mock.NewHTTPClient(
+					mock.Endpoint{
+						Path:     fetchCustomerPath,
+						Method:   "GET",
+						Response: customerResp,
+					},
+					mock.Endpoint{
+						Path:     createQRCodePath,
+						Method:   "POST",
+						Response: qrCodeResp,
+					},
+				)
+			},
+			ExpectError:    false,
+			ExpectedResult: expectedQRCode,
+		},
+		{
+			Name: "customer does not exist",
+			Request: map[string]interface{}{
+				"customer_id": customerID,
+				"usage":       "multiple_use",
+			},
+			MockHttpClient: func() (*http.Client, *httptest.Server) {
+				return mock.NewHTTPClient(
+					mock.Endpoint{
+						Path:     fetchCustomerPath,
+						Method:   "GET",
+						Response: customerNotFoundResp,
+					},
+				)
+			},
+			ExpectError: true,
+			ExpectedErrMsg: "fetching customer failed: " +
+				"The id provided does not exist",
+		},
+		{
+			Name: "missing customer_id",
+			Request: map[string]interface{}{
+				"usage": "multiple_use",
+			},
+			MockHttpClient: nil,
+			ExpectError:    true,
+			ExpectedErrMsg: "missing required parameter: customer_id",
+		},
+		{
+			Name: "fixed amount without payment amount",
+			Request: map[string]interface{}{
+				"customer_id":  customerID,
+				"usage":        "single_use",
+				"fixed_amount": true,
+			},
+			MockHttpClient: nil,
+			ExpectError:    true,
+			ExpectedErrMsg: "payment_amount is required when fixed_amount is true",
+		},
+		{
+			Name: "too many notes with a reference",
+			Request: map[string]interface{}{
+				"customer_id": customerID,
+				"usage":       "multiple_use",
+				"reference":   "invoice_1001",
+				"notes":       notesOfSize(14),
+			},
+			MockHttpClient: nil,
+			ExpectError:    true,
+			ExpectedErrMsg: "notes can have at most 13 keys alongside the " +
+				"automatically added customer_id and reference, got 14",
+		},
+		{
+			Name: "too many notes without a reference",
+			Request: map[string]interface{}{
+				"customer_id": customerID,
+				"usage":       "multiple_use",
+				"notes":       notesOfSize(15),
+			},
+			MockHttpClient: nil,
+			ExpectError:    true,
+			ExpectedErrMsg: "notes can have at most 14 keys alongside the " +
+				"automatically added customer_id and reference, got 15",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.Name, func(t *testing.T) {
+			runToolTest(t, tc, CreateCustomerQRCode, "Customer QR Code")
+		})
+	}
+}
+
 func Test_FetchAllQRCodes(t *testing.T) {
 	qrCodesPath := fmt.Sprintf(
 		"/%s%s",
@@ -690,6 +856,144 @@ func TestFetchQRCode(t *testing.T) {
 	}
 }
 
+func TestFetchQRCodeImage(t *testing.T) {
+	defer func(timeout time.Duration) {
+		qrImageDownloadTimeout = timeout
+	}(qrImageDownloadTimeout)
+	qrImageDownloadTimeout = 50 * time.Millisecond
+
+	png := []byte("\x89PNG\r\n\x1a\nqr")
+	imageServer := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			switch r.URL.Path {
+			case "/qr.png":
+				w.Header().Set("Content-Type", "image/png")
+				_, _ = w.Write(png)
+			case "/slow.png":
+				time.Sleep(200 * time.Millisecond)
+				_, _ = w.Write(png)
+			default:
+				w.WriteHeader(http.StatusNotFound)
+			}
+		}))
+	defer imageServer.Close()
+
+	qrID := "qr_FuZIYx6rMbP6gs"
+	apiPath := fmt.Sprintf(
+		"/%s%s/%s",
+		constants.VERSION_V1,
+		constants.QRCODE_URL,
+		qrID,
+	)
+
+	qrCodeWithImage := func(imageURL string) map[string]interface{} {
+		return map[string]interface{}{
+			"id":        qrID,
+			"entity":    "qr_code",
+			"status":    "active",
+			"image_url": imageURL,
+		}
+	}
+	mockQRCode := func(
+		qrCode map[string]interface{},
+	) func() (*http.Client, *httptest.Server) {
+		return func() (*http.Client, *httptest.Server) {
+			return mock.NewHTTPClient(
+				mock.Endpoint{
+					Path:     apiPath,
+					Method:   "GET",
+					Response: qrCode,
+				},
+			)
+		}
+	}
+
+	tests := []RazorpayToolTestCase{
+		{
+			Name: "image URL only",
+			Request: map[string]interface{}{
+				"qr_code_id": qrID,
+			},
+			MockHttpClient: mockQRCode(
+				qrCodeWithImage("https://rzp.io/i/oCswTOcCo")),
+			ExpectedResult: map[string]interface{}{
+				"qr_code_id": qrID,
+				"status":     "active",
+				"image_url":  "https://rzp.io/i/oCswTOcCo",
+			},
+		},
+		{
+			Name: "inline image",
+			Request: map[string]interface{}{
+				"qr_code_id": qrID,
+				"inline":     true,
+			},
+			MockHttpClient: mockQRCode(
+				qrCodeWithImage(imageServer.URL + "/qr.png")),
+			ExpectedResult: map[string]interface{}{
+				"qr_code_id":   qrID,
+				"status":       "active",
+				"image_url":    imageServer.URL + "/qr.png",
+				"image_base64": base64.StdEncoding.EncodeToString(png),
+				"mime_type":    "image/png",
+			},
+		},
+		{
+			Name: "inline download times out",
+			Request: map[string]interface{}{
+				"qr_code_id": qrID,
+				"inline":     true,
+			},
+			MockHttpClient: mockQRCode(
+				qrCodeWithImage(imageServer.URL + "/slow.png")),
+			ExpectedResult: map[string]interface{}{
+				"qr_code_id": qrID,
+				"status":     "active",
+				"image_url":  imageServer.URL + "/slow.png",
+				"inline_error": "downloading image failed: Get \"" +
+					imageServer.URL + "/slow.png\": context deadline exceeded",
+			},
+		},
+		{
+			Name: "inline download fails",
+			Request: map[string]interface{}{
+				"qr_code_id": qrID,
+				"inline":     true,
+			},
+			MockHttpClient: mockQRCode(
+				qrCodeWithImage(imageServer.URL + "/missing.png")),
+			ExpectedResult: map[string]interface{}{
+				"qr_code_id": qrID,
+				"status":     "active",
+				"image_url":  imageServer.URL + "/missing.png",
+				"inline_error": "downloading image failed with HTTP " +
+					"status: 404",
+			},
+		},
+		{
+			Name: "QR code without image",
+			Request: map[string]interface{}{
+				"qr_code_id": qrID,
+			},
+			MockHttpClient: mockQRCode(qrCodeWithImage("")),
+			ExpectError:    true,
+			ExpectedErrMsg: "QR code qr_FuZIYx6rMbP6gs has no image_url",
+		},
+		{
+			Name:           "missing required qr_code_id parameter",
+			Request:        map[string]interface{}{},
+			ExpectError:    true,
+			ExpectedErrMsg: "missing required parameter: qr_code_id",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.Name, func(t *testing.T) {
+			runToolTest(t, tc, FetchQRCodeImage, "QR Code Image")
+		})
+	}
+}
+
 func TestFetchPaymentsForQRCode(t *testing.T) {
 	apiPath := "/" + constants.VERSION_V1 +
 		constants.QRCODE_URL + "/qr_test123/payments"
@@ -824,9 +1128,42 @@ func TestCloseQRCode(t *testing.T) {
 		"close_reason": "on_demand",
 	}
 
+	activeResponse := map[string]interface{}{
+		"id":     "qr_HMsVL8HOpbMcjU",
+		"entity": "qr_code",
+		"status": "active",
+	}
+
+	paymentsResponse := map[string]interface{}{
+		"entity": "collection",
+		"count":  float64(3),
+		"items": []interface{}{
+			map[string]interface{}{
+				"id":       "pay_1",
+				"amount":   float64(300),
+				"currency": "INR",
+				"status":   "captured",
+			},
+			map[string]interface{}{
+				"id":       "pay_2",
+				"amount":   float64(500),
+				"currency": "INR",
+				"status":   "failed",
+			},
+			map[string]interface{}{
+				"id":       "pay_3",
+				"amount":   float64(200),
+				"currency": "INR",
+				"status":   "captured",
+			},
+		},
+	}
+
 	baseAPIPath := fmt.Sprintf("/%s%s", constants.VERSION_V1, constants.QRCODE_URL)
 	qrCodeID := "qr_HMsVL8HOpbMcjU"
 	apiPath := fmt.Sprintf("%s/%s/close", baseAPIPath, qrCodeID)
+	fetchPath := fmt.Sprintf("%s/%s", baseAPIPath, qrCodeID)
+	paymentsPath := fmt.Sprintf("%s/%s/payments", baseAPIPath, qrCodeID)
 
 	tests := []RazorpayToolTestCase{
 		{
@@ -836,6 +1173,11 @@ func TestCloseQRCode(t *testing.T) {
 			},
 			MockHttpClient: func() (*http.Client, *httptest.Server) {
 				return mock.NewHTTPClient(
+					mock.Endpoint{
+						Path:     fetchPath,
+						Method:   "GET",
+						Response: activeResponse,
+					},
 					mock.Endpoint{
 						Path:     apiPath,
 						Method:   "POST",
@@ -846,6 +1188,84 @@ func TestCloseQRCode(t *testing.T) {
 			ExpectError:    false,
 			ExpectedResult: successResponse,
 		},
+		{
+			Name: "close with payment total",
+			Request: map[string]interface{}{
+				"qr_code_id":            qrCodeID,
+				"include_payment_total": true,
+			},
+			MockHttpClient: func() (*http.Client, *httptest.Server) {
+				return mock.NewHTTPClient(
+					mock.Endpoint{
+						Path:     fetchPath,
+						Method:   "GET",
+						Response: activeResponse,
+					},
+					mock.Endpoint{
+						Path:     paymentsPath,
+						Method:   "GET",
+						Response: paymentsResponse,
+					},
+					mock.Endpoint{
+						Path:     apiPath,
+						Method:   "POST",
+						Response: successResponse,
+					},
+				)
+			},
+			ExpectError: false,
+			ExpectedResult: map[string]interface{}{
+				"qr_code": successResponse,
+				"payment_total": map[string]interface{}{
+					"amount_captured": float64(500),
+					"captured_count":  float64(2),
+					"payments_count":  float64(3),
+					"currency":        "INR",
+					"truncated":       false,
+				},
+			},
+		},
+		{
+			Name: "QR code already closed",
+			Request: map[string]interface{}{
+				"qr_code_id": qrCodeID,
+			},
+			MockHttpClient: func() (*http.Client, *httptest.Server) {
+				return mock.NewHTTPClient(
+					mock.Endpoint{
+						Path:     fetchPath,
+						Method:   "GET",
+						Response: successResponse,
+					},
+				)
+			},
+			ExpectError: true,
+			ExpectedErrMsg: "QR code qr_HMsVL8HOpbMcjU is already closed " +
+				"(close_reason: on_demand)",
+		},
+		{
+			Name: "QR code not found",
+			Request: map[string]interface{}{
+				"qr_code_id": qrCodeID,
+			},
+			MockHttpClient: func() (*http.Client, *httptest.Server) {
+				return mock.NewHTTPClient(
+					mock.Endpoint{
+						Path:   fetchPath,
+						Method: "GET",
+						Response: map[string]interface{}{
+							"error": map[string]interface{}{
+								"code":        "BAD_REQUEST_ERROR",
+								"description": "The id provided does not exist",
+							},
+						},
+					},
+				)
+			},
+			ExpectError: true,
+			ExpectedErrMsg: "fetching QR code failed: " +
+				"The id provided does not exist",
+		},
 		{
 			Name:           "missing required qr_code_id parameter",
 			Request:        map[string]interface{}{},
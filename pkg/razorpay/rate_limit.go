@@ -0,0 +1,259 @@
+package razorpay
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	rzpsdk "github.com/razorpay/razorpay-go"
+
+	"github.com/razorpay/razorpay-mcp-server/pkg/mcpgo"
+	"github.com/razorpay/razorpay-mcp-server/pkg/observability"
+)
+
+// lowRateLimitHeadroom is the fraction of the limit below which the
+// remaining requests are reported as low
+const lowRateLimitHeadroom = 0.1
+
+// rateLimitHeaders maps the reported fields to the response headers that
+// carry them
+var rateLimitHeaders = []struct {
+	field  string
+	header string
+}{
+	{"limit", "X-RateLimit-Limit"},
+	{"remaining", "X-RateLimit-Remaining"},
+	{"reset", "X-RateLimit-Reset"},
+	{"retry_after", "Retry-After"},
+}
+
+// rateLimitSnapshot is what was seen on the most recent API response
+type rateLimitSnapshot struct {
+	observedAt time.Time
+	method     string
+	path       string
+	statusCode int
+	headers    map[string]string
+}
+
+// rateLimitTransport records the rate-limit headers of every response the
+// SDK receives, so the headroom can be reported without another request
+type rateLimitTransport struct {
+	base http.RoundTripper
+
+	mu        sync.Mutex
+	last      *rateLimitSnapshot
+	throttled int
+}
+
+// RoundTrip implements http.RoundTripper
+func (t *rateLimitTransport) RoundTrip(
+	req *http.Request,
+) (*http.Response, error) {
+	base := t.base
+	if base == nil {
+		base = http.DefaultTransport
+	}
+
+	resp, err := base.RoundTrip(req)
+	if err != nil {
+		return resp, err
+	}
+
+	snapshot := &rateLimitSnapshot{
+		observedAt: time.Now().UTC(),
+		method:     req.Method,
+		path:       req.URL.Path,
+		statusCode: resp.StatusCode,
+		headers:    make(map[string]string),
+	}
+	for _, h := range rateLimitHeaders {
+		if v := resp.Header.Get(h.header); v != "" {
+			snapshot.headers[h.field] = v
+		}
+	}
+
+	t.mu.Lock()
+	t.last = snapshot
+	if resp.StatusCode == http.StatusTooManyRequests {
+		t.throttled++
+	}
+	t.mu.Unlock()
+
+	return resp, nil
+}
+
+// status returns the last snapshot and the number of 429s seen so far
+func (t *rateLimitTransport) status() (*rateLimitSnapshot, int) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.last, t.throttled
+}
+
+// exhausted reports whether the most recent response said no requests are
+// left in the current window
+func (t *rateLimitTransport) exhausted() bool {
+	last, _ := t.status()
+	if last == nil {
+		return false
+	}
+	if last.statusCode == http.StatusTooManyRequests {
+		return true
+	}
+	remaining, err := strconv.ParseInt(last.headers["remaining"], 10, 64)
+	return err == nil && remaining <= 0
+}
+
+var rateLimitInstallMu sync.Mutex
+
+// trackRateLimits installs a rateLimitTransport on the client's HTTP client,
+// unless one is already there, and returns it. The SDK shares one Request
+// object across all resources, so this covers every API call.
+func trackRateLimits(client *rzpsdk.Client) *rateLimitTransport {
+	rateLimitInstallMu.Lock()
+	defer rateLimitInstallMu.Unlock()
+
+	req := client.Order.Request
+	if req.HTTPClient == nil {
+		req.HTTPClient = &http.Client{}
+	}
+	if t, ok := req.HTTPClient.Transport.(*rateLimitTransport); ok {
+		return t
+	}
+
+	t := &rateLimitTransport{base: req.HTTPClient.Transport}
+	req.HTTPClient.Transport = t
+	return t
+}
+
+// FetchRateLimitStatus returns a tool that reports the rate-limit headroom
+// seen on the most recent Razorpay API response
+func FetchRateLimitStatus(
+	obs *observability.Observability,
+	client *rzpsdk.Client,
+) mcpgo.Tool {
+	parameters := []mcpgo.ToolParameter{
+		mcpgo.WithBoolean(
+			"probe",
+			mcpgo.Description("Make one cheap request (fetch a single order) "+
+				"first, so the status reflects the current window instead of "+
+				"the last tool call (default: false)"),
+		),
+	}
+
+	handler := func(
+		ctx context.Context,
+		r mcpgo.CallToolRequest,
+	) (*mcpgo.ToolResult, error) {
+		client, err := getClientFromContextOrDefault(ctx, client)
+		if err != nil {
+			return mcpgo.NewToolResultError(err.Error()), nil
+		}
+
+		params := make(map[string]interface{})
+
+		validator := NewValidator(&r).
+			ValidateAndAddOptionalBool(params, "probe")
+
+		if result, err := validator.HandleErrorsIfAny(); result != nil {
+			return result, err
+		}
+
+		tracker := trackRateLimits(client)
+
+		if probe, _ := params["probe"].(bool); probe {
+			_, probeErr := client.Order.All(
+				map[string]interface{}{"count": 1}, nil)
+			if last, _ := tracker.status(); last == nil && probeErr != nil {
+				return mcpgo.NewToolResultError(fmt.Sprintf(
+					"probing rate limit failed: %s", probeErr.Error())), nil
+			}
+		}
+
+		last, throttled := tracker.status()
+		return mcpgo.NewToolResultJSON(
+			buildRateLimitStatus(last, throttled))
+	}
+
+	return mcpgo.NewTool(
+		"fetch_rate_limit_status",
+		"Report the remaining Razorpay API requests from the rate-limit "+
+			"headers of the most recent response, and how many requests "+
+			"were throttled (HTTP 429) so far. Use this before a large "+
+			"pagination or bulk job to pace the requests",
+		parameters,
+		handler,
+	)
+}
+
+// buildRateLimitStatus turns a snapshot into the tool's response, with a
+// headroom of ok, low, exhausted or unknown and a recommendation
+func buildRateLimitStatus(
+	last *rateLimitSnapshot,
+	throttled int,
+) map[string]interface{} {
+	result := map[string]interface{}{
+		"observed":        last != nil,
+		"throttled_count": throttled,
+	}
+
+	if last == nil {
+		result["headroom"] = "unknown"
+		result["recommendation"] = "No Razorpay API response has been " +
+			"seen yet. Call this tool with probe set to true, or after any " +
+			"other Razorpay tool, to see the headroom"
+		return result
+	}
+
+	result["last_response"] = map[string]interface{}{
+		"observed_at": last.observedAt.Format(time.RFC3339),
+		"method":      last.method,
+		"path":        last.path,
+		"status_code": last.statusCode,
+	}
+
+	for _, h := range rateLimitHeaders {
+		v, ok := last.headers[h.field]
+		if !ok {
+			continue
+		}
+		if n, err := strconv.ParseInt(v, 10, 64); err == nil {
+			result[h.field] = n
+		} else {
+			result[h.field] = v
+		}
+	}
+
+	limit, hasLimit := result["limit"].(int64)
+	remaining, hasRemaining := result["remaining"].(int64)
+
+	switch {
+	case last.statusCode == http.StatusTooManyRequests ||
+		(hasRemaining && remaining <= 0):
+		result["headroom"] = "exhausted"
+		result["recommendation"] = "The rate limit is used up. Wait for " +
+			"the window to reset (see retry_after or reset) before " +
+			"sending more requests"
+	case hasRemaining && hasLimit && limit > 0 &&
+		float64(remaining) < float64(limit)*lowRateLimitHeadroom:
+		result["headroom"] = "low"
+		result["recommendation"] = fmt.Sprintf("Only %d of %d requests "+
+			"are left in this window. Pause until it resets before "+
+			"starting a bulk job", remaining, limit)
+	case hasRemaining:
+		result["headroom"] = "ok"
+		result["recommendation"] = fmt.Sprintf("%d requests are left in "+
+			"this window. Keep a large job below that, or check again "+
+			"between pages", remaining)
+	default:
+		result["headroom"] = "unknown"
+		result["recommendation"] = "The last response had no rate-limit " +
+			"headers. Pace bulk jobs conservatively and back off on any " +
+			"HTTP 429"
+	}
+
+	return result
+}
@@ -0,0 +1,208 @@
+package razorpay
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/razorpay/razorpay-go/constants"
+)
+
+// newRateLimitServer returns a server that answers every request with the
+// given status and rate-limit headers
+func newRateLimitServer(
+	status int,
+	headers map[string]string,
+) func() (*http.Client, *httptest.Server) {
+	return func() (*http.Client, *httptest.Server) {
+		server := httptest.NewServer(http.HandlerFunc(
+			func(w http.ResponseWriter, r *http.Request) {
+				for k, v := range headers {
+					w.Header().Set(k, v)
+				}
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(status)
+				if status == http.StatusOK {
+					_, _ = w.Write([]byte(`{"entity":"collection","items":[]}`))
+					return
+				}
+				_, _ = w.Write([]byte(`{"error":{"code":"BAD_REQUEST_ERROR",` +
+					`"description":"Too many requests"}}`))
+			}))
+		return server.Client(), server
+	}
+}
+
+func Test_FetchRateLimitStatus(t *testing.T) {
+	ordersPath := fmt.Sprintf("/%s%s", constants.VERSION_V1, constants.ORDER_URL)
+
+	tests := []RazorpayToolTestCase{
+		{
+			Name:           "nothing observed without a probe",
+			Request:        map[string]interface{}{},
+			MockHttpClient: newRateLimitServer(http.StatusOK, nil),
+			ExpectError:    false,
+			ExpectedResult: map[string]interface{}{
+				"observed":        false,
+				"throttled_count": float64(0),
+				"headroom":        "unknown",
+				"recommendation": "No Razorpay API response has been " +
+					"seen yet. Call this tool with probe set to true, or " +
+					"after any other Razorpay tool, to see the headroom",
+			},
+		},
+		{
+			Name:    "low headroom",
+			Request: map[string]interface{}{"probe": true},
+			MockHttpClient: newRateLimitServer(http.StatusOK, map[string]string{
+				"X-RateLimit-Limit":     "100",
+				"X-RateLimit-Remaining": "5",
+				"X-RateLimit-Reset":     "1700000000",
+			}),
+			ExpectError: false,
+			ExpectedResult: map[string]interface{}{
+				"observed":        true,
+				"throttled_count": float64(0),
+				"limit":           float64(100),
+				"remaining":       float64(5),
+				"reset":           float64(1700000000),
+				"headroom":        "low",
+				"recommendation": "Only 5 of 100 requests are left in " +
+					"this window. Pause until it resets before starting " +
+					"a bulk job",
+			},
+		},
+		{
+			Name:    "throttled",
+			Request: map[string]interface{}{"probe": true},
+			MockHttpClient: newRateLimitServer(http.StatusTooManyRequests,
+				map[string]string{"Retry-After": "30"}),
+			ExpectError: false,
+			ExpectedResult: map[string]interface{}{
+				"observed":        true,
+				"throttled_count": float64(1),
+				"retry_after":     float64(30),
+				"headroom":        "exhausted",
+				"recommendation": "The rate limit is used up. Wait for " +
+					"the window to reset (see retry_after or reset) " +
+					"before sending more requests",
+			},
+		},
+		{
+			Name:           "no rate-limit headers",
+			Request:        map[string]interface{}{"probe": true},
+			MockHttpClient: newRateLimitServer(http.StatusOK, nil),
+			ExpectError:    false,
+			ExpectedResult: map[string]interface{}{
+				"observed":        true,
+				"throttled_count": float64(0),
+				"headroom":        "unknown",
+				"recommendation": "The last response had no rate-limit " +
+					"headers. Pace bulk jobs conservatively and back off " +
+					"on any HTTP 429",
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.Name, func(t *testing.T) {
+			mockClient, server := newMockRzpClient(tc.MockHttpClient)
+			defer server.Close()
+
+			tool := FetchRateLimitStatus(CreateTestObservability(), mockClient)
+			result, err := tool.GetHandler()(
+				context.Background(), createMCPRequest(tc.Request))
+			assert.NoError(t, err)
+
+			var got map[string]interface{}
+			assert.NoError(t, json.Unmarshal([]byte(result.Text), &got))
+
+			// last_response carries a timestamp, so check it on its own
+			if last, ok := got["last_response"].(map[string]interface{}); ok {
+				assert.Equal(t, "GET", last["method"])
+				assert.Equal(t, ordersPath, last["path"])
+				assert.NotEmpty(t, last["observed_at"])
+				delete(got, "last_response")
+			} else {
+				assert.Equal(t, false, got["observed"])
+			}
+
+			assert.Equal(t, tc.ExpectedResult, got)
+		})
+	}
+}
+
+func Test_trackRateLimits(t *testing.T) {
+	mockClient, server := newMockRzpClient(
+		newRateLimitServer(http.StatusOK, map[string]string{
+			"X-RateLimit-Remaining": "42",
+		}))
+	defer server.Close()
+
+	tracker := trackRateLimits(mockClient)
+	assert.Same(t, tracker, trackRateLimits(mockClient),
+		"installing twice must reuse the transport")
+
+	// Every resource shares the request, so any call is recorded
+	_, err := mockClient.Payment.All(nil, nil)
+	assert.NoError(t, err)
+
+	last, throttled := tracker.status()
+	if assert.NotNil(t, last) {
+		assert.Equal(t, "42", last.headers["remaining"])
+		assert.Equal(t, http.StatusOK, last.statusCode)
+	}
+	assert.Equal(t, 0, throttled)
+}
+
+func Test_rateLimitTransport_exhausted(t *testing.T) {
+	tests := []struct {
+		name    string
+		status  int
+		headers map[string]string
+		want    bool
+	}{
+		{
+			name:    "requests left",
+			status:  http.StatusOK,
+			headers: map[string]string{"X-RateLimit-Remaining": "3"},
+			want:    false,
+		},
+		{
+			name:    "no requests left",
+			status:  http.StatusOK,
+			headers: map[string]string{"X-RateLimit-Remaining": "0"},
+			want:    true,
+		},
+		{
+			name:   "throttled",
+			status: http.StatusTooManyRequests,
+			want:   true,
+		},
+		{
+			name:   "no rate-limit headers",
+			status: http.StatusOK,
+			want:   false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockClient, server := newMockRzpClient(
+				newRateLimitServer(tt.status, tt.headers))
+			defer server.Close()
+
+			tracker := trackRateLimits(mockClient)
+			assert.False(t, tracker.exhausted(),
+				"nothing is exhausted before the first response")
+
+			_, _ = mockClient.Order.All(nil, nil)
+			assert.Equal(t, tt.want, tracker.exhausted())
+		})
+	}
+}
@@ -3,6 +3,7 @@ package razorpay
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	rzpsdk "github.com/razorpay/razorpay-go"
 
@@ -33,18 +34,23 @@ func CreateRefund(
 			"speed",
 			mcpgo.Description("The speed at which the refund is to be "+
 				"processed. Default is 'normal'. For instant refunds, speed "+
-				"is set as 'optimum'."),
+				"is set as 'optimum'; check instant in the response to see "+
+				"whether it was processed instantly."),
+			mcpgo.Enum("normal", "optimum"),
 		),
 		mcpgo.WithObject(
 			"notes",
 			mcpgo.Description("Key-value pairs used to store additional "+
-				"information. A maximum of 15 key-value pairs can be included."),
+				"information. A maximum of 15 key-value pairs can be included. "+
+				"Values must be strings; numbers and booleans are converted "+
+				"to strings."),
 		),
 		mcpgo.WithString(
 			"receipt",
 			mcpgo.Description("A unique identifier provided by you for "+
 				"your internal reference."),
 		),
+		idempotencyKeyParameter("refund"),
 	}
 
 	handler := func(
@@ -65,20 +71,36 @@ func CreateRefund(
 			ValidateAndAddRequiredFloat(payload, "amount").
 			ValidateAndAddOptionalString(data, "speed").
 			ValidateAndAddOptionalString(data, "receipt").
-			ValidateAndAddOptionalMap(data, "notes")
+			ValidateAndAddOptionalNotes(data, "notes").
+			ValidateAndAddOptionalString(payload, "idempotency_key")
 
 		if result, err := validator.HandleErrorsIfAny(); result != nil {
 			return result, err
 		}
 
+		switch speed, ok := data["speed"]; {
+		case !ok:
+			data["speed"] = "normal"
+		case speed != "normal" && speed != "optimum":
+			return mcpgo.NewToolResultError(
+				"speed must be one of: normal, optimum"), nil
+		}
+
 		refund, err := client.Payment.Refund(
 			payload["payment_id"].(string),
-			int(payload["amount"].(float64)), data, nil)
+			int(payload["amount"].(float64)), data,
+			idempotencyHeaders(payload, razorpayIdempotencyHeader))
 		if err != nil {
 			return mcpgo.NewToolResultError(
 				fmt.Sprintf("creating refund failed: %s", err.Error())), nil
 		}
 
+		// An optimum refund falls back to normal speed when the payment
+		// method doesn't support instant refunds
+		if speedProcessed, ok := refund["speed_processed"]; ok {
+			refund["instant"] = speedProcessed == "instant"
+		}
+
 		return mcpgo.NewToolResultJSON(refund)
 	}
 
@@ -126,7 +148,9 @@ func FetchRefund(
 			return result, err
 		}
 
-		refund, err := client.Refund.Fetch(payload["refund_id"].(string), nil, nil)
+		refund, err := withRetry(ctx, func() (map[string]interface{}, error) {
+			return client.Refund.Fetch(payload["refund_id"].(string), nil, nil)
+		})
 		if err != nil {
 			return mcpgo.NewToolResultError(
 				fmt.Sprintf("fetching refund failed: %s", err.Error())), nil
@@ -179,7 +203,7 @@ func UpdateRefund(
 
 		validator := NewValidator(&r).
 			ValidateAndAddRequiredString(payload, "refund_id").
-			ValidateAndAddRequiredMap(data, "notes")
+			ValidateAndAddRequiredNotes(data, "notes")
 
 		if result, err := validator.HandleErrorsIfAny(); result != nil {
 			return result, err
@@ -256,8 +280,10 @@ func FetchMultipleRefundsForPayment(
 			return result, err
 		}
 
-		refunds, err := client.Payment.FetchMultipleRefund(
-			fetchReq["payment_id"].(string), fetchOptions, nil)
+		refunds, err := withRetry(ctx, func() (map[string]interface{}, error) {
+			return client.Payment.FetchMultipleRefund(
+				fetchReq["payment_id"].(string), fetchOptions, nil)
+		})
 		if err != nil {
 			return mcpgo.NewToolResultError(
 				fmt.Sprintf("fetching multiple refunds failed: %s",
@@ -316,10 +342,12 @@ func FetchSpecificRefundForPayment(
 			return result, err
 		}
 
-		refund, err := client.Payment.FetchRefund(
-			params["payment_id"].(string),
-			params["refund_id"].(string),
-			nil, nil)
+		refund, err := withRetry(ctx, func() (map[string]interface{}, error) {
+			return client.Payment.FetchRefund(
+				params["payment_id"].(string),
+				params["refund_id"].(string),
+				nil, nil)
+		})
 		if err != nil {
 			return mcpgo.NewToolResultError(
 				fmt.Sprintf("fetching specific refund for payment failed: %s",
@@ -362,6 +390,7 @@ func FetchAllRefunds(
 			mcpgo.Description("The number of refunds to be skipped"),
 		),
 	}
+	parameters = append(parameters, autoPaginationParameters("refunds")...)
 
 	handler := func(
 		ctx context.Context,
@@ -373,17 +402,35 @@ func FetchAllRefunds(
 		}
 
 		queryParams := make(map[string]interface{})
+		paginationOptions := make(map[string]interface{})
 
 		validator := NewValidator(&r).
 			ValidateAndAddOptionalInt(queryParams, "from").
 			ValidateAndAddOptionalInt(queryParams, "to").
 			ValidateAndAddPagination(queryParams)
+		validator = validateAndAddAutoPagination(validator, paginationOptions)
 
 		if result, err := validator.HandleErrorsIfAny(); result != nil {
 			return result, err
 		}
 
-		refunds, err := client.Refund.All(queryParams, nil)
+		fetchAll, maxRecords, err := fetchAllRequested(paginationOptions)
+		if err != nil {
+			return mcpgo.NewToolResultError(err.Error()), nil
+		}
+		if fetchAll {
+			refunds, err := collectAllPages(
+				client.Refund.All, queryParams, maxRecords)
+			if err != nil {
+				return mcpgo.NewToolResultError(
+					fmt.Sprintf("fetching refunds failed: %s", err.Error())), nil
+			}
+			return mcpgo.NewToolResultJSON(refunds)
+		}
+
+		refunds, err := withRetry(ctx, func() (map[string]interface{}, error) {
+			return client.Refund.All(queryParams, nil)
+		})
 		if err != nil {
 			return mcpgo.NewToolResultError(
 				fmt.Sprintf("fetching refunds failed: %s", err.Error())), nil
@@ -400,3 +447,223 @@ func FetchAllRefunds(
 		handler,
 	)
 }
+
+// RefundOrder returns a tool that refunds the captured payments of an order
+// without the caller having to look up the payment ids first
+func RefundOrder(
+	obs *observability.Observability,
+	client *rzpsdk.Client,
+) mcpgo.Tool {
+	parameters := []mcpgo.ToolParameter{
+		mcpgo.WithString(
+			"order_id",
+			mcpgo.Description("Unique identifier of the order to refund. "+
+				"ID should have an order_ prefix."),
+			mcpgo.Required(),
+		),
+		mcpgo.WithNumber(
+			"amount",
+			mcpgo.Description("Amount to refund in the smallest currency unit "+
+				"(e.g., for ₹295, use 29500). Defaults to the full amount not "+
+				"yet refunded. Only allowed when the order has a single "+
+				"captured payment"),
+			mcpgo.Min(100),
+		),
+		mcpgo.WithString(
+			"strategy",
+			mcpgo.Description("What to do when the order has more than one "+
+				"captured payment: 'error' (default) lists them and refunds "+
+				"nothing, 'all' fully refunds each of them"),
+			mcpgo.Enum("error", "all"),
+		),
+		mcpgo.WithString(
+			"speed",
+			mcpgo.Description("The speed at which the refund is to be "+
+				"processed. Default is 'normal'. For instant refunds, speed "+
+				"is set as 'optimum'."),
+			mcpgo.Enum("normal", "optimum"),
+		),
+		mcpgo.WithObject(
+			"notes",
+			mcpgo.Description("Key-value pairs used to store additional "+
+				"information. A maximum of 15 key-value pairs can be included."),
+		),
+		mcpgo.WithString(
+			"receipt",
+			mcpgo.Description("A unique identifier provided by you for "+
+				"your internal reference."),
+		),
+	}
+
+	handler := func(
+		ctx context.Context,
+		r mcpgo.CallToolRequest,
+	) (*mcpgo.ToolResult, error) {
+		client, err := getClientFromContextOrDefault(ctx, client)
+		if err != nil {
+			return mcpgo.NewToolResultError(err.Error()), nil
+		}
+
+		payload := make(map[string]interface{})
+		data := make(map[string]interface{})
+
+		validator := NewValidator(&r).
+			ValidateAndAddRequiredString(payload, "order_id").
+			ValidateAndAddOptionalInt(payload, "amount").
+			ValidateAndAddOptionalString(payload, "strategy").
+			ValidateAndAddOptionalString(data, "speed").
+			ValidateAndAddOptionalString(data, "receipt").
+			ValidateAndAddOptionalNotes(data, "notes")
+
+		if result, err := validator.HandleErrorsIfAny(); result != nil {
+			return result, err
+		}
+
+		orderID := payload["order_id"].(string)
+		strategy, _ := payload["strategy"].(string)
+		if strategy == "" {
+			strategy = "error"
+		}
+		if strategy != "error" && strategy != "all" {
+			return mcpgo.NewToolResultError(
+				"strategy must be one of: error, all"), nil
+		}
+
+		// Checked before any refund is made, since a bad speed would
+		// otherwise only fail after earlier payments were refunded
+		switch speed, ok := data["speed"]; {
+		case !ok:
+			data["speed"] = "normal"
+		case speed != "normal" && speed != "optimum":
+			return mcpgo.NewToolResultError(
+				"speed must be one of: normal, optimum"), nil
+		}
+
+		payments, err := client.Order.Payments(orderID, nil, nil)
+		if err != nil {
+			return mcpgo.NewToolResultError(
+				fmt.Sprintf("fetching payments for order failed: %s",
+					err.Error())), nil
+		}
+
+		captured := refundablePayments(payments)
+		switch {
+		case len(captured) == 0:
+			return mcpgo.NewToolResultError(fmt.Sprintf(
+				"order %s has no captured payment left to refund", orderID)), nil
+		case len(captured) > 1 && strategy == "error":
+			return mcpgo.NewToolResultError(fmt.Sprintf(
+				"order %s has %d captured payments: %s. Refund them one by "+
+					"one with create_refund, or set strategy to 'all' to "+
+					"fully refund each", orderID, len(captured),
+				describeRefundablePayments(captured))), nil
+		case len(captured) > 1 && payload["amount"] != nil:
+			return mcpgo.NewToolResultError(fmt.Sprintf(
+				"amount cannot be used when order %s has %d captured "+
+					"payments: %s", orderID, len(captured),
+				describeRefundablePayments(captured))), nil
+		}
+
+		if amount, ok := payload["amount"].(int64); ok {
+			captured[0].amount = amount
+		}
+
+		refunds := make([]interface{}, 0, len(captured))
+		for _, p := range captured {
+			refund, err := client.Payment.Refund(
+				p.id, int(p.amount), data, nil)
+			if err != nil {
+				msg := fmt.Sprintf("refunding payment %s failed: %s",
+					p.id, err.Error())
+				if len(refunds) > 0 {
+					msg += fmt.Sprintf(" (%d refund(s) already created: %s)",
+						len(refunds), joinRefundIDs(refunds))
+				}
+				return mcpgo.NewToolResultError(msg), nil
+			}
+			refunds = append(refunds, refund)
+		}
+
+		if len(refunds) == 1 {
+			return mcpgo.NewToolResultJSON(refunds[0])
+		}
+
+		return mcpgo.NewToolResultJSON(map[string]interface{}{
+			"order_id": orderID,
+			"count":    len(refunds),
+			"refunds":  refunds,
+		})
+	}
+
+	return mcpgo.NewTool(
+		"refund_order",
+		"Use this tool to refund an order without looking up its payment "+
+			"first. Refunds the order's captured payment in full, or the "+
+			"given amount (in the smallest currency unit). When the order "+
+			"has several captured payments it errors with the list, unless "+
+			"strategy is 'all'",
+		parameters,
+		handler,
+	)
+}
+
+// refundablePayment is a captured payment with the amount not yet refunded
+type refundablePayment struct {
+	id     string
+	amount int64
+}
+
+// refundablePayments returns the captured payments of an order that still
+// have an amount left to refund
+func refundablePayments(
+	payments map[string]interface{},
+) []refundablePayment {
+	result := make([]refundablePayment, 0)
+
+	items, _ := payments["items"].([]interface{})
+	for _, item := range items {
+		payment, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if status, _ := payment["status"].(string); status != "captured" {
+			continue
+		}
+
+		id, _ := payment["id"].(string)
+		amount, _ := payment["amount"].(float64)
+		refunded, _ := payment["amount_refunded"].(float64)
+		if amount-refunded <= 0 {
+			continue
+		}
+
+		result = append(result, refundablePayment{
+			id:     id,
+			amount: int64(amount - refunded),
+		})
+	}
+
+	return result
+}
+
+// describeRefundablePayments lists payments as "pay_x (amount 1000), ..."
+func describeRefundablePayments(payments []refundablePayment) string {
+	parts := make([]string, 0, len(payments))
+	for _, p := range payments {
+		parts = append(parts, fmt.Sprintf("%s (amount %d)", p.id, p.amount))
+	}
+	return strings.Join(parts, ", ")
+}
+
+// joinRefundIDs returns the comma separated ids of the given refunds
+func joinRefundIDs(refunds []interface{}) string {
+	ids := make([]string, 0, len(refunds))
+	for _, refund := range refunds {
+		if m, ok := refund.(map[string]interface{}); ok {
+			if id, ok := m["id"].(string); ok {
+				ids = append(ids, id)
+			}
+		}
+	}
+	return strings.Join(ids, ", ")
+}
@@ -60,7 +60,7 @@ func Test_CreateRefund(t *testing.T) {
 				)
 			},
 			ExpectError:    false,
-			ExpectedResult: successfulRefundResp,
+			ExpectedResult: withInstant(successfulRefundResp, false),
 		},
 		{
 			Name: "refund with speed parameter",
@@ -96,7 +96,38 @@ func Test_CreateRefund(t *testing.T) {
 				"status":          "processed",
 				"speed_processed": "instant",
 				"speed_requested": "optimum",
+				"instant":         true,
+			},
+		},
+		{
+			Name: "optimum refund processed at normal speed",
+			Request: map[string]interface{}{
+				"payment_id": "pay_29QQoUBi66xm2f",
+				"amount":     float64(500100),
+				"speed":      "optimum",
+			},
+			MockHttpClient: func() (*http.Client, *httptest.Server) {
+				return mock.NewHTTPClient(
+					mock.Endpoint{
+						Path:     fmt.Sprintf(createRefundPathFmt, "pay_29QQoUBi66xm2f"),
+						Method:   "POST",
+						Response: successfulRefundResp,
+					},
+				)
 			},
+			ExpectError:    false,
+			ExpectedResult: withInstant(successfulRefundResp, false),
+		},
+		{
+			Name: "invalid speed",
+			Request: map[string]interface{}{
+				"payment_id": "pay_29QQoUBi66xm2f",
+				"amount":     float64(500100),
+				"speed":      "instant",
+			},
+			MockHttpClient: nil,
+			ExpectError:    true,
+			ExpectedErrMsg: "speed must be one of: normal, optimum",
 		},
 		{
 			Name: "refund API server error",
@@ -691,6 +722,40 @@ func Test_FetchAllRefunds(t *testing.T) {
 			ExpectError:    false,
 			ExpectedResult: successfulRefundsResp,
 		},
+		{
+			Name: "fetch all pages",
+			Request: map[string]interface{}{
+				"fetchAll":   true,
+				"maxRecords": 500,
+			},
+			MockHttpClient: func() (*http.Client, *httptest.Server) {
+				return mock.NewHTTPClient(
+					mock.Endpoint{
+						Path:     fetchAllRefundsPath,
+						Method:   "GET",
+						Response: successfulRefundsResp,
+					},
+				)
+			},
+			ExpectError: false,
+			ExpectedResult: map[string]interface{}{
+				"entity":      "collection",
+				"count":       float64(2),
+				"items":       successfulRefundsResp["items"],
+				"truncated":   false,
+				"max_records": float64(500),
+			},
+		},
+		{
+			Name: "fetch all with maxRecords above the cap",
+			Request: map[string]interface{}{
+				"fetchAll":   true,
+				"maxRecords": 2000,
+			},
+			MockHttpClient: nil,
+			ExpectError:    true,
+			ExpectedErrMsg: "maxRecords must be between 1 and 1000",
+		},
 		{
 			Name:    "fetch with API error",
 			Request: map[string]interface{}{},
@@ -730,3 +795,280 @@ func Test_FetchAllRefunds(t *testing.T) {
 		})
 	}
 }
+
+func Test_RefundOrder(t *testing.T) {
+	orderPaymentsPath := fmt.Sprintf(
+		"/%s%s/order_EKwxwAgItmmXdp/payments",
+		constants.VERSION_V1,
+		constants.ORDER_URL,
+	)
+	refundPathFmt := fmt.Sprintf(
+		"/%s%s/%%s/refund",
+		constants.VERSION_V1,
+		constants.PAYMENT_URL,
+	)
+
+	payment := func(id, status string, amount, refunded float64) interface{} {
+		return map[string]interface{}{
+			"id":              id,
+			"entity":          "payment",
+			"status":          status,
+			"amount":          amount,
+			"amount_refunded": refunded,
+			"currency":        "INR",
+		}
+	}
+	paymentsResp := func(items ...interface{}) map[string]interface{} {
+		return map[string]interface{}{
+			"entity": "collection",
+			"count":  float64(len(items)),
+			"items":  items,
+		}
+	}
+	refundResp := func(id, paymentID string) map[string]interface{} {
+		return map[string]interface{}{
+			"id":         id,
+			"entity":     "refund",
+			"payment_id": paymentID,
+			"status":     "processed",
+		}
+	}
+	errorResp := map[string]interface{}{
+		"error": map[string]interface{}{
+			"code":        "BAD_REQUEST_ERROR",
+			"description": "The payment has been fully refunded already",
+		},
+	}
+
+	singleCaptured := paymentsResp(
+		payment("pay_failed", "failed", 50000, 0),
+		payment("pay_A", "captured", 50000, 10000),
+	)
+	twoCaptured := paymentsResp(
+		payment("pay_A", "captured", 50000, 0),
+		payment("pay_B", "captured", 20000, 0),
+	)
+
+	tests := []RazorpayToolTestCase{
+		{
+			Name: "refunds the only captured payment in full",
+			Request: map[string]interface{}{
+				"order_id": "order_EKwxwAgItmmXdp",
+			},
+			MockHttpClient: func() (*http.Client, *httptest.Server) {
+				return mock.NewHTTPClient(
+					mock.Endpoint{
+						Path:     orderPaymentsPath,
+						Method:   "GET",
+						Response: singleCaptured,
+					},
+					mock.Endpoint{
+						Path:     fmt.Sprintf(refundPathFmt, "pay_A"),
+						Method:   "POST",
+						Response: refundResp("rfnd_A", "pay_A"),
+					},
+				)
+			},
+			ExpectError:    false,
+			ExpectedResult: refundResp("rfnd_A", "pay_A"),
+		},
+		{
+			Name: "partial refund of the only captured payment",
+			Request: map[string]interface{}{
+				"order_id": "order_EKwxwAgItmmXdp",
+				"amount":   float64(5000),
+				"speed":    "optimum",
+			},
+			MockHttpClient: func() (*http.Client, *httptest.Server) {
+				return mock.NewHTTPClient(
+					mock.Endpoint{
+						Path:     orderPaymentsPath,
+						Method:   "GET",
+						Response: singleCaptured,
+					},
+					mock.Endpoint{
+						Path:     fmt.Sprintf(refundPathFmt, "pay_A"),
+						Method:   "POST",
+						Response: refundResp("rfnd_A", "pay_A"),
+					},
+				)
+			},
+			ExpectError:    false,
+			ExpectedResult: refundResp("rfnd_A", "pay_A"),
+		},
+		{
+			Name: "multiple captured payments error by default",
+			Request: map[string]interface{}{
+				"order_id": "order_EKwxwAgItmmXdp",
+			},
+			MockHttpClient: func() (*http.Client, *httptest.Server) {
+				return mock.NewHTTPClient(
+					mock.Endpoint{
+						Path:     orderPaymentsPath,
+						Method:   "GET",
+						Response: twoCaptured,
+					},
+				)
+			},
+			ExpectError: true,
+			ExpectedErrMsg: "order order_EKwxwAgItmmXdp has 2 captured payments: " +
+				"pay_A (amount 50000), pay_B (amount 20000). Refund them one " +
+				"by one with create_refund, or set strategy to 'all' to " +
+				"fully refund each",
+		},
+		{
+			Name: "multiple captured payments refunded with strategy all",
+			Request: map[string]interface{}{
+				"order_id": "order_EKwxwAgItmmXdp",
+				"strategy": "all",
+			},
+			MockHttpClient: func() (*http.Client, *httptest.Server) {
+				return mock.NewHTTPClient(
+					mock.Endpoint{
+						Path:     orderPaymentsPath,
+						Method:   "GET",
+						Response: twoCaptured,
+					},
+					mock.Endpoint{
+						Path:     fmt.Sprintf(refundPathFmt, "pay_A"),
+						Method:   "POST",
+						Response: refundResp("rfnd_A", "pay_A"),
+					},
+					mock.Endpoint{
+						Path:     fmt.Sprintf(refundPathFmt, "pay_B"),
+						Method:   "POST",
+						Response: refundResp("rfnd_B", "pay_B"),
+					},
+				)
+			},
+			ExpectError: false,
+			ExpectedResult: map[string]interface{}{
+				"order_id": "order_EKwxwAgItmmXdp",
+				"count":    float64(2),
+				"refunds": []interface{}{
+					refundResp("rfnd_A", "pay_A"),
+					refundResp("rfnd_B", "pay_B"),
+				},
+			},
+		},
+		{
+			Name: "strategy all reports refunds created before a failure",
+			Request: map[string]interface{}{
+				"order_id": "order_EKwxwAgItmmXdp",
+				"strategy": "all",
+			},
+			MockHttpClient: func() (*http.Client, *httptest.Server) {
+				return mock.NewHTTPClient(
+					mock.Endpoint{
+						Path:     orderPaymentsPath,
+						Method:   "GET",
+						Response: twoCaptured,
+					},
+					mock.Endpoint{
+						Path:     fmt.Sprintf(refundPathFmt, "pay_A"),
+						Method:   "POST",
+						Response: refundResp("rfnd_A", "pay_A"),
+					},
+					mock.Endpoint{
+						Path:     fmt.Sprintf(refundPathFmt, "pay_B"),
+						Method:   "POST",
+						Response: errorResp,
+					},
+				)
+			},
+			ExpectError: true,
+			ExpectedErrMsg: "refunding payment pay_B failed: The payment has " +
+				"been fully refunded already (1 refund(s) already created: " +
+				"rfnd_A)",
+		},
+		{
+			Name: "amount with multiple captured payments",
+			Request: map[string]interface{}{
+				"order_id": "order_EKwxwAgItmmXdp",
+				"amount":   float64(5000),
+				"strategy": "all",
+			},
+			MockHttpClient: func() (*http.Client, *httptest.Server) {
+				return mock.NewHTTPClient(
+					mock.Endpoint{
+						Path:     orderPaymentsPath,
+						Method:   "GET",
+						Response: twoCaptured,
+					},
+				)
+			},
+			ExpectError: true,
+			ExpectedErrMsg: "amount cannot be used when order " +
+				"order_EKwxwAgItmmXdp has 2 captured payments",
+		},
+		{
+			Name: "no captured payment",
+			Request: map[string]interface{}{
+				"order_id": "order_EKwxwAgItmmXdp",
+			},
+			MockHttpClient: func() (*http.Client, *httptest.Server) {
+				return mock.NewHTTPClient(
+					mock.Endpoint{
+						Path:   orderPaymentsPath,
+						Method: "GET",
+						Response: paymentsResp(
+							payment("pay_A", "refunded", 50000, 50000),
+							payment("pay_B", "captured", 20000, 20000),
+						),
+					},
+				)
+			},
+			ExpectError: true,
+			ExpectedErrMsg: "order order_EKwxwAgItmmXdp has no captured " +
+				"payment left to refund",
+		},
+		{
+			Name: "invalid strategy",
+			Request: map[string]interface{}{
+				"order_id": "order_EKwxwAgItmmXdp",
+				"strategy": "first",
+			},
+			MockHttpClient: nil,
+			ExpectError:    true,
+			ExpectedErrMsg: "strategy must be one of: error, all",
+		},
+		{
+			Name: "invalid speed is rejected before any refund",
+			Request: map[string]interface{}{
+				"order_id": "order_EKwxwAgItmmXdp",
+				"strategy": "all",
+				"speed":    "instant",
+			},
+			MockHttpClient: nil,
+			ExpectError:    true,
+			ExpectedErrMsg: "speed must be one of: normal, optimum",
+		},
+		{
+			Name:           "missing order_id parameter",
+			Request:        map[string]interface{}{},
+			MockHttpClient: nil,
+			ExpectError:    true,
+			ExpectedErrMsg: "missing required parameter: order_id",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.Name, func(t *testing.T) {
+			runToolTest(t, tc, RefundOrder, "Refund")
+		})
+	}
+}
+
+// withInstant returns a copy of refund with the instant flag create_refund
+// adds to its response
+func withInstant(
+	refund map[string]interface{},
+	instant bool,
+) map[string]interface{} {
+	result := make(map[string]interface{}, len(refund)+1)
+	for key, value := range refund {
+		result[key] = value
+	}
+	result["instant"] = instant
+	return result
+}
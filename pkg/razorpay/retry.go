@@ -0,0 +1,60 @@
+package razorpay
+
+import (
+	"context"
+	"time"
+
+	"github.com/spf13/viper"
+)
+
+// defaultMaxRetries is used when --max-retries isn't set
+const defaultMaxRetries = 2
+
+// retryBaseDelay is the wait before the first retry. Each further retry
+// waits twice as long as the one before.
+var retryBaseDelay = 250 * time.Millisecond
+
+// maxRetries returns how many times a failed read is retried, configured
+// with --max-retries
+func maxRetries() int {
+	if !viper.IsSet("max_retries") {
+		return defaultMaxRetries
+	}
+	if retries := viper.GetInt("max_retries"); retries > 0 {
+		return retries
+	}
+	return 0
+}
+
+// withRetry calls fetch, retrying with exponential backoff while it fails
+// with a server, gateway or network error. Bad requests are never retried.
+// It gives up early when ctx is done or its deadline would pass before the
+// next attempt, returning the last error.
+func withRetry(
+	ctx context.Context,
+	fetch func() (map[string]interface{}, error),
+) (map[string]interface{}, error) {
+	retries := maxRetries()
+	delay := retryBaseDelay
+
+	for attempt := 0; ; attempt++ {
+		result, err := fetch()
+		if err == nil || attempt >= retries ||
+			!parseRazorpayError(err).Retryable {
+			return result, err
+		}
+
+		if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) < delay {
+			return result, err
+		}
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return result, err
+		case <-timer.C:
+		}
+		delay *= 2
+	}
+}
@@ -0,0 +1,132 @@
+package razorpay
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+
+	rzperrors "github.com/razorpay/razorpay-go/errors"
+)
+
+func TestWithRetry(t *testing.T) {
+	defer func(delay time.Duration) { retryBaseDelay = delay }(retryBaseDelay)
+	retryBaseDelay = time.Millisecond
+
+	serverErr := &rzperrors.ServerError{Message: "server error"}
+	badRequest := &rzperrors.BadRequestError{Message: "bad request"}
+
+	tests := []struct {
+		name         string
+		maxRetries   *int
+		errs         []error
+		wantAttempts int
+		wantErr      error
+	}{
+		{
+			name:         "success on the first attempt",
+			errs:         []error{nil},
+			wantAttempts: 1,
+		},
+		{
+			name:         "recovers from a server error",
+			errs:         []error{serverErr, nil},
+			wantAttempts: 2,
+		},
+		{
+			name:         "gives up after the default retries",
+			errs:         []error{serverErr, serverErr, serverErr, nil},
+			wantAttempts: 3,
+			wantErr:      serverErr,
+		},
+		{
+			name:         "never retries a bad request",
+			errs:         []error{badRequest, nil},
+			wantAttempts: 1,
+			wantErr:      badRequest,
+		},
+		{
+			name:         "retries disabled",
+			maxRetries:   intPtr(0),
+			errs:         []error{serverErr, nil},
+			wantAttempts: 1,
+			wantErr:      serverErr,
+		},
+		{
+			name:         "configured retries",
+			maxRetries:   intPtr(4),
+			errs:         []error{serverErr, serverErr, serverErr, serverErr, nil},
+			wantAttempts: 5,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			viper.Reset()
+			defer viper.Reset()
+			if tt.maxRetries != nil {
+				viper.Set("max_retries", *tt.maxRetries)
+			}
+
+			attempts := 0
+			result, err := withRetry(context.Background(),
+				func() (map[string]interface{}, error) {
+					err := tt.errs[attempts]
+					attempts++
+					if err != nil {
+						return nil, err
+					}
+					return map[string]interface{}{"id": "pay_123"}, nil
+				})
+
+			assert.Equal(t, tt.wantAttempts, attempts)
+			if tt.wantErr != nil {
+				assert.Equal(t, tt.wantErr, err)
+				assert.Nil(t, result)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, "pay_123", result["id"])
+			}
+		})
+	}
+}
+
+func TestWithRetry_Context(t *testing.T) {
+	defer func(delay time.Duration) { retryBaseDelay = delay }(retryBaseDelay)
+	retryBaseDelay = time.Hour
+
+	fetch := func(attempts *int) func() (map[string]interface{}, error) {
+		return func() (map[string]interface{}, error) {
+			*attempts++
+			return nil, &rzperrors.GatewayError{Message: "gateway error"}
+		}
+	}
+
+	t.Run("deadline before the next attempt", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+
+		attempts := 0
+		_, err := withRetry(ctx, fetch(&attempts))
+		assert.Error(t, err)
+		assert.Equal(t, 1, attempts)
+	})
+
+	t.Run("cancelled while waiting", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		time.AfterFunc(10*time.Millisecond, cancel)
+
+		attempts := 0
+		start := time.Now()
+		_, err := withRetry(ctx, fetch(&attempts))
+		assert.Error(t, err)
+		assert.Equal(t, 1, attempts)
+		assert.Less(t, time.Since(start), time.Minute)
+	})
+}
+
+func intPtr(i int) *int {
+	return &i
+}
@@ -0,0 +1,416 @@
+package razorpay
+
+import (
+	"context"
+	"strings"
+
+	rzpsdk "github.com/razorpay/razorpay-go"
+
+	"github.com/razorpay/razorpay-mcp-server/pkg/mcpgo"
+	"github.com/razorpay/razorpay-mcp-server/pkg/observability"
+)
+
+// SetupSecretsOutput is the response from setup_secrets
+type SetupSecretsOutput struct {
+	Approach         string       `json:"approach"`
+	Summary          string       `json:"summary"`
+	Files            []FileAction `json:"files"`
+	Dependencies     []Dependency `json:"dependencies"`
+	Commands         []string     `json:"commands"`
+	GitignoreEntries []string     `json:"gitignoreEntries"`
+	Warnings         []string     `json:"warnings"`
+	AIInstructions   string       `json:"aiInstructions"`
+}
+
+// SetupSecrets returns a tool that recommends how to store the Razorpay keys
+// for a stack and returns the files, commands and gitignore entries needed
+func SetupSecrets(
+	obs *observability.Observability,
+	client *rzpsdk.Client,
+) mcpgo.Tool {
+	parameters := []mcpgo.ToolParameter{
+		mcpgo.WithString(
+			"backendFramework",
+			mcpgo.Description("Backend framework: express, nextjs, django, "+
+				"flask, fastapi, gin, echo, fiber, rails, spring, or aspnet"),
+			mcpgo.Required(),
+			mcpgo.Enum("express", "nextjs", "django", "flask", "fastapi",
+				"gin", "echo", "fiber", "rails", "spring", "aspnet"),
+		),
+		mcpgo.WithString(
+			"deployTarget",
+			mcpgo.Description("Where the app runs: local, docker, vercel, "+
+				"netlify, heroku, render, railway, aws, gcp or kubernetes. "+
+				"Picks the recommended approach. Default: local"),
+			mcpgo.Enum("local", "docker", "vercel", "netlify", "heroku",
+				"render", "railway", "aws", "gcp", "kubernetes"),
+		),
+		mcpgo.WithString(
+			"approach",
+			mcpgo.Description("Override the recommended approach: dotenv "+
+				"(git-ignored env file), platform (hosting provider env "+
+				"vars) or vault (a secret manager)"),
+			mcpgo.Enum("dotenv", "platform", "vault"),
+		),
+		mcpgo.WithBoolean(
+			"includeWebhookSecret",
+			mcpgo.Description("Also set up RAZORPAY_WEBHOOK_SECRET. "+
+				"Default: false"),
+		),
+	}
+
+	handler := func(
+		ctx context.Context,
+		r mcpgo.CallToolRequest,
+	) (*mcpgo.ToolResult, error) {
+		args, ok := r.Arguments.(map[string]interface{})
+		if !ok {
+			return mcpgo.NewToolResultError("Invalid arguments"), nil
+		}
+
+		backendFramework, _ := args["backendFramework"].(string)
+		if backendFramework == "" {
+			return mcpgo.NewToolResultError(
+				"missing required parameter: backendFramework"), nil
+		}
+		deployTarget, _ := args["deployTarget"].(string)
+		if deployTarget == "" {
+			deployTarget = "local"
+		}
+		approach, _ := args["approach"].(string)
+		if approach == "" {
+			approach = getRecommendedSecretsApproach(deployTarget)
+		}
+		includeWebhookSecret, _ := args["includeWebhookSecret"].(bool)
+
+		return mcpgo.NewToolResultJSON(getSecretsSetup(
+			backendFramework, deployTarget, approach, includeWebhookSecret))
+	}
+
+	return mcpgo.NewTool(
+		"setup_secrets",
+		"Recommend how to store the Razorpay key secret for a stack (dotenv, "+
+			"platform env vars or a secret manager) and return the exact "+
+			"files, commands and .gitignore entries. Use this before or "+
+			"after integrate_razorpay_checkout so keys never end up in git "+
+			"or in the browser bundle.",
+		parameters,
+		handler,
+	)
+}
+
+// getRecommendedSecretsApproach picks the approach that fits where the app
+// is deployed
+func getRecommendedSecretsApproach(deployTarget string) string {
+	switch deployTarget {
+	case "vercel", "netlify", "heroku", "render", "railway":
+		return "platform"
+	case "aws", "gcp", "kubernetes":
+		return "vault"
+	default: // local, docker
+		return "dotenv"
+	}
+}
+
+// getSecretsSetup builds the setup for a stack. Only placeholders are
+// emitted, never the keys the server is configured with, since the output
+// is meant to be copied into files and shell history.
+func getSecretsSetup(
+	backendFramework string,
+	deployTarget string,
+	approach string,
+	includeWebhookSecret bool,
+) SetupSecretsOutput {
+	envFile := ".env"
+	if backendFramework == "nextjs" {
+		envFile = ".env.local"
+	}
+
+	envVars := []EnvVar{
+		{Name: "RAZORPAY_KEY_ID", Value: "rzp_test_YOUR_KEY_ID"},
+		{Name: "RAZORPAY_KEY_SECRET", Value: "YOUR_KEY_SECRET"},
+	}
+	if includeWebhookSecret {
+		envVars = append(envVars, EnvVar{
+			Name:  "RAZORPAY_WEBHOOK_SECRET",
+			Value: "YOUR_WEBHOOK_SECRET",
+		})
+	}
+
+	gitignoreEntries := []string{".env", ".env.local", ".env.*.local"}
+	if backendFramework == "spring" {
+		gitignoreEntries = append(gitignoreEntries,
+			"application-local.properties")
+	}
+
+	edits := make([]EditItem, 0, len(gitignoreEntries))
+	for _, entry := range gitignoreEntries {
+		edits = append(edits, EditItem{
+			Line: "End of file",
+			Add:  entry,
+			Why:  "Keep files with real keys out of git",
+		})
+	}
+
+	example := "# Copy to " + envFile + " and fill in real values. " +
+		"Commit this file, never " + envFile + ".\n"
+	for _, v := range envVars {
+		example += v.Name + "=" + v.Value + "\n"
+	}
+
+	output := SetupSecretsOutput{
+		Approach: approach,
+		Summary: "Razorpay secrets setup for " + backendFramework +
+			" on " + deployTarget + " using " + approach,
+		Files: []FileAction{
+			{
+				Action:      "create",
+				Path:        ".env.example",
+				Code:        example,
+				Description: "Documents the required env vars with placeholders",
+			},
+			{
+				Action:      "manual_edit",
+				Path:        ".gitignore",
+				Description: "Ignore env files that hold real keys",
+				Edits:       edits,
+			},
+		},
+		Dependencies:     []Dependency{},
+		GitignoreEntries: gitignoreEntries,
+		Warnings: []string{
+			"RAZORPAY_KEY_SECRET must only be read by server code. Only " +
+				"the key id may reach the browser.",
+			"Use rzp_test_ keys in development and keep rzp_live_ keys " +
+				"only in production secrets.",
+			"If a secret was ever committed, regenerate it in Dashboard -> " +
+				"Account & Settings -> API Keys. Rewriting git history does " +
+				"not revoke it.",
+		},
+	}
+
+	switch approach {
+	case "platform":
+		output.Commands = getPlatformSecretsCommands(deployTarget, envVars)
+	case "vault":
+		output.Commands = getVaultSecretsCommands(deployTarget, envVars)
+	default: // dotenv
+		deps, commands, loader := getDotenvSetup(backendFramework, envFile)
+		output.Dependencies = deps
+		output.Commands = commands
+		if loader != nil {
+			output.Files = append(output.Files, *loader)
+		}
+	}
+
+	if backendFramework == "nextjs" {
+		output.Warnings = append(output.Warnings,
+			"Never prefix RAZORPAY_KEY_SECRET or RAZORPAY_WEBHOOK_SECRET "+
+				"with NEXT_PUBLIC_ - Next.js inlines NEXT_PUBLIC_ vars into "+
+				"the browser bundle. The generated order API already returns "+
+				"the key id, so NEXT_PUBLIC_RAZORPAY_KEY_ID is not needed "+
+				"either.")
+	}
+	if deployTarget == "docker" {
+		output.Warnings = append(output.Warnings,
+			"Add "+envFile+" to .dockerignore and pass it at runtime with "+
+				"docker run --env-file "+envFile+" - never COPY it into the "+
+				"image or use it as a build arg.")
+	}
+
+	output.AIInstructions = "SECRETS SETUP (" + approach + "):\n" +
+		"1) Create .env.example and add the .gitignore entries BEFORE " +
+		"writing any real key to disk\n" +
+		"2) Run the commands in order, asking the user to paste the real " +
+		"values - do NOT invent or echo key secrets\n" +
+		"3) Check git status: no file containing a real key may be staged"
+
+	return output
+}
+
+// getDotenvSetup returns the dependencies, commands and loader change needed
+// for the backend to read the env file
+func getDotenvSetup(
+	backendFramework string,
+	envFile string,
+) ([]Dependency, []string, *FileAction) {
+	copyCommand := "cp .env.example " + envFile
+
+	switch backendFramework {
+	case "express":
+		return []Dependency{
+				{Name: "dotenv", InstallCommand: "npm install dotenv"},
+			},
+			[]string{"npm install dotenv", copyCommand},
+			&FileAction{
+				Action:      "manual_edit",
+				Path:        "server.js",
+				Description: "Load " + envFile + " before anything reads it",
+				Edits: []EditItem{{
+					Line: "First line of the server entry file",
+					Add:  "require('dotenv').config();",
+					Why:  "Populate process.env from " + envFile,
+				}},
+			}
+	case "nextjs":
+		// Next.js loads .env.local itself
+		return []Dependency{}, []string{copyCommand}, nil
+	case "django", "flask", "fastapi":
+		entry := map[string]string{
+			"django":  "manage.py",
+			"flask":   "app.py",
+			"fastapi": "main.py",
+		}[backendFramework]
+		return []Dependency{
+				{Name: "python-dotenv", InstallCommand: "pip install python-dotenv"},
+			},
+			[]string{"pip install python-dotenv", copyCommand},
+			&FileAction{
+				Action:      "manual_edit",
+				Path:        entry,
+				Description: "Load " + envFile + " at startup",
+				Edits: []EditItem{
+					{
+						Line: "After imports",
+						Add:  "from dotenv import load_dotenv",
+						Why:  "Import python-dotenv",
+					},
+					{
+						Line: "Before the app or settings are created",
+						Add:  "load_dotenv()",
+						Why:  "Populate os.environ from " + envFile,
+					},
+				},
+			}
+	case "gin", "echo", "fiber":
+		return []Dependency{
+				{
+					Name:           "godotenv",
+					InstallCommand: "go get github.com/joho/godotenv",
+				},
+			},
+			[]string{"go get github.com/joho/godotenv", copyCommand},
+			&FileAction{
+				Action:      "manual_edit",
+				Path:        "main.go",
+				Description: "Load " + envFile + " at startup",
+				Edits: []EditItem{
+					{
+						Line: "In the import block",
+						Add:  `_ "github.com/joho/godotenv/autoload"`,
+						Why:  "Populate the environment from " + envFile,
+					},
+				},
+			}
+	case "rails":
+		// dotenv-rails loads .env on boot without code changes
+		return []Dependency{
+				{Name: "dotenv-rails", InstallCommand: "bundle add dotenv-rails"},
+			},
+			[]string{"bundle add dotenv-rails", copyCommand},
+			nil
+	case "spring":
+		// Spring reads real env vars, so export the file into the shell
+		return []Dependency{},
+			[]string{
+				copyCommand,
+				"set -a && . ./" + envFile + " && set +a && ./mvnw spring-boot:run",
+			},
+			nil
+	case "aspnet":
+		// user-secrets keeps development secrets outside the project folder
+		return []Dependency{},
+			[]string{
+				"dotnet user-secrets init",
+				`dotnet user-secrets set "Razorpay:KeyId" "<key id>"`,
+				`dotnet user-secrets set "Razorpay:KeySecret" "<key secret>"`,
+			},
+			nil
+	default:
+		return []Dependency{}, []string{copyCommand}, nil
+	}
+}
+
+// getPlatformSecretsCommands returns the hosting provider commands that set
+// each env var
+func getPlatformSecretsCommands(
+	deployTarget string,
+	envVars []EnvVar,
+) []string {
+	commands := []string{}
+	switch deployTarget {
+	case "vercel":
+		for _, v := range envVars {
+			commands = append(commands, "vercel env add "+v.Name+" production")
+		}
+	case "netlify":
+		for _, v := range envVars {
+			commands = append(commands,
+				"netlify env:set "+v.Name+" \"<"+v.Name+">\"")
+		}
+	case "heroku":
+		pairs := make([]string, 0, len(envVars))
+		for _, v := range envVars {
+			pairs = append(pairs, v.Name+"=\"<"+v.Name+">\"")
+		}
+		commands = append(commands,
+			"heroku config:set "+strings.Join(pairs, " "))
+	case "railway":
+		for _, v := range envVars {
+			commands = append(commands,
+				"railway variables --set \""+v.Name+"=<"+v.Name+">\"")
+		}
+	default: // render and providers without a CLI for env vars
+		for _, v := range envVars {
+			commands = append(commands, "Add "+v.Name+
+				" under the service's Environment settings in the dashboard")
+		}
+	}
+	return commands
+}
+
+// getVaultSecretsCommands returns the commands that store the env vars in a
+// secret manager
+func getVaultSecretsCommands(
+	deployTarget string,
+	envVars []EnvVar,
+) []string {
+	switch deployTarget {
+	case "aws":
+		fields := make([]string, 0, len(envVars))
+		for _, v := range envVars {
+			fields = append(fields, "\""+v.Name+"\":\"<"+v.Name+">\"")
+		}
+		return []string{
+			"aws secretsmanager create-secret --name razorpay/credentials " +
+				"--secret-string '{" + strings.Join(fields, ",") + "}'",
+		}
+	case "gcp":
+		commands := []string{}
+		for _, v := range envVars {
+			commands = append(commands, "printf '%s' \"<"+v.Name+">\" | "+
+				"gcloud secrets create "+
+				strings.ToLower(strings.ReplaceAll(v.Name, "_", "-"))+
+				" --data-file=-")
+		}
+		return commands
+	case "kubernetes":
+		literals := make([]string, 0, len(envVars))
+		for _, v := range envVars {
+			literals = append(literals,
+				"--from-literal="+v.Name+"=\"<"+v.Name+">\"")
+		}
+		return []string{
+			"kubectl create secret generic razorpay " +
+				strings.Join(literals, " "),
+		}
+	default: // HashiCorp Vault
+		pairs := make([]string, 0, len(envVars))
+		for _, v := range envVars {
+			pairs = append(pairs, v.Name+"=\"<"+v.Name+">\"")
+		}
+		return []string{
+			"vault kv put secret/razorpay " + strings.Join(pairs, " "),
+		}
+	}
+}
@@ -0,0 +1,152 @@
+package razorpay
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/razorpay/razorpay-mcp-server/pkg/mcpgo"
+)
+
+func runSetupSecrets(
+	t *testing.T,
+	args map[string]interface{},
+) SetupSecretsOutput {
+	t.Helper()
+
+	tool := SetupSecrets(nil, nil)
+	result, err := tool.GetHandler()(
+		context.Background(),
+		mcpgo.CallToolRequest{Arguments: args},
+	)
+	assert.NoError(t, err)
+	assert.False(t, result.IsError)
+
+	var output SetupSecretsOutput
+	assert.NoError(t, json.Unmarshal([]byte(result.Text), &output))
+	return output
+}
+
+func TestSetupSecrets(t *testing.T) {
+	tests := []struct {
+		name         string
+		args         map[string]interface{}
+		wantApproach string
+		wantCommand  string
+		wantWarning  string
+	}{
+		{
+			name: "express defaults to dotenv",
+			args: map[string]interface{}{
+				"backendFramework": "express",
+			},
+			wantApproach: "dotenv",
+			wantCommand:  "cp .env.example .env",
+		},
+		{
+			name: "nextjs on vercel uses platform env vars",
+			args: map[string]interface{}{
+				"backendFramework": "nextjs",
+				"deployTarget":     "vercel",
+			},
+			wantApproach: "platform",
+			wantCommand:  "vercel env add RAZORPAY_KEY_SECRET production",
+			wantWarning:  "NEXT_PUBLIC_",
+		},
+		{
+			name: "kubernetes uses a secret manager",
+			args: map[string]interface{}{
+				"backendFramework": "gin",
+				"deployTarget":     "kubernetes",
+			},
+			wantApproach: "vault",
+			wantCommand:  "kubectl create secret generic razorpay",
+		},
+		{
+			name: "approach override",
+			args: map[string]interface{}{
+				"backendFramework": "django",
+				"deployTarget":     "heroku",
+				"approach":         "dotenv",
+			},
+			wantApproach: "dotenv",
+			wantCommand:  "pip install python-dotenv",
+		},
+		{
+			name: "docker warns about baking env files into images",
+			args: map[string]interface{}{
+				"backendFramework": "fastapi",
+				"deployTarget":     "docker",
+			},
+			wantApproach: "dotenv",
+			wantCommand:  "cp .env.example .env",
+			wantWarning:  ".dockerignore",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			output := runSetupSecrets(t, tt.args)
+			assert.Equal(t, tt.wantApproach, output.Approach)
+			assert.Contains(t, output.GitignoreEntries, ".env")
+			assert.Contains(t, output.GitignoreEntries, ".env.local")
+
+			commands := strings.Join(output.Commands, "\n")
+			assert.Contains(t, commands, tt.wantCommand)
+
+			warnings := strings.Join(output.Warnings, "\n")
+			if tt.wantWarning != "" {
+				assert.Contains(t, warnings, tt.wantWarning)
+			} else {
+				assert.NotContains(t, warnings, "NEXT_PUBLIC_")
+			}
+
+			var example *FileAction
+			for i, f := range output.Files {
+				if f.Path == ".env.example" {
+					example = &output.Files[i]
+				}
+			}
+			if assert.NotNil(t, example, ".env.example not emitted") {
+				assert.Contains(t, example.Code, "RAZORPAY_KEY_SECRET=")
+				assert.NotContains(t, example.Code, "RAZORPAY_WEBHOOK_SECRET")
+			}
+		})
+	}
+}
+
+func TestSetupSecrets_NeverEchoesConfiguredKeys(t *testing.T) {
+	viper.Set("key", "rzp_test_configured")
+	viper.Set("secret", "configured_secret")
+	defer viper.Set("key", "")
+	defer viper.Set("secret", "")
+
+	output := runSetupSecrets(t, map[string]interface{}{
+		"backendFramework":     "express",
+		"deployTarget":         "heroku",
+		"includeWebhookSecret": true,
+	})
+
+	raw, err := json.Marshal(output)
+	assert.NoError(t, err)
+	assert.NotContains(t, string(raw), "rzp_test_configured")
+	assert.NotContains(t, string(raw), "configured_secret")
+	assert.Contains(t, string(raw), "RAZORPAY_WEBHOOK_SECRET")
+	assert.Contains(t, strings.Join(output.Commands, "\n"),
+		`RAZORPAY_KEY_SECRET="<RAZORPAY_KEY_SECRET>"`)
+}
+
+func TestSetupSecrets_MissingBackendFramework(t *testing.T) {
+	tool := SetupSecrets(nil, nil)
+	result, err := tool.GetHandler()(
+		context.Background(),
+		mcpgo.CallToolRequest{Arguments: map[string]interface{}{}},
+	)
+	assert.NoError(t, err)
+	assert.True(t, result.IsError)
+	assert.Contains(t, result.Text, "backendFramework")
+}
@@ -16,6 +16,7 @@ func NewRzpMcpServer(
 	client *rzpsdk.Client,
 	enabledToolsets []string,
 	readOnly bool,
+	blockLiveWrites bool,
 	mcpOpts ...mcpgo.ServerOption,
 ) (mcpgo.Server, error) {
 	// Validate required parameters
@@ -39,13 +40,36 @@ func NewRzpMcpServer(
 	// Create server
 	server := mcpgo.NewMcpServer("razorpay-mcp-server", "1.0.0", mcpOpts...)
 
+	// Record rate-limit headers from every API response before any tool runs
+	trackRateLimits(client)
+
 	// Register Razorpay tools
-	toolsets, err := NewToolSets(obs, client, enabledToolsets, readOnly)
+	var toolsetOpts []ToolsetOption
+	if blockLiveWrites {
+		toolsetOpts = append(toolsetOpts, WithBlockLiveWrites(client))
+	}
+	toolsets, err := NewToolSets(
+		obs, client, enabledToolsets, readOnly, toolsetOpts...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create toolsets: %w", err)
 	}
 	toolsets.RegisterTools(server)
 
+	// Introspection is always available, regardless of enabled toolsets
+	listToolsets := ListToolsets(obs, toolsets)
+	listToolsets.SetReadOnly(true)
+	// Rate-limit headroom covers every toolset, so it is always available too
+	rateLimitStatus := FetchRateLimitStatus(obs, client)
+	rateLimitStatus.SetReadOnly(true)
+	// So is the key mode, which decides whether calls move real money
+	apiMode := FetchAPIMode(obs, client, blockLiveWrites)
+	apiMode.SetReadOnly(true)
+	// Checking the credentials is the first step when anything else fails
+	verifyCredentials := VerifyCredentials(obs, client)
+	verifyCredentials.SetReadOnly(true)
+	server.AddTools(
+		listToolsets, rateLimitStatus, apiMode, verifyCredentials)
+
 	return server, nil
 }
 
@@ -16,7 +16,7 @@ func TestNewRzpMcpServer(t *testing.T) {
 		obs := CreateTestObservability()
 		client := rzpsdk.NewClient("test-key", "test-secret")
 
-		server, err := NewRzpMcpServer(obs, client, []string{}, false)
+		server, err := NewRzpMcpServer(obs, client, []string{}, false, false)
 		assert.NoError(t, err)
 		assert.NotNil(t, server)
 	})
@@ -24,7 +24,7 @@ func TestNewRzpMcpServer(t *testing.T) {
 	t.Run("returns error with nil observability", func(t *testing.T) {
 		client := rzpsdk.NewClient("test-key", "test-secret")
 
-		server, err := NewRzpMcpServer(nil, client, []string{}, false)
+		server, err := NewRzpMcpServer(nil, client, []string{}, false, false)
 		assert.Error(t, err)
 		assert.Nil(t, server)
 		assert.Contains(t, err.Error(), "observability is required")
@@ -33,7 +33,7 @@ func TestNewRzpMcpServer(t *testing.T) {
 	t.Run("returns error with nil client", func(t *testing.T) {
 		obs := CreateTestObservability()
 
-		server, err := NewRzpMcpServer(obs, nil, []string{}, false)
+		server, err := NewRzpMcpServer(obs, nil, []string{}, false, false)
 		assert.Error(t, err)
 		assert.Nil(t, server)
 		assert.Contains(t, err.Error(), "razorpay client is required")
@@ -44,7 +44,7 @@ func TestNewRzpMcpServer(t *testing.T) {
 		client := rzpsdk.NewClient("test-key", "test-secret")
 
 		server, err := NewRzpMcpServer(
-			obs, client, []string{"payments", "orders"}, false)
+			obs, client, []string{"payments", "orders"}, false, false)
 		assert.NoError(t, err)
 		assert.NotNil(t, server)
 	})
@@ -53,7 +53,16 @@ func TestNewRzpMcpServer(t *testing.T) {
 		obs := CreateTestObservability()
 		client := rzpsdk.NewClient("test-key", "test-secret")
 
-		server, err := NewRzpMcpServer(obs, client, []string{}, true)
+		server, err := NewRzpMcpServer(obs, client, []string{}, true, false)
+		assert.NoError(t, err)
+		assert.NotNil(t, server)
+	})
+
+	t.Run("creates server blocking live writes", func(t *testing.T) {
+		obs := CreateTestObservability()
+		client := rzpsdk.NewClient("rzp_live_key", "test-secret")
+
+		server, err := NewRzpMcpServer(obs, client, []string{}, false, true)
 		assert.NoError(t, err)
 		assert.NotNil(t, server)
 	})
@@ -62,7 +71,7 @@ func TestNewRzpMcpServer(t *testing.T) {
 		obs := CreateTestObservability()
 		client := rzpsdk.NewClient("test-key", "test-secret")
 
-		server, err := NewRzpMcpServer(obs, client, []string{}, false)
+		server, err := NewRzpMcpServer(obs, client, []string{}, false, false)
 		assert.NoError(t, err)
 		assert.NotNil(t, server)
 	})
@@ -3,6 +3,7 @@ package razorpay
 import (
 	"context"
 	"fmt"
+	"time"
 
 	rzpsdk "github.com/razorpay/razorpay-go"
 
@@ -10,6 +11,17 @@ import (
 	"github.com/razorpay/razorpay-mcp-server/pkg/observability"
 )
 
+const (
+	// maxSettlementSummaryRecords caps the number of records read for a
+	// single summary
+	maxSettlementSummaryRecords = 5000
+	// maxSettlementReconMonths caps the months a from/to recon range may span
+	maxSettlementReconMonths = 3
+)
+
+// settlementReportLocation is the timezone settlement reports are bucketed in
+var settlementReportLocation = time.FixedZone("IST", 5*60*60+30*60)
+
 // FetchSettlement returns a tool that fetches a settlement by ID
 func FetchSettlement(
 	obs *observability.Observability,
@@ -72,28 +84,42 @@ func FetchSettlementRecon(
 		mcpgo.WithNumber(
 			"year",
 			mcpgo.Description("Year for which the settlement report is "+
-				"requested (YYYY format)"),
-			mcpgo.Required(),
+				"requested (YYYY format). Required with month unless from "+
+				"and to are given"),
 		),
 		mcpgo.WithNumber(
 			"month",
 			mcpgo.Description("Month for which the settlement report is "+
-				"requested (MM format)"),
-			mcpgo.Required(),
+				"requested (MM format). Required with year unless from and "+
+				"to are given"),
 		),
 		mcpgo.WithNumber(
 			"day",
 			mcpgo.Description("Optional: Day for which the settlement report is "+
 				"requested (DD format)"),
 		),
+		mcpgo.WithNumber(
+			"from",
+			mcpgo.Description("Unix timestamp (in seconds) from when settled "+
+				"entries are to be returned. Use with to instead of "+
+				"year/month/day to reconcile a range that spans months"),
+			mcpgo.Min(0),
+		),
+		mcpgo.WithNumber(
+			"to",
+			mcpgo.Description("Unix timestamp (in seconds) up till when "+
+				"settled entries are to be returned"),
+			mcpgo.Min(0),
+		),
 		mcpgo.WithNumber(
 			"count",
 			mcpgo.Description("Optional: Number of records to fetch "+
-				"(default: 10, max: 100)"),
+				"(default: 10, max: 100). Ignored with from/to"),
 		),
 		mcpgo.WithNumber(
 			"skip",
-			mcpgo.Description("Optional: Number of records to skip for pagination"),
+			mcpgo.Description("Optional: Number of records to skip for "+
+				"pagination. Ignored with from/to"),
 		),
 	}
 
@@ -108,18 +134,57 @@ func FetchSettlementRecon(
 
 		// Create a parameters map to collect validated parameters
 		fetchReconOptions := make(map[string]interface{})
+		rangeOptions := make(map[string]interface{})
 
 		// Validate using fluent validator
 		validator := NewValidator(&r).
-			ValidateAndAddRequiredInt(fetchReconOptions, "year").
-			ValidateAndAddRequiredInt(fetchReconOptions, "month").
+			ValidateAndAddOptionalInt(fetchReconOptions, "year").
+			ValidateAndAddOptionalInt(fetchReconOptions, "month").
 			ValidateAndAddOptionalInt(fetchReconOptions, "day").
+			ValidateAndAddOptionalInt(rangeOptions, "from").
+			ValidateAndAddOptionalInt(rangeOptions, "to").
 			ValidateAndAddPagination(fetchReconOptions)
 
 		if result, err := validator.HandleErrorsIfAny(); result != nil {
 			return result, err
 		}
 
+		_, hasYear := fetchReconOptions["year"]
+		_, hasMonth := fetchReconOptions["month"]
+		_, hasDay := fetchReconOptions["day"]
+
+		if len(rangeOptions) > 0 {
+			if hasYear || hasMonth || hasDay {
+				return mcpgo.NewToolResultError(
+					"year, month and day cannot be combined with from and to"), nil
+			}
+			from, hasFrom := rangeOptions["from"].(int64)
+			to, hasTo := rangeOptions["to"].(int64)
+			if !hasFrom || !hasTo {
+				return mcpgo.NewToolResultError(
+					"from and to must be provided together"), nil
+			}
+			return fetchSettlementReconRange(client, from, to)
+		}
+
+		// Without a range, year and month are required as before
+		if !hasYear {
+			return mcpgo.NewToolResultError(
+				"missing required parameter: year"), nil
+		}
+		if !hasMonth {
+			return mcpgo.NewToolResultError(
+				"missing required parameter: month"), nil
+		}
+		day, _ := fetchReconOptions["day"].(int64)
+		if err := validateReconDate(
+			fetchReconOptions["year"].(int64),
+			fetchReconOptions["month"].(int64),
+			day,
+		); err != nil {
+			return mcpgo.NewToolResultError(err.Error()), nil
+		}
+
 		report, err := client.Settlement.Reports(fetchReconOptions, nil)
 		if err != nil {
 			return mcpgo.NewToolResultError(
@@ -132,12 +197,318 @@ func FetchSettlementRecon(
 
 	return mcpgo.NewTool(
 		"fetch_settlement_recon_details",
-		"Fetch settlement reconciliation report for a specific time period",
+		"Fetch settlement reconciliation report for a specific time period. "+
+			"Pass year and month (and optionally day), or a from/to range",
+		parameters,
+		handler,
+	)
+}
+
+// FetchSettlementReconByDate returns a tool that fetches every
+// reconciliation entry for a single settlement day
+func FetchSettlementReconByDate(
+	obs *observability.Observability,
+	client *rzpsdk.Client,
+) mcpgo.Tool {
+	parameters := []mcpgo.ToolParameter{
+		mcpgo.WithNumber(
+			"year",
+			mcpgo.Description("Year of the settlement day (YYYY format)"),
+			mcpgo.Required(),
+		),
+		mcpgo.WithNumber(
+			"month",
+			mcpgo.Description("Month of the settlement day (MM format)"),
+			mcpgo.Required(),
+			mcpgo.Min(1),
+			mcpgo.Max(12),
+		),
+		mcpgo.WithNumber(
+			"day",
+			mcpgo.Description("Day of the settlement day (DD format)"),
+			mcpgo.Required(),
+			mcpgo.Min(1),
+			mcpgo.Max(31),
+		),
+	}
+
+	handler := func(
+		ctx context.Context,
+		r mcpgo.CallToolRequest,
+	) (*mcpgo.ToolResult, error) {
+		client, err := getClientFromContextOrDefault(ctx, client)
+		if err != nil {
+			return mcpgo.NewToolResultError(err.Error()), nil
+		}
+
+		options := make(map[string]interface{})
+
+		validator := NewValidator(&r).
+			ValidateAndAddRequiredInt(options, "year").
+			ValidateAndAddRequiredInt(options, "month").
+			ValidateAndAddRequiredInt(options, "day")
+
+		if result, err := validator.HandleErrorsIfAny(); result != nil {
+			return result, err
+		}
+
+		if err := validateReconDate(
+			options["year"].(int64),
+			options["month"].(int64),
+			options["day"].(int64),
+		); err != nil {
+			return mcpgo.NewToolResultError(err.Error()), nil
+		}
+
+		entries, truncated, err := collectAllRecords(
+			client.Settlement.Reports, options, maxSettlementSummaryRecords)
+		if err != nil {
+			return mcpgo.NewToolResultError(
+				fmt.Sprintf("fetching settlement reconciliation report failed: %s",
+					err.Error())), nil
+		}
+
+		return mcpgo.NewToolResultJSON(map[string]interface{}{
+			"date": fmt.Sprintf("%04d-%02d-%02d",
+				options["year"], options["month"], options["day"]),
+			"count":     len(entries),
+			"truncated": truncated,
+			"items":     entries,
+		})
+	}
+
+	return mcpgo.NewTool(
+		"fetch_settlement_recon_by_date",
+		"Fetch every settlement reconciliation entry for a single settlement "+
+			"day, across all pages",
 		parameters,
 		handler,
 	)
 }
 
+// FetchSettlementTransactions returns a tool that lists the transactions
+// (payments, refunds, adjustments, ...) a settlement was made up of, with
+// totals per type
+func FetchSettlementTransactions(
+	obs *observability.Observability,
+	client *rzpsdk.Client,
+) mcpgo.Tool {
+	parameters := []mcpgo.ToolParameter{
+		mcpgo.WithString(
+			"settlement_id",
+			mcpgo.Description("The ID of the settlement to drill into. "+
+				"ID starts with the 'setl_'"),
+			mcpgo.Required(),
+		),
+	}
+
+	handler := func(
+		ctx context.Context,
+		r mcpgo.CallToolRequest,
+	) (*mcpgo.ToolResult, error) {
+		client, err := getClientFromContextOrDefault(ctx, client)
+		if err != nil {
+			return mcpgo.NewToolResultError(err.Error()), nil
+		}
+
+		params := make(map[string]interface{})
+
+		validator := NewValidator(&r).
+			ValidateAndAddRequiredString(params, "settlement_id")
+
+		if result, err := validator.HandleErrorsIfAny(); result != nil {
+			return result, err
+		}
+
+		settlementID := params["settlement_id"].(string)
+		settlement, err := withRetry(ctx, func() (map[string]interface{}, error) {
+			return client.Settlement.Fetch(settlementID, nil, nil)
+		})
+		if err != nil {
+			return mcpgo.NewToolResultError(
+				fmt.Sprintf("fetching settlement failed: %s", err.Error())), nil
+		}
+
+		// The recon report is bucketed by the IST day entries were settled
+		// on, which is the day the settlement was created
+		createdAt, _ := settlement["created_at"].(float64)
+		day := time.Unix(int64(createdAt), 0).In(settlementReportLocation)
+		entries, truncated, err := collectAllRecords(
+			client.Settlement.Reports,
+			map[string]interface{}{
+				"year":  day.Year(),
+				"month": int(day.Month()),
+				"day":   day.Day(),
+			},
+			maxSettlementSummaryRecords,
+		)
+		if err != nil {
+			return mcpgo.NewToolResultError(
+				fmt.Sprintf("fetching settlement reconciliation report failed: %s",
+					err.Error())), nil
+		}
+
+		transactions := make([]map[string]interface{}, 0)
+		for _, entry := range entries {
+			if entry["settlement_id"] == settlementID {
+				transactions = append(transactions, entry)
+			}
+		}
+
+		result := buildSettlementTransactionTotals(settlement, transactions)
+		result["settlement_id"] = settlementID
+		result["truncated"] = truncated
+		result["items"] = transactions
+		return mcpgo.NewToolResultJSON(result)
+	}
+
+	return mcpgo.NewTool(
+		"fetch_settlement_transactions",
+		"Fetch the transactions (payments, refunds, adjustments, transfers) "+
+			"that make up a settlement, from the reconciliation report, with "+
+			"count, credit, debit, fee and tax totals per type and the net "+
+			"amount compared to the settled amount. Amounts are in paise",
+		parameters,
+		handler,
+	)
+}
+
+// buildSettlementTransactionTotals totals a settlement's recon entries per
+// transaction type and compares their net amount with the settled amount
+func buildSettlementTransactionTotals(
+	settlement map[string]interface{},
+	transactions []map[string]interface{},
+) map[string]interface{} {
+	byType := make(map[string]map[string]interface{})
+	var totalCredit, totalDebit float64
+
+	for _, transaction := range transactions {
+		txType, _ := transaction["type"].(string)
+		if txType == "" {
+			txType, _ = transaction["entity"].(string)
+		}
+		totals, ok := byType[txType]
+		if !ok {
+			totals = map[string]interface{}{
+				"count":  0,
+				"credit": float64(0),
+				"debit":  float64(0),
+				"fee":    float64(0),
+				"tax":    float64(0),
+			}
+			byType[txType] = totals
+		}
+
+		totals["count"] = totals["count"].(int) + 1
+		for _, field := range []string{"credit", "debit", "fee", "tax"} {
+			value, _ := transaction[field].(float64)
+			totals[field] = totals[field].(float64) + value
+		}
+		credit, _ := transaction["credit"].(float64)
+		debit, _ := transaction["debit"].(float64)
+		totalCredit += credit
+		totalDebit += debit
+	}
+
+	settledAmount, _ := settlement["amount"].(float64)
+	netAmount := totalCredit - totalDebit
+	return map[string]interface{}{
+		"count":          len(transactions),
+		"by_type":        byType,
+		"net_amount":     netAmount,
+		"settled_amount": settledAmount,
+		"difference":     settledAmount - netAmount,
+	}
+}
+
+// fetchSettlementReconRange fetches the recon report for every month the
+// from/to range touches and keeps the entries settled within the range. The
+// report is bucketed by IST calendar month.
+func fetchSettlementReconRange(
+	client *rzpsdk.Client,
+	from int64,
+	to int64,
+) (*mcpgo.ToolResult, error) {
+	if from > to {
+		return mcpgo.NewToolResultError("from must not be after to"), nil
+	}
+
+	start := time.Unix(from, 0).In(settlementReportLocation)
+	end := time.Unix(to, 0).In(settlementReportLocation)
+	months := (end.Year()-start.Year())*12 + int(end.Month()-start.Month()) + 1
+	if months > maxSettlementReconMonths {
+		return mcpgo.NewToolResultError(fmt.Sprintf(
+			"from and to span %d months, at most %d are supported",
+			months, maxSettlementReconMonths)), nil
+	}
+
+	entries := make([]map[string]interface{}, 0)
+	truncated := false
+	month := time.Date(
+		start.Year(), start.Month(), 1, 0, 0, 0, 0, settlementReportLocation)
+	for i := 0; i < months; i++ {
+		monthEntries, monthTruncated, err := collectAllRecords(
+			client.Settlement.Reports,
+			map[string]interface{}{
+				"year":  month.Year(),
+				"month": int(month.Month()),
+			},
+			maxSettlementSummaryRecords,
+		)
+		if err != nil {
+			return mcpgo.NewToolResultError(
+				fmt.Sprintf("fetching settlement reconciliation report failed: %s",
+					err.Error())), nil
+		}
+		truncated = truncated || monthTruncated
+
+		for _, entry := range monthEntries {
+			settledAt, _ := entry["settled_at"].(float64)
+			if int64(settledAt) >= from && int64(settledAt) <= to {
+				entries = append(entries, entry)
+			}
+		}
+		month = month.AddDate(0, 1, 0)
+	}
+
+	return mcpgo.NewToolResultJSON(map[string]interface{}{
+		"from":      from,
+		"to":        to,
+		"count":     len(entries),
+		"truncated": truncated,
+		"items":     entries,
+	})
+}
+
+// validateReconDate checks that year, month and the optional day (0 when
+// absent) form a real calendar date that is not in the future
+func validateReconDate(year, month, day int64) error {
+	if month < 1 || month > 12 {
+		return fmt.Errorf("invalid month %d: must be between 1 and 12", month)
+	}
+
+	checkDay := day
+	if day == 0 {
+		checkDay = 1
+	}
+	date := time.Date(int(year), time.Month(month), int(checkDay),
+		0, 0, 0, 0, settlementReportLocation)
+	if date.Year() != int(year) || date.Month() != time.Month(month) ||
+		date.Day() != int(checkDay) {
+		return fmt.Errorf("invalid date %04d-%02d-%02d: %s has only %d days",
+			year, month, day, time.Month(month),
+			time.Date(int(year), time.Month(month)+1, 0, 0, 0, 0, 0,
+				settlementReportLocation).Day())
+	}
+
+	if date.After(time.Now().In(settlementReportLocation)) {
+		return fmt.Errorf("invalid date %04d-%02d-%02d: date is in the future",
+			year, month, checkDay)
+	}
+
+	return nil
+}
+
 // FetchAllSettlements returns a tool to fetch multiple settlements with
 // filtering and pagination
 func FetchAllSettlements(
@@ -171,7 +542,20 @@ func FetchAllSettlements(
 				"settlements are to be fetched"),
 			mcpgo.Min(0),
 		),
+		mcpgo.WithBoolean(
+			"summarize",
+			mcpgo.Description("Return totals for every settlement in the "+
+				"from/to range instead of a single page: total settled "+
+				"amount, count, and amounts grouped by currency and status. "+
+				"count and skip are ignored (default: false)"),
+		),
+		mcpgo.WithBoolean(
+			"include_items",
+			mcpgo.Description("With summarize, also return the settlements "+
+				"the totals were computed from (default: false)"),
+		),
 	}
+	parameters = append(parameters, autoPaginationParameters("settlements")...)
 
 	handler := func(
 		ctx context.Context,
@@ -184,17 +568,41 @@ func FetchAllSettlements(
 
 		// Create parameters map to collect validated parameters
 		fetchAllSettlementsOptions := make(map[string]interface{})
+		summaryOptions := make(map[string]interface{})
 
 		// Validate using fluent validator
 		validator := NewValidator(&r).
 			ValidateAndAddPagination(fetchAllSettlementsOptions).
 			ValidateAndAddOptionalInt(fetchAllSettlementsOptions, "from").
-			ValidateAndAddOptionalInt(fetchAllSettlementsOptions, "to")
+			ValidateAndAddOptionalInt(fetchAllSettlementsOptions, "to").
+			ValidateAndAddOptionalBool(summaryOptions, "summarize").
+			ValidateAndAddOptionalBool(summaryOptions, "include_items")
+		validator = validateAndAddAutoPagination(validator, summaryOptions)
 
 		if result, err := validator.HandleErrorsIfAny(); result != nil {
 			return result, err
 		}
 
+		if summarize, _ := summaryOptions["summarize"].(bool); summarize {
+			includeItems, _ := summaryOptions["include_items"].(bool)
+			return summarizeSettlements(
+				client, fetchAllSettlementsOptions, includeItems)
+		}
+
+		fetchAll, maxRecords, err := fetchAllRequested(summaryOptions)
+		if err != nil {
+			return mcpgo.NewToolResultError(err.Error()), nil
+		}
+		if fetchAll {
+			settlements, err := collectAllPages(
+				client.Settlement.All, fetchAllSettlementsOptions, maxRecords)
+			if err != nil {
+				return mcpgo.NewToolResultError(
+					fmt.Sprintf("fetching settlements failed: %s", err.Error())), nil
+			}
+			return mcpgo.NewToolResultJSON(settlements)
+		}
+
 		// Fetch all settlements using Razorpay SDK
 		settlements, err := client.Settlement.All(fetchAllSettlementsOptions, nil)
 		if err != nil {
@@ -207,12 +615,97 @@ func FetchAllSettlements(
 
 	return mcpgo.NewTool(
 		"fetch_all_settlements",
-		"Fetch all settlements with optional filtering and pagination",
+		"Fetch all settlements with optional filtering and pagination. "+
+			"Set summarize to get month-end totals grouped by currency and "+
+			"status instead of the list",
 		parameters,
 		handler,
 	)
 }
 
+// summarizeSettlements pages through every settlement in the from/to range
+// of options and returns their totals
+func summarizeSettlements(
+	client *rzpsdk.Client,
+	options map[string]interface{},
+	includeItems bool,
+) (*mcpgo.ToolResult, error) {
+	settlements, truncated, err := collectAllRecords(
+		client.Settlement.All, options, maxSettlementSummaryRecords)
+	if err != nil {
+		return mcpgo.NewToolResultError(
+			fmt.Sprintf("fetching settlements failed: %s", err.Error())), nil
+	}
+
+	summary := buildSettlementsSummary(settlements)
+	summary["from"] = options["from"]
+	summary["to"] = options["to"]
+	// The record cap was hit, so the totals don't cover the whole range
+	summary["truncated"] = truncated
+	if includeItems {
+		summary["items"] = settlements
+	}
+
+	return mcpgo.NewToolResultJSON(summary)
+}
+
+// buildSettlementsSummary totals settlements overall and grouped by
+// currency and status. Only processed settlements count towards the
+// settled amount.
+func buildSettlementsSummary(
+	settlements []map[string]interface{},
+) map[string]interface{} {
+	totalSettled := float64(0)
+	totalFees := float64(0)
+	totalTax := float64(0)
+	byCurrency := make(map[string]map[string]interface{})
+	byStatus := make(map[string]map[string]interface{})
+
+	addTo := func(
+		groups map[string]map[string]interface{},
+		key string,
+		amount float64,
+	) {
+		group, ok := groups[key]
+		if !ok {
+			group = map[string]interface{}{"count": 0, "amount": float64(0)}
+			groups[key] = group
+		}
+		group["count"] = group["count"].(int) + 1
+		group["amount"] = group["amount"].(float64) + amount
+	}
+
+	for _, settlement := range settlements {
+		amount, _ := settlement["amount"].(float64)
+		fees, _ := settlement["fees"].(float64)
+		tax, _ := settlement["tax"].(float64)
+		status, _ := settlement["status"].(string)
+		// Settlements are made to the merchant's bank account in INR
+		currency, _ := settlement["currency"].(string)
+		if currency == "" {
+			currency = "INR"
+		}
+
+		addTo(byStatus, status, amount)
+		if status != "processed" {
+			continue
+		}
+		addTo(byCurrency, currency, amount)
+		totalSettled += amount
+		totalFees += fees
+		totalTax += tax
+	}
+
+	return map[string]interface{}{
+		"count":                len(settlements),
+		"total_settled_amount": totalSettled,
+		"total_fees":           totalFees,
+		"total_tax":            totalTax,
+		"by_currency":          byCurrency,
+		"by_status":            byStatus,
+	}
+}
+
 // CreateInstantSettlement returns a tool that creates an instant settlement
 func CreateInstantSettlement(
 	obs *observability.Observability,
@@ -263,7 +756,7 @@ func CreateInstantSettlement(
 			ValidateAndAddRequiredInt(createInstantSettlementReq, "amount").
 			ValidateAndAddOptionalBool(createInstantSettlementReq, "settle_full_balance"). // nolint:lll
 			ValidateAndAddOptionalString(createInstantSettlementReq, "description").
-			ValidateAndAddOptionalMap(createInstantSettlementReq, "notes")
+			ValidateAndAddOptionalNotes(createInstantSettlementReq, "notes")
 
 		if result, err := validator.HandleErrorsIfAny(); result != nil {
 			return result, err
@@ -333,6 +826,33 @@ func FetchAllInstantSettlements(
 				"enum": []interface{}{"ondemand_payouts"},
 			}),
 		),
+		// Filters applied to every instant settlement in the from/to range
+		mcpgo.WithString(
+			"settlement_type",
+			mcpgo.Description("Only return instant settlements of this type: "+
+				"on_demand (requested manually or through the API) or "+
+				"scheduled (created automatically at the configured times)"),
+			mcpgo.Enum("on_demand", "scheduled"),
+		),
+		mcpgo.WithNumber(
+			"min_amount",
+			mcpgo.Description("Only return instant settlements with a requested "+
+				"amount of at least this much, in the smallest currency sub-unit"),
+			mcpgo.Min(0),
+		),
+		mcpgo.WithNumber(
+			"max_amount",
+			mcpgo.Description("Only return instant settlements with a requested "+
+				"amount of at most this much, in the smallest currency sub-unit"),
+			mcpgo.Min(0),
+		),
+		mcpgo.WithBoolean(
+			"summarize",
+			mcpgo.Description("Return totals of the settled amount, fees and "+
+				"tax over the from/to range. Implied by settlement_type, "+
+				"min_amount and max_amount, which need the whole range to "+
+				"filter. count and skip are ignored (default: false)"),
+		),
 	}
 
 	handler := func(
@@ -346,18 +866,29 @@ func FetchAllInstantSettlements(
 
 		// Create parameters map to collect validated parameters
 		options := make(map[string]interface{})
+		filters := make(map[string]interface{})
 
 		// Validate using fluent validator
 		validator := NewValidator(&r).
 			ValidateAndAddPagination(options).
 			ValidateAndAddExpand(options).
 			ValidateAndAddOptionalInt(options, "from").
-			ValidateAndAddOptionalInt(options, "to")
+			ValidateAndAddOptionalInt(options, "to").
+			ValidateAndAddOptionalString(filters, "settlement_type").
+			ValidateAndAddOptionalInt(filters, "min_amount").
+			ValidateAndAddOptionalInt(filters, "max_amount").
+			ValidateAndAddOptionalBool(filters, "summarize")
 
 		if result, err := validator.HandleErrorsIfAny(); result != nil {
 			return result, err
 		}
 
+		summarize, _ := filters["summarize"].(bool)
+		delete(filters, "summarize")
+		if summarize || len(filters) > 0 {
+			return summarizeInstantSettlements(client, options, filters)
+		}
+
 		// Fetch all instant settlements using Razorpay SDK
 		settlements, err := client.Settlement.FetchAllOnDemandSettlement(options, nil)
 		if err != nil {
@@ -370,12 +901,109 @@ func FetchAllInstantSettlements(
 
 	return mcpgo.NewTool(
 		"fetch_all_instant_settlements",
-		"Fetch all instant settlements with optional filtering, pagination, and payout details", //nolint:lll
+		"Fetch all instant settlements with optional filtering, pagination, and payout details. "+ //nolint:lll
+			"Filter by settlement type or amount range to get the total settled "+
+			"amount and fees for those instant settlements over the period",
 		parameters,
 		handler,
 	)
 }
 
+// summarizeInstantSettlements pages through every instant settlement in the
+// from/to range of options, keeps the ones matching filters and returns them
+// with their totals
+func summarizeInstantSettlements(
+	client *rzpsdk.Client,
+	options map[string]interface{},
+	filters map[string]interface{},
+) (*mcpgo.ToolResult, error) {
+	settlements, truncated, err := collectAllRecords(
+		client.Settlement.FetchAllOnDemandSettlement, options,
+		maxSettlementSummaryRecords)
+	if err != nil {
+		return mcpgo.NewToolResultError(
+			fmt.Sprintf("fetching instant settlements failed: %s",
+				err.Error())), nil
+	}
+
+	matched := make([]map[string]interface{}, 0, len(settlements))
+	for _, settlement := range settlements {
+		if matchesInstantSettlementFilters(settlement, filters) {
+			matched = append(matched, settlement)
+		}
+	}
+
+	summary := buildInstantSettlementsSummary(matched)
+	summary["from"] = options["from"]
+	summary["to"] = options["to"]
+	// The record cap was hit, so the totals don't cover the whole range
+	summary["truncated"] = truncated
+	summary["items"] = matched
+
+	return mcpgo.NewToolResultJSON(summary)
+}
+
+// matchesInstantSettlementFilters reports whether an instant settlement has
+// the requested type and a requested amount within the requested range
+func matchesInstantSettlementFilters(
+	settlement map[string]interface{},
+	filters map[string]interface{},
+) bool {
+	if settlementType, ok := filters["settlement_type"].(string); ok {
+		scheduled, _ := settlement["scheduled"].(bool)
+		if (settlementType == "scheduled") != scheduled {
+			return false
+		}
+	}
+
+	amount, _ := settlement["amount_requested"].(float64)
+	if minAmount, ok := filters["min_amount"].(int64); ok &&
+		amount < float64(minAmount) {
+		return false
+	}
+	if maxAmount, ok := filters["max_amount"].(int64); ok &&
+		amount > float64(maxAmount) {
+		return false
+	}
+
+	return true
+}
+
+// buildInstantSettlementsSummary totals the requested and settled amounts,
+// fees and tax of instant settlements, with counts by status
+func buildInstantSettlementsSummary(
+	settlements []map[string]interface{},
+) map[string]interface{} {
+	totalRequested := float64(0)
+	totalSettled := float64(0)
+	totalFees := float64(0)
+	totalTax := float64(0)
+	byStatus := make(map[string]int)
+
+	for _, settlement := range settlements {
+		requested, _ := settlement["amount_requested"].(float64)
+		settled, _ := settlement["amount_settled"].(float64)
+		fees, _ := settlement["fees"].(float64)
+		tax, _ := settlement["tax"].(float64)
+		status, _ := settlement["status"].(string)
+
+		totalRequested += requested
+		totalSettled += settled
+		totalFees += fees
+		totalTax += tax
+		byStatus[status]++
+	}
+
+	return map[string]interface{}{
+		"count":                  len(settlements),
+		"total_amount_requested": totalRequested,
+		"total_amount_settled":   totalSettled,
+		"total_fees":             totalFees,
+		"total_tax":              totalTax,
+		"by_status":              byStatus,
+	}
+}
+
 // FetchInstantSettlement returns a tool that fetches instant settlement by ID
 func FetchInstantSettlement(
 	obs *observability.Observability,
@@ -722,3 +722,649 @@ func Test_FetchInstantSettlement(t *testing.T) {
 		})
 	}
 }
+
+func Test_FetchAllSettlements_Summary(t *testing.T) {
+	fetchAllSettlementsPath := fmt.Sprintf(
+		"/%s%s",
+		constants.VERSION_V1,
+		constants.SETTLEMENT_URL,
+	)
+
+	settlementItems := []interface{}{
+		map[string]interface{}{
+			"id":     "setl_FNj7g2YS5J67Rz",
+			"entity": "settlement",
+			"amount": float64(9973635),
+			"fees":   float64(471),
+			"tax":    float64(72),
+			"status": "processed",
+		},
+		map[string]interface{}{
+			"id":     "setl_FJOp0jOWlalIvt",
+			"entity": "settlement",
+			"amount": float64(299114),
+			"fees":   float64(10),
+			"tax":    float64(2),
+			"status": "processed",
+		},
+		map[string]interface{}{
+			"id":     "setl_FJOp0jOWlalIvu",
+			"entity": "settlement",
+			"amount": float64(5000),
+			"fees":   float64(0),
+			"tax":    float64(0),
+			"status": "failed",
+		},
+	}
+
+	settlementsResp := map[string]interface{}{
+		"entity": "collection",
+		"count":  float64(3),
+		"items":  settlementItems,
+	}
+
+	summary := map[string]interface{}{
+		"count":                float64(3),
+		"total_settled_amount": float64(10272749),
+		"total_fees":           float64(481),
+		"total_tax":            float64(74),
+		"by_currency": map[string]interface{}{
+			"INR": map[string]interface{}{
+				"count":  float64(2),
+				"amount": float64(10272749),
+			},
+		},
+		"by_status": map[string]interface{}{
+			"processed": map[string]interface{}{
+				"count":  float64(2),
+				"amount": float64(10272749),
+			},
+			"failed": map[string]interface{}{
+				"count":  float64(1),
+				"amount": float64(5000),
+			},
+		},
+		"from":      float64(1609459200),
+		"to":        float64(1612137599),
+		"truncated": false,
+	}
+
+	summaryWithItems := make(map[string]interface{}, len(summary)+1)
+	for k, v := range summary {
+		summaryWithItems[k] = v
+	}
+	summaryWithItems["items"] = settlementItems
+
+	tests := []RazorpayToolTestCase{
+		{
+			Name: "summary over a date range",
+			Request: map[string]interface{}{
+				"from":      float64(1609459200),
+				"to":        float64(1612137599),
+				"summarize": true,
+			},
+			MockHttpClient: func() (*http.Client, *httptest.Server) {
+				return mock.NewHTTPClient(
+					mock.Endpoint{
+						Path:     fetchAllSettlementsPath,
+						Method:   "GET",
+						Response: settlementsResp,
+					},
+				)
+			},
+			ExpectError:    false,
+			ExpectedResult: summary,
+		},
+		{
+			Name: "summary with items",
+			Request: map[string]interface{}{
+				"from":          float64(1609459200),
+				"to":            float64(1612137599),
+				"summarize":     true,
+				"include_items": true,
+			},
+			MockHttpClient: func() (*http.Client, *httptest.Server) {
+				return mock.NewHTTPClient(
+					mock.Endpoint{
+						Path:     fetchAllSettlementsPath,
+						Method:   "GET",
+						Response: settlementsResp,
+					},
+				)
+			},
+			ExpectError:    false,
+			ExpectedResult: summaryWithItems,
+		},
+		{
+			Name: "summary fetch fails",
+			Request: map[string]interface{}{
+				"summarize": true,
+			},
+			MockHttpClient: func() (*http.Client, *httptest.Server) {
+				return mock.NewHTTPClient(
+					mock.Endpoint{
+						Path:   fetchAllSettlementsPath,
+						Method: "GET",
+						Response: map[string]interface{}{
+							"error": map[string]interface{}{
+								"code": "BAD_REQUEST_ERROR",
+								"description": "from must be between " +
+									"946684800 and 4765046400",
+							},
+						},
+					},
+				)
+			},
+			ExpectError: true,
+			ExpectedErrMsg: "fetching settlements failed: " +
+				"from must be between 946684800 and 4765046400",
+		},
+		{
+			Name: "invalid summarize parameter",
+			Request: map[string]interface{}{
+				"summarize": "yes",
+			},
+			MockHttpClient: nil,
+			ExpectError:    true,
+			ExpectedErrMsg: "invalid parameter type: summarize",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.Name, func(t *testing.T) {
+			runToolTest(t, tc, FetchAllSettlements, "Settlements Summary")
+		})
+	}
+}
+
+func Test_FetchAllInstantSettlements_Filters(t *testing.T) {
+	fetchAllInstantSettlementsPath := fmt.Sprintf(
+		"/%s%s/ondemand",
+		constants.VERSION_V1,
+		constants.SETTLEMENT_URL,
+	)
+
+	onDemandSmall := map[string]interface{}{
+		"id":               "setlod_FNj7g2YS5J67Rz",
+		"entity":           "settlement.ondemand",
+		"amount_requested": float64(200000),
+		"amount_settled":   float64(199410),
+		"fees":             float64(590),
+		"tax":              float64(90),
+		"status":           "processed",
+		"scheduled":        false,
+	}
+	onDemandLarge := map[string]interface{}{
+		"id":               "setlod_FJOp0jOWlalIvt",
+		"entity":           "settlement.ondemand",
+		"amount_requested": float64(3000000),
+		"amount_settled":   float64(2991140),
+		"fees":             float64(8860),
+		"tax":              float64(1360),
+		"status":           "processed",
+		"scheduled":        false,
+	}
+	scheduled := map[string]interface{}{
+		"id":               "setlod_FJOp0jOWlalIvu",
+		"entity":           "settlement.ondemand",
+		"amount_requested": float64(500000),
+		"amount_settled":   float64(0),
+		"fees":             float64(0),
+		"tax":              float64(0),
+		"status":           "initiated",
+		"scheduled":        true,
+	}
+
+	settlementsResp := map[string]interface{}{
+		"entity": "collection",
+		"count":  float64(3),
+		"items": []interface{}{
+			onDemandSmall, onDemandLarge, scheduled,
+		},
+	}
+
+	mockClient := func() (*http.Client, *httptest.Server) {
+		return mock.NewHTTPClient(
+			mock.Endpoint{
+				Path:     fetchAllInstantSettlementsPath,
+				Method:   "GET",
+				Response: settlementsResp,
+			},
+		)
+	}
+
+	tests := []RazorpayToolTestCase{
+		{
+			Name: "on demand settlements within an amount range",
+			Request: map[string]interface{}{
+				"from":            float64(1596700000),
+				"to":              float64(1596800000),
+				"settlement_type": "on_demand",
+				"max_amount":      float64(1000000),
+			},
+			MockHttpClient: mockClient,
+			ExpectError:    false,
+			ExpectedResult: map[string]interface{}{
+				"count":                  float64(1),
+				"total_amount_requested": float64(200000),
+				"total_amount_settled":   float64(199410),
+				"total_fees":             float64(590),
+				"total_tax":              float64(90),
+				"by_status": map[string]interface{}{
+					"processed": float64(1),
+				},
+				"from":      float64(1596700000),
+				"to":        float64(1596800000),
+				"truncated": false,
+				"items":     []interface{}{onDemandSmall},
+			},
+		},
+		{
+			Name: "summary of every instant settlement",
+			Request: map[string]interface{}{
+				"summarize": true,
+			},
+			MockHttpClient: mockClient,
+			ExpectError:    false,
+			ExpectedResult: map[string]interface{}{
+				"count":                  float64(3),
+				"total_amount_requested": float64(3700000),
+				"total_amount_settled":   float64(3190550),
+				"total_fees":             float64(9450),
+				"total_tax":              float64(1450),
+				"by_status": map[string]interface{}{
+					"processed": float64(2),
+					"initiated": float64(1),
+				},
+				"from":      nil,
+				"to":        nil,
+				"truncated": false,
+				"items": []interface{}{
+					onDemandSmall, onDemandLarge, scheduled,
+				},
+			},
+		},
+		{
+			Name: "scheduled settlements only",
+			Request: map[string]interface{}{
+				"settlement_type": "scheduled",
+				"min_amount":      float64(100000),
+			},
+			MockHttpClient: mockClient,
+			ExpectError:    false,
+			ExpectedResult: map[string]interface{}{
+				"count":                  float64(1),
+				"total_amount_requested": float64(500000),
+				"total_amount_settled":   float64(0),
+				"total_fees":             float64(0),
+				"total_tax":              float64(0),
+				"by_status": map[string]interface{}{
+					"initiated": float64(1),
+				},
+				"from":      nil,
+				"to":        nil,
+				"truncated": false,
+				"items":     []interface{}{scheduled},
+			},
+		},
+		{
+			Name: "invalid min_amount parameter",
+			Request: map[string]interface{}{
+				"min_amount": "1000",
+			},
+			MockHttpClient: nil,
+			ExpectError:    true,
+			ExpectedErrMsg: "invalid parameter type: min_amount",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.Name, func(t *testing.T) {
+			runToolTest(t, tc, FetchAllInstantSettlements, "Instant Settlements")
+		})
+	}
+}
+
+func Test_FetchSettlementRecon_DateRange(t *testing.T) {
+	fetchSettlementReconPath := fmt.Sprintf(
+		"/%s%s/recon/combined",
+		constants.VERSION_V1,
+		constants.SETTLEMENT_URL,
+	)
+
+	inRange := map[string]interface{}{
+		"entity":        "payment",
+		"settlement_id": "setl_FNj7g2YS5J67Rz",
+		"amount":        float64(10000),
+		"settled_at":    float64(1665000000),
+	}
+	outOfRange := map[string]interface{}{
+		"entity":        "payment",
+		"settlement_id": "setl_FJOp0jOWlalIvt",
+		"amount":        float64(20000),
+		"settled_at":    float64(1667300000),
+	}
+
+	reconResp := map[string]interface{}{
+		"entity": "collection",
+		"count":  float64(2),
+		"items":  []interface{}{inRange, outOfRange},
+	}
+
+	tests := []RazorpayToolTestCase{
+		{
+			// 2022-10-01 00:00:00 to 2022-10-31 23:59:59 IST
+			Name: "entries settled within the range",
+			Request: map[string]interface{}{
+				"from": float64(1664562600),
+				"to":   float64(1667240999),
+			},
+			MockHttpClient: func() (*http.Client, *httptest.Server) {
+				return mock.NewHTTPClient(
+					mock.Endpoint{
+						Path:     fetchSettlementReconPath,
+						Method:   "GET",
+						Response: reconResp,
+					},
+				)
+			},
+			ExpectError: false,
+			ExpectedResult: map[string]interface{}{
+				"from":      float64(1664562600),
+				"to":        float64(1667240999),
+				"count":     float64(1),
+				"truncated": false,
+				"items":     []interface{}{inRange},
+			},
+		},
+		{
+			Name: "range combined with year and month",
+			Request: map[string]interface{}{
+				"year":  float64(2022),
+				"month": float64(10),
+				"from":  float64(1664562600),
+				"to":    float64(1667240999),
+			},
+			MockHttpClient: nil,
+			ExpectError:    true,
+			ExpectedErrMsg: "year, month and day cannot be combined with from and to",
+		},
+		{
+			Name: "from without to",
+			Request: map[string]interface{}{
+				"from": float64(1664562600),
+			},
+			MockHttpClient: nil,
+			ExpectError:    true,
+			ExpectedErrMsg: "from and to must be provided together",
+		},
+		{
+			Name: "from after to",
+			Request: map[string]interface{}{
+				"from": float64(1667240999),
+				"to":   float64(1664562600),
+			},
+			MockHttpClient: nil,
+			ExpectError:    true,
+			ExpectedErrMsg: "from must not be after to",
+		},
+		{
+			// 2022-10-01 to 2023-01-31 IST
+			Name: "range spanning too many months",
+			Request: map[string]interface{}{
+				"from": float64(1664562600),
+				"to":   float64(1675189799),
+			},
+			MockHttpClient: nil,
+			ExpectError:    true,
+			ExpectedErrMsg: "from and to span 4 months, at most 3 are supported",
+		},
+		{
+			Name: "invalid month",
+			Request: map[string]interface{}{
+				"year":  float64(2022),
+				"month": float64(13),
+			},
+			MockHttpClient: nil,
+			ExpectError:    true,
+			ExpectedErrMsg: "invalid month 13: must be between 1 and 12",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.Name, func(t *testing.T) {
+			runToolTest(t, tc, FetchSettlementRecon, "Settlement Recon")
+		})
+	}
+}
+
+func Test_FetchSettlementReconByDate(t *testing.T) {
+	fetchSettlementReconPath := fmt.Sprintf(
+		"/%s%s/recon/combined",
+		constants.VERSION_V1,
+		constants.SETTLEMENT_URL,
+	)
+
+	entry := map[string]interface{}{
+		"entity":        "payment",
+		"settlement_id": "setl_FNj7g2YS5J67Rz",
+		"amount":        float64(10000),
+		"settled_at":    float64(1665820800),
+	}
+
+	tests := []RazorpayToolTestCase{
+		{
+			Name: "entries for a settlement day",
+			Request: map[string]interface{}{
+				"year":  float64(2022),
+				"month": float64(10),
+				"day":   float64(15),
+			},
+			MockHttpClient: func() (*http.Client, *httptest.Server) {
+				return mock.NewHTTPClient(
+					mock.Endpoint{
+						Path:   fetchSettlementReconPath,
+						Method: "GET",
+						Response: map[string]interface{}{
+							"entity": "collection",
+							"count":  float64(1),
+							"items":  []interface{}{entry},
+						},
+					},
+				)
+			},
+			ExpectError: false,
+			ExpectedResult: map[string]interface{}{
+				"date":      "2022-10-15",
+				"count":     float64(1),
+				"truncated": false,
+				"items":     []interface{}{entry},
+			},
+		},
+		{
+			Name: "day that does not exist",
+			Request: map[string]interface{}{
+				"year":  float64(2023),
+				"month": float64(2),
+				"day":   float64(30),
+			},
+			MockHttpClient: nil,
+			ExpectError:    true,
+			ExpectedErrMsg: "invalid date 2023-02-30: February has only 28 days",
+		},
+		{
+			Name: "day in the future",
+			Request: map[string]interface{}{
+				"year":  float64(2999),
+				"month": float64(1),
+				"day":   float64(1),
+			},
+			MockHttpClient: nil,
+			ExpectError:    true,
+			ExpectedErrMsg: "invalid date 2999-01-01: date is in the future",
+		},
+		{
+			Name: "missing day parameter",
+			Request: map[string]interface{}{
+				"year":  float64(2022),
+				"month": float64(10),
+			},
+			MockHttpClient: nil,
+			ExpectError:    true,
+			ExpectedErrMsg: "missing required parameter: day",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.Name, func(t *testing.T) {
+			runToolTest(t, tc, FetchSettlementReconByDate, "Settlement Recon")
+		})
+	}
+}
+
+func Test_FetchSettlementTransactions(t *testing.T) {
+	fetchSettlementPathFmt := fmt.Sprintf(
+		"/%s%s/%%s",
+		constants.VERSION_V1,
+		constants.SETTLEMENT_URL,
+	)
+	fetchSettlementReconPath := fmt.Sprintf(
+		"/%s%s/recon/combined",
+		constants.VERSION_V1,
+		constants.SETTLEMENT_URL,
+	)
+
+	settlement := map[string]interface{}{
+		"id":         "setl_FNj7g2YS5J67Rz",
+		"entity":     "settlement",
+		"amount":     float64(9550),
+		"status":     "processed",
+		"created_at": float64(1665820800),
+	}
+
+	payment := map[string]interface{}{
+		"entity_id":     "pay_DEXrnipqTmWVGE",
+		"type":          "payment",
+		"credit":        float64(9800),
+		"debit":         float64(0),
+		"fee":           float64(200),
+		"tax":           float64(30),
+		"settlement_id": "setl_FNj7g2YS5J67Rz",
+	}
+	secondPayment := map[string]interface{}{
+		"entity_id":     "pay_DEXrnipqTmWVGF",
+		"type":          "payment",
+		"credit":        float64(950),
+		"debit":         float64(0),
+		"fee":           float64(50),
+		"tax":           float64(8),
+		"settlement_id": "setl_FNj7g2YS5J67Rz",
+	}
+	refund := map[string]interface{}{
+		"entity_id":     "rfnd_DGRcGzwTdZKXeC",
+		"type":          "refund",
+		"credit":        float64(0),
+		"debit":         float64(1200),
+		"fee":           float64(0),
+		"tax":           float64(0),
+		"settlement_id": "setl_FNj7g2YS5J67Rz",
+	}
+	otherSettlement := map[string]interface{}{
+		"entity_id":     "pay_DEXrnipqTmWVGG",
+		"type":          "payment",
+		"credit":        float64(5000),
+		"settlement_id": "setl_FNj7g2YS5J67Sa",
+	}
+
+	tests := []RazorpayToolTestCase{
+		{
+			Name: "transactions with totals per type",
+			Request: map[string]interface{}{
+				"settlement_id": "setl_FNj7g2YS5J67Rz",
+			},
+			MockHttpClient: func() (*http.Client, *httptest.Server) {
+				return mock.NewHTTPClient(
+					mock.Endpoint{
+						Path: fmt.Sprintf(fetchSettlementPathFmt,
+							"setl_FNj7g2YS5J67Rz"),
+						Method:   "GET",
+						Response: settlement,
+					},
+					mock.Endpoint{
+						Path:   fetchSettlementReconPath,
+						Method: "GET",
+						Response: map[string]interface{}{
+							"entity": "collection",
+							"count":  float64(4),
+							"items": []interface{}{
+								payment, otherSettlement, secondPayment, refund,
+							},
+						},
+					},
+				)
+			},
+			ExpectedResult: map[string]interface{}{
+				"settlement_id": "setl_FNj7g2YS5J67Rz",
+				"count":         float64(3),
+				"by_type": map[string]interface{}{
+					"payment": map[string]interface{}{
+						"count":  float64(2),
+						"credit": float64(10750),
+						"debit":  float64(0),
+						"fee":    float64(250),
+						"tax":    float64(38),
+					},
+					"refund": map[string]interface{}{
+						"count":  float64(1),
+						"credit": float64(0),
+						"debit":  float64(1200),
+						"fee":    float64(0),
+						"tax":    float64(0),
+					},
+				},
+				"net_amount":     float64(9550),
+				"settled_amount": float64(9550),
+				"difference":     float64(0),
+				"truncated":      false,
+				"items": []interface{}{
+					payment, secondPayment, refund,
+				},
+			},
+		},
+		{
+			Name: "settlement not found",
+			Request: map[string]interface{}{
+				"settlement_id": "setl_invalid",
+			},
+			MockHttpClient: func() (*http.Client, *httptest.Server) {
+				return mock.NewHTTPClient(
+					mock.Endpoint{
+						Path:   fmt.Sprintf(fetchSettlementPathFmt, "setl_invalid"),
+						Method: "GET",
+						Response: map[string]interface{}{
+							"error": map[string]interface{}{
+								"code":        "BAD_REQUEST_ERROR",
+								"description": "The id provided does not exist",
+							},
+						},
+					},
+				)
+			},
+			ExpectError: true,
+			ExpectedErrMsg: "fetching settlement failed: " +
+				"The id provided does not exist",
+		},
+		{
+			Name:           "missing settlement_id parameter",
+			Request:        map[string]interface{}{},
+			ExpectError:    true,
+			ExpectedErrMsg: "missing required parameter: settlement_id",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.Name, func(t *testing.T) {
+			runToolTest(t, tc, FetchSettlementTransactions, "Settlement")
+		})
+	}
+}
@@ -0,0 +1,350 @@
+package razorpay
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/spf13/viper"
+
+	rzpsdk "github.com/razorpay/razorpay-go"
+
+	"github.com/razorpay/razorpay-mcp-server/pkg/mcpgo"
+	"github.com/razorpay/razorpay-mcp-server/pkg/observability"
+)
+
+const missingKeySecretError = "key_secret is required when the server " +
+	"has no key secret configured"
+
+// VerifyPaymentSignature returns a tool that verifies the signature returned
+// by Checkout for a successful payment
+func VerifyPaymentSignature(
+	obs *observability.Observability,
+	client *rzpsdk.Client,
+) mcpgo.Tool {
+	parameters := []mcpgo.ToolParameter{
+		mcpgo.WithString(
+			"razorpay_order_id",
+			mcpgo.Description("Order ID returned by Checkout. "+
+				"For example, 'order_00000000000001'"),
+			mcpgo.Required(),
+		),
+		mcpgo.WithString(
+			"razorpay_payment_id",
+			mcpgo.Description("Payment ID returned by Checkout. "+
+				"For example, 'pay_00000000000001'"),
+			mcpgo.Required(),
+		),
+		mcpgo.WithString(
+			"razorpay_signature",
+			mcpgo.Description("Signature returned by Checkout"),
+			mcpgo.Required(),
+		),
+		mcpgo.WithString(
+			"key_secret",
+			mcpgo.Description("Key secret to verify the signature with. "+
+				"Defaults to the key secret the server is configured with"),
+		),
+	}
+
+	handler := func(
+		ctx context.Context,
+		r mcpgo.CallToolRequest,
+	) (*mcpgo.ToolResult, error) {
+		params := make(map[string]interface{})
+
+		validator := NewValidator(&r).
+			ValidateAndAddRequiredString(params, "razorpay_order_id").
+			ValidateAndAddRequiredString(params, "razorpay_payment_id").
+			ValidateAndAddRequiredString(params, "razorpay_signature").
+			ValidateAndAddOptionalString(params, "key_secret")
+
+		if result, err := validator.HandleErrorsIfAny(); result != nil {
+			return result, err
+		}
+
+		keySecret := resolveKeySecret(params)
+		if keySecret == "" {
+			return mcpgo.NewToolResultError(missingKeySecretError), nil
+		}
+
+		payload := params["razorpay_order_id"].(string) + "|" +
+			params["razorpay_payment_id"].(string)
+		expected := computeSignature(payload, keySecret)
+		signature := params["razorpay_signature"].(string)
+
+		result := map[string]interface{}{
+			"valid": hmac.Equal([]byte(expected), []byte(signature)),
+		}
+
+		// A signature computed with the server's own secret would let any
+		// caller forge Checkout signatures for the account, so it is only
+		// echoed back when the caller supplied the secret themselves
+		if callerSecret, _ := params["key_secret"].(string); callerSecret != "" {
+			result["computed_signature"] = expected
+		}
+
+		return mcpgo.NewToolResultJSON(result)
+	}
+
+	return mcpgo.NewTool(
+		"verify_payment_signature",
+		"Verify the razorpay_signature returned by Checkout for a payment. "+
+			"Computes HMAC-SHA256 of 'razorpay_order_id|razorpay_payment_id' "+
+			"with the key secret and returns whether it matches. The computed "+
+			"signature is included for comparison only when key_secret is "+
+			"passed explicitly",
+		parameters,
+		handler,
+	)
+}
+
+// VerifyCheckout returns a tool that verifies a completed checkout by
+// checking the signature and then confirming the payment with the API
+func VerifyCheckout(
+	obs *observability.Observability,
+	client *rzpsdk.Client,
+) mcpgo.Tool {
+	parameters := []mcpgo.ToolParameter{
+		mcpgo.WithString(
+			"razorpay_order_id",
+			mcpgo.Description("Order ID returned by Checkout. "+
+				"For example, 'order_00000000000001'"),
+			mcpgo.Required(),
+		),
+		mcpgo.WithString(
+			"razorpay_payment_id",
+			mcpgo.Description("Payment ID returned by Checkout. "+
+				"For example, 'pay_00000000000001'"),
+			mcpgo.Required(),
+		),
+		mcpgo.WithString(
+			"razorpay_signature",
+			mcpgo.Description("Signature returned by Checkout"),
+			mcpgo.Required(),
+		),
+		mcpgo.WithString(
+			"key_secret",
+			mcpgo.Description("Key secret to verify the signature with. "+
+				"Defaults to the key secret of the account the payment and "+
+				"order are fetched from"),
+		),
+	}
+
+	handler := func(
+		ctx context.Context,
+		r mcpgo.CallToolRequest,
+	) (*mcpgo.ToolResult, error) {
+		client, err := getClientFromContextOrDefault(ctx, client)
+		if err != nil {
+			return mcpgo.NewToolResultError(err.Error()), nil
+		}
+
+		params := make(map[string]interface{})
+
+		validator := NewValidator(&r).
+			ValidateAndAddRequiredString(params, "razorpay_order_id").
+			ValidateAndAddRequiredString(params, "razorpay_payment_id").
+			ValidateAndAddRequiredString(params, "razorpay_signature").
+			ValidateAndAddOptionalString(params, "key_secret")
+
+		if result, err := validator.HandleErrorsIfAny(); result != nil {
+			return result, err
+		}
+
+		// The payment and order are fetched with the request's client, so the
+		// signature must be checked against that same account's secret
+		keySecret := resolveClientKeySecret(params, client)
+		if keySecret == "" {
+			return mcpgo.NewToolResultError(missingKeySecretError), nil
+		}
+
+		orderID := params["razorpay_order_id"].(string)
+		paymentID := params["razorpay_payment_id"].(string)
+		expected := computeSignature(orderID+"|"+paymentID, keySecret)
+		signature := params["razorpay_signature"].(string)
+
+		// A forged signature means the IDs can't be trusted either, so there
+		// is nothing worth fetching
+		if !hmac.Equal([]byte(expected), []byte(signature)) {
+			return mcpgo.NewToolResultJSON(map[string]interface{}{
+				"valid":           false,
+				"signature_valid": false,
+				"reasons": []string{
+					"signature does not match the order and payment IDs",
+				},
+			})
+		}
+
+		payment, err := client.Payment.Fetch(paymentID, nil, nil)
+		if err != nil {
+			return mcpgo.NewToolResultError(
+				fmt.Sprintf("fetching payment failed: %s", err.Error())), nil
+		}
+
+		order, err := client.Order.Fetch(orderID, nil, nil)
+		if err != nil {
+			return mcpgo.NewToolResultError(
+				fmt.Sprintf("fetching order failed: %s", err.Error())), nil
+		}
+
+		return mcpgo.NewToolResultJSON(buildCheckoutVerification(payment, order))
+	}
+
+	return mcpgo.NewTool(
+		"verify_checkout",
+		"Verify a completed Checkout end-to-end using the razorpay_order_id, "+
+			"razorpay_payment_id and razorpay_signature it returned. Checks "+
+			"the signature, then fetches the payment and order to confirm "+
+			"the payment belongs to the order, is captured or authorized, and "+
+			"its amount and currency match the order. Only treat the "+
+			"payment as successful when valid is true",
+		parameters,
+		handler,
+	)
+}
+
+// buildCheckoutVerification compares a payment against the order it was
+// made for. The signature is assumed to have been verified already.
+func buildCheckoutVerification(
+	payment map[string]interface{},
+	order map[string]interface{},
+) map[string]interface{} {
+	reasons := []string{}
+
+	status, _ := payment["status"].(string)
+	statusOK := status == "captured" || status == "authorized"
+	if !statusOK {
+		reasons = append(reasons, fmt.Sprintf(
+			"payment status is %q, expected captured or authorized", status))
+	}
+
+	orderMatches := payment["order_id"] == order["id"]
+	if !orderMatches {
+		reasons = append(reasons, fmt.Sprintf(
+			"payment belongs to order %v, not %v",
+			payment["order_id"], order["id"]))
+	}
+
+	amountMatches := payment["amount"] == order["amount"] &&
+		payment["currency"] == order["currency"]
+	if !amountMatches {
+		reasons = append(reasons, fmt.Sprintf(
+			"payment amount %v %v does not match order amount %v %v",
+			payment["amount"], payment["currency"],
+			order["amount"], order["currency"]))
+	}
+
+	return map[string]interface{}{
+		"valid":           len(reasons) == 0,
+		"signature_valid": true,
+		"status_ok":       statusOK,
+		"order_matches":   orderMatches,
+		"amount_matches":  amountMatches,
+		"payment_id":      payment["id"],
+		"order_id":        order["id"],
+		"payment_status":  status,
+		"payment_amount":  payment["amount"],
+		"order_amount":    order["amount"],
+		"currency":        payment["currency"],
+		"reasons":         reasons,
+	}
+}
+
+// VerifyWebhookSignature returns a tool that verifies the
+// X-Razorpay-Signature header sent with a webhook
+func VerifyWebhookSignature(
+	obs *observability.Observability,
+	client *rzpsdk.Client,
+) mcpgo.Tool {
+	parameters := []mcpgo.ToolParameter{
+		mcpgo.WithString(
+			"webhook_body",
+			mcpgo.Description("Raw webhook request body exactly as received, "+
+				"before any JSON parsing or re-serialization"),
+			mcpgo.Required(),
+		),
+		mcpgo.WithString(
+			"webhook_signature",
+			mcpgo.Description("Value of the X-Razorpay-Signature header"),
+			mcpgo.Required(),
+		),
+		mcpgo.WithString(
+			"webhook_secret",
+			mcpgo.Description("Secret set for the webhook in the Dashboard. "+
+				"This is NOT the API key secret"),
+			mcpgo.Required(),
+		),
+	}
+
+	handler := func(
+		ctx context.Context,
+		r mcpgo.CallToolRequest,
+	) (*mcpgo.ToolResult, error) {
+		params := make(map[string]interface{})
+
+		validator := NewValidator(&r).
+			ValidateAndAddRequiredString(params, "webhook_body").
+			ValidateAndAddRequiredString(params, "webhook_signature").
+			ValidateAndAddRequiredString(params, "webhook_secret")
+
+		if result, err := validator.HandleErrorsIfAny(); result != nil {
+			return result, err
+		}
+
+		// Unlike payment signatures, webhook signatures are computed over the
+		// raw body with the webhook secret
+		expected := computeSignature(
+			params["webhook_body"].(string),
+			params["webhook_secret"].(string),
+		)
+		signature := params["webhook_signature"].(string)
+
+		return mcpgo.NewToolResultJSON(map[string]interface{}{
+			"valid":              hmac.Equal([]byte(expected), []byte(signature)),
+			"computed_signature": expected,
+		})
+	}
+
+	return mcpgo.NewTool(
+		"verify_webhook_signature",
+		"Verify the X-Razorpay-Signature header of a webhook. Computes "+
+			"HMAC-SHA256 of the raw request body with the webhook secret and "+
+			"returns whether it matches, along with the computed signature. "+
+			"The body must be passed exactly as received; parsing and "+
+			"re-serializing the JSON changes the signature",
+		parameters,
+		handler,
+	)
+}
+
+// resolveKeySecret returns the key_secret param, falling back to the key
+// secret the server is configured with
+func resolveKeySecret(params map[string]interface{}) string {
+	if keySecret, _ := params["key_secret"].(string); keySecret != "" {
+		return keySecret
+	}
+	return viper.GetString("secret")
+}
+
+// resolveClientKeySecret returns the key_secret param, falling back to the
+// key secret of the client the request is made with
+func resolveClientKeySecret(
+	params map[string]interface{},
+	client *rzpsdk.Client,
+) string {
+	if keySecret, _ := params["key_secret"].(string); keySecret != "" {
+		return keySecret
+	}
+	return client.Auth.Secret
+}
+
+// computeSignature returns the hex encoded HMAC-SHA256 of payload keyed with
+// secret, as computed by Razorpay for its signatures
+func computeSignature(payload string, secret string) string {
+	h := hmac.New(sha256.New, []byte(secret))
+	h.Write([]byte(payload))
+	return hex.EncodeToString(h.Sum(nil))
+}
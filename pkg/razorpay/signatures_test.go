@@ -0,0 +1,422 @@
+package razorpay
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/razorpay/razorpay-go/constants"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/razorpay/razorpay-mcp-server/pkg/contextkey"
+	"github.com/razorpay/razorpay-mcp-server/pkg/razorpay/mock"
+)
+
+func Test_VerifyPaymentSignature(t *testing.T) {
+	// HMAC-SHA256 of "order_00000000000001|pay_00000000000001"
+	testSecretSignature :=
+		"ad87696c81770479d86a5ee7fd255fe234e8e2b7cb8aba963821fc049007f1d3"
+	configSecretSignature :=
+		"b5131afefb03839c0fad3fc25512ca457fe0f4fffaa0dafee621f2f746aa4442"
+
+	tests := []RazorpayToolTestCase{
+		{
+			Name: "valid signature with key secret",
+			Request: map[string]interface{}{
+				"razorpay_order_id":   "order_00000000000001",
+				"razorpay_payment_id": "pay_00000000000001",
+				"razorpay_signature":  testSecretSignature,
+				"key_secret":          "test_secret",
+			},
+			ExpectError: false,
+			ExpectedResult: map[string]interface{}{
+				"valid":              true,
+				"computed_signature": testSecretSignature,
+			},
+		},
+		{
+			Name: "tampered signature",
+			Request: map[string]interface{}{
+				"razorpay_order_id":   "order_00000000000001",
+				"razorpay_payment_id": "pay_00000000000001",
+				"razorpay_signature":  "deadbeef",
+				"key_secret":          "test_secret",
+			},
+			ExpectError: false,
+			ExpectedResult: map[string]interface{}{
+				"valid":              false,
+				"computed_signature": testSecretSignature,
+			},
+		},
+		{
+			Name: "signature for a different payment",
+			Request: map[string]interface{}{
+				"razorpay_order_id":   "order_00000000000001",
+				"razorpay_payment_id": "pay_00000000000002",
+				"razorpay_signature":  testSecretSignature,
+				"key_secret":          "test_secret",
+			},
+			ExpectError: false,
+			ExpectedResult: map[string]interface{}{
+				"valid": false,
+				"computed_signature": "53780f6b371edc35139b3e7680a35610" +
+					"f08818e94d4d39a8495e743f56462701",
+			},
+		},
+		{
+			Name: "falls back to configured key secret",
+			Request: map[string]interface{}{
+				"razorpay_order_id":   "order_00000000000001",
+				"razorpay_payment_id": "pay_00000000000001",
+				"razorpay_signature":  configSecretSignature,
+			},
+			ExpectError: false,
+			ExpectedResult: map[string]interface{}{
+				"valid": true,
+			},
+		},
+		{
+			Name: "configured key secret does not leak the signature",
+			Request: map[string]interface{}{
+				"razorpay_order_id":   "order_00000000000001",
+				"razorpay_payment_id": "pay_00000000000002",
+				"razorpay_signature":  "deadbeef",
+			},
+			ExpectError: false,
+			ExpectedResult: map[string]interface{}{
+				"valid": false,
+			},
+		},
+		{
+			Name: "missing signature parameter",
+			Request: map[string]interface{}{
+				"razorpay_order_id":   "order_00000000000001",
+				"razorpay_payment_id": "pay_00000000000001",
+			},
+			ExpectError:    true,
+			ExpectedErrMsg: "missing required parameter: razorpay_signature",
+		},
+	}
+
+	viper.Set("secret", "config_secret")
+	defer viper.Set("secret", "")
+
+	for _, tc := range tests {
+		t.Run(tc.Name, func(t *testing.T) {
+			runToolTest(t, tc, VerifyPaymentSignature, "Signature Verification")
+		})
+	}
+
+	t.Run("configured key secret is not returned as an oracle", func(t *testing.T) {
+		tool := VerifyPaymentSignature(CreateTestObservability(), nil)
+		result, err := tool.GetHandler()(context.Background(), createMCPRequest(
+			map[string]interface{}{
+				"razorpay_order_id":   "order_00000000000001",
+				"razorpay_payment_id": "pay_00000000000001",
+				"razorpay_signature":  "deadbeef",
+			},
+		))
+
+		assert.NoError(t, err)
+		assert.False(t, result.IsError)
+		assert.NotContains(t, result.Text, configSecretSignature)
+		assert.NotContains(t, result.Text, "computed_signature")
+	})
+
+	t.Run("no key secret available", func(t *testing.T) {
+		viper.Set("secret", "")
+		runToolTest(t, RazorpayToolTestCase{
+			Request: map[string]interface{}{
+				"razorpay_order_id":   "order_00000000000001",
+				"razorpay_payment_id": "pay_00000000000001",
+				"razorpay_signature":  testSecretSignature,
+			},
+			ExpectError:    true,
+			ExpectedErrMsg: "key_secret is required",
+		}, VerifyPaymentSignature, "Signature Verification")
+	})
+}
+
+func Test_VerifyCheckout(t *testing.T) {
+	// HMAC-SHA256 of "order_00000000000001|pay_00000000000001"
+	signature :=
+		"ad87696c81770479d86a5ee7fd255fe234e8e2b7cb8aba963821fc049007f1d3"
+
+	fetchPaymentPath := fmt.Sprintf(
+		"/%s%s/%s",
+		constants.VERSION_V1,
+		constants.PAYMENT_URL,
+		"pay_00000000000001",
+	)
+	fetchOrderPath := fmt.Sprintf(
+		"/%s%s/%s",
+		constants.VERSION_V1,
+		constants.ORDER_URL,
+		"order_00000000000001",
+	)
+
+	request := map[string]interface{}{
+		"razorpay_order_id":   "order_00000000000001",
+		"razorpay_payment_id": "pay_00000000000001",
+		"razorpay_signature":  signature,
+		"key_secret":          "test_secret",
+	}
+
+	orderResp := map[string]interface{}{
+		"id":       "order_00000000000001",
+		"amount":   float64(50000),
+		"currency": "INR",
+		"status":   "paid",
+	}
+
+	paymentResp := func(
+		status string,
+		amount float64,
+	) map[string]interface{} {
+		return map[string]interface{}{
+			"id":       "pay_00000000000001",
+			"order_id": "order_00000000000001",
+			"amount":   amount,
+			"currency": "INR",
+			"status":   status,
+		}
+	}
+
+	mockClient := func(
+		payment map[string]interface{},
+	) func() (*http.Client, *httptest.Server) {
+		return func() (*http.Client, *httptest.Server) {
+			return mock.NewHTTPClient(
+				mock.Endpoint{
+					Path:     fetchPaymentPath,
+					Method:   "GET",
+					Response: payment,
+				},
+				mock.Endpoint{
+					Path:     fetchOrderPath,
+					Method:   "GET",
+					Response: orderResp,
+				},
+			)
+		}
+	}
+
+	tests := []RazorpayToolTestCase{
+		{
+			Name:           "captured payment matching the order",
+			Request:        request,
+			MockHttpClient: mockClient(paymentResp("captured", 50000)),
+			ExpectError:    false,
+			ExpectedResult: map[string]interface{}{
+				"valid":           true,
+				"signature_valid": true,
+				"status_ok":       true,
+				"order_matches":   true,
+				"amount_matches":  true,
+				"payment_id":      "pay_00000000000001",
+				"order_id":        "order_00000000000001",
+				"payment_status":  "captured",
+				"payment_amount":  float64(50000),
+				"order_amount":    float64(50000),
+				"currency":        "INR",
+				"reasons":         []interface{}{},
+			},
+		},
+		{
+			Name:           "valid signature but failed payment",
+			Request:        request,
+			MockHttpClient: mockClient(paymentResp("failed", 50000)),
+			ExpectError:    false,
+			ExpectedResult: map[string]interface{}{
+				"valid":           false,
+				"signature_valid": true,
+				"status_ok":       false,
+				"order_matches":   true,
+				"amount_matches":  true,
+				"payment_id":      "pay_00000000000001",
+				"order_id":        "order_00000000000001",
+				"payment_status":  "failed",
+				"payment_amount":  float64(50000),
+				"order_amount":    float64(50000),
+				"currency":        "INR",
+				"reasons": []interface{}{
+					"payment status is \"failed\", " +
+						"expected captured or authorized",
+				},
+			},
+		},
+		{
+			Name:           "amount does not match the order",
+			Request:        request,
+			MockHttpClient: mockClient(paymentResp("authorized", 100)),
+			ExpectError:    false,
+			ExpectedResult: map[string]interface{}{
+				"valid":           false,
+				"signature_valid": true,
+				"status_ok":       true,
+				"order_matches":   true,
+				"amount_matches":  false,
+				"payment_id":      "pay_00000000000001",
+				"order_id":        "order_00000000000001",
+				"payment_status":  "authorized",
+				"payment_amount":  float64(100),
+				"order_amount":    float64(50000),
+				"currency":        "INR",
+				"reasons": []interface{}{
+					"payment amount 100 INR does not match " +
+						"order amount 50000 INR",
+				},
+			},
+		},
+		{
+			Name: "invalid signature skips the API calls",
+			Request: map[string]interface{}{
+				"razorpay_order_id":   "order_00000000000001",
+				"razorpay_payment_id": "pay_00000000000001",
+				"razorpay_signature":  "deadbeef",
+				"key_secret":          "test_secret",
+			},
+			ExpectError: false,
+			ExpectedResult: map[string]interface{}{
+				"valid":           false,
+				"signature_valid": false,
+				"reasons": []interface{}{
+					"signature does not match the order and payment IDs",
+				},
+			},
+		},
+		{
+			Name:    "fetching payment fails",
+			Request: request,
+			MockHttpClient: mockClient(map[string]interface{}{
+				"error": map[string]interface{}{
+					"code":        "BAD_REQUEST_ERROR",
+					"description": "The id provided does not exist",
+				},
+			}),
+			ExpectError: true,
+			ExpectedErrMsg: "fetching payment failed: " +
+				"The id provided does not exist",
+		},
+		{
+			Name: "missing razorpay_signature parameter",
+			Request: map[string]interface{}{
+				"razorpay_order_id":   "order_00000000000001",
+				"razorpay_payment_id": "pay_00000000000001",
+			},
+			ExpectError:    true,
+			ExpectedErrMsg: "missing required parameter: razorpay_signature",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.Name, func(t *testing.T) {
+			runToolTest(t, tc, VerifyCheckout, "Checkout Verification")
+		})
+	}
+
+	t.Run("uses the secret of the client in the context", func(t *testing.T) {
+		// The server's own secret belongs to a different account
+		viper.Set("secret", "config_secret")
+		defer viper.Set("secret", "")
+
+		// newMockRzpClient authenticates with sample_secret
+		client, server := newMockRzpClient(
+			mockClient(paymentResp("captured", 50000)))
+		defer server.Close()
+		ctx := contextkey.WithClient(context.Background(), client)
+
+		tool := VerifyCheckout(CreateTestObservability(), nil)
+		result, err := tool.GetHandler()(ctx, createMCPRequest(
+			map[string]interface{}{
+				"razorpay_order_id":   "order_00000000000001",
+				"razorpay_payment_id": "pay_00000000000001",
+				// HMAC-SHA256 of the IDs keyed with sample_secret
+				"razorpay_signature": "44d752b5982555b28cf3984226b8d437" +
+					"8c80b844a6ec6a197a2a0f0e95fc4eb3",
+			},
+		))
+		assert.NoError(t, err)
+		assert.False(t, result.IsError, result.Text)
+
+		var verification map[string]interface{}
+		assert.NoError(t, json.Unmarshal([]byte(result.Text), &verification))
+		assert.Equal(t, true, verification["signature_valid"])
+		assert.Equal(t, true, verification["valid"])
+	})
+}
+
+func Test_VerifyWebhookSignature(t *testing.T) {
+	// Sample payment.captured payload from the Razorpay webhook docs
+	webhookBody := `{"entity":"event","account_id":"acc_BFQ7uQEaa7j2z7",` +
+		`"event":"payment.captured","contains":["payment"],"payload":{` +
+		`"payment":{"entity":{"id":"pay_DESlfW9H8K9uqM","entity":"payment",` +
+		`"amount":100,"currency":"INR","status":"captured",` +
+		`"order_id":"order_DESlLckIVRkHWj","method":"netbanking",` +
+		`"captured":true}}},"created_at":1567674606}`
+	webhookSignature :=
+		"aa1ad628cdbf82c58ac14ce33df348915dec298085e48f7963320932f11e1a89"
+
+	tests := []RazorpayToolTestCase{
+		{
+			Name: "valid webhook signature",
+			Request: map[string]interface{}{
+				"webhook_body":      webhookBody,
+				"webhook_signature": webhookSignature,
+				"webhook_secret":    "12345678",
+			},
+			ExpectError: false,
+			ExpectedResult: map[string]interface{}{
+				"valid":              true,
+				"computed_signature": webhookSignature,
+			},
+		},
+		{
+			Name: "wrong webhook secret",
+			Request: map[string]interface{}{
+				"webhook_body":      webhookBody,
+				"webhook_signature": webhookSignature,
+				"webhook_secret":    "87654321",
+			},
+			ExpectError: false,
+			ExpectedResult: map[string]interface{}{
+				"valid": false,
+				"computed_signature": "e112b12c73826d42ee8cab0443872d36" +
+					"5c45e3ab63155ed870e371657a136778",
+			},
+		},
+		{
+			Name: "re-serialized body",
+			Request: map[string]interface{}{
+				"webhook_body":      webhookBody + " ",
+				"webhook_signature": webhookSignature,
+				"webhook_secret":    "12345678",
+			},
+			ExpectError: false,
+			ExpectedResult: map[string]interface{}{
+				"valid": false,
+				"computed_signature": "e63a98a3a70fc4fb01d8c6f662a641df" +
+					"83cdf43ca152975a709558b08742f738",
+			},
+		},
+		{
+			Name: "missing webhook secret",
+			Request: map[string]interface{}{
+				"webhook_body":      webhookBody,
+				"webhook_signature": webhookSignature,
+			},
+			ExpectError:    true,
+			ExpectedErrMsg: "missing required parameter: webhook_secret",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.Name, func(t *testing.T) {
+			runToolTest(t, tc, VerifyWebhookSignature, "Webhook Signature")
+		})
+	}
+}
@@ -0,0 +1,552 @@
+package razorpay
+
+import (
+	"context"
+	"fmt"
+
+	rzpsdk "github.com/razorpay/razorpay-go"
+
+	"github.com/razorpay/razorpay-mcp-server/pkg/mcpgo"
+	"github.com/razorpay/razorpay-mcp-server/pkg/observability"
+)
+
+const (
+	// subscriptionInvoicePageSize is the max number of invoices fetched per
+	// API call
+	subscriptionInvoicePageSize = 100
+	// maxSubscriptionInvoicePages caps the number of invoice pages read for a
+	// single subscription
+	maxSubscriptionInvoicePages = 20
+)
+
+// CreateSubscription returns a tool that creates a subscription for a plan
+func CreateSubscription(
+	obs *observability.Observability,
+	client *rzpsdk.Client,
+) mcpgo.Tool {
+	parameters := []mcpgo.ToolParameter{
+		mcpgo.WithString(
+			"plan_id",
+			mcpgo.Description("Unique identifier of the plan the customer "+
+				"should be subscribed to. For example, 'plan_00000000000001'"),
+			mcpgo.Required(),
+		),
+		mcpgo.WithNumber(
+			"total_count",
+			mcpgo.Description("Number of billing cycles the customer is "+
+				"charged for"),
+			mcpgo.Required(),
+			mcpgo.Min(1),
+		),
+		mcpgo.WithString(
+			"customer_id",
+			mcpgo.Description("Unique identifier of the customer to link "+
+				"the subscription to. For example, 'cust_00000000000001'"),
+		),
+		mcpgo.WithNumber(
+			"quantity",
+			mcpgo.Description("Number of times the plan amount is charged "+
+				"per billing cycle (default: 1)"),
+			mcpgo.Min(1),
+		),
+		mcpgo.WithNumber(
+			"start_at",
+			mcpgo.Description("Unix timestamp (in seconds) from when the "+
+				"subscription starts. Defaults to when the customer "+
+				"authorizes it"),
+			mcpgo.Min(0),
+		),
+		mcpgo.WithNumber(
+			"expire_by",
+			mcpgo.Description("Unix timestamp (in seconds) until when the "+
+				"customer can authorize the subscription"),
+			mcpgo.Min(0),
+		),
+		mcpgo.WithBoolean(
+			"customer_notify",
+			mcpgo.Description("Whether Razorpay sends notifications to the "+
+				"customer (default: true)"),
+		),
+		mcpgo.WithObject(
+			"notes",
+			mcpgo.Description("Key-value pairs that can be used to store "+
+				"additional information about the subscription. A maximum of "+
+				"15 key-value pairs, each of 256 characters (maximum) are "+
+				"allowed"),
+		),
+	}
+
+	handler := func(
+		ctx context.Context,
+		r mcpgo.CallToolRequest,
+	) (*mcpgo.ToolResult, error) {
+		client, err := getClientFromContextOrDefault(ctx, client)
+		if err != nil {
+			return mcpgo.NewToolResultError(err.Error()), nil
+		}
+
+		params := make(map[string]interface{})
+
+		validator := NewValidator(&r).
+			ValidateAndAddRequiredString(params, "plan_id").
+			ValidateAndAddRequiredInt(params, "total_count").
+			ValidateAndAddOptionalString(params, "customer_id").
+			ValidateAndAddOptionalInt(params, "quantity").
+			ValidateAndAddOptionalInt(params, "start_at").
+			ValidateAndAddOptionalInt(params, "expire_by").
+			ValidateAndAddOptionalBool(params, "customer_notify").
+			ValidateAndAddOptionalNotes(params, "notes")
+
+		if result, err := validator.HandleErrorsIfAny(); result != nil {
+			return result, err
+		}
+
+		// The API takes customer_notify as 0 or 1
+		if customerNotify, ok := params["customer_notify"].(bool); ok {
+			params["customer_notify"] = 0
+			if customerNotify {
+				params["customer_notify"] = 1
+			}
+		}
+
+		subscription, err := client.Subscription.Create(params, nil)
+		if err != nil {
+			return mcpgo.NewToolResultError(
+				fmt.Sprintf("creating subscription failed: %s", err.Error())), nil
+		}
+
+		return mcpgo.NewToolResultJSON(subscription)
+	}
+
+	return mcpgo.NewTool(
+		"create_subscription",
+		"Create a subscription that charges a customer for a plan every "+
+			"billing cycle. Returns the subscription with its short_url, "+
+			"which the customer opens to authorize the recurring payment",
+		parameters,
+		handler,
+	)
+}
+
+// FetchSubscription returns a tool that fetches a subscription by ID
+func FetchSubscription(
+	obs *observability.Observability,
+	client *rzpsdk.Client,
+) mcpgo.Tool {
+	parameters := []mcpgo.ToolParameter{
+		mcpgo.WithString(
+			"subscription_id",
+			mcpgo.Description("Unique identifier of the subscription. "+
+				"For example, 'sub_00000000000001'"),
+			mcpgo.Required(),
+		),
+	}
+
+	handler := func(
+		ctx context.Context,
+		r mcpgo.CallToolRequest,
+	) (*mcpgo.ToolResult, error) {
+		client, err := getClientFromContextOrDefault(ctx, client)
+		if err != nil {
+			return mcpgo.NewToolResultError(err.Error()), nil
+		}
+
+		params := make(map[string]interface{})
+
+		validator := NewValidator(&r).
+			ValidateAndAddRequiredString(params, "subscription_id")
+
+		if result, err := validator.HandleErrorsIfAny(); result != nil {
+			return result, err
+		}
+
+		subscription, err := client.Subscription.Fetch(
+			params["subscription_id"].(string), nil, nil)
+		if err != nil {
+			return mcpgo.NewToolResultError(
+				fmt.Sprintf("fetching subscription failed: %s", err.Error())), nil
+		}
+
+		return mcpgo.NewToolResultJSON(subscription)
+	}
+
+	return mcpgo.NewTool(
+		"fetch_subscription",
+		"Fetch a subscription's details using its ID",
+		parameters,
+		handler,
+	)
+}
+
+// FetchAllSubscriptions returns a tool that fetches all subscriptions
+func FetchAllSubscriptions(
+	obs *observability.Observability,
+	client *rzpsdk.Client,
+) mcpgo.Tool {
+	parameters := []mcpgo.ToolParameter{
+		mcpgo.WithNumber(
+			"count",
+			mcpgo.Description("Number of subscriptions to be fetched "+
+				"(default: 10, max: 100)"),
+			mcpgo.Min(1),
+			mcpgo.Max(100),
+		),
+		mcpgo.WithNumber(
+			"skip",
+			mcpgo.Description("Number of subscriptions to be skipped "+
+				"(default: 0)"),
+			mcpgo.Min(0),
+		),
+		mcpgo.WithString(
+			"plan_id",
+			mcpgo.Description("Only fetch subscriptions of this plan"),
+		),
+		mcpgo.WithNumber(
+			"from",
+			mcpgo.Description("Timestamp (in Unix format) from when "+
+				"the subscriptions should be fetched"),
+			mcpgo.Min(0),
+		),
+		mcpgo.WithNumber(
+			"to",
+			mcpgo.Description("Timestamp (in Unix format) up till "+
+				"when subscriptions are to be fetched"),
+			mcpgo.Min(0),
+		),
+	}
+
+	handler := func(
+		ctx context.Context,
+		r mcpgo.CallToolRequest,
+	) (*mcpgo.ToolResult, error) {
+		client, err := getClientFromContextOrDefault(ctx, client)
+		if err != nil {
+			return mcpgo.NewToolResultError(err.Error()), nil
+		}
+
+		queryParams := make(map[string]interface{})
+
+		validator := NewValidator(&r).
+			ValidateAndAddPagination(queryParams).
+			ValidateAndAddOptionalString(queryParams, "plan_id").
+			ValidateAndAddOptionalInt(queryParams, "from").
+			ValidateAndAddOptionalInt(queryParams, "to")
+
+		if result, err := validator.HandleErrorsIfAny(); result != nil {
+			return result, err
+		}
+
+		subscriptions, err := client.Subscription.All(queryParams, nil)
+		if err != nil {
+			return mcpgo.NewToolResultError(
+				fmt.Sprintf("fetching subscriptions failed: %s", err.Error())), nil
+		}
+
+		return mcpgo.NewToolResultJSON(subscriptions)
+	}
+
+	return mcpgo.NewTool(
+		"fetch_all_subscriptions",
+		"Fetch all subscriptions with optional filtering and pagination",
+		parameters,
+		handler,
+	)
+}
+
+// CancelSubscription returns a tool that cancels a subscription
+func CancelSubscription(
+	obs *observability.Observability,
+	client *rzpsdk.Client,
+) mcpgo.Tool {
+	parameters := []mcpgo.ToolParameter{
+		mcpgo.WithString(
+			"subscription_id",
+			mcpgo.Description("Unique identifier of the subscription to be "+
+				"cancelled. For example, 'sub_00000000000001'"),
+			mcpgo.Required(),
+		),
+		mcpgo.WithBoolean(
+			"cancel_at_cycle_end",
+			mcpgo.Description("If true, the subscription is cancelled at "+
+				"the end of the current billing cycle instead of immediately "+
+				"(default: false)"),
+		),
+	}
+
+	handler := func(
+		ctx context.Context,
+		r mcpgo.CallToolRequest,
+	) (*mcpgo.ToolResult, error) {
+		client, err := getClientFromContextOrDefault(ctx, client)
+		if err != nil {
+			return mcpgo.NewToolResultError(err.Error()), nil
+		}
+
+		params := make(map[string]interface{})
+
+		validator := NewValidator(&r).
+			ValidateAndAddRequiredString(params, "subscription_id").
+			ValidateAndAddOptionalBool(params, "cancel_at_cycle_end")
+
+		if result, err := validator.HandleErrorsIfAny(); result != nil {
+			return result, err
+		}
+
+		// The API takes cancel_at_cycle_end as 0 or 1
+		data := map[string]interface{}{"cancel_at_cycle_end": 0}
+		if atCycleEnd, ok := params["cancel_at_cycle_end"].(bool); ok &&
+			atCycleEnd {
+			data["cancel_at_cycle_end"] = 1
+		}
+
+		subscription, err := client.Subscription.Cancel(
+			params["subscription_id"].(string), data, nil)
+		if err != nil {
+			return mcpgo.NewToolResultError(
+				fmt.Sprintf("cancelling subscription failed: %s",
+					err.Error())), nil
+		}
+
+		return mcpgo.NewToolResultJSON(subscription)
+	}
+
+	return mcpgo.NewTool(
+		"cancel_subscription",
+		"Cancel a subscription, either immediately or at the end of the "+
+			"current billing cycle. A cancelled subscription cannot be "+
+			"renewed or reactivated",
+		parameters,
+		handler,
+	)
+}
+
+// PauseSubscription returns a tool that pauses an active subscription
+func PauseSubscription(
+	obs *observability.Observability,
+	client *rzpsdk.Client,
+) mcpgo.Tool {
+	parameters := []mcpgo.ToolParameter{
+		mcpgo.WithString(
+			"subscription_id",
+			mcpgo.Description("Unique identifier of the subscription to be "+
+				"paused. For example, 'sub_00000000000001'"),
+			mcpgo.Required(),
+		),
+	}
+
+	handler := func(
+		ctx context.Context,
+		r mcpgo.CallToolRequest,
+	) (*mcpgo.ToolResult, error) {
+		client, err := getClientFromContextOrDefault(ctx, client)
+		if err != nil {
+			return mcpgo.NewToolResultError(err.Error()), nil
+		}
+
+		params := make(map[string]interface{})
+
+		validator := NewValidator(&r).
+			ValidateAndAddRequiredString(params, "subscription_id")
+
+		if result, err := validator.HandleErrorsIfAny(); result != nil {
+			return result, err
+		}
+
+		// Subscriptions can only be paused immediately
+		subscription, err := client.Subscription.Pause(
+			params["subscription_id"].(string),
+			map[string]interface{}{"pause_at": "now"},
+			nil,
+		)
+		if err != nil {
+			return mcpgo.NewToolResultError(
+				fmt.Sprintf("pausing subscription failed: %s", err.Error())), nil
+		}
+
+		return mcpgo.NewToolResultJSON(subscription)
+	}
+
+	return mcpgo.NewTool(
+		"pause_subscription",
+		"Pause an active subscription immediately. No charges are made "+
+			"while it is paused",
+		parameters,
+		handler,
+	)
+}
+
+// ResumeSubscription returns a tool that resumes a paused subscription
+func ResumeSubscription(
+	obs *observability.Observability,
+	client *rzpsdk.Client,
+) mcpgo.Tool {
+	parameters := []mcpgo.ToolParameter{
+		mcpgo.WithString(
+			"subscription_id",
+			mcpgo.Description("Unique identifier of the paused subscription "+
+				"to be resumed. For example, 'sub_00000000000001'"),
+			mcpgo.Required(),
+		),
+	}
+
+	handler := func(
+		ctx context.Context,
+		r mcpgo.CallToolRequest,
+	) (*mcpgo.ToolResult, error) {
+		client, err := getClientFromContextOrDefault(ctx, client)
+		if err != nil {
+			return mcpgo.NewToolResultError(err.Error()), nil
+		}
+
+		params := make(map[string]interface{})
+
+		validator := NewValidator(&r).
+			ValidateAndAddRequiredString(params, "subscription_id")
+
+		if result, err := validator.HandleErrorsIfAny(); result != nil {
+			return result, err
+		}
+
+		subscription, err := client.Subscription.Resume(
+			params["subscription_id"].(string),
+			map[string]interface{}{"resume_at": "now"},
+			nil,
+		)
+		if err != nil {
+			return mcpgo.NewToolResultError(
+				fmt.Sprintf("resuming subscription failed: %s", err.Error())), nil
+		}
+
+		return mcpgo.NewToolResultJSON(subscription)
+	}
+
+	return mcpgo.NewTool(
+		"resume_subscription",
+		"Resume a paused subscription immediately",
+		parameters,
+		handler,
+	)
+}
+
+// FetchSubscriptionPayments returns a tool that lists the charge history of a
+// subscription
+func FetchSubscriptionPayments(
+	obs *observability.Observability,
+	client *rzpsdk.Client,
+) mcpgo.Tool {
+	parameters := []mcpgo.ToolParameter{
+		mcpgo.WithString(
+			"subscription_id",
+			mcpgo.Description("Unique identifier of the subscription. "+
+				"For example, 'sub_00000000000001'"),
+			mcpgo.Required(),
+		),
+	}
+
+	handler := func(
+		ctx context.Context,
+		r mcpgo.CallToolRequest,
+	) (*mcpgo.ToolResult, error) {
+		client, err := getClientFromContextOrDefault(ctx, client)
+		if err != nil {
+			return mcpgo.NewToolResultError(err.Error()), nil
+		}
+
+		params := make(map[string]interface{})
+
+		validator := NewValidator(&r).
+			ValidateAndAddRequiredString(params, "subscription_id")
+
+		if result, err := validator.HandleErrorsIfAny(); result != nil {
+			return result, err
+		}
+
+		subscriptionID := params["subscription_id"].(string)
+
+		subscription, err := client.Subscription.Fetch(subscriptionID, nil, nil)
+		if err != nil {
+			return mcpgo.NewToolResultError(
+				fmt.Sprintf("fetching subscription failed: %s", err.Error())), nil
+		}
+
+		// Every billing cycle of a subscription raises an invoice, which
+		// carries the payment made against it
+		invoices := make([]map[string]interface{}, 0)
+		for page := 0; page < maxSubscriptionInvoicePages; page++ {
+			response, err := client.Invoice.All(map[string]interface{}{
+				"subscription_id": subscriptionID,
+				"count":           subscriptionInvoicePageSize,
+				"skip":            page * subscriptionInvoicePageSize,
+			}, nil)
+			if err != nil {
+				return mcpgo.NewToolResultError(
+					fmt.Sprintf("fetching subscription invoices failed: %s",
+						err.Error())), nil
+			}
+
+			items, _ := response["items"].([]interface{})
+			for _, item := range items {
+				if invoice, ok := item.(map[string]interface{}); ok {
+					invoices = append(invoices, invoice)
+				}
+			}
+
+			if len(items) < subscriptionInvoicePageSize {
+				break
+			}
+		}
+
+		return mcpgo.NewToolResultJSON(
+			buildSubscriptionPayments(subscription, invoices))
+	}
+
+	return mcpgo.NewTool(
+		"fetch_subscription_payments",
+		"Fetch the charge history of a subscription. Returns one entry per "+
+			"billing cycle invoice with its payment ID, amount, amount paid, "+
+			"status and billing/paid dates, along with the subscription status "+
+			"and the total amount paid. Amounts are in the smallest currency "+
+			"sub-unit (paisa) and dates are Unix timestamps",
+		parameters,
+		handler,
+	)
+}
+
+// buildSubscriptionPayments reduces a subscription and its invoices to the
+// charge history of the subscription
+func buildSubscriptionPayments(
+	subscription map[string]interface{},
+	invoices []map[string]interface{},
+) map[string]interface{} {
+	payments := make([]map[string]interface{}, 0, len(invoices))
+	totalPaid := float64(0)
+	for _, invoice := range invoices {
+		amountPaid, _ := invoice["amount_paid"].(float64)
+		totalPaid += amountPaid
+
+		payments = append(payments, map[string]interface{}{
+			"invoice_id":    invoice["id"],
+			"payment_id":    invoice["payment_id"],
+			"status":        invoice["status"],
+			"amount":        invoice["amount"],
+			"amount_paid":   invoice["amount_paid"],
+			"currency":      invoice["currency"],
+			"billing_start": invoice["billing_start"],
+			"billing_end":   invoice["billing_end"],
+			"issued_at":     invoice["issued_at"],
+			"paid_at":       invoice["paid_at"],
+		})
+	}
+
+	return map[string]interface{}{
+		"subscription_id":     subscription["id"],
+		"subscription_status": subscription["status"],
+		"plan_id":             subscription["plan_id"],
+		"customer_id":         subscription["customer_id"],
+		"paid_count":          subscription["paid_count"],
+		"total_count":         subscription["total_count"],
+		"count":               len(payments),
+		"total_amount_paid":   totalPaid,
+		"payments":            payments,
+	}
+}
@@ -0,0 +1,483 @@
+package razorpay
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	rzpsdk "github.com/razorpay/razorpay-go"
+	"github.com/razorpay/razorpay-go/constants"
+
+	"github.com/razorpay/razorpay-mcp-server/pkg/mcpgo"
+	"github.com/razorpay/razorpay-mcp-server/pkg/observability"
+	"github.com/razorpay/razorpay-mcp-server/pkg/razorpay/mock"
+)
+
+func Test_FetchSubscriptionPayments(t *testing.T) {
+	fetchSubscriptionPath := fmt.Sprintf(
+		"/%s%s/%s",
+		constants.VERSION_V1,
+		constants.SUBSCRIPTION_URL,
+		"sub_00000000000001",
+	)
+	fetchInvoicesPath := fmt.Sprintf(
+		"/%s%s",
+		constants.VERSION_V1,
+		constants.INVOICE_URL,
+	)
+
+	subscriptionResp := map[string]interface{}{
+		"id":          "sub_00000000000001",
+		"entity":      "subscription",
+		"plan_id":     "plan_00000000000001",
+		"customer_id": "cust_00000000000001",
+		"status":      "active",
+		"paid_count":  float64(1),
+		"total_count": float64(12),
+	}
+
+	invoicesResp := map[string]interface{}{
+		"entity": "collection",
+		"count":  float64(2),
+		"items": []interface{}{
+			map[string]interface{}{
+				"id":            "inv_00000000000001",
+				"payment_id":    "pay_00000000000001",
+				"status":        "paid",
+				"amount":        float64(49900),
+				"amount_paid":   float64(49900),
+				"currency":      "INR",
+				"billing_start": float64(1700000000),
+				"billing_end":   float64(1702592000),
+				"issued_at":     float64(1700000000),
+				"paid_at":       float64(1700000100),
+			},
+			map[string]interface{}{
+				"id":            "inv_00000000000002",
+				"payment_id":    nil,
+				"status":        "issued",
+				"amount":        float64(49900),
+				"amount_paid":   float64(0),
+				"currency":      "INR",
+				"billing_start": float64(1702592000),
+				"billing_end":   float64(1705270400),
+				"issued_at":     float64(1702592000),
+				"paid_at":       nil,
+			},
+		},
+	}
+
+	errorResp := map[string]interface{}{
+		"error": map[string]interface{}{
+			"code":        "BAD_REQUEST_ERROR",
+			"description": "The id provided does not exist",
+		},
+	}
+
+	tests := []RazorpayToolTestCase{
+		{
+			Name: "successful subscription payments fetch",
+			Request: map[string]interface{}{
+				"subscription_id": "sub_00000000000001",
+			},
+			MockHttpClient: func() (*http.Client, *httptest.Server) {
+				return mock.NewHTTPClient(
+					mock.Endpoint{
+						Path:     fetchSubscriptionPath,
+						Method:   "GET",
+						Response: subscriptionResp,
+					},
+					mock.Endpoint{
+						Path:     fetchInvoicesPath,
+						Method:   "GET",
+						Response: invoicesResp,
+					},
+				)
+			},
+			ExpectError: false,
+			ExpectedResult: map[string]interface{}{
+				"subscription_id":     "sub_00000000000001",
+				"subscription_status": "active",
+				"plan_id":             "plan_00000000000001",
+				"customer_id":         "cust_00000000000001",
+				"paid_count":          float64(1),
+				"total_count":         float64(12),
+				"count":               float64(2),
+				"total_amount_paid":   float64(49900),
+				"payments": []interface{}{
+					map[string]interface{}{
+						"invoice_id":    "inv_00000000000001",
+						"payment_id":    "pay_00000000000001",
+						"status":        "paid",
+						"amount":        float64(49900),
+						"amount_paid":   float64(49900),
+						"currency":      "INR",
+						"billing_start": float64(1700000000),
+						"billing_end":   float64(1702592000),
+						"issued_at":     float64(1700000000),
+						"paid_at":       float64(1700000100),
+					},
+					map[string]interface{}{
+						"invoice_id":    "inv_00000000000002",
+						"payment_id":    nil,
+						"status":        "issued",
+						"amount":        float64(49900),
+						"amount_paid":   float64(0),
+						"currency":      "INR",
+						"billing_start": float64(1702592000),
+						"billing_end":   float64(1705270400),
+						"issued_at":     float64(1702592000),
+						"paid_at":       nil,
+					},
+				},
+			},
+		},
+		{
+			Name: "subscription not found",
+			Request: map[string]interface{}{
+				"subscription_id": "sub_00000000000001",
+			},
+			MockHttpClient: func() (*http.Client, *httptest.Server) {
+				return mock.NewHTTPClient(
+					mock.Endpoint{
+						Path:     fetchSubscriptionPath,
+						Method:   "GET",
+						Response: errorResp,
+					},
+				)
+			},
+			ExpectError: true,
+			ExpectedErrMsg: "fetching subscription failed: " +
+				"The id provided does not exist",
+		},
+		{
+			Name: "fetching invoices fails",
+			Request: map[string]interface{}{
+				"subscription_id": "sub_00000000000001",
+			},
+			MockHttpClient: func() (*http.Client, *httptest.Server) {
+				return mock.NewHTTPClient(
+					mock.Endpoint{
+						Path:     fetchSubscriptionPath,
+						Method:   "GET",
+						Response: subscriptionResp,
+					},
+					mock.Endpoint{
+						Path:     fetchInvoicesPath,
+						Method:   "GET",
+						Response: errorResp,
+					},
+				)
+			},
+			ExpectError: true,
+			ExpectedErrMsg: "fetching subscription invoices failed: " +
+				"The id provided does not exist",
+		},
+		{
+			Name:           "missing subscription_id parameter",
+			Request:        map[string]interface{}{},
+			MockHttpClient: nil,
+			ExpectError:    true,
+			ExpectedErrMsg: "missing required parameter: subscription_id",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.Name, func(t *testing.T) {
+			runToolTest(t, tc, FetchSubscriptionPayments, "Subscription Payments")
+		})
+	}
+}
+
+func Test_CreateSubscription(t *testing.T) {
+	createSubscriptionPath := fmt.Sprintf(
+		"/%s%s",
+		constants.VERSION_V1,
+		constants.SUBSCRIPTION_URL,
+	)
+
+	subscriptionResp := map[string]interface{}{
+		"id":              "sub_00000000000001",
+		"entity":          "subscription",
+		"plan_id":         "plan_00000000000001",
+		"customer_id":     "cust_00000000000001",
+		"status":          "created",
+		"total_count":     float64(12),
+		"customer_notify": true,
+		"short_url":       "https://rzp.io/i/z3b1R61A9",
+	}
+
+	tests := []RazorpayToolTestCase{
+		{
+			Name: "successful subscription creation",
+			Request: map[string]interface{}{
+				"plan_id":         "plan_00000000000001",
+				"total_count":     float64(12),
+				"customer_id":     "cust_00000000000001",
+				"customer_notify": true,
+			},
+			MockHttpClient: func() (*http.Client, *httptest.Server) {
+				return mock.NewHTTPClient(
+					mock.Endpoint{
+						Path:     createSubscriptionPath,
+						Method:   "POST",
+						Response: subscriptionResp,
+					},
+				)
+			},
+			ExpectError:    false,
+			ExpectedResult: subscriptionResp,
+		},
+		{
+			Name: "subscription creation fails",
+			Request: map[string]interface{}{
+				"plan_id":     "plan_00000000000001",
+				"total_count": float64(12),
+			},
+			MockHttpClient: func() (*http.Client, *httptest.Server) {
+				return mock.NewHTTPClient(
+					mock.Endpoint{
+						Path:   createSubscriptionPath,
+						Method: "POST",
+						Response: map[string]interface{}{
+							"error": map[string]interface{}{
+								"code":        "BAD_REQUEST_ERROR",
+								"description": "The id provided does not exist",
+							},
+						},
+					},
+				)
+			},
+			ExpectError: true,
+			ExpectedErrMsg: "creating subscription failed: " +
+				"The id provided does not exist",
+		},
+		{
+			Name: "missing total_count parameter",
+			Request: map[string]interface{}{
+				"plan_id": "plan_00000000000001",
+			},
+			MockHttpClient: nil,
+			ExpectError:    true,
+			ExpectedErrMsg: "missing required parameter: total_count",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.Name, func(t *testing.T) {
+			runToolTest(t, tc, CreateSubscription, "Subscription")
+		})
+	}
+}
+
+func Test_FetchSubscription(t *testing.T) {
+	fetchSubscriptionPath := fmt.Sprintf(
+		"/%s%s/%s",
+		constants.VERSION_V1,
+		constants.SUBSCRIPTION_URL,
+		"sub_00000000000001",
+	)
+
+	subscriptionResp := map[string]interface{}{
+		"id":      "sub_00000000000001",
+		"entity":  "subscription",
+		"plan_id": "plan_00000000000001",
+		"status":  "active",
+	}
+
+	tests := []RazorpayToolTestCase{
+		{
+			Name: "successful subscription fetch",
+			Request: map[string]interface{}{
+				"subscription_id": "sub_00000000000001",
+			},
+			MockHttpClient: func() (*http.Client, *httptest.Server) {
+				return mock.NewHTTPClient(
+					mock.Endpoint{
+						Path:     fetchSubscriptionPath,
+						Method:   "GET",
+						Response: subscriptionResp,
+					},
+				)
+			},
+			ExpectError:    false,
+			ExpectedResult: subscriptionResp,
+		},
+		{
+			Name:           "missing subscription_id parameter",
+			Request:        map[string]interface{}{},
+			MockHttpClient: nil,
+			ExpectError:    true,
+			ExpectedErrMsg: "missing required parameter: subscription_id",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.Name, func(t *testing.T) {
+			runToolTest(t, tc, FetchSubscription, "Subscription")
+		})
+	}
+}
+
+func Test_FetchAllSubscriptions(t *testing.T) {
+	fetchAllSubscriptionsPath := fmt.Sprintf(
+		"/%s%s",
+		constants.VERSION_V1,
+		constants.SUBSCRIPTION_URL,
+	)
+
+	subscriptionsResp := map[string]interface{}{
+		"entity": "collection",
+		"count":  float64(1),
+		"items": []interface{}{
+			map[string]interface{}{
+				"id":      "sub_00000000000001",
+				"entity":  "subscription",
+				"plan_id": "plan_00000000000001",
+				"status":  "active",
+			},
+		},
+	}
+
+	tests := []RazorpayToolTestCase{
+		{
+			Name: "successful subscriptions fetch",
+			Request: map[string]interface{}{
+				"count":   float64(10),
+				"plan_id": "plan_00000000000001",
+			},
+			MockHttpClient: func() (*http.Client, *httptest.Server) {
+				return mock.NewHTTPClient(
+					mock.Endpoint{
+						Path:     fetchAllSubscriptionsPath,
+						Method:   "GET",
+						Response: subscriptionsResp,
+					},
+				)
+			},
+			ExpectError:    false,
+			ExpectedResult: subscriptionsResp,
+		},
+		{
+			Name: "invalid from parameter",
+			Request: map[string]interface{}{
+				"from": "yesterday",
+			},
+			MockHttpClient: nil,
+			ExpectError:    true,
+			ExpectedErrMsg: "invalid parameter type: from",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.Name, func(t *testing.T) {
+			runToolTest(t, tc, FetchAllSubscriptions, "Subscriptions")
+		})
+	}
+}
+
+func Test_SubscriptionStateChanges(t *testing.T) {
+	subscriptionPathFmt := fmt.Sprintf(
+		"/%s%s/%s/%%s",
+		constants.VERSION_V1,
+		constants.SUBSCRIPTION_URL,
+		"sub_00000000000001",
+	)
+
+	tools := []struct {
+		name    string
+		action  string
+		tool    func(*observability.Observability, *rzpsdk.Client) mcpgo.Tool
+		status  string
+		errVerb string
+	}{
+		{
+			name:    "cancel",
+			action:  "cancel",
+			tool:    CancelSubscription,
+			status:  "cancelled",
+			errVerb: "cancelling",
+		},
+		{
+			name:    "pause",
+			action:  "pause",
+			tool:    PauseSubscription,
+			status:  "paused",
+			errVerb: "pausing",
+		},
+		{
+			name:    "resume",
+			action:  "resume",
+			tool:    ResumeSubscription,
+			status:  "active",
+			errVerb: "resuming",
+		},
+	}
+
+	for _, tt := range tools {
+		path := fmt.Sprintf(subscriptionPathFmt, tt.action)
+		subscriptionResp := map[string]interface{}{
+			"id":     "sub_00000000000001",
+			"entity": "subscription",
+			"status": tt.status,
+		}
+
+		tests := []RazorpayToolTestCase{
+			{
+				Name: "successful " + tt.name,
+				Request: map[string]interface{}{
+					"subscription_id": "sub_00000000000001",
+				},
+				MockHttpClient: func() (*http.Client, *httptest.Server) {
+					return mock.NewHTTPClient(
+						mock.Endpoint{
+							Path:     path,
+							Method:   "POST",
+							Response: subscriptionResp,
+						},
+					)
+				},
+				ExpectError:    false,
+				ExpectedResult: subscriptionResp,
+			},
+			{
+				Name: tt.name + " fails",
+				Request: map[string]interface{}{
+					"subscription_id": "sub_00000000000001",
+				},
+				MockHttpClient: func() (*http.Client, *httptest.Server) {
+					return mock.NewHTTPClient(
+						mock.Endpoint{
+							Path:   path,
+							Method: "POST",
+							Response: map[string]interface{}{
+								"error": map[string]interface{}{
+									"code": "BAD_REQUEST_ERROR",
+									"description": "Invalid subscription " +
+										"state for this operation",
+								},
+							},
+						},
+					)
+				},
+				ExpectError: true,
+				ExpectedErrMsg: tt.errVerb + " subscription failed: " +
+					"Invalid subscription state for this operation",
+			},
+			{
+				Name:           tt.name + " missing subscription_id",
+				Request:        map[string]interface{}{},
+				MockHttpClient: nil,
+				ExpectError:    true,
+				ExpectedErrMsg: "missing required parameter: subscription_id",
+			},
+		}
+
+		for _, tc := range tests {
+			t.Run(tc.Name, func(t *testing.T) {
+				runToolTest(t, tc, tt.tool, "Subscription")
+			})
+		}
+	}
+}
@@ -22,8 +22,20 @@ func FetchSavedPaymentMethods(
 			"contact",
 			mcpgo.Description(
 				"Contact number of the customer to fetch all saved payment methods for. "+
-					"For example, 9876543210 or +919876543210"),
-			mcpgo.Required(),
+					"For example, 9876543210 or +919876543210. "+
+					"Required unless customer_id is given"),
+		),
+		mcpgo.WithString(
+			"customer_id",
+			mcpgo.Description(
+				"ID of the customer to fetch saved payment methods for, instead "+
+					"of looking the customer up by contact. Example: 'cust_xxx'"),
+		),
+		mcpgo.WithString(
+			"method",
+			mcpgo.Description(
+				"Only return saved payment methods of this type"),
+			mcpgo.Enum("card", "upi"),
 		),
 	}
 
@@ -37,37 +49,57 @@ func FetchSavedPaymentMethods(
 			return mcpgo.NewToolResultError(err.Error()), nil
 		}
 
-		validator := NewValidator(&r)
+		params := make(map[string]interface{})
 
-		// Validate required contact parameter
-		contactValue, err := extractValueGeneric[string](&r, "contact", true)
-		if err != nil {
-			validator = validator.addError(err)
-		} else if contactValue == nil || *contactValue == "" {
+		validator := NewValidator(&r).
+			ValidateAndAddOptionalString(params, "contact").
+			ValidateAndAddOptionalString(params, "customer_id").
+			ValidateAndAddOptionalString(params, "method")
+
+		contact, _ := params["contact"].(string)
+		customerID, _ := params["customer_id"].(string)
+		if contact == "" && customerID == "" {
+			validator = validator.addError(fmt.Errorf(
+				"missing required parameter: contact (or customer_id)"))
+		}
+		method, _ := params["method"].(string)
+		if method != "" && method != "card" && method != "upi" {
 			validator = validator.addError(
-				fmt.Errorf("missing required parameter: contact"))
+				fmt.Errorf("method must be card or upi"))
 		}
 		if result, err := validator.HandleErrorsIfAny(); result != nil {
 			return result, err
 		}
-		contact := *contactValue
-		customerData := map[string]interface{}{
-			"contact":       contact,
-			"fail_existing": "0", // Get existing customer if exists
-		}
 
-		// Create/get customer using Razorpay SDK
-		customer, err := client.Customer.Create(customerData, nil)
-		if err != nil {
-			return mcpgo.NewToolResultError(
-				fmt.Sprintf(
-					"Failed to create/fetch customer with contact %s: %v", contact, err,
-				)), nil
-		}
+		var customer map[string]interface{}
+		if customerID != "" {
+			customer, err = client.Customer.Fetch(customerID, nil, nil)
+			if err != nil {
+				return mcpgo.NewToolResultError(
+					fmt.Sprintf(
+						"Failed to fetch customer %s: %v", customerID, err,
+					)), nil
+			}
+		} else {
+			customerData := map[string]interface{}{
+				"contact":       contact,
+				"fail_existing": "0", // Get existing customer if exists
+			}
 
-		customerID, ok := customer["id"].(string)
-		if !ok {
-			return mcpgo.NewToolResultError("Customer ID not found in response"), nil
+			// Create/get customer using Razorpay SDK
+			customer, err = client.Customer.Create(customerData, nil)
+			if err != nil {
+				return mcpgo.NewToolResultError(
+					fmt.Sprintf(
+						"Failed to create/fetch customer with contact %s: %v", contact, err,
+					)), nil
+			}
+
+			var ok bool
+			customerID, ok = customer["id"].(string)
+			if !ok {
+				return mcpgo.NewToolResultError("Customer ID not found in response"), nil
+			}
 		}
 
 		url := fmt.Sprintf("/%s/customers/%s/tokens",
@@ -84,28 +116,76 @@ func FetchSavedPaymentMethods(
 				)), nil
 		}
 
+		// The tokens endpoint has no method filter, so it is applied here
+		if method != "" {
+			tokensResponse = filterTokensByMethod(tokensResponse, method)
+		}
+
 		result := map[string]interface{}{
 			"customer":              customer,
 			"saved_payment_methods": tokensResponse,
 		}
+		if items, _ := tokensResponse["items"].([]interface{}); len(items) == 0 {
+			kind := "payment methods"
+			if method != "" {
+				kind = method + " payment methods"
+			}
+			result["message"] = fmt.Sprintf(
+				"No saved %s found for customer %s", kind, customerID)
+		}
 		return mcpgo.NewToolResultJSON(result)
 	}
 
 	return mcpgo.NewTool(
 		"fetch_tokens",
 		"Get all saved payment methods (cards, UPI)"+
-			" for a contact number. "+
-			"This tool first finds or creates a"+
+			" for a contact number or customer_id. "+
+			"Given a contact, this tool first finds or creates a"+
 			" customer with the given contact number, "+
 			"then fetches all saved payment tokens "+
 			"associated with that customer including "+
 			"credit/debit cards, UPI IDs, digital wallets,"+
-			" and other tokenized payment instruments.",
+			" and other tokenized payment instruments. "+
+			"Pass method to only get cards or UPI IDs; when "+
+			"nothing matches, message says so.",
 		parameters,
 		handler,
 	)
 }
 
+// filterTokensByMethod returns the tokens collection with only the tokens of
+// the given method
+func filterTokensByMethod(
+	tokens map[string]interface{},
+	method string,
+) map[string]interface{} {
+	filtered := make([]interface{}, 0)
+	items, _ := tokens["items"].([]interface{})
+	for _, item := range items {
+		if token, ok := item.(map[string]interface{}); ok &&
+			token["method"] == method {
+			filtered = append(filtered, token)
+		}
+	}
+
+	result := make(map[string]interface{}, len(tokens))
+	for key, value := range tokens {
+		result[key] = value
+	}
+	result["items"] = filtered
+	result["count"] = len(filtered)
+	return result
+}
+
+// tokenRevoked reports whether a fetched token has already been revoked
+func tokenRevoked(token map[string]interface{}) bool {
+	if token["status"] == "deactivated" || token["status"] == "cancelled" {
+		return true
+	}
+	recurring, _ := token["recurring_details"].(map[string]interface{})
+	return recurring["status"] == "cancelled"
+}
+
 // RevokeToken returns a tool that revokes a saved payment token
 func RevokeToken(
 	obs *observability.Observability,
@@ -128,6 +208,13 @@ func RevokeToken(
 					"Example: 'token_xxx'"),
 			mcpgo.Required(),
 		),
+		mcpgo.WithBoolean(
+			"confirm",
+			mcpgo.Description(
+				"Must be true. Revoking removes the saved payment method "+
+					"for good, so confirm with the user before setting it"),
+			mcpgo.Required(),
+		),
 	}
 
 	handler := func(
@@ -168,6 +255,46 @@ func RevokeToken(
 		}
 		tokenID := *tokenIDValue
 
+		params := make(map[string]interface{})
+		validator = validator.ValidateAndAddRequiredBool(params, "confirm")
+		if result, err := validator.HandleErrorsIfAny(); result != nil {
+			return result, err
+		}
+		if confirm, _ := params["confirm"].(bool); !confirm {
+			return mcpgo.NewToolResultError(
+				"revoking a saved payment method cannot be undone; " +
+					"set confirm=true to proceed"), nil
+		}
+
+		// Fetching through the customer makes sure the token is theirs
+		token, err := client.Token.Fetch(customerID, tokenID, nil, nil)
+		if err != nil {
+			return mcpgo.NewToolResultError(
+				fmt.Sprintf(
+					"Token %s not found for customer %s: %v",
+					tokenID,
+					customerID,
+					err,
+				)), nil
+		}
+		if owner, ok := token["customer_id"].(string); ok && owner != customerID {
+			return mcpgo.NewToolResultError(
+				fmt.Sprintf(
+					"Token %s belongs to customer %s, not %s",
+					tokenID,
+					owner,
+					customerID,
+				)), nil
+		}
+		if tokenRevoked(token) {
+			return mcpgo.NewToolResultJSON(map[string]interface{}{
+				"id":              tokenID,
+				"customer_id":     customerID,
+				"deleted":         true,
+				"already_revoked": true,
+			})
+		}
+
 		url := fmt.Sprintf(
 			"/%s%s/%s/tokens/%s/cancel",
 			constants.VERSION_V1,
@@ -195,8 +322,168 @@ func RevokeToken(
 		"Revoke a saved payment method (token) for a customer. "+
 			"This tool revokes the specified token "+
 			"associated with the given customer ID. "+
-			"Once revoked, the token cannot be used for future payments.",
+			"Once revoked, the token cannot be used for future payments. "+
+			"Requires confirm=true; revoking an already revoked token "+
+			"succeeds with already_revoked set.",
 		parameters,
 		handler,
 	)
 }
+
+// tokenizationNote explains why saved cards only expose display metadata.
+const tokenizationNote = "Under RBI card-on-file tokenization rules " +
+	"merchants may not store card numbers, so saved cards are returned " +
+	"as network tokens with display metadata only (network, issuer, " +
+	"last4, expiry). The full card number and CVV are never returned, " +
+	"and a card token exists only if the customer consented to save " +
+	"the card during a previous checkout."
+
+// FetchCheckoutPrefill returns a tool that fetches a customer's contact
+// details and saved tokens, shaped for checkout options.prefill
+func FetchCheckoutPrefill(
+	obs *observability.Observability,
+	client *rzpsdk.Client,
+) mcpgo.Tool {
+	parameters := []mcpgo.ToolParameter{
+		mcpgo.WithString(
+			"customer_id",
+			mcpgo.Description(
+				"ID of the returning customer. "+
+					"Must start with 'cust_'. Example: 'cust_xxx'"),
+			mcpgo.Required(),
+		),
+	}
+
+	handler := func(
+		ctx context.Context,
+		r mcpgo.CallToolRequest,
+	) (*mcpgo.ToolResult, error) {
+		// Get client from context or use default
+		client, err := getClientFromContextOrDefault(ctx, client)
+		if err != nil {
+			return mcpgo.NewToolResultError(err.Error()), nil
+		}
+
+		params := make(map[string]interface{})
+
+		validator := NewValidator(&r).
+			ValidateAndAddRequiredString(params, "customer_id")
+
+		if result, err := validator.HandleErrorsIfAny(); result != nil {
+			return result, err
+		}
+		customerID := params["customer_id"].(string)
+
+		customer, err := client.Customer.Fetch(customerID, nil, nil)
+		if err != nil {
+			return mcpgo.NewToolResultError(
+				fmt.Sprintf("fetching customer failed: %s", err.Error())), nil
+		}
+
+		url := fmt.Sprintf("/%s%s/%s/tokens",
+			constants.VERSION_V1, constants.CUSTOMER_URL, customerID)
+
+		tokensResponse, err := client.Request.Get(url, nil, nil)
+		if err != nil {
+			return mcpgo.NewToolResultError(
+				fmt.Sprintf(
+					"Failed to fetch saved payment methods for customer %s: %v",
+					customerID,
+					err,
+				)), nil
+		}
+
+		return mcpgo.NewToolResultJSON(
+			buildCheckoutPrefill(customerID, customer, tokensResponse))
+	}
+
+	return mcpgo.NewTool(
+		"fetch_checkout_prefill",
+		"Fetch a returning customer's contact details and saved payment "+
+			"methods (cards, UPI IDs) formatted for checkout. The "+
+			"checkout_options field can be merged into the Standard Checkout "+
+			"options so name, email and contact are pre-filled and saved "+
+			"methods are shown; saved_methods lists them for display. Cards "+
+			"only include tokenized display metadata, see rbi_note.",
+		parameters,
+		handler,
+	)
+}
+
+// buildCheckoutPrefill converts a customer and its tokens collection into
+// checkout options and a display friendly list of saved methods
+func buildCheckoutPrefill(
+	customerID string,
+	customer map[string]interface{},
+	tokens map[string]interface{},
+) map[string]interface{} {
+	prefill := map[string]interface{}{}
+	for _, key := range []string{"name", "email", "contact"} {
+		if value, ok := customer[key].(string); ok && value != "" {
+			prefill[key] = value
+		}
+	}
+
+	savedMethods := []interface{}{}
+	items, _ := tokens["items"].([]interface{})
+	for _, item := range items {
+		token, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if method := summarizeSavedMethod(token); method != nil {
+			savedMethods = append(savedMethods, method)
+		}
+	}
+
+	return map[string]interface{}{
+		"customer_id": customerID,
+		"checkout_options": map[string]interface{}{
+			"customer_id": customerID,
+			"prefill":     prefill,
+		},
+		"saved_methods": savedMethods,
+		"rbi_note":      tokenizationNote,
+	}
+}
+
+// summarizeSavedMethod keeps only the fields of a token that are safe and
+// useful to show a customer. It returns nil for unsupported methods.
+func summarizeSavedMethod(token map[string]interface{}) map[string]interface{} {
+	method, _ := token["method"].(string)
+	summary := map[string]interface{}{
+		"token_id": token["id"],
+		"method":   method,
+	}
+	if usedAt, ok := token["used_at"]; ok && usedAt != nil {
+		summary["last_used_at"] = usedAt
+	}
+
+	switch method {
+	case "card":
+		card, ok := token["card"].(map[string]interface{})
+		if !ok {
+			return nil
+		}
+		for _, key := range []string{
+			"network", "issuer", "type", "last4", "expiry_month", "expiry_year",
+		} {
+			if value, ok := card[key]; ok && value != nil {
+				summary[key] = value
+			}
+		}
+		summary["display"] = fmt.Sprintf(
+			"%v •••• %v", card["network"], card["last4"])
+	case "upi":
+		vpa, ok := token["vpa"].(map[string]interface{})
+		if !ok {
+			return nil
+		}
+		address := fmt.Sprintf("%v@%v", vpa["username"], vpa["handle"])
+		summary["vpa"] = address
+		summary["display"] = address
+	default:
+		return nil
+	}
+	return summary
+}
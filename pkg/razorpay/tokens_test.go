@@ -321,8 +321,105 @@ func Test_FetchSavedPaymentMethods(t *testing.T) {
 					"count":  float64(0),
 					"items":  []interface{}{},
 				},
+				"message": "No saved payment methods found for customer " +
+					"cust_1Aa00000000003",
 			},
 		},
+		{
+			Name: "fetch by customer_id filtered to upi",
+			Request: map[string]interface{}{
+				"customer_id": "cust_1Aa00000000003",
+				"method":      "upi",
+			},
+			MockHttpClient: func() (*http.Client, *httptest.Server) {
+				return mock.NewHTTPClient(
+					mock.Endpoint{
+						Path: fmt.Sprintf("%s/%s",
+							createCustomerPath, "cust_1Aa00000000003"),
+						Method:   "GET",
+						Response: customerResp,
+					},
+					mock.Endpoint{
+						Path:     fmt.Sprintf(fetchTokensPathFmt, "cust_1Aa00000000003"),
+						Method:   "GET",
+						Response: tokensResp,
+					},
+				)
+			},
+			ExpectedResult: map[string]interface{}{
+				"customer": customerResp,
+				"saved_payment_methods": map[string]interface{}{
+					"entity": "collection",
+					"count":  float64(1),
+					"items": []interface{}{
+						tokensResp["items"].([]interface{})[1],
+					},
+				},
+			},
+		},
+		{
+			Name: "no saved methods of the requested type",
+			Request: map[string]interface{}{
+				"contact": "9876543210",
+				"method":  "card",
+			},
+			MockHttpClient: func() (*http.Client, *httptest.Server) {
+				return mock.NewHTTPClient(
+					mock.Endpoint{
+						Path:     createCustomerPath,
+						Method:   "POST",
+						Response: customerResp,
+					},
+					mock.Endpoint{
+						Path:   fmt.Sprintf(fetchTokensPathFmt, "cust_1Aa00000000003"),
+						Method: "GET",
+						Response: map[string]interface{}{
+							"entity": "collection",
+							"count":  float64(1),
+							"items": []interface{}{
+								tokensResp["items"].([]interface{})[1],
+							},
+						},
+					},
+				)
+			},
+			ExpectedResult: map[string]interface{}{
+				"customer": customerResp,
+				"saved_payment_methods": map[string]interface{}{
+					"entity": "collection",
+					"count":  float64(0),
+					"items":  []interface{}{},
+				},
+				"message": "No saved card payment methods found for customer " +
+					"cust_1Aa00000000003",
+			},
+		},
+		{
+			Name: "customer_id not found",
+			Request: map[string]interface{}{
+				"customer_id": "cust_invalid",
+			},
+			MockHttpClient: func() (*http.Client, *httptest.Server) {
+				return mock.NewHTTPClient(
+					mock.Endpoint{
+						Path:     fmt.Sprintf("%s/%s", createCustomerPath, "cust_invalid"),
+						Method:   "GET",
+						Response: tokensAPIFailedResp,
+					},
+				)
+			},
+			ExpectError:    true,
+			ExpectedErrMsg: "Failed to fetch customer cust_invalid",
+		},
+		{
+			Name: "unsupported method",
+			Request: map[string]interface{}{
+				"contact": "9876543210",
+				"method":  "wallet",
+			},
+			ExpectError:    true,
+			ExpectedErrMsg: "method must be card or upi",
+		},
 	}
 
 	for _, tc := range tests {
@@ -417,11 +514,25 @@ func Test_RevokeToken(t *testing.T) {
 		constants.VERSION_V1,
 	)
 
+	fetchTokenPathFmt := fmt.Sprintf(
+		"/%s/customers/%%s/tokens/%%s",
+		constants.VERSION_V1,
+	)
+
 	// Sample successful token revocation response
 	successResp := map[string]interface{}{
 		"deleted": true,
 	}
 
+	activeToken := map[string]interface{}{
+		"id":     "token_ABCDEFGH",
+		"entity": "token",
+		"method": "card",
+		"recurring_details": map[string]interface{}{
+			"status": "confirmed",
+		},
+	}
+
 	// Error responses
 	tokenNotFoundResp := map[string]interface{}{
 		"error": map[string]interface{}{
@@ -430,10 +541,10 @@ func Test_RevokeToken(t *testing.T) {
 		},
 	}
 
-	customerNotFoundResp := map[string]interface{}{
+	cancelFailedResp := map[string]interface{}{
 		"error": map[string]interface{}{
-			"code":        "BAD_REQUEST_ERROR",
-			"description": "Customer not found",
+			"code":        "SERVER_ERROR",
+			"description": "The server encountered an error",
 		},
 	}
 
@@ -443,9 +554,19 @@ func Test_RevokeToken(t *testing.T) {
 			Request: map[string]interface{}{
 				"customer_id": "cust_1Aa00000000003",
 				"token_id":    "token_ABCDEFGH",
+				"confirm":     true,
 			},
 			MockHttpClient: func() (*http.Client, *httptest.Server) {
 				return mock.NewHTTPClient(
+					mock.Endpoint{
+						Path: fmt.Sprintf(
+							fetchTokenPathFmt,
+							"cust_1Aa00000000003",
+							"token_ABCDEFGH",
+						),
+						Method:   "GET",
+						Response: activeToken,
+					},
 					mock.Endpoint{
 						Path: fmt.Sprintf(
 							revokeTokenPathFmt,
@@ -461,50 +582,140 @@ func Test_RevokeToken(t *testing.T) {
 			ExpectedResult: successResp,
 		},
 		{
-			Name: "token not found error",
+			Name: "already revoked token succeeds without cancelling again",
+			Request: map[string]interface{}{
+				"customer_id": "cust_1Aa00000000003",
+				"token_id":    "token_ABCDEFGH",
+				"confirm":     true,
+			},
+			MockHttpClient: func() (*http.Client, *httptest.Server) {
+				return mock.NewHTTPClient(
+					mock.Endpoint{
+						Path: fmt.Sprintf(
+							fetchTokenPathFmt,
+							"cust_1Aa00000000003",
+							"token_ABCDEFGH",
+						),
+						Method: "GET",
+						Response: map[string]interface{}{
+							"id":     "token_ABCDEFGH",
+							"entity": "token",
+							"recurring_details": map[string]interface{}{
+								"status": "cancelled",
+							},
+						},
+					},
+				)
+			},
+			ExpectedResult: map[string]interface{}{
+				"id":              "token_ABCDEFGH",
+				"customer_id":     "cust_1Aa00000000003",
+				"deleted":         true,
+				"already_revoked": true,
+			},
+		},
+		{
+			Name: "token not found for customer",
 			Request: map[string]interface{}{
 				"customer_id": "cust_1Aa00000000003",
 				"token_id":    "token_nonexistent",
+				"confirm":     true,
 			},
 			MockHttpClient: func() (*http.Client, *httptest.Server) {
 				return mock.NewHTTPClient(
 					mock.Endpoint{
 						Path: fmt.Sprintf(
-							revokeTokenPathFmt,
+							fetchTokenPathFmt,
 							"cust_1Aa00000000003",
 							"token_nonexistent",
 						),
-						Method:   "PUT",
+						Method:   "GET",
 						Response: tokenNotFoundResp,
 					},
 				)
 			},
 			ExpectError: true,
-			ExpectedErrMsg: "Failed to revoke token token_nonexistent for " +
+			ExpectedErrMsg: "Token token_nonexistent not found for " +
 				"customer cust_1Aa00000000003: Token not found",
 		},
 		{
-			Name: "customer not found error",
+			Name: "token of another customer",
+			Request: map[string]interface{}{
+				"customer_id": "cust_1Aa00000000003",
+				"token_id":    "token_ABCDEFGH",
+				"confirm":     true,
+			},
+			MockHttpClient: func() (*http.Client, *httptest.Server) {
+				return mock.NewHTTPClient(
+					mock.Endpoint{
+						Path: fmt.Sprintf(
+							fetchTokenPathFmt,
+							"cust_1Aa00000000003",
+							"token_ABCDEFGH",
+						),
+						Method: "GET",
+						Response: map[string]interface{}{
+							"id":          "token_ABCDEFGH",
+							"customer_id": "cust_1Aa00000000004",
+						},
+					},
+				)
+			},
+			ExpectError: true,
+			ExpectedErrMsg: "Token token_ABCDEFGH belongs to customer " +
+				"cust_1Aa00000000004, not cust_1Aa00000000003",
+		},
+		{
+			Name: "cancel api error",
 			Request: map[string]interface{}{
-				"customer_id": "cust_nonexistent",
+				"customer_id": "cust_1Aa00000000003",
 				"token_id":    "token_ABCDEFGH",
+				"confirm":     true,
 			},
 			MockHttpClient: func() (*http.Client, *httptest.Server) {
 				return mock.NewHTTPClient(
+					mock.Endpoint{
+						Path: fmt.Sprintf(
+							fetchTokenPathFmt,
+							"cust_1Aa00000000003",
+							"token_ABCDEFGH",
+						),
+						Method:   "GET",
+						Response: activeToken,
+					},
 					mock.Endpoint{
 						Path: fmt.Sprintf(
 							revokeTokenPathFmt,
-							"cust_nonexistent",
+							"cust_1Aa00000000003",
 							"token_ABCDEFGH",
 						),
 						Method:   "PUT",
-						Response: customerNotFoundResp,
+						Response: cancelFailedResp,
 					},
 				)
 			},
 			ExpectError: true,
 			ExpectedErrMsg: "Failed to revoke token token_ABCDEFGH for " +
-				"customer cust_nonexistent: Customer not found",
+				"customer cust_1Aa00000000003: The server encountered an error",
+		},
+		{
+			Name: "confirm not set",
+			Request: map[string]interface{}{
+				"customer_id": "cust_1Aa00000000003",
+				"token_id":    "token_ABCDEFGH",
+			},
+			ExpectError:    true,
+			ExpectedErrMsg: "missing required parameter: confirm",
+		},
+		{
+			Name: "confirm false",
+			Request: map[string]interface{}{
+				"customer_id": "cust_1Aa00000000003",
+				"token_id":    "token_ABCDEFGH",
+				"confirm":     false,
+			},
+			ExpectError:    true,
+			ExpectedErrMsg: "set confirm=true to proceed",
 		},
 		{
 			Name: "missing customer_id parameter",
@@ -661,3 +872,173 @@ func Test_RevokeToken_ClientContextScenarios(t *testing.T) {
 		}
 	})
 }
+
+func Test_FetchCheckoutPrefill(t *testing.T) {
+	fetchCustomerPath := fmt.Sprintf(
+		"/%s%s/cust_1Aa00000000003",
+		constants.VERSION_V1,
+		constants.CUSTOMER_URL,
+	)
+	fetchTokensPath := fetchCustomerPath + "/tokens"
+
+	customerResp := map[string]interface{}{
+		"id":      "cust_1Aa00000000003",
+		"entity":  "customer",
+		"name":    "Gaurav Kumar",
+		"email":   "gaurav.kumar@example.com",
+		"contact": "9876543210",
+	}
+
+	tokensResp := map[string]interface{}{
+		"entity": "collection",
+		"count":  float64(3),
+		"items": []interface{}{
+			map[string]interface{}{
+				"id":     "token_ABCDEFGH",
+				"method": "card",
+				"card": map[string]interface{}{
+					"last4":        "1111",
+					"network":      "Visa",
+					"type":         "debit",
+					"issuer":       "HDFC",
+					"expiry_month": "12",
+					"expiry_year":  "2030",
+				},
+				"used_at": float64(1629779657),
+			},
+			map[string]interface{}{
+				"id":     "token_EhYXHrLsJdwRhN",
+				"method": "upi",
+				"vpa": map[string]interface{}{
+					"username": "gauravkumar",
+					"handle":   "okhdfcbank",
+				},
+				"used_at": nil,
+			},
+			map[string]interface{}{
+				"id":     "token_EhYXHrLsJdwRhO",
+				"method": "emandate",
+			},
+		},
+	}
+
+	tests := []RazorpayToolTestCase{
+		{
+			Name: "prefill and saved methods for a returning customer",
+			Request: map[string]interface{}{
+				"customer_id": "cust_1Aa00000000003",
+			},
+			MockHttpClient: func() (*http.Client, *httptest.Server) {
+				return mock.NewHTTPClient(
+					mock.Endpoint{
+						Path:     fetchCustomerPath,
+						Method:   "GET",
+						Response: customerResp,
+					},
+					mock.Endpoint{
+						Path:     fetchTokensPath,
+						Method:   "GET",
+						Response: tokensResp,
+					},
+				)
+			},
+			ExpectError: false,
+			ExpectedResult: map[string]interface{}{
+				"customer_id": "cust_1Aa00000000003",
+				"checkout_options": map[string]interface{}{
+					"customer_id": "cust_1Aa00000000003",
+					"prefill": map[string]interface{}{
+						"name":    "Gaurav Kumar",
+						"email":   "gaurav.kumar@example.com",
+						"contact": "9876543210",
+					},
+				},
+				"saved_methods": []interface{}{
+					map[string]interface{}{
+						"token_id":     "token_ABCDEFGH",
+						"method":       "card",
+						"network":      "Visa",
+						"issuer":       "HDFC",
+						"type":         "debit",
+						"last4":        "1111",
+						"expiry_month": "12",
+						"expiry_year":  "2030",
+						"display":      "Visa •••• 1111",
+						"last_used_at": float64(1629779657),
+					},
+					map[string]interface{}{
+						"token_id": "token_EhYXHrLsJdwRhN",
+						"method":   "upi",
+						"vpa":      "gauravkumar@okhdfcbank",
+						"display":  "gauravkumar@okhdfcbank",
+					},
+				},
+				"rbi_note": tokenizationNote,
+			},
+		},
+		{
+			Name: "customer not found",
+			Request: map[string]interface{}{
+				"customer_id": "cust_1Aa00000000003",
+			},
+			MockHttpClient: func() (*http.Client, *httptest.Server) {
+				return mock.NewHTTPClient(
+					mock.Endpoint{
+						Path:   fetchCustomerPath,
+						Method: "GET",
+						Response: map[string]interface{}{
+							"error": map[string]interface{}{
+								"code":        "BAD_REQUEST_ERROR",
+								"description": "The id provided does not exist",
+							},
+						},
+					},
+				)
+			},
+			ExpectError: true,
+			ExpectedErrMsg: "fetching customer failed: " +
+				"The id provided does not exist",
+		},
+		{
+			Name: "tokens API failure",
+			Request: map[string]interface{}{
+				"customer_id": "cust_1Aa00000000003",
+			},
+			MockHttpClient: func() (*http.Client, *httptest.Server) {
+				return mock.NewHTTPClient(
+					mock.Endpoint{
+						Path:     fetchCustomerPath,
+						Method:   "GET",
+						Response: customerResp,
+					},
+					mock.Endpoint{
+						Path:   fetchTokensPath,
+						Method: "GET",
+						Response: map[string]interface{}{
+							"error": map[string]interface{}{
+								"code":        "BAD_REQUEST_ERROR",
+								"description": "Customer not found",
+							},
+						},
+					},
+				)
+			},
+			ExpectError: true,
+			ExpectedErrMsg: "Failed to fetch saved payment methods for " +
+				"customer cust_1Aa00000000003: Customer not found",
+		},
+		{
+			Name:           "missing customer_id parameter",
+			Request:        map[string]interface{}{},
+			MockHttpClient: nil,
+			ExpectError:    true,
+			ExpectedErrMsg: "missing required parameter: customer_id",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.Name, func(t *testing.T) {
+			runToolTest(t, tc, FetchCheckoutPrefill, "Checkout Prefill")
+		})
+	}
+}
@@ -1,17 +1,47 @@
 package razorpay
 
 import (
+	"fmt"
+
 	rzpsdk "github.com/razorpay/razorpay-go"
 
 	"github.com/razorpay/razorpay-mcp-server/pkg/observability"
 	"github.com/razorpay/razorpay-mcp-server/pkg/toolsets"
 )
 
+// ToolsetOption customizes the toolsets created by NewToolSets
+type ToolsetOption func(tg *toolsets.ToolsetGroup) error
+
+// WithToolsetDescription overrides the description of the named toolset
+func WithToolsetDescription(name string, description string) ToolsetOption {
+	return func(tg *toolsets.ToolsetGroup) error {
+		ts, exists := tg.Toolsets[name]
+		if !exists {
+			return fmt.Errorf("toolset %s does not exist", name)
+		}
+		ts.Description = description
+		return nil
+	}
+}
+
+// WithToolsetTags attaches tags to the named toolset
+func WithToolsetTags(name string, tags ...string) ToolsetOption {
+	return func(tg *toolsets.ToolsetGroup) error {
+		ts, exists := tg.Toolsets[name]
+		if !exists {
+			return fmt.Errorf("toolset %s does not exist", name)
+		}
+		ts.AddTags(tags...)
+		return nil
+	}
+}
+
 func NewToolSets(
 	obs *observability.Observability,
 	client *rzpsdk.Client,
 	enabledToolsets []string,
 	readOnly bool,
+	opts ...ToolsetOption,
 ) (*toolsets.ToolsetGroup, error) {
 	// Create a new toolset group
 	toolsetGroup := toolsets.NewToolsetGroup(readOnly)
@@ -22,6 +52,16 @@ func NewToolSets(
 			FetchPayment(obs, client),
 			FetchPaymentCardDetails(obs, client),
 			FetchAllPayments(obs, client),
+			FetchFeeBearerConfig(obs, client),
+			FetchBrandingConfig(obs, client),
+			VerifyPaymentSignature(obs, client),
+			VerifyCheckout(obs, client),
+			VerifyWebhookSignature(obs, client),
+			ConvertAmount(obs, client),
+			FetchOtpStatus(obs, client),
+			FetchPaymentDowntimes(obs, client),
+			FetchPaymentDowntimeByID(obs, client),
+			FetchEmiPlans(obs, client),
 		).
 		AddWriteTools(
 			CapturePayment(obs, client),
@@ -40,9 +80,12 @@ func NewToolSets(
 		).
 		AddWriteTools(
 			CreatePaymentLink(obs, client),
+			CreatePaymentLinksBulk(obs, client),
 			CreateUpiPaymentLink(obs, client),
+			CreatePaymentLinkWithLineItems(obs, client),
 			ResendPaymentLinkNotification(obs, client),
 			UpdatePaymentLink(obs, client),
+			CancelPaymentLink(obs, client),
 		)
 
 	orders := toolsets.NewToolset("orders", "Razorpay Orders related tools").
@@ -50,9 +93,11 @@ func NewToolSets(
 			FetchOrder(obs, client),
 			FetchAllOrders(obs, client),
 			FetchOrderPayments(obs, client),
+			FetchOrderPaymentsNeedingAction(obs, client),
 		).
 		AddWriteTools(
 			CreateOrder(obs, client),
+			CreateOrderForReference(obs, client),
 			UpdateOrder(obs, client),
 		)
 
@@ -65,6 +110,7 @@ func NewToolSets(
 		).
 		AddWriteTools(
 			CreateRefund(obs, client),
+			RefundOrder(obs, client),
 			UpdateRefund(obs, client),
 		)
 
@@ -72,11 +118,49 @@ func NewToolSets(
 		AddReadTools(
 			FetchPayout(obs, client),
 			FetchAllPayouts(obs, client),
+			FetchBulkPayoutStatus(obs, client),
+		).
+		AddWriteTools(
+			CreatePayout(obs, client),
+			CreatePayoutToUpi(obs, client),
+			CancelPayout(obs, client),
+			RetryPayout(obs, client),
+		)
+
+	payoutLinks := toolsets.NewToolset("payout_links",
+		"RazorpayX Payout Links related tools").
+		AddReadTools(
+			FetchPayoutLink(obs, client),
+			FetchAllPayoutLinks(obs, client),
+		).
+		AddWriteTools(
+			CreatePayoutLink(obs, client),
+			CancelPayoutLink(obs, client),
+		)
+
+	contacts := toolsets.NewToolset("contacts",
+		"RazorpayX Contacts related tools").
+		AddReadTools(
+			FetchContact(obs, client),
+			FetchAllContacts(obs, client),
+		).
+		AddWriteTools(
+			CreateContact(obs, client),
+		)
+
+	fundAccounts := toolsets.NewToolset("fund_accounts",
+		"RazorpayX Fund Accounts related tools").
+		AddReadTools(
+			FetchAllFundAccounts(obs, client),
+		).
+		AddWriteTools(
+			CreateFundAccount(obs, client),
 		)
 
 	qrCodes := toolsets.NewToolset("qr_codes", "Razorpay QR Codes related tools").
 		AddReadTools(
 			FetchQRCode(obs, client),
+			FetchQRCodeImage(obs, client),
 			FetchAllQRCodes(obs, client),
 			FetchQRCodesByCustomerID(obs, client),
 			FetchQRCodesByPaymentID(obs, client),
@@ -84,6 +168,7 @@ func NewToolSets(
 		).
 		AddWriteTools(
 			CreateQRCode(obs, client),
+			CreateCustomerQRCode(obs, client),
 			CloseQRCode(obs, client),
 		)
 
@@ -92,6 +177,8 @@ func NewToolSets(
 		AddReadTools(
 			FetchSettlement(obs, client),
 			FetchSettlementRecon(obs, client),
+			FetchSettlementReconByDate(obs, client),
+			FetchSettlementTransactions(obs, client),
 			FetchAllSettlements(obs, client),
 			FetchAllInstantSettlements(obs, client),
 			FetchInstantSettlement(obs, client),
@@ -100,8 +187,83 @@ func NewToolSets(
 			CreateInstantSettlement(obs, client),
 		)
 
+	disputes := toolsets.NewToolset("disputes", "Razorpay Disputes related tools").
+		AddReadTools(
+			FetchDisputeSummary(obs, client),
+		)
+
+	customers := toolsets.NewToolset("customers",
+		"Razorpay Customers related tools").
+		AddReadTools(
+			FetchCustomer(obs, client),
+			FetchAllCustomers(obs, client),
+		).
+		AddWriteTools(
+			CreateCustomer(obs, client),
+			EditCustomer(obs, client),
+		)
+
+	subscriptions := toolsets.NewToolset("subscriptions",
+		"Razorpay Subscriptions related tools").
+		AddReadTools(
+			FetchSubscription(obs, client),
+			FetchAllSubscriptions(obs, client),
+			FetchSubscriptionPayments(obs, client),
+		).
+		AddWriteTools(
+			CreateSubscription(obs, client),
+			CancelSubscription(obs, client),
+			PauseSubscription(obs, client),
+			ResumeSubscription(obs, client),
+		)
+
+	plans := toolsets.NewToolset("plans", "Razorpay Plans related tools").
+		AddReadTools(
+			FetchPlan(obs, client),
+			FetchAllPlans(obs, client),
+		).
+		AddWriteTools(
+			CreatePlan(obs, client),
+		)
+
+	virtualAccounts := toolsets.NewToolset("virtual_accounts",
+		"Razorpay Smart Collect (Virtual Accounts) related tools").
+		AddReadTools(
+			FetchVirtualAccount(obs, client),
+			FetchAllVirtualAccounts(obs, client),
+			FetchPaymentsForVirtualAccount(obs, client),
+		).
+		AddWriteTools(
+			CreateVirtualAccount(obs, client),
+			CloseVirtualAccount(obs, client),
+		)
+
+	items := toolsets.NewToolset("items", "Razorpay Items related tools").
+		AddReadTools(
+			FetchItem(obs, client),
+			FetchAllItems(obs, client),
+		).
+		AddWriteTools(
+			CreateItem(obs, client),
+			UpdateItem(obs, client),
+			DeleteItem(obs, client),
+		)
+
+	addons := toolsets.NewToolset("addons",
+		"Razorpay Subscription Add-ons related tools").
+		AddReadTools(
+			FetchAddon(obs, client),
+		).
+		AddWriteTools(
+			CreateAddon(obs, client),
+			DeleteAddon(obs, client),
+		)
+
 	// Add the single custom tool to an existing toolset
-	payments.AddReadTools(FetchSavedPaymentMethods(obs, client)).
+	payments.AddReadTools(
+		FetchSavedPaymentMethods(obs, client),
+		FetchCheckoutPrefill(obs, client),
+	).
 		AddWriteTools(RevokeToken(obs, client))
 
 	// Checkout Integration toolset - helps developers integrate Razorpay checkout
@@ -111,6 +273,10 @@ func NewToolSets(
 		AddReadTools(
 			IntegrateRazorpayCheckout(obs, client),
 			DetectStack(obs, client),
+			IntegrateRazorpayWebhook(obs, client),
+			IntegrateRazorpaySubscription(obs, client),
+			SetupSecrets(obs, client),
+			ValidateIntegration(obs, client),
 		)
 
 	// Add toolsets to the group
@@ -120,8 +286,25 @@ func NewToolSets(
 	toolsetGroup.AddToolset(orders)
 	toolsetGroup.AddToolset(refunds)
 	toolsetGroup.AddToolset(payouts)
+	toolsetGroup.AddToolset(payoutLinks)
+	toolsetGroup.AddToolset(contacts)
+	toolsetGroup.AddToolset(fundAccounts)
 	toolsetGroup.AddToolset(qrCodes)
 	toolsetGroup.AddToolset(settlements)
+	toolsetGroup.AddToolset(disputes)
+	toolsetGroup.AddToolset(customers)
+	toolsetGroup.AddToolset(subscriptions)
+	toolsetGroup.AddToolset(plans)
+	toolsetGroup.AddToolset(virtualAccounts)
+	toolsetGroup.AddToolset(items)
+	toolsetGroup.AddToolset(addons)
+
+	// Apply operator customizations before anything reads the toolsets
+	for _, opt := range opts {
+		if err := opt(toolsetGroup); err != nil {
+			return nil, err
+		}
+	}
 
 	// Enable the requested features
 	if err := toolsetGroup.EnableToolsets(enabledToolsets); err != nil {
@@ -3,6 +3,10 @@ package razorpay
 import (
 	"encoding/json"
 	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"strconv"
 	"strings"
 	"time"
 
@@ -222,6 +226,95 @@ func (v *Validator) ValidateAndAddOptionalMap(
 	return validateAndAddOptional[map[string]interface{}](v, params, name)
 }
 
+// Razorpay accepts at most 15 notes of up to 256 characters each
+const (
+	maxNotes      = 15
+	maxNoteLength = 256
+)
+
+// validateAndAddNotes validates a notes map and adds it with every value as
+// a string. Numbers and booleans are coerced, other values are rejected.
+func validateAndAddNotes(
+	v *Validator,
+	params map[string]interface{},
+	name string,
+	required bool,
+) *Validator {
+	value, err := extractValueGeneric[map[string]interface{}](
+		v.request, name, required)
+	if err != nil {
+		return v.addError(err)
+	}
+	if value == nil {
+		return v
+	}
+
+	notes, err := normalizeNotes(name, *value)
+	if err != nil {
+		return v.addError(err)
+	}
+	params[name] = notes
+	return v
+}
+
+// normalizeNotes returns a copy of notes with string values, checked against
+// Razorpay's limits
+func normalizeNotes(
+	name string,
+	notes map[string]interface{},
+) (map[string]interface{}, error) {
+	if len(notes) > maxNotes {
+		return nil, fmt.Errorf("%s can have at most %d keys, got %d",
+			name, maxNotes, len(notes))
+	}
+
+	keys := make([]string, 0, len(notes))
+	for key := range notes {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	normalized := make(map[string]interface{}, len(notes))
+	for _, key := range keys {
+		var str string
+		switch val := notes[key].(type) {
+		case string:
+			str = val
+		case float64:
+			str = strconv.FormatFloat(val, 'f', -1, 64)
+		case bool:
+			str = strconv.FormatBool(val)
+		default:
+			return nil, fmt.Errorf(
+				"%s.%s must be a string, number or boolean", name, key)
+		}
+		if len([]rune(str)) > maxNoteLength {
+			return nil, fmt.Errorf("%s.%s must be at most %d characters",
+				name, key, maxNoteLength)
+		}
+		normalized[key] = str
+	}
+	return normalized, nil
+}
+
+// ValidateAndAddRequiredNotes validates and adds a required notes map,
+// coercing its values to strings
+func (v *Validator) ValidateAndAddRequiredNotes(
+	params map[string]interface{},
+	name string,
+) *Validator {
+	return validateAndAddNotes(v, params, name, true)
+}
+
+// ValidateAndAddOptionalNotes validates and adds an optional notes map,
+// coercing its values to strings
+func (v *Validator) ValidateAndAddOptionalNotes(
+	params map[string]interface{},
+	name string,
+) *Validator {
+	return validateAndAddNotes(v, params, name, false)
+}
+
 // ValidateAndAddRequiredArray validates and adds a required array parameter
 func (v *Validator) ValidateAndAddRequiredArray(
 	params map[string]interface{},
@@ -268,6 +361,32 @@ func (v *Validator) ValidateAndAddExpand(
 	return v
 }
 
+// ValidateAndAddExpandValues validates expand against the values an endpoint
+// supports and adds all of them, sent as repeated expand[] query params
+func (v *Validator) ValidateAndAddExpandValues(
+	params map[string]interface{},
+	supported ...string,
+) *Validator {
+	expand, err := extractValueGeneric[[]string](v.request, "expand", false)
+	if err != nil {
+		return v.addError(err)
+	}
+
+	if expand == nil || len(*expand) == 0 {
+		return v
+	}
+
+	for _, val := range *expand {
+		if !slices.Contains(supported, val) {
+			return v.addError(fmt.Errorf(
+				"unsupported expand value: %s (supported: %s)",
+				val, strings.Join(supported, ", ")))
+		}
+	}
+	params["expand[]"] = *expand
+	return v
+}
+
 // ValidateAndAddRequiredInt validates and adds a required integer parameter
 func (v *Validator) ValidateAndAddRequiredInt(
 	params map[string]interface{},
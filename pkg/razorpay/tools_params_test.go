@@ -1,6 +1,8 @@
 package razorpay
 
 import (
+	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -1031,6 +1033,56 @@ func TestValidateAndAddExpand(t *testing.T) {
 	})
 }
 
+func TestValidateAndAddExpandValues(t *testing.T) {
+	tests := []struct {
+		name       string
+		args       map[string]interface{}
+		wantParams map[string]interface{}
+		wantErr    string
+	}{
+		{
+			name: "all values forwarded",
+			args: map[string]interface{}{
+				"expand": []interface{}{"card", "emi"},
+			},
+			wantParams: map[string]interface{}{
+				"expand[]": []string{"card", "emi"},
+			},
+		},
+		{
+			name:       "missing expand parameter",
+			args:       map[string]interface{}{},
+			wantParams: map[string]interface{}{},
+		},
+		{
+			name: "unsupported value",
+			args: map[string]interface{}{
+				"expand": []interface{}{"card", "payments"},
+			},
+			wantParams: map[string]interface{}{},
+			wantErr: "unsupported expand value: payments " +
+				"(supported: card, emi)",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			request := &mcpgo.CallToolRequest{Arguments: tt.args}
+			params := make(map[string]interface{})
+			validator := NewValidator(request).
+				ValidateAndAddExpandValues(params, "card", "emi")
+
+			if tt.wantErr != "" {
+				assert.True(t, validator.HasErrors())
+				assert.EqualError(t, validator.errors[0], tt.wantErr)
+			} else {
+				assert.False(t, validator.HasErrors())
+			}
+			assert.Equal(t, tt.wantParams, params)
+		})
+	}
+}
+
 // Test for token validation functions edge cases
 func TestTokenValidationEdgeCases(t *testing.T) {
 	t.Run("validateTokenMaxAmount - int conversion", func(t *testing.T) {
@@ -1126,3 +1178,112 @@ func TestValidateAndAddTokenEdgeCases(t *testing.T) {
 		assert.Empty(t, params)
 	})
 }
+
+func TestValidateAndAddNotes(t *testing.T) {
+	tooMany := map[string]interface{}{}
+	for i := 0; i < maxNotes+1; i++ {
+		tooMany[fmt.Sprintf("key_%d", i)] = "value"
+	}
+
+	tests := []struct {
+		name      string
+		args      map[string]interface{}
+		required  bool
+		wantNotes interface{}
+		wantErr   string
+	}{
+		{
+			name: "string values are kept",
+			args: map[string]interface{}{
+				"notes": map[string]interface{}{"customer": "Gaurav"},
+			},
+			wantNotes: map[string]interface{}{"customer": "Gaurav"},
+		},
+		{
+			name: "numbers and booleans are coerced to strings",
+			args: map[string]interface{}{
+				"notes": map[string]interface{}{
+					"order_no": float64(1042),
+					"weight":   1.5,
+					"gift":     true,
+				},
+			},
+			wantNotes: map[string]interface{}{
+				"order_no": "1042",
+				"weight":   "1.5",
+				"gift":     "true",
+			},
+		},
+		{
+			name: "nested objects are rejected",
+			args: map[string]interface{}{
+				"notes": map[string]interface{}{
+					"address": map[string]interface{}{"city": "Pune"},
+				},
+			},
+			wantErr: "notes.address must be a string, number or boolean",
+		},
+		{
+			name: "null values are rejected",
+			args: map[string]interface{}{
+				"notes": map[string]interface{}{"customer": nil},
+			},
+			wantErr: "notes.customer must be a string, number or boolean",
+		},
+		{
+			name: "values longer than 256 characters are rejected",
+			args: map[string]interface{}{
+				"notes": map[string]interface{}{
+					"comment": strings.Repeat("a", maxNoteLength+1),
+				},
+			},
+			wantErr: "notes.comment must be at most 256 characters",
+		},
+		{
+			name:    "more than 15 keys are rejected",
+			args:    map[string]interface{}{"notes": tooMany},
+			wantErr: "notes can have at most 15 keys, got 16",
+		},
+		{
+			name:    "not an object",
+			args:    map[string]interface{}{"notes": "customer=Gaurav"},
+			wantErr: "invalid parameter type: notes",
+		},
+		{
+			name: "optional and missing",
+			args: map[string]interface{}{},
+		},
+		{
+			name:     "required and missing",
+			args:     map[string]interface{}{},
+			required: true,
+			wantErr:  "missing required parameter: notes",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			params := make(map[string]interface{})
+			validator := NewValidator(&mcpgo.CallToolRequest{Arguments: tt.args})
+			if tt.required {
+				validator.ValidateAndAddRequiredNotes(params, "notes")
+			} else {
+				validator.ValidateAndAddOptionalNotes(params, "notes")
+			}
+
+			if tt.wantErr != "" {
+				result, _ := validator.HandleErrorsIfAny()
+				assert.NotNil(t, result)
+				assert.Contains(t, result.Text, tt.wantErr)
+				assert.Empty(t, params)
+				return
+			}
+			assert.False(t, validator.HasErrors())
+			if tt.wantNotes == nil {
+				assert.Empty(t, params)
+				return
+			}
+			assert.Equal(t, tt.wantNotes, params["notes"])
+		})
+	}
+}
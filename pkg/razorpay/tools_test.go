@@ -43,6 +43,41 @@ func TestNewToolSets(t *testing.T) {
 	t.Run("creates toolsets with multiple specific toolsets", func(t *testing.T) {
 		testMultipleSpecificToolsets(t, obs, client)
 	})
+
+	t.Run("applies toolset options", func(t *testing.T) {
+		testToolsetOptions(t, obs, client)
+	})
+}
+
+func testToolsetOptions(t *testing.T, obs *observability.Observability,
+	client *rzpsdk.Client) {
+	toolsetGroup, err := NewToolSets(obs, client, []string{}, false,
+		WithToolsetDescription("payouts", "Marketplace vendor payouts"),
+		WithToolsetTags("payouts", "finance"),
+		WithToolsetTags("settlements", "finance", "reporting"),
+	)
+	if err != nil {
+		t.Fatalf("NewToolSets failed: %v", err)
+	}
+
+	payouts := toolsetGroup.Toolsets["payouts"]
+	if payouts.Description != "Marketplace vendor payouts" {
+		t.Errorf("Expected overridden description, got '%s'",
+			payouts.Description)
+	}
+	if !payouts.HasTag("finance") {
+		t.Error("Expected payouts toolset to be tagged finance")
+	}
+	if !toolsetGroup.Toolsets["settlements"].HasTag("reporting") {
+		t.Error("Expected settlements toolset to be tagged reporting")
+	}
+
+	_, err = NewToolSets(obs, client, []string{}, false,
+		WithToolsetTags("invalid_toolset", "finance"))
+	expectedError := "toolset invalid_toolset does not exist"
+	if err == nil || err.Error() != expectedError {
+		t.Errorf("Expected error '%s', got '%v'", expectedError, err)
+	}
 }
 
 func testCreateAllToolsets(t *testing.T, obs *observability.Observability,
@@ -58,7 +93,10 @@ func testCreateAllToolsets(t *testing.T, obs *observability.Observability,
 
 	expectedToolsets := []string{
 		"payments", "payment_links", "orders",
-		"refunds", "payouts", "qr_codes", "settlements",
+		"refunds", "payouts", "qr_codes", "settlements", "disputes",
+		"customers", "subscriptions", "plans", "virtual_accounts",
+		"items", "addons", "contacts", "fund_accounts",
+		"payout_links",
 	}
 
 	for _, name := range expectedToolsets {
@@ -0,0 +1,416 @@
+package razorpay
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"regexp"
+	"sort"
+	"strings"
+
+	rzpsdk "github.com/razorpay/razorpay-go"
+
+	"github.com/razorpay/razorpay-mcp-server/pkg/mcpgo"
+	"github.com/razorpay/razorpay-mcp-server/pkg/observability"
+)
+
+// IntegrationCheck is one item of the validate_integration checklist
+type IntegrationCheck struct {
+	Name   string `json:"name"`
+	Status string `json:"status"`
+	Detail string `json:"detail"`
+	Fix    string `json:"fix,omitempty"`
+}
+
+// ValidateIntegrationOutput is the response from validate_integration
+type ValidateIntegrationOutput struct {
+	Passed         bool               `json:"passed"`
+	Summary        string             `json:"summary"`
+	Checks         []IntegrationCheck `json:"checks"`
+	AIInstructions string             `json:"aiInstructions"`
+}
+
+// ValidateIntegration returns a tool that checks an applied checkout
+// integration against the project's files
+func ValidateIntegration(
+	obs *observability.Observability,
+	client *rzpsdk.Client,
+) mcpgo.Tool {
+	parameters := []mcpgo.ToolParameter{
+		mcpgo.WithObject(
+			"files",
+			mcpgo.Description("Current contents of the project files touched "+
+				"by the integration, keyed by path: the order/verify route "+
+				"files, the main server file, the checkout page and its "+
+				"script, and the env file if it exists. Example: "+
+				`{"server.js": "...", "routes/razorpay.js": "..."}`),
+			mcpgo.Required(),
+		),
+		mcpgo.WithString(
+			"checkoutPage",
+			mcpgo.Description("Path (a key of files) of the page where the "+
+				"customer pays. When set, that page must open the checkout"),
+		),
+	}
+
+	handler := func(
+		ctx context.Context,
+		r mcpgo.CallToolRequest,
+	) (*mcpgo.ToolResult, error) {
+		args, ok := r.Arguments.(map[string]interface{})
+		if !ok {
+			return mcpgo.NewToolResultError("Invalid arguments"), nil
+		}
+
+		rawFiles, ok := args["files"].(map[string]interface{})
+		if !ok || len(rawFiles) == 0 {
+			return mcpgo.NewToolResultError(
+				"missing required parameter: files"), nil
+		}
+		files := make(map[string]string, len(rawFiles))
+		for p, v := range rawFiles {
+			code, ok := v.(string)
+			if !ok {
+				return mcpgo.NewToolResultError(fmt.Sprintf(
+					"files[%q] must be the file contents as a string", p)), nil
+			}
+			files[p] = code
+		}
+
+		checkoutPage, _ := args["checkoutPage"].(string)
+		if checkoutPage != "" {
+			if _, ok := files[checkoutPage]; !ok {
+				return mcpgo.NewToolResultError(fmt.Sprintf(
+					"checkoutPage %s is not one of the files", checkoutPage)), nil
+			}
+		}
+
+		return mcpgo.NewToolResultJSON(
+			validateCheckoutIntegration(files, checkoutPage))
+	}
+
+	return mcpgo.NewTool(
+		"validate_integration",
+		"Check an applied Razorpay Checkout integration against the "+
+			"project's files. Verifies that the order and verify routes "+
+			"exist, that they are registered with the server, that the "+
+			"Razorpay env vars are read, and that the checkout page loads "+
+			"checkout.js and opens the checkout. Returns a pass/fail "+
+			"checklist with a fix for every failure. Run it after applying "+
+			"integrate_razorpay_checkout and fix every failed item.",
+		parameters,
+		handler,
+	)
+}
+
+var (
+	orderCreateCall = regexp.MustCompile(
+		`(?i)\borders?\.create\(|\bOrder\.Create\(|new OrderService\(`)
+	signatureVerifyCall = regexp.MustCompile(
+		`(?i)createHmac\(|hmac\.new\(|hmac\.New\(|HMACSHA256|` +
+			`verify_?payment_?signature|validatePaymentVerification|` +
+			`OpenSSL::HMAC|Utils\.verifyPaymentSignature`)
+	handlerDefinition = regexp.MustCompile(
+		`(?m)^(?:def|func) +(\w*(?:[Oo]rder|[Vv]erify|[Pp]ayment)\w*)`)
+	checkoutOpenCall = regexp.MustCompile(
+		`new (?:window\.)?Razorpay\(|RazorpayCheckout\.open\(|` +
+			`Razorpay\(\)\.open\(|_razorpay\.open\(|\.open\(options\)`)
+	checkoutScriptRef = regexp.MustCompile(
+		`checkout\.razorpay\.com/v1/checkout\.js|` +
+			`react-native-razorpay|razorpay_flutter`)
+)
+
+// fileBasedRoutePrefixes are directories whose files are routed by the
+// framework itself, so they need no registration in a server file
+var fileBasedRoutePrefixes = []string{
+	"app/api/", "src/app/api/", "pages/api/", "src/pages/api/",
+	"server/api/", "app/routes/",
+}
+
+// validateCheckoutIntegration runs every check against the project files
+func validateCheckoutIntegration(
+	files map[string]string,
+	checkoutPage string,
+) ValidateIntegrationOutput {
+	paths := make([]string, 0, len(files))
+	for p := range files {
+		paths = append(paths, p)
+	}
+	sort.Strings(paths)
+
+	orderFiles := filesMatching(files, paths, orderCreateCall.MatchString)
+	verifyFiles := filesMatching(files, paths, func(code string) bool {
+		return strings.Contains(code, "razorpay_signature") &&
+			signatureVerifyCall.MatchString(code)
+	})
+
+	checks := []IntegrationCheck{
+		checkRouteExists("order_route", orderFiles,
+			"creates a Razorpay order",
+			"Add the order endpoint from integrate_razorpay_checkout; it "+
+				"must call the Razorpay orders API on the server"),
+		checkRouteExists("verify_route", verifyFiles,
+			"verifies razorpay_signature with HMAC-SHA256",
+			"Add the verify endpoint from integrate_razorpay_checkout; "+
+				"never mark an order paid without checking the signature"),
+		checkRoutesRegistered(files, paths, append(orderFiles, verifyFiles...)),
+		checkEnvVars(files, paths),
+		checkCheckoutScript(files, paths),
+		checkCheckoutPage(files, paths, checkoutPage),
+	}
+
+	failed := 0
+	for _, c := range checks {
+		if c.Status == "fail" {
+			failed++
+		}
+	}
+
+	output := ValidateIntegrationOutput{
+		Passed: failed == 0,
+		Checks: checks,
+	}
+	if failed == 0 {
+		output.Summary = fmt.Sprintf("All %d checks passed.", len(checks))
+		output.AIInstructions = "The integration is wired up. Test a " +
+			"payment with the test card 4111 1111 1111 1111."
+		return output
+	}
+
+	output.Summary = fmt.Sprintf("%d of %d checks failed.", failed, len(checks))
+	output.AIInstructions = "The integration is NOT complete. Apply the " +
+		"fix of every failed check, then run validate_integration again " +
+		"with the updated files until every check passes. Do not hand " +
+		"the failures to the user as next steps."
+	return output
+}
+
+// filesMatching returns the paths whose contents match, in path order
+func filesMatching(
+	files map[string]string,
+	paths []string,
+	match func(string) bool,
+) []string {
+	var matched []string
+	for _, p := range paths {
+		if match(files[p]) {
+			matched = append(matched, p)
+		}
+	}
+	return matched
+}
+
+func checkRouteExists(
+	name string,
+	matched []string,
+	what string,
+	fix string,
+) IntegrationCheck {
+	if len(matched) == 0 {
+		return IntegrationCheck{
+			Name:   name,
+			Status: "fail",
+			Detail: "No file " + what,
+			Fix:    fix,
+		}
+	}
+	return IntegrationCheck{
+		Name:   name,
+		Status: "pass",
+		Detail: strings.Join(matched, ", ") + " " + what,
+	}
+}
+
+// checkRoutesRegistered checks that every route file is either routed by
+// the framework or referenced from another file, by module path or by the
+// name of a handler it defines
+func checkRoutesRegistered(
+	files map[string]string,
+	paths []string,
+	routeFiles []string,
+) IntegrationCheck {
+	check := IntegrationCheck{Name: "routes_registered"}
+	if len(routeFiles) == 0 {
+		check.Status = "fail"
+		check.Detail = "No order or verify route to register"
+		check.Fix = "Add the routes first, then register them with the server"
+		return check
+	}
+
+	seen := map[string]bool{}
+	var registered, unregistered []string
+	for _, routeFile := range routeFiles {
+		if seen[routeFile] {
+			continue
+		}
+		seen[routeFile] = true
+
+		if isFileBasedRoute(routeFile) ||
+			isRouteReferenced(files, paths, routeFile) {
+			registered = append(registered, routeFile)
+		} else {
+			unregistered = append(unregistered, routeFile)
+		}
+	}
+
+	if len(unregistered) > 0 {
+		check.Status = "fail"
+		check.Detail = strings.Join(unregistered, ", ") +
+			" is not imported or mounted by any other file"
+		check.Fix = "Import the routes in the main server file (e.g. " +
+			"app.use('/api/razorpay', razorpayRoutes) for Express, " +
+			"app.include_router for FastAPI, a url pattern for Django or " +
+			"r.POST for Go) and include that file in files"
+		return check
+	}
+	check.Status = "pass"
+	check.Detail = strings.Join(registered, ", ") + " is registered"
+	return check
+}
+
+func isFileBasedRoute(p string) bool {
+	for _, prefix := range fileBasedRoutePrefixes {
+		if strings.HasPrefix(p, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+func isRouteReferenced(
+	files map[string]string,
+	paths []string,
+	routeFile string,
+) bool {
+	module := strings.TrimSuffix(routeFile, path.Ext(routeFile))
+	refs := []string{module, strings.ReplaceAll(module, "/", ".")}
+	if !strings.Contains(module, "/") {
+		refs = []string{"import " + module, "from " + module,
+			"require('./" + module, `require("./` + module}
+	}
+	for _, m := range handlerDefinition.FindAllStringSubmatch(
+		files[routeFile], -1) {
+		refs = append(refs, m[1])
+	}
+
+	for _, p := range paths {
+		if p == routeFile {
+			continue
+		}
+		for _, ref := range refs {
+			if strings.Contains(files[p], ref) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// checkEnvVars checks that both keys are read by the code and, when an env
+// file is given, that it defines them
+func checkEnvVars(files map[string]string, paths []string) IntegrationCheck {
+	check := IntegrationCheck{Name: "env_vars"}
+	var missing []string
+	for _, name := range []string{"RAZORPAY_KEY_ID", "RAZORPAY_KEY_SECRET"} {
+		referenced, defined, hasEnvFile := false, false, false
+		for _, p := range paths {
+			if isEnvFile(p) {
+				hasEnvFile = true
+				if strings.Contains(files[p], name+"=") {
+					defined = true
+				}
+			} else if strings.Contains(files[p], name) {
+				referenced = true
+			}
+		}
+		if !referenced {
+			missing = append(missing, name+" is not read by the code")
+		}
+		if hasEnvFile && !defined {
+			missing = append(missing, name+" is not set in the env file")
+		}
+	}
+
+	if len(missing) > 0 {
+		check.Status = "fail"
+		check.Detail = strings.Join(missing, "; ")
+		check.Fix = "Read the keys from the environment on the server " +
+			"(e.g. process.env.RAZORPAY_KEY_ID) and set both in the env file"
+		return check
+	}
+	check.Status = "pass"
+	check.Detail = "RAZORPAY_KEY_ID and RAZORPAY_KEY_SECRET are read from " +
+		"the environment"
+	return check
+}
+
+func isEnvFile(p string) bool {
+	base := path.Base(p)
+	return base == ".env" || strings.HasPrefix(base, ".env.")
+}
+
+func checkCheckoutScript(
+	files map[string]string,
+	paths []string,
+) IntegrationCheck {
+	matched := filesMatching(files, paths, checkoutScriptRef.MatchString)
+	if len(matched) == 0 {
+		return IntegrationCheck{
+			Name:   "checkout_script",
+			Status: "fail",
+			Detail: "No file loads https://checkout.razorpay.com/v1/checkout.js",
+			Fix: "Add <script src=\"https://checkout.razorpay.com/v1/" +
+				"checkout.js\"></script> to the checkout page or its layout",
+		}
+	}
+	return IntegrationCheck{
+		Name:   "checkout_script",
+		Status: "pass",
+		Detail: "checkout.js is loaded in " + strings.Join(matched, ", "),
+	}
+}
+
+// checkCheckoutPage checks that the checkout page opens the checkout and
+// sends the result to the verify endpoint. Without a checkoutPage any file
+// that opens the checkout counts.
+func checkCheckoutPage(
+	files map[string]string,
+	paths []string,
+	checkoutPage string,
+) IntegrationCheck {
+	check := IntegrationCheck{Name: "checkout_page"}
+
+	if checkoutPage != "" {
+		if !strings.Contains(strings.ToLower(files[checkoutPage]), "razorpay") {
+			check.Status = "fail"
+			check.Detail = checkoutPage + " does not open the Razorpay checkout"
+			check.Fix = "Wire the pay button on " + checkoutPage + " to the " +
+				"generated checkout code so paying goes through Razorpay " +
+				"instead of placing the order directly"
+			return check
+		}
+	}
+
+	openers := filesMatching(files, paths, checkoutOpenCall.MatchString)
+	if len(openers) == 0 {
+		check.Status = "fail"
+		check.Detail = "No file opens the checkout with new Razorpay(options)"
+		check.Fix = "Add the frontend file from integrate_razorpay_checkout " +
+			"and include it in files"
+		return check
+	}
+
+	for _, p := range openers {
+		if strings.Contains(files[p], "verify") {
+			check.Status = "pass"
+			check.Detail = p + " opens the checkout and calls the verify endpoint"
+			return check
+		}
+	}
+	check.Status = "fail"
+	check.Detail = strings.Join(openers, ", ") + " opens the checkout but " +
+		"never calls the verify endpoint"
+	check.Fix = "POST razorpay_order_id, razorpay_payment_id and " +
+		"razorpay_signature from the success handler to the verify endpoint"
+	return check
+}
@@ -0,0 +1,239 @@
+package razorpay
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/razorpay/razorpay-mcp-server/pkg/mcpgo"
+)
+
+func runValidateIntegration(
+	t *testing.T,
+	args map[string]interface{},
+) ValidateIntegrationOutput {
+	t.Helper()
+
+	tool := ValidateIntegration(nil, nil)
+	result, err := tool.GetHandler()(
+		context.Background(),
+		mcpgo.CallToolRequest{Arguments: args},
+	)
+	assert.NoError(t, err)
+	assert.False(t, result.IsError, result.Text)
+
+	var output ValidateIntegrationOutput
+	assert.NoError(t, json.Unmarshal([]byte(result.Text), &output))
+	return output
+}
+
+// generatedExpressFiles applies integrate_razorpay_checkout for express the
+// way an agent would, returning the project files by path
+func generatedExpressFiles(t *testing.T) map[string]interface{} {
+	t.Helper()
+
+	output := runIntegrateCheckout(t, map[string]interface{}{
+		"language":          "javascript",
+		"backendFramework":  "express",
+		"frontendFramework": "vanilla",
+	})
+	files := map[string]interface{}{}
+	for _, f := range output.Files {
+		if f.Action == "create" {
+			files[f.Path] = f.Code
+		}
+	}
+	files["server.js"] = "require('dotenv').config();\n" +
+		"const express = require('express');\n" +
+		"const razorpayRoutes = require('./routes/razorpay');\n" +
+		"const app = express();\n" +
+		"app.use('/api/razorpay', razorpayRoutes);\n"
+	files["public/checkout.html"] = "<button id=\"pay\">Pay</button>\n" +
+		"<script src=\"https://checkout.razorpay.com/v1/checkout.js\"></script>\n" +
+		"<script src=\"/js/razorpay.js\"></script>\n"
+	files[".env"] = "RAZORPAY_KEY_ID=rzp_test_abc\nRAZORPAY_KEY_SECRET=secret\n"
+	return files
+}
+
+func checkStatuses(output ValidateIntegrationOutput) map[string]string {
+	statuses := map[string]string{}
+	for _, c := range output.Checks {
+		statuses[c.Name] = c.Status
+	}
+	return statuses
+}
+
+func TestValidateIntegration(t *testing.T) {
+	tests := []struct {
+		name       string
+		modify     func(files map[string]interface{})
+		page       string
+		wantFailed []string
+	}{
+		{
+			name: "generated express integration passes",
+		},
+		{
+			name: "routes not mounted in server.js",
+			modify: func(files map[string]interface{}) {
+				files["server.js"] = "const express = require('express');\n" +
+					"const app = express();\n"
+			},
+			wantFailed: []string{"routes_registered"},
+		},
+		{
+			name: "verify route missing",
+			modify: func(files map[string]interface{}) {
+				files["routes/razorpay.js"] = "router.post('/order', async () => {\n" +
+					"  await razorpay.orders.create({ amount });\n" +
+					"  process.env.RAZORPAY_KEY_ID; process.env.RAZORPAY_KEY_SECRET;\n" +
+					"});\n"
+			},
+			wantFailed: []string{"verify_route"},
+		},
+		{
+			name: "key secret not set in the env file",
+			modify: func(files map[string]interface{}) {
+				files[".env"] = "RAZORPAY_KEY_ID=rzp_test_abc\n"
+			},
+			wantFailed: []string{"env_vars"},
+		},
+		{
+			name: "checkout.js never loaded",
+			modify: func(files map[string]interface{}) {
+				files["public/checkout.html"] = "<button id=\"pay\">Pay</button>\n"
+				// The generated script loads checkout.js on demand
+				files["public/js/razorpay.js"] = strings.ReplaceAll(
+					files["public/js/razorpay.js"].(string),
+					"https://checkout.razorpay.com/v1/checkout.js", "")
+			},
+			wantFailed: []string{"checkout_script"},
+		},
+		{
+			name: "checkout page still places the order directly",
+			modify: func(files map[string]interface{}) {
+				files["public/cart.html"] = "<form action=\"/orders\" method=\"post\">" +
+					"<button>Place order (COD)</button></form>\n"
+			},
+			page:       "public/cart.html",
+			wantFailed: []string{"checkout_page"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			files := generatedExpressFiles(t)
+			if tt.modify != nil {
+				tt.modify(files)
+			}
+			args := map[string]interface{}{"files": files}
+			if tt.page != "" {
+				args["checkoutPage"] = tt.page
+			}
+
+			output := runValidateIntegration(t, args)
+
+			var failed []string
+			for name, status := range checkStatuses(output) {
+				if status == "fail" {
+					failed = append(failed, name)
+				}
+			}
+			assert.ElementsMatch(t, tt.wantFailed, failed)
+			assert.Equal(t, len(tt.wantFailed) == 0, output.Passed)
+			for _, c := range output.Checks {
+				if c.Status == "fail" {
+					assert.NotEmpty(t, c.Fix, c.Name)
+				}
+			}
+			if !output.Passed {
+				assert.Contains(t, output.AIInstructions, "NOT complete")
+			}
+		})
+	}
+}
+
+func TestValidateIntegration_RegisteredByHandlerName(t *testing.T) {
+	output := runIntegrateCheckout(t, map[string]interface{}{
+		"language":          "go",
+		"backendFramework":  "gin",
+		"frontendFramework": "vanilla",
+	})
+	files := map[string]interface{}{}
+	for _, f := range output.Files {
+		if f.Action == "create" {
+			files[f.Path] = f.Code
+		}
+	}
+	files["main.go"] = "package main\n\nfunc main() {\n" +
+		"\tr.POST(\"/api/razorpay/order\", handlers.CreateOrder)\n" +
+		"\tr.POST(\"/api/razorpay/verify\", handlers.VerifyPayment)\n}\n"
+	files["templates/checkout.html"] = "<script " +
+		"src=\"https://checkout.razorpay.com/v1/checkout.js\"></script>\n"
+
+	statuses := checkStatuses(runValidateIntegration(t, map[string]interface{}{
+		"files": files,
+	}))
+	assert.Equal(t, "pass", statuses["order_route"])
+	assert.Equal(t, "pass", statuses["verify_route"])
+	assert.Equal(t, "pass", statuses["routes_registered"])
+}
+
+func TestValidateIntegration_FileBasedRoutes(t *testing.T) {
+	files := map[string]interface{}{
+		"app/api/razorpay/order/route.ts": "const order = " +
+			"await razorpay.orders.create({ amount });\n" +
+			"process.env.RAZORPAY_KEY_ID; process.env.RAZORPAY_KEY_SECRET;\n",
+	}
+
+	statuses := checkStatuses(runValidateIntegration(t, map[string]interface{}{
+		"files": files,
+	}))
+	assert.Equal(t, "pass", statuses["routes_registered"])
+	assert.Equal(t, "fail", statuses["verify_route"])
+}
+
+func TestValidateIntegration_InvalidArguments(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    map[string]interface{}
+		wantErr string
+	}{
+		{
+			name:    "missing files",
+			args:    map[string]interface{}{},
+			wantErr: "missing required parameter: files",
+		},
+		{
+			name: "file contents not a string",
+			args: map[string]interface{}{
+				"files": map[string]interface{}{"server.js": 1},
+			},
+			wantErr: `files["server.js"] must be the file contents as a string`,
+		},
+		{
+			name: "unknown checkout page",
+			args: map[string]interface{}{
+				"files":        map[string]interface{}{"server.js": ""},
+				"checkoutPage": "checkout.html",
+			},
+			wantErr: "checkoutPage checkout.html is not one of the files",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tool := ValidateIntegration(nil, nil)
+			result, err := tool.GetHandler()(
+				context.Background(),
+				mcpgo.CallToolRequest{Arguments: tt.args},
+			)
+			assert.NoError(t, err)
+			assert.True(t, result.IsError)
+			assert.Equal(t, tt.wantErr, result.Text)
+		})
+	}
+}
@@ -0,0 +1,381 @@
+package razorpay
+
+import (
+	"context"
+	"fmt"
+
+	rzpsdk "github.com/razorpay/razorpay-go"
+
+	"github.com/razorpay/razorpay-mcp-server/pkg/mcpgo"
+	"github.com/razorpay/razorpay-mcp-server/pkg/observability"
+)
+
+// virtualAccountReceiverTypes are the receivers a virtual account can
+// accept payments on
+var virtualAccountReceiverTypes = map[string]bool{
+	"bank_account": true,
+	"vpa":          true,
+	"qr_code":      true,
+}
+
+// CreateVirtualAccount returns a tool that creates a Smart Collect virtual
+// account
+func CreateVirtualAccount(
+	obs *observability.Observability,
+	client *rzpsdk.Client,
+) mcpgo.Tool {
+	parameters := []mcpgo.ToolParameter{
+		mcpgo.WithArray(
+			"receiver_types",
+			mcpgo.Description("Receivers customers can pay the virtual "+
+				"account on. Possible values: 'bank_account', 'vpa', 'qr_code'"),
+			mcpgo.Required(),
+			mcpgo.Items(map[string]interface{}{
+				"type": "string",
+				"enum": []string{"bank_account", "vpa", "qr_code"},
+			}),
+		),
+		mcpgo.WithString(
+			"description",
+			mcpgo.Description("A brief description of the virtual account"),
+		),
+		mcpgo.WithString(
+			"customer_id",
+			mcpgo.Description("Unique identifier of the customer the virtual "+
+				"account is created for. For example, 'cust_00000000000001'"),
+		),
+		mcpgo.WithNumber(
+			"amount_expected",
+			mcpgo.Description("Amount the virtual account should accept, in "+
+				"the smallest currency sub-unit. Payments of any other amount "+
+				"are refunded automatically"),
+			mcpgo.Min(100),
+		),
+		mcpgo.WithNumber(
+			"close_by",
+			mcpgo.Description("Unix timestamp (in seconds) at which the "+
+				"virtual account is automatically closed"),
+			mcpgo.Min(0),
+		),
+		mcpgo.WithObject(
+			"notes",
+			mcpgo.Description("Key-value pairs that can be used to store "+
+				"additional information about the virtual account. A maximum "+
+				"of 15 key-value pairs, each of 256 characters (maximum) are "+
+				"allowed"),
+		),
+	}
+
+	handler := func(
+		ctx context.Context,
+		r mcpgo.CallToolRequest,
+	) (*mcpgo.ToolResult, error) {
+		client, err := getClientFromContextOrDefault(ctx, client)
+		if err != nil {
+			return mcpgo.NewToolResultError(err.Error()), nil
+		}
+
+		params := make(map[string]interface{})
+		va := make(map[string]interface{})
+
+		validator := NewValidator(&r).
+			ValidateAndAddRequiredArray(params, "receiver_types").
+			ValidateAndAddOptionalString(va, "description").
+			ValidateAndAddOptionalString(va, "customer_id").
+			ValidateAndAddOptionalInt(va, "amount_expected").
+			ValidateAndAddOptionalInt(va, "close_by").
+			ValidateAndAddOptionalNotes(va, "notes")
+
+		if result, err := validator.HandleErrorsIfAny(); result != nil {
+			return result, err
+		}
+
+		receiverTypes := params["receiver_types"].([]interface{})
+		if len(receiverTypes) == 0 {
+			return mcpgo.NewToolResultError(
+				"receiver_types must contain at least one receiver type"), nil
+		}
+		for _, rt := range receiverTypes {
+			if s, ok := rt.(string); !ok || !virtualAccountReceiverTypes[s] {
+				return mcpgo.NewToolResultError(fmt.Sprintf(
+					"invalid receiver type: %v. Must be one of bank_account, "+
+						"vpa or qr_code", rt)), nil
+			}
+		}
+		va["receivers"] = map[string]interface{}{
+			"types": receiverTypes,
+		}
+
+		virtualAccount, err := client.VirtualAccount.Create(va, nil)
+		if err != nil {
+			return mcpgo.NewToolResultError(
+				fmt.Sprintf("creating virtual account failed: %s",
+					err.Error())), nil
+		}
+
+		return mcpgo.NewToolResultJSON(virtualAccount)
+	}
+
+	return mcpgo.NewTool(
+		"create_virtual_account",
+		"Create a Smart Collect virtual account that customers can pay by "+
+			"bank transfer (NEFT/RTGS/IMPS), UPI or QR code",
+		parameters,
+		handler,
+	)
+}
+
+// FetchVirtualAccount returns a tool that fetches a virtual account by ID
+func FetchVirtualAccount(
+	obs *observability.Observability,
+	client *rzpsdk.Client,
+) mcpgo.Tool {
+	parameters := []mcpgo.ToolParameter{
+		mcpgo.WithString(
+			"virtual_account_id",
+			mcpgo.Description("Unique identifier of the virtual account. "+
+				"For example, 'va_00000000000001'"),
+			mcpgo.Required(),
+		),
+	}
+
+	handler := func(
+		ctx context.Context,
+		r mcpgo.CallToolRequest,
+	) (*mcpgo.ToolResult, error) {
+		client, err := getClientFromContextOrDefault(ctx, client)
+		if err != nil {
+			return mcpgo.NewToolResultError(err.Error()), nil
+		}
+
+		params := make(map[string]interface{})
+
+		validator := NewValidator(&r).
+			ValidateAndAddRequiredString(params, "virtual_account_id")
+
+		if result, err := validator.HandleErrorsIfAny(); result != nil {
+			return result, err
+		}
+
+		virtualAccount, err := client.VirtualAccount.Fetch(
+			params["virtual_account_id"].(string), nil, nil)
+		if err != nil {
+			return mcpgo.NewToolResultError(
+				fmt.Sprintf("fetching virtual account failed: %s",
+					err.Error())), nil
+		}
+
+		return mcpgo.NewToolResultJSON(virtualAccount)
+	}
+
+	return mcpgo.NewTool(
+		"fetch_virtual_account",
+		"Fetch a virtual account's details, including its receivers, "+
+			"using its ID",
+		parameters,
+		handler,
+	)
+}
+
+// FetchAllVirtualAccounts returns a tool that fetches all virtual accounts
+func FetchAllVirtualAccounts(
+	obs *observability.Observability,
+	client *rzpsdk.Client,
+) mcpgo.Tool {
+	parameters := []mcpgo.ToolParameter{
+		mcpgo.WithNumber(
+			"from",
+			mcpgo.Description("Unix timestamp (in seconds) from when "+
+				"virtual accounts are to be fetched"),
+			mcpgo.Min(0),
+		),
+		mcpgo.WithNumber(
+			"to",
+			mcpgo.Description("Unix timestamp (in seconds) up till when "+
+				"virtual accounts are to be fetched"),
+			mcpgo.Min(0),
+		),
+		mcpgo.WithNumber(
+			"count",
+			mcpgo.Description("Number of virtual accounts to be fetched "+
+				"(default: 10, max: 100)"),
+			mcpgo.Min(1),
+			mcpgo.Max(100),
+		),
+		mcpgo.WithNumber(
+			"skip",
+			mcpgo.Description("Number of virtual accounts to be skipped "+
+				"(default: 0)"),
+			mcpgo.Min(0),
+		),
+	}
+
+	handler := func(
+		ctx context.Context,
+		r mcpgo.CallToolRequest,
+	) (*mcpgo.ToolResult, error) {
+		client, err := getClientFromContextOrDefault(ctx, client)
+		if err != nil {
+			return mcpgo.NewToolResultError(err.Error()), nil
+		}
+
+		queryParams := make(map[string]interface{})
+
+		validator := NewValidator(&r).
+			ValidateAndAddOptionalInt(queryParams, "from").
+			ValidateAndAddOptionalInt(queryParams, "to").
+			ValidateAndAddPagination(queryParams)
+
+		if result, err := validator.HandleErrorsIfAny(); result != nil {
+			return result, err
+		}
+
+		virtualAccounts, err := client.VirtualAccount.All(queryParams, nil)
+		if err != nil {
+			return mcpgo.NewToolResultError(
+				fmt.Sprintf("fetching virtual accounts failed: %s",
+					err.Error())), nil
+		}
+
+		return mcpgo.NewToolResultJSON(virtualAccounts)
+	}
+
+	return mcpgo.NewTool(
+		"fetch_all_virtual_accounts",
+		"Fetch all virtual accounts with optional filtering and pagination",
+		parameters,
+		handler,
+	)
+}
+
+// CloseVirtualAccount returns a tool that closes a virtual account
+func CloseVirtualAccount(
+	obs *observability.Observability,
+	client *rzpsdk.Client,
+) mcpgo.Tool {
+	parameters := []mcpgo.ToolParameter{
+		mcpgo.WithString(
+			"virtual_account_id",
+			mcpgo.Description("Unique identifier of the virtual account to "+
+				"be closed. For example, 'va_00000000000001'"),
+			mcpgo.Required(),
+		),
+	}
+
+	handler := func(
+		ctx context.Context,
+		r mcpgo.CallToolRequest,
+	) (*mcpgo.ToolResult, error) {
+		client, err := getClientFromContextOrDefault(ctx, client)
+		if err != nil {
+			return mcpgo.NewToolResultError(err.Error()), nil
+		}
+
+		params := make(map[string]interface{})
+
+		validator := NewValidator(&r).
+			ValidateAndAddRequiredString(params, "virtual_account_id")
+
+		if result, err := validator.HandleErrorsIfAny(); result != nil {
+			return result, err
+		}
+
+		virtualAccount, err := client.VirtualAccount.Close(
+			params["virtual_account_id"].(string), nil, nil)
+		if err != nil {
+			return mcpgo.NewToolResultError(
+				fmt.Sprintf("closing virtual account failed: %s",
+					err.Error())), nil
+		}
+
+		return mcpgo.NewToolResultJSON(virtualAccount)
+	}
+
+	return mcpgo.NewTool(
+		"close_virtual_account",
+		"Close a virtual account so that it stops accepting payments. "+
+			"A closed virtual account cannot be reopened",
+		parameters,
+		handler,
+	)
+}
+
+// FetchPaymentsForVirtualAccount returns a tool that fetches the payments
+// made to a virtual account
+func FetchPaymentsForVirtualAccount(
+	obs *observability.Observability,
+	client *rzpsdk.Client,
+) mcpgo.Tool {
+	parameters := []mcpgo.ToolParameter{
+		mcpgo.WithString(
+			"virtual_account_id",
+			mcpgo.Description("Unique identifier of the virtual account. "+
+				"For example, 'va_00000000000001'"),
+			mcpgo.Required(),
+		),
+		mcpgo.WithNumber(
+			"from",
+			mcpgo.Description("Unix timestamp (in seconds) from when "+
+				"payments are to be fetched"),
+			mcpgo.Min(0),
+		),
+		mcpgo.WithNumber(
+			"to",
+			mcpgo.Description("Unix timestamp (in seconds) up till when "+
+				"payments are to be fetched"),
+			mcpgo.Min(0),
+		),
+		mcpgo.WithNumber(
+			"count",
+			mcpgo.Description("Number of payments to be fetched "+
+				"(default: 10, max: 100)"),
+			mcpgo.Min(1),
+			mcpgo.Max(100),
+		),
+		mcpgo.WithNumber(
+			"skip",
+			mcpgo.Description("Number of payments to be skipped (default: 0)"),
+			mcpgo.Min(0),
+		),
+	}
+
+	handler := func(
+		ctx context.Context,
+		r mcpgo.CallToolRequest,
+	) (*mcpgo.ToolResult, error) {
+		client, err := getClientFromContextOrDefault(ctx, client)
+		if err != nil {
+			return mcpgo.NewToolResultError(err.Error()), nil
+		}
+
+		params := make(map[string]interface{})
+		queryParams := make(map[string]interface{})
+
+		validator := NewValidator(&r).
+			ValidateAndAddRequiredString(params, "virtual_account_id").
+			ValidateAndAddOptionalInt(queryParams, "from").
+			ValidateAndAddOptionalInt(queryParams, "to").
+			ValidateAndAddPagination(queryParams)
+
+		if result, err := validator.HandleErrorsIfAny(); result != nil {
+			return result, err
+		}
+
+		payments, err := client.VirtualAccount.Payments(
+			params["virtual_account_id"].(string), queryParams, nil)
+		if err != nil {
+			return mcpgo.NewToolResultError(
+				fmt.Sprintf("fetching payments for virtual account failed: %s",
+					err.Error())), nil
+		}
+
+		return mcpgo.NewToolResultJSON(payments)
+	}
+
+	return mcpgo.NewTool(
+		"fetch_payments_for_virtual_account",
+		"Fetch the payments received on a virtual account",
+		parameters,
+		handler,
+	)
+}
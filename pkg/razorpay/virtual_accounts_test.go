@@ -0,0 +1,397 @@
+package razorpay
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/razorpay/razorpay-go/constants"
+
+	"github.com/razorpay/razorpay-mcp-server/pkg/razorpay/mock"
+)
+
+func Test_CreateVirtualAccount(t *testing.T) {
+	createVirtualAccountPath := fmt.Sprintf(
+		"/%s%s",
+		constants.VERSION_V1,
+		constants.VIRTUAL_ACCOUNT_URL,
+	)
+
+	virtualAccountResp := map[string]interface{}{
+		"id":          "va_00000000000001",
+		"entity":      "virtual_account",
+		"status":      "active",
+		"description": "Virtual account for Acme",
+		"customer_id": "cust_00000000000001",
+		"receivers": []interface{}{
+			map[string]interface{}{
+				"id":             "ba_00000000000001",
+				"entity":         "bank_account",
+				"ifsc":           "RATN0VAAPIS",
+				"account_number": "2223330099089860",
+			},
+		},
+	}
+
+	tests := []RazorpayToolTestCase{
+		{
+			Name: "successful virtual account creation",
+			Request: map[string]interface{}{
+				"receiver_types": []interface{}{"bank_account"},
+				"description":    "Virtual account for Acme",
+				"customer_id":    "cust_00000000000001",
+			},
+			MockHttpClient: func() (*http.Client, *httptest.Server) {
+				return mock.NewHTTPClient(
+					mock.Endpoint{
+						Path:     createVirtualAccountPath,
+						Method:   "POST",
+						Response: virtualAccountResp,
+					},
+				)
+			},
+			ExpectError:    false,
+			ExpectedResult: virtualAccountResp,
+		},
+		{
+			Name: "virtual account creation fails",
+			Request: map[string]interface{}{
+				"receiver_types": []interface{}{"vpa"},
+			},
+			MockHttpClient: func() (*http.Client, *httptest.Server) {
+				return mock.NewHTTPClient(
+					mock.Endpoint{
+						Path:   createVirtualAccountPath,
+						Method: "POST",
+						Response: map[string]interface{}{
+							"error": map[string]interface{}{
+								"code": "BAD_REQUEST_ERROR",
+								"description": "Smart Collect is not " +
+									"enabled for the merchant",
+							},
+						},
+					},
+				)
+			},
+			ExpectError: true,
+			ExpectedErrMsg: "creating virtual account failed: " +
+				"Smart Collect is not enabled for the merchant",
+		},
+		{
+			Name: "invalid receiver type",
+			Request: map[string]interface{}{
+				"receiver_types": []interface{}{"bank_account", "card"},
+			},
+			MockHttpClient: nil,
+			ExpectError:    true,
+			ExpectedErrMsg: "invalid receiver type: card. Must be one of " +
+				"bank_account, vpa or qr_code",
+		},
+		{
+			Name: "empty receiver types",
+			Request: map[string]interface{}{
+				"receiver_types": []interface{}{},
+			},
+			MockHttpClient: nil,
+			ExpectError:    true,
+			ExpectedErrMsg: "receiver_types must contain at least one " +
+				"receiver type",
+		},
+		{
+			Name:           "missing receiver_types parameter",
+			Request:        map[string]interface{}{},
+			MockHttpClient: nil,
+			ExpectError:    true,
+			ExpectedErrMsg: "missing required parameter: receiver_types",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.Name, func(t *testing.T) {
+			runToolTest(t, tc, CreateVirtualAccount, "Virtual Account")
+		})
+	}
+}
+
+func Test_FetchVirtualAccount(t *testing.T) {
+	fetchVirtualAccountPath := fmt.Sprintf(
+		"/%s%s/%s",
+		constants.VERSION_V1,
+		constants.VIRTUAL_ACCOUNT_URL,
+		"va_00000000000001",
+	)
+
+	virtualAccountResp := map[string]interface{}{
+		"id":          "va_00000000000001",
+		"entity":      "virtual_account",
+		"status":      "active",
+		"amount_paid": float64(0),
+	}
+
+	tests := []RazorpayToolTestCase{
+		{
+			Name: "successful virtual account fetch",
+			Request: map[string]interface{}{
+				"virtual_account_id": "va_00000000000001",
+			},
+			MockHttpClient: func() (*http.Client, *httptest.Server) {
+				return mock.NewHTTPClient(
+					mock.Endpoint{
+						Path:     fetchVirtualAccountPath,
+						Method:   "GET",
+						Response: virtualAccountResp,
+					},
+				)
+			},
+			ExpectError:    false,
+			ExpectedResult: virtualAccountResp,
+		},
+		{
+			Name: "virtual account not found",
+			Request: map[string]interface{}{
+				"virtual_account_id": "va_00000000000001",
+			},
+			MockHttpClient: func() (*http.Client, *httptest.Server) {
+				return mock.NewHTTPClient(
+					mock.Endpoint{
+						Path:   fetchVirtualAccountPath,
+						Method: "GET",
+						Response: map[string]interface{}{
+							"error": map[string]interface{}{
+								"code":        "BAD_REQUEST_ERROR",
+								"description": "The id provided does not exist",
+							},
+						},
+					},
+				)
+			},
+			ExpectError: true,
+			ExpectedErrMsg: "fetching virtual account failed: " +
+				"The id provided does not exist",
+		},
+		{
+			Name:           "missing virtual_account_id parameter",
+			Request:        map[string]interface{}{},
+			MockHttpClient: nil,
+			ExpectError:    true,
+			ExpectedErrMsg: "missing required parameter: virtual_account_id",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.Name, func(t *testing.T) {
+			runToolTest(t, tc, FetchVirtualAccount, "Virtual Account")
+		})
+	}
+}
+
+func Test_FetchAllVirtualAccounts(t *testing.T) {
+	fetchAllVirtualAccountsPath := fmt.Sprintf(
+		"/%s%s",
+		constants.VERSION_V1,
+		constants.VIRTUAL_ACCOUNT_URL,
+	)
+
+	virtualAccountsResp := map[string]interface{}{
+		"entity": "collection",
+		"count":  float64(1),
+		"items": []interface{}{
+			map[string]interface{}{
+				"id":     "va_00000000000001",
+				"entity": "virtual_account",
+				"status": "active",
+			},
+		},
+	}
+
+	tests := []RazorpayToolTestCase{
+		{
+			Name: "successful virtual accounts fetch",
+			Request: map[string]interface{}{
+				"count": float64(10),
+				"from":  float64(1700000000),
+			},
+			MockHttpClient: func() (*http.Client, *httptest.Server) {
+				return mock.NewHTTPClient(
+					mock.Endpoint{
+						Path:     fetchAllVirtualAccountsPath,
+						Method:   "GET",
+						Response: virtualAccountsResp,
+					},
+				)
+			},
+			ExpectError:    false,
+			ExpectedResult: virtualAccountsResp,
+		},
+		{
+			Name: "invalid count parameter",
+			Request: map[string]interface{}{
+				"count": "ten",
+			},
+			MockHttpClient: nil,
+			ExpectError:    true,
+			ExpectedErrMsg: "invalid parameter type: count",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.Name, func(t *testing.T) {
+			runToolTest(t, tc, FetchAllVirtualAccounts, "Virtual Accounts")
+		})
+	}
+}
+
+func Test_CloseVirtualAccount(t *testing.T) {
+	closeVirtualAccountPath := fmt.Sprintf(
+		"/%s%s/%s/close",
+		constants.VERSION_V1,
+		constants.VIRTUAL_ACCOUNT_URL,
+		"va_00000000000001",
+	)
+
+	virtualAccountResp := map[string]interface{}{
+		"id":        "va_00000000000001",
+		"entity":    "virtual_account",
+		"status":    "closed",
+		"closed_at": float64(1700000000),
+	}
+
+	tests := []RazorpayToolTestCase{
+		{
+			Name: "successful virtual account close",
+			Request: map[string]interface{}{
+				"virtual_account_id": "va_00000000000001",
+			},
+			MockHttpClient: func() (*http.Client, *httptest.Server) {
+				return mock.NewHTTPClient(
+					mock.Endpoint{
+						Path:     closeVirtualAccountPath,
+						Method:   "POST",
+						Response: virtualAccountResp,
+					},
+				)
+			},
+			ExpectError:    false,
+			ExpectedResult: virtualAccountResp,
+		},
+		{
+			Name: "virtual account already closed",
+			Request: map[string]interface{}{
+				"virtual_account_id": "va_00000000000001",
+			},
+			MockHttpClient: func() (*http.Client, *httptest.Server) {
+				return mock.NewHTTPClient(
+					mock.Endpoint{
+						Path:   closeVirtualAccountPath,
+						Method: "POST",
+						Response: map[string]interface{}{
+							"error": map[string]interface{}{
+								"code": "BAD_REQUEST_ERROR",
+								"description": "Virtual account is " +
+									"already closed",
+							},
+						},
+					},
+				)
+			},
+			ExpectError: true,
+			ExpectedErrMsg: "closing virtual account failed: " +
+				"Virtual account is already closed",
+		},
+		{
+			Name:           "missing virtual_account_id parameter",
+			Request:        map[string]interface{}{},
+			MockHttpClient: nil,
+			ExpectError:    true,
+			ExpectedErrMsg: "missing required parameter: virtual_account_id",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.Name, func(t *testing.T) {
+			runToolTest(t, tc, CloseVirtualAccount, "Virtual Account")
+		})
+	}
+}
+
+func Test_FetchPaymentsForVirtualAccount(t *testing.T) {
+	fetchPaymentsPath := fmt.Sprintf(
+		"/%s%s/%s/payments",
+		constants.VERSION_V1,
+		constants.VIRTUAL_ACCOUNT_URL,
+		"va_00000000000001",
+	)
+
+	paymentsResp := map[string]interface{}{
+		"entity": "collection",
+		"count":  float64(1),
+		"items": []interface{}{
+			map[string]interface{}{
+				"id":     "pay_00000000000001",
+				"entity": "payment",
+				"amount": float64(100000),
+				"method": "bank_transfer",
+				"status": "captured",
+			},
+		},
+	}
+
+	tests := []RazorpayToolTestCase{
+		{
+			Name: "successful payments fetch",
+			Request: map[string]interface{}{
+				"virtual_account_id": "va_00000000000001",
+				"count":              float64(10),
+			},
+			MockHttpClient: func() (*http.Client, *httptest.Server) {
+				return mock.NewHTTPClient(
+					mock.Endpoint{
+						Path:     fetchPaymentsPath,
+						Method:   "GET",
+						Response: paymentsResp,
+					},
+				)
+			},
+			ExpectError:    false,
+			ExpectedResult: paymentsResp,
+		},
+		{
+			Name: "payments fetch fails",
+			Request: map[string]interface{}{
+				"virtual_account_id": "va_00000000000001",
+			},
+			MockHttpClient: func() (*http.Client, *httptest.Server) {
+				return mock.NewHTTPClient(
+					mock.Endpoint{
+						Path:   fetchPaymentsPath,
+						Method: "GET",
+						Response: map[string]interface{}{
+							"error": map[string]interface{}{
+								"code":        "BAD_REQUEST_ERROR",
+								"description": "The id provided does not exist",
+							},
+						},
+					},
+				)
+			},
+			ExpectError: true,
+			ExpectedErrMsg: "fetching payments for virtual account failed: " +
+				"The id provided does not exist",
+		},
+		{
+			Name:           "missing virtual_account_id parameter",
+			Request:        map[string]interface{}{},
+			MockHttpClient: nil,
+			ExpectError:    true,
+			ExpectedErrMsg: "missing required parameter: virtual_account_id",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.Name, func(t *testing.T) {
+			runToolTest(t, tc, FetchPaymentsForVirtualAccount,
+				"Virtual Account Payments")
+		})
+	}
+}
@@ -10,6 +10,7 @@ import (
 type Toolset struct {
 	Name        string
 	Description string
+	Tags        []string
 	Enabled     bool
 	readOnly    bool
 	writeTools  []mcpgo.Tool
@@ -56,6 +57,36 @@ func (t *Toolset) AddReadTools(tools ...mcpgo.Tool) *Toolset {
 	return t
 }
 
+// WrapWriteTools wraps the handler of every write tool in the toolset
+func (t *Toolset) WrapWriteTools(
+	wrap func(handler mcpgo.ToolHandler) mcpgo.ToolHandler,
+) *Toolset {
+	for _, tool := range t.writeTools {
+		tool.WrapHandler(wrap)
+	}
+	return t
+}
+
+// AddTags attaches tags to the toolset, skipping ones it already has
+func (t *Toolset) AddTags(tags ...string) *Toolset {
+	for _, tag := range tags {
+		if !t.HasTag(tag) {
+			t.Tags = append(t.Tags, tag)
+		}
+	}
+	return t
+}
+
+// HasTag reports whether the toolset has the given tag
+func (t *Toolset) HasTag(tag string) bool {
+	for _, existing := range t.Tags {
+		if existing == tag {
+			return true
+		}
+	}
+	return false
+}
+
 // RegisterTools registers all active tools with the server
 func (t *Toolset) RegisterTools(s mcpgo.Server) {
 	if !t.Enabled {
@@ -40,6 +40,23 @@ func TestNewToolset(t *testing.T) {
 	})
 }
 
+func TestToolset_AddTags(t *testing.T) {
+	t.Run("adds tags without duplicates", func(t *testing.T) {
+		ts := NewToolset("test", "Test").
+			AddTags("finance", "codegen").
+			AddTags("finance")
+		assert.Equal(t, []string{"finance", "codegen"}, ts.Tags)
+		assert.True(t, ts.HasTag("codegen"))
+		assert.False(t, ts.HasTag("payments"))
+	})
+
+	t.Run("new toolset has no tags", func(t *testing.T) {
+		ts := NewToolset("test", "Test")
+		assert.Empty(t, ts.Tags)
+		assert.False(t, ts.HasTag("finance"))
+	})
+}
+
 func TestNewToolsetGroup(t *testing.T) {
 	t.Run("creates toolset group with readOnly false", func(t *testing.T) {
 		tg := NewToolsetGroup(false)
@@ -120,6 +137,39 @@ func TestToolset_AddWriteTools(t *testing.T) {
 	})
 }
 
+func TestToolset_WrapWriteTools(t *testing.T) {
+	handler := func(ctx context.Context,
+		req mcpgo.CallToolRequest) (*mcpgo.ToolResult, error) {
+		return mcpgo.NewToolResultText("result"), nil
+	}
+	readTool := mcpgo.NewTool("read", "Read", []mcpgo.ToolParameter{}, handler)
+	writeTool := mcpgo.NewTool("write", "Write", []mcpgo.ToolParameter{},
+		handler)
+
+	ts := NewToolset("test", "Test").
+		AddReadTools(readTool).
+		AddWriteTools(writeTool)
+	result := ts.WrapWriteTools(
+		func(next mcpgo.ToolHandler) mcpgo.ToolHandler {
+			return func(ctx context.Context,
+				req mcpgo.CallToolRequest) (*mcpgo.ToolResult, error) {
+				return mcpgo.NewToolResultError("blocked"), nil
+			}
+		})
+	assert.Equal(t, ts, result)
+
+	res, err := writeTool.GetHandler()(
+		context.Background(), mcpgo.CallToolRequest{})
+	assert.NoError(t, err)
+	assert.True(t, res.IsError)
+	assert.Equal(t, "blocked", res.Text)
+
+	res, err = readTool.GetHandler()(
+		context.Background(), mcpgo.CallToolRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, "result", res.Text)
+}
+
 func TestToolset_AddReadTools(t *testing.T) {
 	t.Run("adds read tools", func(t *testing.T) {
 		ts := NewToolset("test", "Test")